@@ -6,12 +6,21 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gidyon/machama-app/internal/blob"
 	chama_app "github.com/gidyon/machama-app/internal/chama"
 	"github.com/gidyon/machama-app/internal/chamamember"
+	"github.com/gidyon/machama-app/internal/fine"
+	"github.com/gidyon/machama-app/internal/invitation"
+	"github.com/gidyon/machama-app/internal/kyc"
 	loan_app "github.com/gidyon/machama-app/internal/loan"
 	loanproduct "github.com/gidyon/machama-app/internal/loanplan"
+	"github.com/gidyon/machama-app/internal/meeting"
+	"github.com/gidyon/machama-app/internal/memberexit"
 	"github.com/gidyon/machama-app/internal/models"
 	"github.com/gidyon/machama-app/internal/moneyaccount"
+	"github.com/gidyon/machama-app/internal/notification"
+	"github.com/gidyon/machama-app/internal/payout"
+	"github.com/gidyon/machama-app/internal/rotation"
 	transaction_app "github.com/gidyon/machama-app/internal/transaction"
 	"github.com/gidyon/machama-app/pkg/api/chama"
 	"github.com/gidyon/machama-app/pkg/api/loan"
@@ -23,6 +32,7 @@ import (
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/zaplogger"
 	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/pkg/api/messaging/sms"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	"go.uber.org/zap"
 
@@ -97,18 +107,144 @@ func main() {
 			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.ChamaAccount{}))
 		}
 
-		if !sqlDB.Migrator().HasTable(&models.ChamaMember{}) {
-			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.ChamaMember{}))
+		// Member tables created before identities were unique get the unique keys of open members
+		if !sqlDB.Migrator().HasTable(&models.ChamaMember{}) || !sqlDB.Migrator().HasIndex(&models.ChamaMember{}, "idx_chama_open_id_number") ||
+			!sqlDB.Migrator().HasIndex(&models.ChamaMember{}, "idx_chama_open_phone") {
+			errs.Panic(chamamember.MigrateMemberIdentities(sqlDB, logger))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.ContributionPlan{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.ContributionPlan{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.MemberContribution{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.MemberContribution{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.Rotation{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.Rotation{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.RotationSlot{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.RotationSlot{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.RotationCycle{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.RotationCycle{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.RotationContribution{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.RotationContribution{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.RotationBid{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.RotationBid{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.Meeting{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.Meeting{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.MeetingAttendance{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.MeetingAttendance{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.MeetingResolution{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.MeetingResolution{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.FineType{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.FineType{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.Fine{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.Fine{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.MemberInvitation{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.MemberInvitation{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.MemberKYC{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.MemberKYC{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.KYCDocument{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.KYCDocument{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.MemberExit{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.MemberExit{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.MemberExitLine{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.MemberExitLine{}))
 		}
 
 		if !sqlDB.Migrator().HasTable(&models.Loan{}) {
 			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.Loan{}))
 		}
 
+		if !sqlDB.Migrator().HasTable(&models.LoanInstallment{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.LoanInstallment{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.LoanCharge{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.LoanCharge{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.LoanPayout{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.LoanPayout{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.LoanFeeCharge{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.LoanFeeCharge{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.LoanApproval{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.LoanApproval{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.LoanVote{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.LoanVote{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.LoanWriteOff{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.LoanWriteOff{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.LoanPayoffQuote{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.LoanPayoffQuote{}))
+		}
+
 		if !sqlDB.Migrator().HasTable(&models.LoanProduct{}) {
 			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.LoanProduct{}))
 		}
 
+		if !sqlDB.Migrator().HasTable(&models.LoanProductVersion{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.LoanProductVersion{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.LoanCollateral{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.LoanCollateral{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.LoanHoliday{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.LoanHoliday{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.LoanRepayment{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.LoanRepayment{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.NotificationTemplate{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.NotificationTemplate{}))
+		}
+
+		if !sqlDB.Migrator().HasTable(&models.NotificationDelivery{}) {
+			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.NotificationDelivery{}))
+		}
+
 		if !sqlDB.Migrator().HasTable(&models.Transaction{}) {
 			errs.Panic(sqlDB.Migrator().AutoMigrate(&models.Transaction{}))
 		}
@@ -122,7 +258,7 @@ func main() {
 			PageHasher:    pageHasher,
 			Logger:        logger,
 			Auth:          authAPI,
-			AllowedGroups: append(authAPI.AdminGroups(), "TREASURER"),
+			AllowedGroups: authAPI.AdminGroups(),
 		})
 		errs.Panic(err)
 
@@ -135,7 +271,7 @@ func main() {
 			PageHasher:    pageHasher,
 			Logger:        logger,
 			Auth:          authAPI,
-			AllowedGroups: append(authAPI.AdminGroups(), "TREASURER", "CHAIRMAN"),
+			AllowedGroups: authAPI.AdminGroups(),
 		})
 		errs.Panic(err)
 
@@ -148,7 +284,7 @@ func main() {
 			PageHasher:    pageHasher,
 			Logger:        logger,
 			Auth:          authAPI,
-			AllowedGroups: append(authAPI.AdminGroups(), "TREASURER", "CHAIRMAN"),
+			AllowedGroups: authAPI.AdminGroups(),
 		})
 		errs.Panic(err)
 
@@ -161,13 +297,123 @@ func main() {
 			PageHasher:    pageHasher,
 			Logger:        logger,
 			Auth:          authAPI,
-			AllowedGroups: append(authAPI.AdminGroups(), "TREASURER", "CHAIRMAN"),
+			AllowedGroups: authAPI.AdminGroups(),
 		})
 		errs.Panic(err)
 
 		transaction.RegisterChamaAccountAPIServer(app.GRPCServer(), chamaAccountsAPI)
 		errs.Panic(transaction.RegisterChamaAccountAPIHandler(ctx, app.RuntimeMux(), app.ClientConn()))
 
+		// ROTATION API
+		rotationAPI, err := rotation.NewRotationAPI(ctx, &rotation.Options{
+			MoneyAccountAPI: chamaAccountsAPI,
+			TransactionAPI:  transactionAPI,
+			SQLDB:           sqlDB,
+			PageHasher:      pageHasher,
+			Logger:          logger,
+			Auth:            authAPI,
+			AllowedGroups:   authAPI.AdminGroups(),
+		})
+		errs.Panic(err)
+
+		chama.RegisterRotationAPIServer(app.GRPCServer(), rotationAPI)
+		errs.Panic(chama.RegisterRotationAPIHandler(ctx, app.RuntimeMux(), app.ClientConn()))
+
+		// MEETING API
+		meetingAPI, err := meeting.NewMeetingAPI(ctx, &meeting.Options{
+			MoneyAccountAPI: chamaAccountsAPI,
+			TransactionAPI:  transactionAPI,
+			SQLDB:           sqlDB,
+			PageHasher:      pageHasher,
+			Logger:          logger,
+			Auth:            authAPI,
+			AllowedGroups:   authAPI.AdminGroups(),
+		})
+		errs.Panic(err)
+
+		chama.RegisterMeetingAPIServer(app.GRPCServer(), meetingAPI)
+		errs.Panic(chama.RegisterMeetingAPIHandler(ctx, app.RuntimeMux(), app.ClientConn()))
+
+		// FINE API
+		fineAPI, err := fine.NewFineAPI(ctx, &fine.Options{
+			MoneyAccountAPI: chamaAccountsAPI,
+			TransactionAPI:  transactionAPI,
+			SQLDB:           sqlDB,
+			PageHasher:      pageHasher,
+			Logger:          logger,
+			Auth:            authAPI,
+			AllowedGroups:   authAPI.AdminGroups(),
+		})
+		errs.Panic(err)
+
+		chama.RegisterFineAPIServer(app.GRPCServer(), fineAPI)
+		errs.Panic(chama.RegisterFineAPIHandler(ctx, app.RuntimeMux(), app.ClientConn()))
+
+		// M-PESA B2C payouts are enabled when configured
+		var payoutProvider payout.Provider
+		if os.Getenv("MPESA_B2C_API_URL") != "" {
+			mpesaB2C, err := payout.NewMpesaB2C(&payout.MpesaOptions{
+				APIURL:             os.Getenv("MPESA_B2C_API_URL"),
+				ConsumerKey:        os.Getenv("MPESA_CONSUMER_KEY"),
+				ConsumerSecret:     os.Getenv("MPESA_CONSUMER_SECRET"),
+				InitiatorName:      os.Getenv("MPESA_B2C_INITIATOR_NAME"),
+				SecurityCredential: os.Getenv("MPESA_B2C_SECURITY_CREDENTIAL"),
+				ShortCode:          os.Getenv("MPESA_B2C_SHORT_CODE"),
+				ResultURL:          os.Getenv("MPESA_B2C_RESULT_URL"),
+				QueueTimeoutURL:    os.Getenv("MPESA_B2C_QUEUE_TIMEOUT_URL"),
+				CallbackToken:      os.Getenv("MPESA_B2C_CALLBACK_TOKEN"),
+			})
+			errs.Panic(err)
+
+			app.AddEndpoint("/api/machama/payouts/mpesa/b2c/result", mpesaB2C)
+
+			payoutProvider = mpesaB2C
+		}
+
+		// Repayment reminders and invitations go out through the sms service
+		smsConn, err := app.ExternalServiceConn("sms")
+		errs.Panic(err)
+
+		smsSender, err := notification.NewSMSSender(sms.NewSMSAPIClient(smsConn), "MACHAMA")
+		errs.Panic(err)
+
+		// INVITATION API
+		invitationAPI, err := invitation.NewInvitationAPI(ctx, &invitation.Options{
+			SQLDB:         sqlDB,
+			PageHasher:    pageHasher,
+			Logger:        logger,
+			Auth:          authAPI,
+			AllowedGroups: authAPI.AdminGroups(),
+			SMSSender:     smsSender,
+		})
+		errs.Panic(err)
+
+		chama.RegisterInvitationAPIServer(app.GRPCServer(), invitationAPI)
+		errs.Panic(chama.RegisterInvitationAPIHandler(ctx, app.RuntimeMux(), app.ClientConn()))
+
+		// KYC documents are kept on the local filesystem
+		kycDir := os.Getenv("KYC_DOCUMENTS_DIR")
+		if kycDir == "" {
+			kycDir = "kyc-documents"
+		}
+
+		kycStore, err := blob.NewLocal(kycDir)
+		errs.Panic(err)
+
+		// KYC API
+		kycAPI, err := kyc.NewKycAPI(ctx, &kyc.Options{
+			SQLDB:         sqlDB,
+			PageHasher:    pageHasher,
+			Logger:        logger,
+			Auth:          authAPI,
+			AllowedGroups: authAPI.AdminGroups(),
+			BlobStore:     kycStore,
+		})
+		errs.Panic(err)
+
+		chama.RegisterKycAPIServer(app.GRPCServer(), kycAPI)
+		errs.Panic(chama.RegisterKycAPIHandler(ctx, app.RuntimeMux(), app.ClientConn()))
+
 		// LOAN API
 		loanAPI, err := loan_app.NewLoanAPI(ctx, &loan_app.Options{
 			MoneyAccountAPI: chamaAccountsAPI,
@@ -176,20 +422,37 @@ func main() {
 			PageHasher:      pageHasher,
 			Logger:          logger,
 			Auth:            authAPI,
-			AllowedGroups:   append(authAPI.AdminGroups(), "TREASURER", "CHAIRMAN"),
+			AllowedGroups:   authAPI.AdminGroups(),
+			PayoutProvider:  payoutProvider,
+			SMSSender:       smsSender,
 		})
 		errs.Panic(err)
 
 		loan.RegisterLoanAPIServer(app.GRPCServer(), loanAPI)
 		errs.Panic(loan.RegisterLoanAPIHandler(ctx, app.RuntimeMux(), app.ClientConn()))
 
+		// MEMBER EXIT API
+		memberExitAPI, err := memberexit.NewMemberExitAPI(ctx, &memberexit.Options{
+			MoneyAccountAPI: chamaAccountsAPI,
+			TransactionAPI:  transactionAPI,
+			LoanAPI:         loanAPI,
+			SQLDB:           sqlDB,
+			Logger:          logger,
+			Auth:            authAPI,
+			AllowedGroups:   authAPI.AdminGroups(),
+		})
+		errs.Panic(err)
+
+		chama.RegisterMemberExitAPIServer(app.GRPCServer(), memberExitAPI)
+		errs.Panic(chama.RegisterMemberExitAPIHandler(ctx, app.RuntimeMux(), app.ClientConn()))
+
 		// LOAN PRODUCT API
 		LoanProductAPI, err := loanproduct.NewLoanProductAPI(ctx, &loanproduct.Options{
 			SQLDB:         sqlDB,
 			PageHasher:    pageHasher,
 			Logger:        logger,
 			Auth:          authAPI,
-			AllowedGroups: append(authAPI.AdminGroups(), "TREASURER", "CHAIRMAN"),
+			AllowedGroups: authAPI.AdminGroups(),
 		})
 		errs.Panic(err)
 
@@ -7,6 +7,7 @@ require (
 	github.com/gidyon/micro v1.12.0
 	github.com/gidyon/micro/v2 v2.4.0
 	github.com/gidyon/services v0.8.0
+	github.com/go-sql-driver/mysql v1.5.0
 	github.com/golang/protobuf v1.5.2
 	github.com/grpc-ecosystem/go-grpc-middleware v1.2.2
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.3.0
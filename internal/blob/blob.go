@@ -0,0 +1,32 @@
+// Package blob stores binary objects such as uploaded documents.
+package blob
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+// ErrNotFound is returned when no object is stored under a key
+var ErrNotFound = errors.New("blob not found")
+
+// Store keeps objects under keys chosen by the caller. Keys are slash separated paths such as "kyc/12/ID_FRONT-3".
+type Store interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// ValidateKey rejects keys that are empty or that could escape the root of a store
+func ValidateKey(key string) error {
+	switch {
+	case key == "":
+		return errs.MissingField("blob key")
+	case strings.HasPrefix(key, "/"), path.Clean(key) != key, key == "..", strings.HasPrefix(key, "../"):
+		return errs.IncorrectVal("blob key")
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Local stores objects as files under a directory
+type Local struct {
+	dir string
+}
+
+// NewLocal creates a store rooted at dir, creating the directory when missing
+func NewLocal(dir string) (*Local, error) {
+	if dir == "" {
+		return nil, errors.New("missing blob directory")
+	}
+
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Local{dir: dir}, nil
+}
+
+func (local *Local) path(key string) string {
+	return filepath.Join(local.dir, filepath.FromSlash(key))
+}
+
+// Put writes to a temporary file first so that readers never see a partly written object
+func (local *Local) Put(ctx context.Context, key string, data []byte) error {
+	err := ValidateKey(key)
+	if err != nil {
+		return err
+	}
+
+	name := local.path(key)
+
+	err = os.MkdirAll(filepath.Dir(name), 0700)
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(data)
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), name)
+}
+
+func (local *Local) Get(ctx context.Context, key string) ([]byte, error) {
+	err := ValidateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	bs, err := ioutil.ReadFile(local.path(key))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+
+	return bs, err
+}
+
+func (local *Local) Delete(ctx context.Context, key string) error {
+	err := ValidateKey(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(local.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	return err
+}
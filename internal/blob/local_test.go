@@ -0,0 +1,69 @@
+package blob
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Local blob store", func() {
+	var (
+		dir   string
+		store *Local
+		ctx   = context.Background()
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "blob")
+		Expect(err).ShouldNot(HaveOccurred())
+		store, err = NewLocal(dir)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("should fail to create a store without a directory", func() {
+		_, err := NewLocal("")
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("should read back what was put", func() {
+		Expect(store.Put(ctx, "kyc/1/ID_FRONT", []byte("front"))).Should(Succeed())
+		bs, err := store.Get(ctx, "kyc/1/ID_FRONT")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(bs)).Should(Equal("front"))
+	})
+
+	It("should overwrite an existing object", func() {
+		Expect(store.Put(ctx, "kyc/1/SELFIE", []byte("old"))).Should(Succeed())
+		Expect(store.Put(ctx, "kyc/1/SELFIE", []byte("new"))).Should(Succeed())
+		bs, err := store.Get(ctx, "kyc/1/SELFIE")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(bs)).Should(Equal("new"))
+	})
+
+	It("should report missing objects", func() {
+		_, err := store.Get(ctx, "kyc/1/missing")
+		Expect(err).Should(Equal(ErrNotFound))
+	})
+
+	It("should delete objects", func() {
+		Expect(store.Put(ctx, "kyc/1/ID_BACK", []byte("back"))).Should(Succeed())
+		Expect(store.Delete(ctx, "kyc/1/ID_BACK")).Should(Succeed())
+		Expect(store.Delete(ctx, "kyc/1/ID_BACK")).Should(Succeed())
+		_, err := store.Get(ctx, "kyc/1/ID_BACK")
+		Expect(err).Should(Equal(ErrNotFound))
+	})
+
+	It("should reject keys outside the store", func() {
+		for _, key := range []string{"", "/etc/passwd", "../secret", "kyc/../../secret", "kyc//1"} {
+			Expect(store.Put(ctx, key, []byte("x"))).ShouldNot(Succeed(), key)
+		}
+	})
+})
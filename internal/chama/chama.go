@@ -3,8 +3,10 @@ package chama
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
 	"github.com/gidyon/machama-app/pkg/api/chama"
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
 	"github.com/gidyon/micro/v2/utils/errs"
@@ -17,16 +19,18 @@ import (
 )
 
 type Options struct {
-	SQLDB         *gorm.DB
-	PageHasher    *hashids.HashID
-	Logger        grpclog.LoggerV2
-	Auth          auth.API
-	AllowedGroups []string
+	SQLDB                *gorm.DB
+	PageHasher           *hashids.HashID
+	Logger               grpclog.LoggerV2
+	Auth                 auth.API
+	AllowedGroups        []string
+	ContributionInterval time.Duration
 }
 
 type chamaAPIServer struct {
 	chama.UnimplementedChamaAPIServer
 	*Options
+	authorizer *roles.Authorizer
 }
 
 func NewChamaAPI(ctx context.Context, opt *Options) (chama.ChamaAPIServer, error) {
@@ -48,12 +52,19 @@ func NewChamaAPI(ctx context.Context, opt *Options) (chama.ChamaAPIServer, error
 		if len(opt.AllowedGroups) == 0 {
 			opt.AllowedGroups = opt.Auth.AdminGroups()
 		}
+		if opt.ContributionInterval == 0 {
+			opt.ContributionInterval = time.Hour
+		}
 	}
 
 	chamaAPI := &chamaAPIServer{
-		Options: opt,
+		Options:    opt,
+		authorizer: roles.NewAuthorizer(opt.Auth, opt.SQLDB, opt.AllowedGroups),
 	}
 
+	// Expect and match member contributions in background
+	go chamaAPI.runContributions(ctx)
+
 	return chamaAPI, nil
 }
 
@@ -105,12 +116,6 @@ func (chamaAPI *chamaAPIServer) CreateChama(
 func (chamaAPI *chamaAPIServer) UpdateChama(
 	ctx context.Context, req *chama.UpdateChamaRequest,
 ) (*emptypb.Empty, error) {
-	// Authorization
-	_, err := chamaAPI.Auth.AuthorizeGroup(ctx, chamaAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validate
 	switch {
 	case req == nil:
@@ -121,6 +126,12 @@ func (chamaAPI *chamaAPIServer) UpdateChama(
 		return nil, errs.MissingField("chama id")
 	}
 
+	// Authorization
+	_, err := chamaAPI.authorizer.AuthorizeChama(ctx, req.Chama.ChamaId, roles.Secretary...)
+	if err != nil {
+		return nil, err
+	}
+
 	db, err := models.ChamaModel(req.Chama)
 	if err != nil {
 		return nil, err
@@ -139,18 +150,18 @@ const defaultPageSize = 50
 func (chamaAPI *chamaAPIServer) ListChamas(
 	ctx context.Context, req *chama.ListChamasRequest,
 ) (*chama.ListChamasResponse, error) {
-	// Authorization
-	actor, err := chamaAPI.Auth.AuthorizeGroup(ctx, chamaAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validation
 	switch {
 	case req == nil:
 		return nil, errs.NilObject("list request")
 	}
 
+	// Authorization
+	actor, chamaIDs, err := chamaAPI.authorizer.ScopeChamas(ctx, nil, roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
 	pageSize := req.GetPageSize()
 	switch {
 	case pageSize <= 0:
@@ -176,6 +187,11 @@ func (chamaAPI *chamaAPIServer) ListChamas(
 		db = db.Where("id<?", ID)
 	}
 
+	// Actors only see chamas they are members of
+	if chamaIDs != nil {
+		db = db.Where("id IN (?)", chamaIDs)
+	}
+
 	// Apply tv filters
 	if req.Filter != nil {
 		if len(req.Filter.CreatorIds) != 0 {
@@ -225,12 +241,6 @@ func (chamaAPI *chamaAPIServer) ListChamas(
 func (chamaAPI *chamaAPIServer) GetChama(
 	ctx context.Context, req *chama.GetChamaRequest,
 ) (*chama.Chama, error) {
-	// Authorization
-	_, err := chamaAPI.Auth.AuthorizeGroup(ctx, chamaAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validation
 	switch {
 	case req == nil:
@@ -239,6 +249,12 @@ func (chamaAPI *chamaAPIServer) GetChama(
 		return nil, errs.MissingField("chama id")
 	}
 
+	// Authorization
+	_, err := chamaAPI.authorizer.AuthorizeChama(ctx, req.ChamaId, roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
 	db := &models.Chama{}
 
 	err = chamaAPI.SQLDB.First(db, "id = ?", req.ChamaId).Error
@@ -28,6 +28,13 @@ var (
 	ChamaAPI       chama.ChamaAPIServer
 	modelsStructs  = []interface{}{
 		&models.Chama{},
+		&models.ChamaMember{},
+		&models.ChamaAccount{},
+		&models.Transaction{},
+		&models.ContributionPlan{},
+		&models.MemberContribution{},
+		&models.FineType{},
+		&models.Fine{},
 	}
 	schema = "machama"
 )
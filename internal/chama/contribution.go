@@ -0,0 +1,551 @@
+package chama
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/fine"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	contributionDateLayout = "2006-01-02"
+	amountTolerance        = 0.005
+)
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// ValidateContributionPlan validates a contribution plan. Due day is a weekday from 1 (Monday) to 7 (Sunday) for weekly
+// and fortnightly plans and a day of the month from 1 to 28 for monthly plans.
+func ValidateContributionPlan(pb *chama.ContributionPlan) error {
+	switch {
+	case pb == nil:
+		return errs.MissingField("contribution plan")
+	case pb.ChamaId == "":
+		return errs.MissingField("chama id")
+	case pb.Amount <= 0:
+		return errs.IncorrectVal("contribution amount")
+	case pb.LateFine < 0:
+		return errs.IncorrectVal("late fine")
+	}
+
+	switch pb.Frequency {
+	case chama.ContributionFrequency_WEEKLY, chama.ContributionFrequency_FORTNIGHTLY:
+		if pb.DueDay < 1 || pb.DueDay > 7 {
+			return errs.IncorrectVal("contribution due day")
+		}
+	case chama.ContributionFrequency_MONTHLY:
+		if pb.DueDay < 1 || pb.DueDay > 28 {
+			return errs.IncorrectVal("contribution due day")
+		}
+	default:
+		return errs.MissingField("contribution frequency")
+	}
+
+	return nil
+}
+
+// dueDates are the due dates of the plan from one day to another, both inclusive
+func dueDates(planDB *models.ContributionPlan, from, to time.Time) []time.Time {
+	start := startOfDay(planDB.StartDate)
+	from, to = startOfDay(from), startOfDay(to)
+
+	dates := make([]time.Time, 0)
+
+	if planDB.Frequency == chama.ContributionFrequency_MONTHLY.String() {
+		year, month, _ := start.Date()
+		for date := time.Date(year, month, int(planDB.DueDay), 0, 0, 0, 0, start.Location()); !date.After(to); {
+			if !date.Before(start) && !date.Before(from) {
+				dates = append(dates, date)
+			}
+			month++
+			date = time.Date(year, month, int(planDB.DueDay), 0, 0, 0, 0, start.Location())
+		}
+		return dates
+	}
+
+	step := 7
+	if planDB.Frequency == chama.ContributionFrequency_FORTNIGHTLY.String() {
+		step = 14
+	}
+
+	// Weekdays of the plan start from Monday
+	offset := (int(time.Weekday(planDB.DueDay%7)) - int(start.Weekday()) + 7) % 7
+	for date := start.AddDate(0, 0, offset); !date.After(to); date = date.AddDate(0, 0, step) {
+		if !date.Before(from) {
+			dates = append(dates, date)
+		}
+	}
+
+	return dates
+}
+
+func (chamaAPI *chamaAPIServer) runContributions(ctx context.Context) {
+	ticker := time.NewTicker(chamaAPI.ContributionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			plans := make([]*models.ContributionPlan, 0)
+			err := chamaAPI.SQLDB.Find(&plans, "active = ?", true).Error
+			if err != nil {
+				chamaAPI.Logger.Errorf("failed to get contribution plans: %v", err)
+				continue
+			}
+			for _, planDB := range plans {
+				err = chamaAPI.refreshContributions(planDB, time.Now())
+				if err != nil {
+					chamaAPI.Logger.Errorf("failed to update contributions of chama %s: %v", planDB.ChamaID, err)
+				}
+			}
+		}
+	}
+}
+
+// refreshContributions expects contributions from active members on each due date so far and matches them against member deposits
+func (chamaAPI *chamaAPIServer) refreshContributions(planDB *models.ContributionPlan, now time.Time) error {
+	if planDB.Active {
+		members := make([]*models.ChamaMember, 0)
+		err := chamaAPI.SQLDB.Find(&members, "chama_id = ? AND active = ?", planDB.ChamaID, true).Error
+		if err != nil {
+			return errs.FailedToFind("chama members", err)
+		}
+
+		for _, memberDB := range members {
+			from := planDB.StartDate
+			if memberDB.CreatedAt.After(from) {
+				from = memberDB.CreatedAt
+			}
+
+			contributions := make([]*models.MemberContribution, 0)
+			for _, dueDate := range dueDates(planDB, from, now) {
+				contributions = append(contributions, &models.MemberContribution{
+					PlanID:    planDB.ID,
+					ChamaID:   planDB.ChamaID,
+					MemberID:  fmt.Sprint(memberDB.ID),
+					DueDate:   dueDate,
+					AmountDue: planDB.Amount,
+				})
+			}
+
+			if len(contributions) == 0 {
+				continue
+			}
+
+			// Contributions expected earlier keep the amount they were expected with
+			err = chamaAPI.SQLDB.Clauses(clause.OnConflict{DoNothing: true}).Create(contributions).Error
+			if err != nil {
+				return errs.FailedToSave("member contributions", err)
+			}
+		}
+	}
+
+	memberIDs := make([]string, 0)
+	err := chamaAPI.SQLDB.Model(&models.MemberContribution{}).Distinct("member_id").
+		Where("plan_id = ?", planDB.ID).Pluck("member_id", &memberIDs).Error
+	if err != nil {
+		return errs.FailedToFind("member contributions", err)
+	}
+
+	for _, memberID := range memberIDs {
+		err = chamaAPI.matchContributions(planDB, memberID, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type memberDeposit struct {
+	TransactionAmount float64
+	CreatedAt         time.Time
+}
+
+// matchContributions allocates member deposits made since the plan started to contributions in order of due date.
+// Each contribution is paid before its fine. Contributions paid in full after their due date attract the late fine of the plan,
+// which is issued to the member as a fine and paid from deposits like the contribution. Waived fines aren't paid.
+func (chamaAPI *chamaAPIServer) matchContributions(planDB *models.ContributionPlan, memberID string, now time.Time) error {
+	contributions := make([]*models.MemberContribution, 0)
+	err := chamaAPI.SQLDB.Order("due_date ASC").Find(&contributions, "plan_id = ? AND member_id = ?", planDB.ID, memberID).Error
+	if err != nil {
+		return errs.FailedToFind("member contributions", err)
+	}
+
+	fineIDs := make([]uint, 0)
+	for _, contribution := range contributions {
+		if contribution.FineID != 0 {
+			fineIDs = append(fineIDs, contribution.FineID)
+		}
+	}
+
+	waived := make(map[uint]bool)
+	if len(fineIDs) != 0 {
+		waivedIDs := make([]uint, 0)
+		err = chamaAPI.SQLDB.Model(&models.Fine{}).Where("id IN (?) AND status = ?", fineIDs, chama.FineStatus_FINE_WAIVED.String()).
+			Pluck("id", &waivedIDs).Error
+		if err != nil {
+			return errs.FailedToFind("fines", err)
+		}
+		for _, fineID := range waivedIDs {
+			waived[fineID] = true
+		}
+	}
+
+	db := chamaAPI.SQLDB.Model(&models.Transaction{}).Select("transactions.transaction_amount, transactions.created_at").
+		Joins("JOIN chama_accounts ON chama_accounts.id = transactions.account_id").
+		Where("chama_accounts.owner_id = ? AND transactions.transaction_type = ?", memberID, transaction.TransactionType_DEPOSIT.String()).
+		Where("transactions.created_at >= ?", startOfDay(planDB.StartDate))
+	if planDB.AccountName != "" {
+		db = db.Where("chama_accounts.account_name = ?", planDB.AccountName)
+	}
+
+	deposits := make([]*memberDeposit, 0)
+	err = db.Order("transactions.created_at ASC, transactions.id ASC").Scan(&deposits).Error
+	if err != nil {
+		return errs.FailedToFind("member deposits", err)
+	}
+
+	var (
+		next int
+		left float64
+	)
+	if len(deposits) != 0 {
+		left = deposits[0].TransactionAmount
+	}
+
+	// pay takes up to amount from deposits in the order they were made, returning what was paid and when the last deposit used was made
+	pay := func(amount float64) (float64, time.Time) {
+		var (
+			paid   float64
+			paidAt time.Time
+		)
+		for amount-paid > amountTolerance && next < len(deposits) {
+			part := math.Min(left, amount-paid)
+			paid += part
+			left -= part
+			paidAt = deposits[next].CreatedAt
+			if left <= amountTolerance {
+				next++
+				if next < len(deposits) {
+					left = deposits[next].TransactionAmount
+				}
+			}
+		}
+		return roundAmount(paid), paidAt
+	}
+
+	for _, contribution := range contributions {
+		amountPaid, lastPaid := pay(contribution.AmountDue)
+
+		var paidAt *time.Time
+		if contribution.AmountDue-amountPaid <= amountTolerance {
+			paidAt = &lastPaid
+		}
+
+		// Fines stay at the amount they were charged with
+		fineAmount := contribution.FineAmount
+		lateAfter := contribution.DueDate.AddDate(0, 0, 1)
+		if fineAmount == 0 && contribution.FineID == 0 &&
+			((paidAt != nil && !paidAt.Before(lateAfter)) || (paidAt == nil && !now.Before(lateAfter))) {
+			fineAmount = planDB.LateFine
+		}
+		if waived[contribution.FineID] {
+			fineAmount = 0
+		}
+
+		finePaid, _ := pay(fineAmount)
+
+		if amountPaid == contribution.AmountPaid && fineAmount == contribution.FineAmount && finePaid == contribution.FinePaid &&
+			(paidAt == nil) == (contribution.PaidAt == nil) {
+			continue
+		}
+
+		err = chamaAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+			fineID, err := chamaAPI.chargeLateFine(tx, planDB, contribution, fineAmount, finePaid)
+			if err != nil {
+				return err
+			}
+
+			err = tx.Model(contribution).Updates(map[string]interface{}{
+				"amount_paid": amountPaid,
+				"fine_amount": fineAmount,
+				"fine_paid":   finePaid,
+				"fine_id":     fineID,
+				"paid_at":     paidAt,
+			}).Error
+			if err != nil {
+				return errs.FailedToUpdate("member contribution", err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// chargeLateFine issues the late fine of a contribution to the member the first time it is charged, and afterwards keeps
+// the fine paid as far as deposits have paid it
+func (chamaAPI *chamaAPIServer) chargeLateFine(
+	tx *gorm.DB, planDB *models.ContributionPlan, contribution *models.MemberContribution, amount, paid float64,
+) (uint, error) {
+	if contribution.FineID != 0 {
+		if amount == 0 {
+			return contribution.FineID, nil
+		}
+
+		updates := map[string]interface{}{"paid_amount": paid}
+		if amount-paid <= amountTolerance {
+			updates["status"] = chama.FineStatus_FINE_PAID.String()
+			updates["paid_at"] = time.Now()
+		}
+
+		// Fines paid some other way, such as on exit, stay paid
+		err := tx.Model(&models.Fine{}).
+			Where("id = ? AND status = ?", contribution.FineID, chama.FineStatus_FINE_OUTSTANDING.String()).
+			Updates(updates).Error
+		if err != nil {
+			return 0, errs.FailedToUpdate("fine", err)
+		}
+
+		return contribution.FineID, nil
+	}
+
+	if amount == 0 {
+		return 0, nil
+	}
+
+	memberDB := &models.ChamaMember{}
+	err := tx.First(memberDB, "id = ?", contribution.MemberID).Error
+	if err != nil {
+		return 0, errs.FailedToFind("chama member", err)
+	}
+
+	fineDB := &models.Fine{
+		ChamaID:     contribution.ChamaID,
+		MemberID:    contribution.MemberID,
+		MemberNames: memberDB.FirstName + " " + memberDB.LastName,
+		FineName:    fine.LateContributionFine,
+		AccountName: planDB.AccountName,
+		Amount:      amount,
+		PaidAmount:  paid,
+		Reason:      "Contribution due on " + contribution.DueDate.Format(contributionDateLayout) + " paid late",
+	}
+
+	err = fine.Charge(tx, fineDB)
+	if err != nil {
+		return 0, err
+	}
+
+	return fineDB.ID, nil
+}
+
+func (chamaAPI *chamaAPIServer) contributionPlan(chamaID string) (*models.ContributionPlan, error) {
+	planDB := &models.ContributionPlan{}
+	err := chamaAPI.SQLDB.First(planDB, "chama_id = ?", chamaID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("contribution plan", chamaID)
+	default:
+		return nil, errs.FailedToFind("contribution plan", err)
+	}
+	return planDB, nil
+}
+
+func (chamaAPI *chamaAPIServer) SetContributionPlan(
+	ctx context.Context, req *chama.SetContributionPlanRequest,
+) (*chama.ContributionPlan, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	default:
+		err := ValidateContributionPlan(req.Plan)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Authorization
+	_, err := chamaAPI.authorizer.AuthorizeChama(ctx, req.Plan.ChamaId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = chamaAPI.SQLDB.First(&models.Chama{}, "id = ?", req.Plan.ChamaId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("chama", req.Plan.ChamaId)
+	default:
+		return nil, errs.FailedToFind("chama", err)
+	}
+
+	db, err := models.ContributionPlanModel(req.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	if db.StartDate.IsZero() {
+		db.StartDate = startOfDay(time.Now())
+	}
+
+	planDB := &models.ContributionPlan{}
+	err = chamaAPI.SQLDB.First(planDB, "chama_id = ?", db.ChamaID).Error
+	switch {
+	case err == nil:
+		err = chamaAPI.SQLDB.Model(planDB).
+			Select("account_name", "amount", "frequency", "due_day", "late_fine", "start_date", "active").Updates(db).Error
+		if err != nil {
+			return nil, errs.FailedToUpdate("contribution plan", err)
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		planDB = db
+		err = chamaAPI.SQLDB.Create(planDB).Error
+		if err != nil {
+			return nil, errs.FailedToSave("contribution plan", err)
+		}
+	default:
+		return nil, errs.FailedToFind("contribution plan", err)
+	}
+
+	return models.ContributionPlanProto(planDB)
+}
+
+func (chamaAPI *chamaAPIServer) GetContributionPlan(
+	ctx context.Context, req *chama.GetContributionPlanRequest,
+) (*chama.ContributionPlan, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.ChamaId == "":
+		return nil, errs.MissingField("chama id")
+	}
+
+	// Authorization
+	_, err := chamaAPI.authorizer.AuthorizeChama(ctx, req.ChamaId, roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
+	planDB, err := chamaAPI.contributionPlan(req.ChamaId)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.ContributionPlanProto(planDB)
+}
+
+func (chamaAPI *chamaAPIServer) ListContributionArrears(
+	ctx context.Context, req *chama.ListContributionArrearsRequest,
+) (*chama.ListContributionArrearsResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.ChamaId == "":
+		return nil, errs.MissingField("chama id")
+	}
+
+	// Authorization
+	_, err := chamaAPI.authorizer.AuthorizeChama(ctx, req.ChamaId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	planDB, err := chamaAPI.contributionPlan(req.ChamaId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	err = chamaAPI.refreshContributions(planDB, now)
+	if err != nil {
+		return nil, err
+	}
+
+	today := startOfDay(now)
+
+	contributions := make([]*models.MemberContribution, 0)
+	err = chamaAPI.SQLDB.Order("due_date ASC").
+		Find(&contributions, "plan_id = ? AND ((due_date < ? AND amount_paid < amount_due) OR fine_paid < fine_amount)", planDB.ID, today).Error
+	if err != nil {
+		return nil, errs.FailedToFind("member contributions", err)
+	}
+
+	arrears := make(map[string]*chama.MemberArrears)
+	memberIDs := make([]string, 0)
+	for _, contribution := range contributions {
+		pb, ok := arrears[contribution.MemberID]
+		if !ok {
+			pb = &chama.MemberArrears{MemberId: contribution.MemberID}
+			arrears[contribution.MemberID] = pb
+			memberIDs = append(memberIDs, contribution.MemberID)
+		}
+		if contribution.DueDate.Before(today) && contribution.AmountPaid < contribution.AmountDue {
+			if pb.OverdueContributions == 0 {
+				pb.OldestDueDate = contribution.DueDate.Format(contributionDateLayout)
+			}
+			pb.OverdueContributions++
+			pb.ContributionsOwed = roundAmount(pb.ContributionsOwed + contribution.AmountDue - contribution.AmountPaid)
+		}
+		pb.FinesOwed = roundAmount(pb.FinesOwed + contribution.FineAmount - contribution.FinePaid)
+		pb.TotalOwed = roundAmount(pb.ContributionsOwed + pb.FinesOwed)
+	}
+
+	members := make([]*models.ChamaMember, 0, len(memberIDs))
+	if len(memberIDs) != 0 {
+		err = chamaAPI.SQLDB.Select("id, first_name, last_name, phone").Find(&members, "id IN (?)", memberIDs).Error
+		if err != nil {
+			return nil, errs.FailedToFind("chama members", err)
+		}
+	}
+	for _, memberDB := range members {
+		pb := arrears[fmt.Sprint(memberDB.ID)]
+		pb.MemberNames = memberDB.FirstName + " " + memberDB.LastName
+		pb.Phone = memberDB.Phone
+	}
+
+	res := &chama.ListContributionArrearsResponse{
+		Arrears: make([]*chama.MemberArrears, 0, len(memberIDs)),
+	}
+	for _, memberID := range memberIDs {
+		res.Arrears = append(res.Arrears, arrears[memberID])
+		res.TotalOwed += arrears[memberID].TotalOwed
+	}
+	res.TotalOwed = roundAmount(res.TotalOwed)
+
+	// Members owing the most come first
+	sort.SliceStable(res.Arrears, func(i, j int) bool {
+		return res.Arrears[i].TotalOwed > res.Arrears[j].TotalOwed
+	})
+
+	return res, nil
+}
@@ -0,0 +1,228 @@
+package chama
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/machama-app/internal/fine"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Contribution plans and arrears", func() {
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+	})
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+	}
+
+	Describe("Due dates of a plan", func() {
+		It("should fall on the due day of each month", func() {
+			dates := dueDates(&models.ContributionPlan{
+				Frequency: chama.ContributionFrequency_MONTHLY.String(),
+				DueDay:    15,
+				StartDate: date(2021, 1, 20),
+			}, date(2021, 1, 1), date(2021, 4, 14))
+			Expect(dates).Should(Equal([]time.Time{date(2021, 2, 15), date(2021, 3, 15)}))
+		})
+
+		It("should fall on the due weekday", func() {
+			planDB := &models.ContributionPlan{
+				Frequency: chama.ContributionFrequency_WEEKLY.String(),
+				DueDay:    1,
+				StartDate: date(2021, 1, 1),
+			}
+			Expect(dueDates(planDB, date(2021, 1, 1), date(2021, 1, 20))).
+				Should(Equal([]time.Time{date(2021, 1, 4), date(2021, 1, 11), date(2021, 1, 18)}))
+
+			planDB.Frequency = chama.ContributionFrequency_FORTNIGHTLY.String()
+			Expect(dueDates(planDB, date(2021, 1, 5), date(2021, 1, 20))).Should(Equal([]time.Time{date(2021, 1, 18)}))
+		})
+	})
+
+	Describe("SetContributionPlan with malformed request", func() {
+		var plan *chama.ContributionPlan
+
+		BeforeEach(func() {
+			plan = &chama.ContributionPlan{
+				ChamaId:   "1",
+				Amount:    500,
+				Frequency: chama.ContributionFrequency_MONTHLY,
+				DueDay:    5,
+			}
+		})
+
+		It("should fail when the request is nil", func() {
+			planRes, err := ChamaAPI.SetContributionPlan(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(planRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when amount is missing", func() {
+			plan.Amount = 0
+			planRes, err := ChamaAPI.SetContributionPlan(ctx, &chama.SetContributionPlanRequest{Plan: plan})
+			Expect(err).Should(HaveOccurred())
+			Expect(planRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when frequency is missing", func() {
+			plan.Frequency = chama.ContributionFrequency_FREQUENCY_UNSPECIFIED
+			planRes, err := ChamaAPI.SetContributionPlan(ctx, &chama.SetContributionPlanRequest{Plan: plan})
+			Expect(err).Should(HaveOccurred())
+			Expect(planRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when due day is not in the month", func() {
+			plan.DueDay = 30
+			planRes, err := ChamaAPI.SetContributionPlan(ctx, &chama.SetContributionPlanRequest{Plan: plan})
+			Expect(err).Should(HaveOccurred())
+			Expect(planRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the chama does not exist", func() {
+			plan.ChamaId = "0"
+			planRes, err := ChamaAPI.SetContributionPlan(ctx, &chama.SetContributionPlanRequest{Plan: plan})
+			Expect(err).Should(HaveOccurred())
+			Expect(planRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("Tracking member arrears", func() {
+		var (
+			chamaID string
+			start   time.Time
+		)
+
+		createMember := func() string {
+			memberDB := &models.ChamaMember{
+				ChamaID:   chamaID,
+				FirstName: randomdata.FirstName(randomdata.Female),
+				LastName:  randomdata.LastName(),
+				Phone:     fmt.Sprintf("07%08d", randomdata.Number(10000000, 99999999)),
+				IDNumber:  fmt.Sprint(randomdata.Number(22222222, 44444444)),
+				Active:    true,
+				CreatedAt: start,
+			}
+			Expect(ChamaAPIServer.SQLDB.Create(memberDB).Error).ShouldNot(HaveOccurred())
+			return fmt.Sprint(memberDB.ID)
+		}
+
+		BeforeEach(func() {
+			chamaDB := mockChama()
+			Expect(ChamaAPIServer.SQLDB.Create(chamaDB).Error).ShouldNot(HaveOccurred())
+			chamaID = fmt.Sprint(chamaDB.ID)
+			start = startOfDay(time.Now()).AddDate(0, 0, -21)
+		})
+
+		It("should fail when the chama has no plan", func() {
+			arrearsRes, err := ChamaAPI.ListContributionArrears(ctx, &chama.ListContributionArrearsRequest{ChamaId: chamaID})
+			Expect(err).Should(HaveOccurred())
+			Expect(arrearsRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+
+		It("should list who owes what after matching deposits", func() {
+			payingMember := createMember()
+			absentMember := createMember()
+
+			accountDB := &models.ChamaAccount{
+				OwnerID:     payingMember,
+				AccountName: "savings",
+				AccountType: transaction.AccountType_SAVINGS_ACCOUNT.String(),
+				Active:      true,
+			}
+			Expect(ChamaAPIServer.SQLDB.Create(accountDB).Error).ShouldNot(HaveOccurred())
+			Expect(ChamaAPIServer.SQLDB.Create(&models.Transaction{
+				ActorID:           payingMember,
+				AccountID:         fmt.Sprint(accountDB.ID),
+				Description:       "contribution",
+				TransactionType:   transaction.TransactionType_DEPOSIT.String(),
+				TransactionAmount: 150,
+			}).Error).ShouldNot(HaveOccurred())
+
+			// Weekly plan due on the weekday it started, so that three contributions are overdue and one is due today
+			planRes, err := ChamaAPI.SetContributionPlan(ctx, &chama.SetContributionPlanRequest{
+				Plan: &chama.ContributionPlan{
+					ChamaId:     chamaID,
+					AccountName: "savings",
+					Amount:      100,
+					Frequency:   chama.ContributionFrequency_WEEKLY,
+					DueDay:      int32(start.Weekday()+6)%7 + 1,
+					LateFine:    10,
+					StartDate:   start.Format(contributionDateLayout),
+					Active:      true,
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(planRes.PlanId).ShouldNot(BeEmpty())
+
+			arrearsRes, err := ChamaAPI.ListContributionArrears(ctx, &chama.ListContributionArrearsRequest{ChamaId: chamaID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(arrearsRes.Arrears).Should(HaveLen(2))
+
+			// The deposit pays the first contribution late along with its fine and part of the second
+			absent, paying := arrearsRes.Arrears[0], arrearsRes.Arrears[1]
+			Expect(absent.MemberId).Should(Equal(absentMember))
+			Expect(absent.OverdueContributions).Should(BeEquivalentTo(3))
+			Expect(absent.ContributionsOwed).Should(BeNumerically("~", 300, 0.001))
+			Expect(absent.FinesOwed).Should(BeNumerically("~", 30, 0.001))
+			Expect(absent.OldestDueDate).Should(Equal(start.Format(contributionDateLayout)))
+
+			Expect(paying.MemberId).Should(Equal(payingMember))
+			Expect(paying.OverdueContributions).Should(BeEquivalentTo(2))
+			Expect(paying.ContributionsOwed).Should(BeNumerically("~", 160, 0.001))
+			Expect(paying.FinesOwed).Should(BeNumerically("~", 20, 0.001))
+			Expect(paying.TotalOwed).Should(BeNumerically("~", 180, 0.001))
+
+			Expect(arrearsRes.TotalOwed).Should(BeNumerically("~", 510, 0.001))
+
+			var expected int64
+			err = ChamaAPIServer.SQLDB.Model(&models.MemberContribution{}).Where("chama_id = ?", chamaID).Count(&expected).Error
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(expected).Should(BeEquivalentTo(8))
+
+			// Late fines are issued to the members like any other fine
+			fines := make([]*models.Fine, 0)
+			err = ChamaAPIServer.SQLDB.Find(&fines, "chama_id = ?", chamaID).Error
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fines).Should(HaveLen(6))
+
+			var paid, outstanding float64
+			for _, fineDB := range fines {
+				Expect(fineDB.FineName).Should(Equal(fine.LateContributionFine))
+				paid += fineDB.PaidAmount
+				if fineDB.Status == chama.FineStatus_FINE_OUTSTANDING.String() {
+					outstanding += fineDB.Amount - fineDB.PaidAmount
+				}
+			}
+			Expect(paid).Should(BeNumerically("~", 10, 0.001))
+			Expect(outstanding).Should(BeNumerically("~", 50, 0.001))
+
+			// Waived fines are no longer owed
+			waivedFine := &models.Fine{}
+			err = ChamaAPIServer.SQLDB.First(waivedFine, "chama_id = ? AND member_id = ?", chamaID, absentMember).Error
+			Expect(err).ShouldNot(HaveOccurred())
+			err = ChamaAPIServer.SQLDB.Model(waivedFine).Update("status", chama.FineStatus_FINE_WAIVED.String()).Error
+			Expect(err).ShouldNot(HaveOccurred())
+
+			arrearsRes, err = ChamaAPI.ListContributionArrears(ctx, &chama.ListContributionArrearsRequest{ChamaId: chamaID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(arrearsRes.Arrears[0].MemberId).Should(Equal(absentMember))
+			Expect(arrearsRes.Arrears[0].FinesOwed).Should(BeNumerically("~", 20, 0.001))
+		})
+	})
+})
@@ -28,6 +28,15 @@ var (
 	ChamaMemberAPI       chama.ChamaMemberAPIServer
 	modelsStructs        = []interface{}{
 		&models.ChamaMember{},
+		&models.ChamaAccount{},
+		&models.Transaction{},
+		&models.Loan{},
+		&models.LoanInstallment{},
+		&models.Fine{},
+		&models.MemberContribution{},
+		&models.MemberKYC{},
+		&models.KYCDocument{},
+		&models.Chama{},
 	}
 	schema = "machama"
 )
@@ -0,0 +1,462 @@
+package chamamember
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/utils/errs"
+
+	"github.com/go-sql-driver/mysql"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
+)
+
+// Members that no longer count towards the identities registered in a chama
+var closedMemberStatuses = []string{models.MemberStatusExited, models.MemberStatusMerged}
+
+// otherChamasHeader carries the other chamas a new member belongs to
+const otherChamasHeader = "x-member-other-chamas"
+
+// CheckMemberIdentity fails when the id number or phone is already registered to another member of the chama
+func CheckMemberIdentity(sqlDB *gorm.DB, chamaID, idNumber, phone string, exceptID uint) error {
+	memberDB := &models.ChamaMember{}
+	err := sqlDB.Select("id, id_number, phone").
+		Where("chama_id = ? AND status NOT IN (?) AND id != ?", chamaID, closedMemberStatuses, exceptID).
+		Where("(id_number != '' AND id_number = ?) OR (phone != '' AND phone = ?)", idNumber, phone).
+		First(memberDB).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil
+	case err != nil:
+		return errs.FailedToFind("chama members", err)
+	case idNumber != "" && memberDB.IDNumber == idNumber:
+		return errs.WrapMessagef(codes.AlreadyExists, "id number %s is already registered to member %d", idNumber, memberDB.ID)
+	default:
+		return errs.WrapMessagef(codes.AlreadyExists, "phone %s is already registered to member %d", phone, memberDB.ID)
+	}
+}
+
+// IdentityConflict gives the error for a member that clashes with another member on the unique identity keys of the
+// chama. It is nil when err is not such a clash.
+func IdentityConflict(err error) error {
+	mysqlErr := &mysql.MySQLError{}
+	if !errors.As(err, &mysqlErr) || mysqlErr.Number != duplicateEntryErr {
+		return nil
+	}
+	if strings.Contains(mysqlErr.Message, "idx_chama_open_phone") {
+		return errs.WrapMessage(codes.AlreadyExists, "phone is already registered to another member of the chama")
+	}
+	return errs.WrapMessage(codes.AlreadyExists, "id number is already registered to another member of the chama")
+}
+
+const duplicateEntryErr = 1062
+
+// sharedIdentity is an id number or phone shared by open members of a chama
+type sharedIdentity struct {
+	ChamaID   string
+	Identity  string
+	MemberIDs string
+}
+
+// MigrateMemberIdentities migrates the members table and adds the unique keys on the identities of open members.
+// Members registered before identities were unique may share an id number or phone, in which case the keys can't be
+// added. The shared identities are logged so that officers can merge the members, and the keys are added by a
+// migration that runs once they are merged.
+func MigrateMemberIdentities(sqlDB *gorm.DB, logger grpclog.LoggerV2) error {
+	migrator := sqlDB.Migrator()
+	if !migrator.HasTable(&models.ChamaMember{}) {
+		return migrator.AutoMigrate(&models.ChamaMember{})
+	}
+
+	shared := make([]*sharedIdentity, 0)
+	for _, column := range []string{"id_number", "phone"} {
+		identities := make([]*sharedIdentity, 0)
+		err := sqlDB.Model(&models.ChamaMember{}).
+			Select(fmt.Sprintf("chama_id, %s AS identity, GROUP_CONCAT(id ORDER BY id) AS member_ids", column)).
+			Where(fmt.Sprintf("%s != '' AND COALESCE(status, '') NOT IN (?)", column), closedMemberStatuses).
+			Group("chama_id, " + column).
+			Having("COUNT(*) > 1").
+			Scan(&identities).Error
+		if err != nil {
+			return errs.FailedToFind("chama members", err)
+		}
+		shared = append(shared, identities...)
+	}
+
+	if len(shared) == 0 {
+		return migrator.AutoMigrate(&models.ChamaMember{})
+	}
+
+	for _, identity := range shared {
+		logger.Warningf(
+			"members %s of chama %s share the identity %s and must be merged before identities are made unique",
+			identity.MemberIDs, identity.ChamaID, identity.Identity,
+		)
+	}
+
+	// Columns are still added so that the members table stays usable without the unique keys
+	stmt := &gorm.Statement{DB: sqlDB}
+	err := stmt.Parse(&models.ChamaMember{})
+	if err != nil {
+		return err
+	}
+	for _, field := range stmt.Schema.Fields {
+		if field.DBName == "" || migrator.HasColumn(&models.ChamaMember{}, field.DBName) {
+			continue
+		}
+		err = migrator.AddColumn(&models.ChamaMember{}, field.DBName)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// otherChamaIDs are the other chamas the holder of the id number is a member of
+func (chamaMemberAPI *chamaMemberAPIServer) otherChamaIDs(chamaID, idNumber string) ([]string, error) {
+	chamaIDs := make([]string, 0)
+	if idNumber == "" {
+		return chamaIDs, nil
+	}
+
+	err := chamaMemberAPI.SQLDB.Model(&models.ChamaMember{}).Distinct("chama_id").
+		Where("id_number = ? AND chama_id != ? AND status NOT IN (?)", idNumber, chamaID, closedMemberStatuses).
+		Order("chama_id").Pluck("chama_id", &chamaIDs).Error
+	if err != nil {
+		return nil, errs.FailedToFind("chama members", err)
+	}
+
+	return chamaIDs, nil
+}
+
+// warnOtherChamas lets the caller know that the new member already belongs to other chamas
+func (chamaMemberAPI *chamaMemberAPIServer) warnOtherChamas(ctx context.Context, pb *chama.ChamaMember) {
+	chamaIDs, err := chamaMemberAPI.otherChamaIDs(pb.ChamaId, pb.IdNumber)
+	if err != nil {
+		chamaMemberAPI.Logger.Errorf("failed to check other chamas of id number %s: %v", pb.IdNumber, err)
+		return
+	}
+	if len(chamaIDs) == 0 {
+		return
+	}
+
+	chamaMemberAPI.Logger.Warningf(
+		"member with id number %s added to chama %s is also a member of chamas %s", pb.IdNumber, pb.ChamaId, strings.Join(chamaIDs, ", "),
+	)
+
+	// Not every caller is a grpc stream
+	_ = grpc.SetHeader(ctx, metadata.Pairs(otherChamasHeader, strings.Join(chamaIDs, ",")))
+}
+
+func (chamaMemberAPI *chamaMemberAPIServer) FindMemberByIdentity(
+	ctx context.Context, req *chama.FindMemberByIdentityRequest,
+) (*chama.FindMemberByIdentityResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.IdNumber == "" && req.Phone == "":
+		return nil, errs.MissingField("id number or phone")
+	}
+
+	// Authorization
+	_, chamaIDs, err := chamaMemberAPI.authorizer.ScopeChamas(ctx, nil, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	db := chamaMemberAPI.SQLDB.Order("id DESC").Where("status NOT IN (?)", closedMemberStatuses)
+	switch {
+	case req.IdNumber != "" && req.Phone != "":
+		db = db.Where("id_number = ? OR phone = ?", req.IdNumber, req.Phone)
+	case req.IdNumber != "":
+		db = db.Where("id_number = ?", req.IdNumber)
+	default:
+		db = db.Where("phone = ?", req.Phone)
+	}
+	if chamaIDs != nil {
+		db = db.Where("chama_id IN (?)", chamaIDs)
+	}
+
+	dbs := make([]*models.ChamaMember, 0)
+	err = db.Limit(defaultPageSize).Find(&dbs).Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "FIND")
+	}
+
+	pbs := make([]*chama.ChamaMember, 0, len(dbs))
+	for _, db := range dbs {
+		pb, err := models.ChamaMemberProto(db)
+		if err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+
+	// Each record lists the other chamas of the same identity
+	for _, pb := range pbs {
+		for _, other := range pbs {
+			if other.ChamaId != pb.ChamaId && other.IdNumber == pb.IdNumber {
+				pb.OtherChamaIds = append(pb.OtherChamaIds, other.ChamaId)
+			}
+		}
+	}
+
+	return &chama.FindMemberByIdentityResponse{
+		ChamaMembers: pbs,
+	}, nil
+}
+
+// memberRecord is a table whose rows belong to a member. Rows of tables keyed per member are only moved where the
+// primary member has no row for the same key, otherwise they stay with the merged record.
+type memberRecord struct {
+	table   string
+	column  string
+	keyedBy string
+}
+
+var memberRecords = []*memberRecord{
+	{table: "loans", column: "member_id"},
+	{table: "fines", column: "member_id"},
+	{table: "member_invitations", column: "member_id"},
+	{table: "member_contributions", column: "member_id", keyedBy: "plan_id, due_date"},
+	{table: "loan_votes", column: "member_id", keyedBy: "loan_id"},
+	{table: "meeting_attendance", column: "member_id", keyedBy: "meeting_id"},
+	{table: "rotation_slots", column: "member_id", keyedBy: "rotation_id"},
+	{table: "rotation_contributions", column: "member_id", keyedBy: "cycle_id"},
+	{table: "rotation_bids", column: "member_id", keyedBy: "cycle_id"},
+	{table: "member_kyc", column: "member_id", keyedBy: "chama_id"},
+	{table: "kyc_documents", column: "member_id", keyedBy: "document_type"},
+}
+
+func (record *memberRecord) repoint(tx *gorm.DB, fromID, toID string) error {
+	if !tx.Migrator().HasTable(record.table) {
+		return nil
+	}
+
+	db := tx.Table(record.table).Where(fmt.Sprintf("%s = ?", record.column), fromID)
+	if record.keyedBy != "" {
+		db = db.Where(
+			fmt.Sprintf("(%s) NOT IN (SELECT %s FROM (SELECT %s FROM %s WHERE %s = ?) AS taken)",
+				record.keyedBy, record.keyedBy, record.keyedBy, record.table, record.column),
+			toID,
+		)
+	}
+
+	err := db.Update(record.column, toID).Error
+	if err != nil {
+		return errs.FailedToUpdate(record.table, err)
+	}
+
+	return nil
+}
+
+// memberAccounts scopes accounts to those of a member. Accounts created before accounts recorded their chama have no
+// chama and are the member's unless the owner is also the id of a chama.
+func memberAccounts(tx *gorm.DB, memberDB *models.ChamaMember) *gorm.DB {
+	return tx.Where(
+		"owner_id = ? AND (chama_id = ? OR (chama_id = '' AND owner_id NOT IN (SELECT CAST(id AS CHAR) FROM chamas)))",
+		fmt.Sprint(memberDB.ID), memberDB.ChamaID,
+	)
+}
+
+// mergeAccounts moves the accounts of the duplicate to the primary member. Transactions of an account the primary
+// member already has by name are moved into that account together with its balances.
+func mergeAccounts(tx *gorm.DB, duplicateDB, primaryDB *models.ChamaMember) error {
+	accounts := make([]*models.ChamaAccount, 0)
+	err := memberAccounts(tx, duplicateDB).Find(&accounts).Error
+	if err != nil {
+		return errs.FailedToFind("member accounts", err)
+	}
+
+	for _, accountDB := range accounts {
+		primaryAccount := &models.ChamaAccount{}
+		err = memberAccounts(tx, primaryDB).First(primaryAccount, "account_name = ?", accountDB.AccountName).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			err = tx.Model(accountDB).Updates(map[string]interface{}{
+				"owner_id": fmt.Sprint(primaryDB.ID),
+				"chama_id": primaryDB.ChamaID,
+			}).Error
+			if err != nil {
+				return errs.FailedToUpdate("member account", err)
+			}
+			continue
+		case err != nil:
+			return errs.FailedToFind("member account", err)
+		}
+
+		err = tx.Model(&models.Transaction{}).Where("account_id = ?", fmt.Sprint(accountDB.ID)).
+			Update("account_id", fmt.Sprint(primaryAccount.ID)).Error
+		if err != nil {
+			return errs.FailedToUpdate("transactions", err)
+		}
+
+		err = tx.Model(primaryAccount).Updates(map[string]interface{}{
+			"available_amount":       gorm.Expr("available_amount + ?", accountDB.AvailableAmount),
+			"total_deposited_amount": gorm.Expr("total_deposited_amount + ?", accountDB.TotalDepositedAmount),
+			"total_withdrawn_amount": gorm.Expr("total_withdrawn_amount + ?", accountDB.TotalWithdrawnAmount),
+		}).Error
+		if err != nil {
+			return errs.FailedToUpdate("member account", err)
+		}
+
+		err = tx.Model(accountDB).Updates(map[string]interface{}{
+			"available_amount":       0,
+			"total_deposited_amount": 0,
+			"total_withdrawn_amount": 0,
+			"active":                 false,
+		}).Error
+		if err != nil {
+			return errs.FailedToUpdate("member account", err)
+		}
+	}
+
+	return nil
+}
+
+// mergeProfile fills what the primary member is missing from the duplicate
+func mergeProfile(duplicateDB, primaryDB *models.ChamaMember) map[string]interface{} {
+	updates := map[string]interface{}{}
+	fill := func(column, primary, duplicate string) {
+		if primary == "" && duplicate != "" {
+			updates[column] = duplicate
+		}
+	}
+	fill("email", primaryDB.Email, duplicateDB.Email)
+	fill("residence", primaryDB.Residence, duplicateDB.Residence)
+	fill("user_id", primaryDB.UserID, duplicateDB.UserID)
+	if len(primaryDB.Beneficiaries) == 0 && len(duplicateDB.Beneficiaries) != 0 {
+		updates["beneficiaries"] = duplicateDB.Beneficiaries
+	}
+	if len(primaryDB.Guarantees) == 0 && len(duplicateDB.Guarantees) != 0 {
+		updates["guarantees"] = duplicateDB.Guarantees
+	}
+	if len(primaryDB.JobDetails) == 0 && len(duplicateDB.JobDetails) != 0 {
+		updates["job_details"] = duplicateDB.JobDetails
+	}
+	if len(primaryDB.KYC) == 0 && len(duplicateDB.KYC) != 0 {
+		updates["kyc"] = duplicateDB.KYC
+	}
+	// An office held by the duplicate passes to the primary member
+	if primaryDB.Role == chama.ChamaRole_ROLE_MEMBER.String() && duplicateDB.Role != chama.ChamaRole_ROLE_MEMBER.String() {
+		updates["role"] = duplicateDB.Role
+	}
+	return updates
+}
+
+func (chamaMemberAPI *chamaMemberAPIServer) MergeChamaMembers(
+	ctx context.Context, req *chama.MergeChamaMembersRequest,
+) (*chama.ChamaMember, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.PrimaryMemberId == "":
+		return nil, errs.MissingField("primary member id")
+	case len(req.DuplicateMemberIds) == 0:
+		return nil, errs.MissingField("duplicate member ids")
+	}
+
+	// Authorization
+	_, primaryDB, err := chamaMemberAPI.authorizer.AuthorizeMember(ctx, req.PrimaryMemberId, roles.Chairman...)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicates := make([]*models.ChamaMember, 0, len(req.DuplicateMemberIds))
+	for _, memberID := range req.DuplicateMemberIds {
+		if memberID == req.PrimaryMemberId {
+			return nil, errs.WrapMessage(codes.InvalidArgument, "a member cannot be merged into itself")
+		}
+
+		duplicateDB := &models.ChamaMember{}
+		err = chamaMemberAPI.SQLDB.First(duplicateDB, "id = ?", memberID).Error
+		switch {
+		case err == nil:
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, errs.DoesNotExist("chama member", memberID)
+		default:
+			return nil, errs.FailedToFind("chama member", err)
+		}
+
+		switch {
+		case duplicateDB.ChamaID != primaryDB.ChamaID:
+			return nil, errs.WrapMessagef(codes.InvalidArgument, "member %s is not in the same chama", memberID)
+		case duplicateDB.UserID != "" && primaryDB.UserID != "" && duplicateDB.UserID != primaryDB.UserID:
+			return nil, errs.WrapMessagef(codes.FailedPrecondition, "member %s is linked to a different user account", memberID)
+		}
+
+		duplicates = append(duplicates, duplicateDB)
+	}
+
+	for _, memberDB := range append(duplicates, primaryDB) {
+		if leavingStatus(memberDB.Status) {
+			return nil, errs.WrapMessagef(codes.FailedPrecondition, "member %d has left the chama", memberDB.ID)
+		}
+	}
+
+	primaryID := fmt.Sprint(primaryDB.ID)
+
+	err = chamaMemberAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		for _, duplicateDB := range duplicates {
+			duplicateID := fmt.Sprint(duplicateDB.ID)
+
+			for _, record := range memberRecords {
+				err := record.repoint(tx, duplicateID, primaryID)
+				if err != nil {
+					return err
+				}
+			}
+
+			err := mergeAccounts(tx, duplicateDB, primaryDB)
+			if err != nil {
+				return err
+			}
+
+			updates := mergeProfile(duplicateDB, primaryDB)
+			if len(updates) != 0 {
+				err = tx.Model(primaryDB).Updates(updates).Error
+				if err != nil {
+					return errs.FailedToUpdate("chama member", err)
+				}
+
+				err = tx.First(primaryDB, primaryDB.ID).Error
+				if err != nil {
+					return errs.FailedToFind("chama member", err)
+				}
+			}
+
+			// The duplicate is kept for the records that could not be moved
+			err = tx.Model(duplicateDB).Updates(map[string]interface{}{
+				"status":  models.MemberStatusMerged,
+				"active":  false,
+				"role":    chama.ChamaRole_ROLE_MEMBER.String(),
+				"user_id": "",
+			}).Error
+			if err != nil {
+				return errs.FailedToUpdate("chama member", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = chamaMemberAPI.SQLDB.First(primaryDB, primaryDB.ID).Error
+	if err != nil {
+		return nil, errs.FailedToFind("chama member", err)
+	}
+
+	return models.ChamaMemberProto(primaryDB)
+}
@@ -0,0 +1,252 @@
+package chamamember
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Member identity", func() {
+	var (
+		ctx     context.Context
+		chamaID string
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		chamaID = fmt.Sprint(randomdata.Number(1000, 999999))
+	})
+
+	create := func(pb *chama.ChamaMember) error {
+		_, err := ChamaMemberAPI.CreateChamaMember(ctx, &chama.CreateChamaMemberRequest{ChamaMember: pb})
+		return err
+	}
+
+	Describe("Registering members", func() {
+		It("should not register the same id number or phone twice in a chama", func() {
+			memberPB := mockChamaMember()
+			memberPB.ChamaId = chamaID
+			Expect(create(memberPB)).ShouldNot(HaveOccurred())
+
+			duplicatePB := mockChamaMember()
+			duplicatePB.ChamaId = chamaID
+			duplicatePB.IdNumber = memberPB.IdNumber
+			err := create(duplicatePB)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.AlreadyExists))
+
+			duplicatePB = mockChamaMember()
+			duplicatePB.ChamaId = chamaID
+			duplicatePB.Phone = memberPB.Phone
+			err = create(duplicatePB)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.AlreadyExists))
+		})
+
+		It("should keep the identities of open members unique in the database", func() {
+			memberDB := createRoleMember(chamaID, "", chama.ChamaRole_ROLE_MEMBER)
+
+			duplicateDB, err := models.ChamaMemberModel(mockChamaMember())
+			Expect(err).ShouldNot(HaveOccurred())
+			duplicateDB.ChamaID = chamaID
+			duplicateDB.IDNumber = memberDB.IDNumber
+
+			err = ChamaMemberAPIServer.SQLDB.Create(duplicateDB).Error
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(IdentityConflict(err))).Should(Equal(codes.AlreadyExists))
+
+			Expect(ChamaMemberAPIServer.SQLDB.Model(memberDB).Update("status", models.MemberStatusMerged).Error).ShouldNot(HaveOccurred())
+			Expect(ChamaMemberAPIServer.SQLDB.Create(duplicateDB).Error).ShouldNot(HaveOccurred())
+		})
+
+		It("should let exited members be registered again", func() {
+			memberDB := createRoleMember(chamaID, "", chama.ChamaRole_ROLE_MEMBER)
+			Expect(ChamaMemberAPIServer.SQLDB.Model(memberDB).Update("status", models.MemberStatusExited).Error).ShouldNot(HaveOccurred())
+
+			memberPB := mockChamaMember()
+			memberPB.ChamaId = chamaID
+			memberPB.IdNumber = memberDB.IDNumber
+			Expect(create(memberPB)).ShouldNot(HaveOccurred())
+		})
+
+		It("should show the other chamas of a member", func() {
+			memberDB := createRoleMember(chamaID, "", chama.ChamaRole_ROLE_MEMBER)
+
+			otherChamaID := fmt.Sprint(randomdata.Number(1000, 999999))
+			memberPB := mockChamaMember()
+			memberPB.ChamaId = otherChamaID
+			memberPB.IdNumber = memberDB.IDNumber
+			Expect(create(memberPB)).ShouldNot(HaveOccurred())
+
+			getRes, err := ChamaMemberAPI.GetChamaMember(ctx, &chama.GetChamaMemberRequest{MemberId: fmt.Sprint(memberDB.ID)})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.OtherChamaIds).Should(ConsistOf(otherChamaID))
+
+			findRes, err := ChamaMemberAPI.FindMemberByIdentity(ctx, &chama.FindMemberByIdentityRequest{IdNumber: memberDB.IDNumber})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(findRes.ChamaMembers).Should(HaveLen(2))
+		})
+	})
+
+	Describe("FindMemberByIdentity", func() {
+		It("should fail when neither id number nor phone is given", func() {
+			findRes, err := ChamaMemberAPI.FindMemberByIdentity(ctx, &chama.FindMemberByIdentityRequest{})
+			Expect(err).Should(HaveOccurred())
+			Expect(findRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should find members by phone", func() {
+			memberDB := createRoleMember(chamaID, "", chama.ChamaRole_ROLE_MEMBER)
+
+			findRes, err := ChamaMemberAPI.FindMemberByIdentity(ctx, &chama.FindMemberByIdentityRequest{Phone: memberDB.Phone})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(findRes.ChamaMembers).Should(HaveLen(1))
+			Expect(findRes.ChamaMembers[0].MemberId).Should(Equal(fmt.Sprint(memberDB.ID)))
+		})
+	})
+
+	Describe("MergeChamaMembers", func() {
+		It("should fail when the duplicate is in another chama", func() {
+			primaryDB := createRoleMember(chamaID, "", chama.ChamaRole_ROLE_MEMBER)
+			otherDB := createRoleMember(fmt.Sprint(randomdata.Number(1000, 999999)), "", chama.ChamaRole_ROLE_MEMBER)
+
+			mergeRes, err := ChamaMemberAPI.MergeChamaMembers(ctx, &chama.MergeChamaMembersRequest{
+				PrimaryMemberId:    fmt.Sprint(primaryDB.ID),
+				DuplicateMemberIds: []string{fmt.Sprint(otherDB.ID)},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(mergeRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("should re-point loans, fines, contributions and transactions to the primary member", func() {
+			primaryDB := createRoleMember(chamaID, "", chama.ChamaRole_ROLE_MEMBER)
+			duplicateDB := createRoleMember(chamaID, randomdata.RandStringRunes(10), chama.ChamaRole_TREASURER)
+			primaryID, duplicateID := fmt.Sprint(primaryDB.ID), fmt.Sprint(duplicateDB.ID)
+
+			db := ChamaMemberAPIServer.SQLDB
+
+			loanDB := &models.Loan{
+				ChamaID: chamaID, ProductID: "1", MemberID: duplicateID,
+				LoaneeNames: duplicateDB.FirstName, LoaneePhone: duplicateDB.Phone, NationalID: duplicateDB.IDNumber,
+			}
+			Expect(db.Create(loanDB).Error).ShouldNot(HaveOccurred())
+
+			fineDB := &models.Fine{
+				ChamaID: chamaID, MemberID: duplicateID, FineTypeID: 1, FineName: "Lateness", AccountName: "fines", Amount: 100,
+				Status: chama.FineStatus_FINE_OUTSTANDING.String(),
+			}
+			Expect(db.Create(fineDB).Error).ShouldNot(HaveOccurred())
+
+			// Both have a contribution for the same due date and the duplicate has another
+			dueDate := time.Now().Truncate(24 * time.Hour)
+			for _, contributionDB := range []*models.MemberContribution{
+				{PlanID: 1, ChamaID: chamaID, MemberID: primaryID, DueDate: dueDate, AmountDue: 100},
+				{PlanID: 1, ChamaID: chamaID, MemberID: duplicateID, DueDate: dueDate, AmountDue: 100},
+				{PlanID: 1, ChamaID: chamaID, MemberID: duplicateID, DueDate: dueDate.AddDate(0, 1, 0), AmountDue: 100},
+			} {
+				Expect(db.Create(contributionDB).Error).ShouldNot(HaveOccurred())
+			}
+
+			accounts := make([]*models.ChamaAccount, 0, 2)
+			for _, ownerID := range []string{primaryID, duplicateID} {
+				accountDB := &models.ChamaAccount{
+					OwnerID: ownerID, ChamaID: chamaID, AccountName: "savings",
+					AccountType: transaction.AccountType_SAVINGS_ACCOUNT.String(), AvailableAmount: 500, TotalDepositedAmount: 500, Active: true,
+				}
+				Expect(db.Create(accountDB).Error).ShouldNot(HaveOccurred())
+				accounts = append(accounts, accountDB)
+			}
+
+			// Accounts created before accounts recorded their chama have none
+			sharesDB := &models.ChamaAccount{
+				OwnerID: duplicateID, AccountName: "shares",
+				AccountType: transaction.AccountType_SHARE_CAPITAL_ACCOUNT.String(), AvailableAmount: 200, TotalDepositedAmount: 200, Active: true,
+			}
+			Expect(db.Create(sharesDB).Error).ShouldNot(HaveOccurred())
+
+			kycDB := &models.MemberKYC{MemberID: duplicateID, ChamaID: chamaID, Status: chama.KycStatus_KYC_VERIFIED.String()}
+			Expect(db.Create(kycDB).Error).ShouldNot(HaveOccurred())
+
+			documentDB := &models.KYCDocument{
+				MemberID: duplicateID, DocumentType: chama.KycDocumentType_KYC_ID_FRONT.String(), StorageKey: "kyc/id_front", ContentType: "image/png",
+			}
+			Expect(db.Create(documentDB).Error).ShouldNot(HaveOccurred())
+
+			transactionDB := &models.Transaction{
+				ActorID: "1", AccountID: fmt.Sprint(accounts[1].ID), Description: "Deposit",
+				TransactionType: transaction.TransactionType_DEPOSIT.String(), TransactionAmount: 500,
+			}
+			Expect(db.Create(transactionDB).Error).ShouldNot(HaveOccurred())
+
+			mergeRes, err := ChamaMemberAPI.MergeChamaMembers(ctx, &chama.MergeChamaMembersRequest{
+				PrimaryMemberId:    primaryID,
+				DuplicateMemberIds: []string{duplicateID},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(mergeRes.Role).Should(Equal(chama.ChamaRole_TREASURER))
+			Expect(mergeRes.UserId).Should(Equal(duplicateDB.UserID))
+
+			Expect(db.First(loanDB, loanDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(loanDB.MemberID).Should(Equal(primaryID))
+
+			Expect(db.First(fineDB, fineDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(fineDB.MemberID).Should(Equal(primaryID))
+
+			var count int64
+			Expect(db.Model(&models.MemberContribution{}).Where("member_id = ?", primaryID).Count(&count).Error).ShouldNot(HaveOccurred())
+			Expect(count).Should(BeEquivalentTo(2))
+
+			Expect(db.First(transactionDB, transactionDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(transactionDB.AccountID).Should(Equal(fmt.Sprint(accounts[0].ID)))
+
+			Expect(db.First(accounts[0], accounts[0].ID).Error).ShouldNot(HaveOccurred())
+			Expect(accounts[0].AvailableAmount).Should(BeEquivalentTo(1000))
+
+			Expect(db.First(sharesDB, sharesDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(sharesDB.OwnerID).Should(Equal(primaryID))
+
+			Expect(db.First(kycDB, kycDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(kycDB.MemberID).Should(Equal(primaryID))
+
+			Expect(db.First(documentDB, documentDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(documentDB.MemberID).Should(Equal(primaryID))
+
+			Expect(db.First(duplicateDB, duplicateDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(duplicateDB.Status).Should(Equal(models.MemberStatusMerged))
+			Expect(duplicateDB.Active).Should(BeFalse())
+		})
+	})
+
+	Describe("Migrating member identities", func() {
+		It("should leave out the unique keys until members sharing an identity are merged", func() {
+			db := ChamaMemberAPIServer.SQLDB
+			for _, index := range []string{"idx_chama_open_id_number", "idx_chama_open_phone"} {
+				Expect(db.Migrator().DropIndex(&models.ChamaMember{}, index)).ShouldNot(HaveOccurred())
+			}
+
+			// Registered twice before identities were unique
+			memberDB := createRoleMember(chamaID, "", chama.ChamaRole_ROLE_MEMBER)
+			duplicateDB := createRoleMember(chamaID, "", chama.ChamaRole_ROLE_MEMBER)
+			Expect(db.Model(duplicateDB).Update("id_number", memberDB.IDNumber).Error).ShouldNot(HaveOccurred())
+
+			Expect(MigrateMemberIdentities(db, ChamaMemberAPIServer.Logger)).ShouldNot(HaveOccurred())
+			Expect(db.Migrator().HasIndex(&models.ChamaMember{}, "idx_chama_open_id_number")).Should(BeFalse())
+
+			Expect(db.Model(duplicateDB).Update("status", models.MemberStatusMerged).Error).ShouldNot(HaveOccurred())
+
+			Expect(MigrateMemberIdentities(db, ChamaMemberAPIServer.Logger)).ShouldNot(HaveOccurred())
+			Expect(db.Migrator().HasIndex(&models.ChamaMember{}, "idx_chama_open_id_number")).Should(BeTrue())
+			Expect(db.Migrator().HasIndex(&models.ChamaMember{}, "idx_chama_open_phone")).Should(BeTrue())
+		})
+	})
+})
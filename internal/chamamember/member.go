@@ -3,8 +3,11 @@ package chamamember
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/gidyon/machama-app/internal/kyc"
 	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
 	"github.com/gidyon/machama-app/pkg/api/chama"
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
 	"github.com/gidyon/micro/v2/utils/errs"
@@ -27,6 +30,7 @@ type Options struct {
 type chamaMemberAPIServer struct {
 	chama.UnimplementedChamaMemberAPIServer
 	*Options
+	authorizer *roles.Authorizer
 }
 
 func NewChamaMemberAPI(ctx context.Context, opt *Options) (chama.ChamaMemberAPIServer, error) {
@@ -51,7 +55,8 @@ func NewChamaMemberAPI(ctx context.Context, opt *Options) (chama.ChamaMemberAPIS
 	}
 
 	chamaMemberAPI := &chamaMemberAPIServer{
-		Options: opt,
+		Options:    opt,
+		authorizer: roles.NewAuthorizer(opt.Auth, opt.SQLDB, opt.AllowedGroups),
 	}
 
 	return chamaMemberAPI, nil
@@ -78,23 +83,28 @@ func ValidateChamaMember(pb *chama.ChamaMember) error {
 func (chamaMemberAPI *chamaMemberAPIServer) CreateChamaMember(
 	ctx context.Context, req *chama.CreateChamaMemberRequest,
 ) (*emptypb.Empty, error) {
-	// Authorization
-	_, err := chamaMemberAPI.Auth.AuthorizeGroup(ctx, chamaMemberAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validate
 	switch {
 	case req == nil:
 		return nil, errs.NilObject("request body")
 	default:
-		err = ValidateChamaMember(req.ChamaMember)
+		err := ValidateChamaMember(req.ChamaMember)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	// Authorization
+	_, err := chamaMemberAPI.authorizer.AuthorizeChama(ctx, req.ChamaMember.ChamaId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = CheckMemberIdentity(chamaMemberAPI.SQLDB, req.ChamaMember.ChamaId, req.ChamaMember.IdNumber, req.ChamaMember.Phone, 0)
+	if err != nil {
+		return nil, err
+	}
+
 	db, err := models.ChamaMemberModel(req.ChamaMember)
 	if err != nil {
 		return nil, err
@@ -102,21 +112,20 @@ func (chamaMemberAPI *chamaMemberAPIServer) CreateChamaMember(
 
 	err = chamaMemberAPI.SQLDB.Create(db).Error
 	if err != nil {
+		if errConflict := IdentityConflict(err); errConflict != nil {
+			return nil, errConflict
+		}
 		return nil, errs.FailedToSave("chamaMember", err)
 	}
 
+	chamaMemberAPI.warnOtherChamas(ctx, req.ChamaMember)
+
 	return &emptypb.Empty{}, nil
 }
 
 func (chamaMemberAPI *chamaMemberAPIServer) UpdateChamaMember(
 	ctx context.Context, req *chama.UpdateChamaMemberRequest,
 ) (*emptypb.Empty, error) {
-	// Authorization
-	_, err := chamaMemberAPI.Auth.AuthorizeGroup(ctx, chamaMemberAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validate
 	switch {
 	case req == nil:
@@ -127,36 +136,74 @@ func (chamaMemberAPI *chamaMemberAPIServer) UpdateChamaMember(
 		return nil, errs.MissingField("member id")
 	}
 
+	// Authorization
+	_, memberDB, err := chamaMemberAPI.authorizer.AuthorizeMember(ctx, req.ChamaMember.MemberId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
 	db, err := models.ChamaMemberModel(req.ChamaMember)
 	if err != nil {
 		return nil, err
 	}
 
+	// Members leave through an exit settlement or a merge and are kept as they were left
+	switch {
+	case leavingStatus(memberDB.Status):
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "member has left the chama")
+	case leavingStatus(db.Status):
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "members leave a chama through an exit settlement")
+	}
+
+	if db.IDNumber != "" || db.Phone != "" {
+		err = CheckMemberIdentity(chamaMemberAPI.SQLDB, memberDB.ChamaID, db.IDNumber, db.Phone, memberDB.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Members don't move between chamas and a linked user account carries the member's role, so neither is changed here
+	db.ChamaID = ""
+	if memberDB.UserID != "" {
+		db.UserID = ""
+	}
+
 	err = chamaMemberAPI.SQLDB.Where("id = ?", req.ChamaMember.MemberId).Updates(db).Error
 	if err != nil {
+		if errConflict := IdentityConflict(err); errConflict != nil {
+			return nil, errConflict
+		}
 		return nil, errs.FailedToUpdate("chamaMember", err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+func leavingStatus(status string) bool {
+	switch status {
+	case models.MemberStatusExiting, models.MemberStatusExited, models.MemberStatusMerged:
+		return true
+	}
+	return false
+}
+
 const defaultPageSize = 50
 
 func (chamaMemberAPI *chamaMemberAPIServer) ListChamaMembers(
 	ctx context.Context, req *chama.ListChamaMembersRequest,
 ) (*chama.ListChamaMembersResponse, error) {
-	// Authorization
-	actor, err := chamaMemberAPI.Auth.AuthorizeGroup(ctx, chamaMemberAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validation
 	switch {
 	case req == nil:
 		return nil, errs.NilObject("list request")
 	}
 
+	// Authorization
+	actor, chamaIDs, err := chamaMemberAPI.authorizer.ScopeChamas(ctx, req.GetFilter().GetChamaIds(), roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
 	pageSize := req.GetPageSize()
 	switch {
 	case pageSize <= 0:
@@ -177,16 +224,21 @@ func (chamaMemberAPI *chamaMemberAPIServer) ListChamaMembers(
 		ID = uint(ids[0])
 	}
 
-	db := chamaMemberAPI.SQLDB.Unscoped().Limit(int(pageSize + 1)).Order("id DESC")
-	if ID != 0 {
-		db = db.Where("id<?", ID)
+	db := chamaMemberAPI.SQLDB.Unscoped().Limit(int(pageSize + 1))
+
+	db, err = sortMembers(db, chamaMemberAPI.SQLDB, req.SortOrder, ID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Apply tv filters
-	if req.Filter != nil {
-		if len(req.Filter.ChamaIds) != 0 {
-			db = db.Where("chama_id IN (?)", req.Filter.ChamaIds)
-		}
+	if chamaIDs != nil {
+		db = db.Where("chama_id IN (?)", chamaIDs)
+	}
+
+	db, err = filterMembers(db, req.GetFilter(), time.Now())
+	if err != nil {
+		return nil, err
 	}
 
 	dbs := make([]*models.ChamaMember, 0, pageSize+1)
@@ -231,12 +283,6 @@ func (chamaMemberAPI *chamaMemberAPIServer) ListChamaMembers(
 func (chamaMemberAPI *chamaMemberAPIServer) GetChamaMember(
 	ctx context.Context, req *chama.GetChamaMemberRequest,
 ) (*chama.ChamaMember, error) {
-	// Authorization
-	_, err := chamaMemberAPI.Auth.AuthorizeGroup(ctx, chamaMemberAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validation
 	switch {
 	case req == nil:
@@ -245,41 +291,26 @@ func (chamaMemberAPI *chamaMemberAPIServer) GetChamaMember(
 		return nil, errs.MissingField("member id")
 	}
 
-	db := &models.ChamaMember{}
-
-	err = chamaMemberAPI.SQLDB.First(db, "id = ?", req.MemberId).Error
-	switch {
-	case err == nil:
-	case errors.Is(err, gorm.ErrRecordNotFound):
-		return nil, errs.DoesNotExist("chamaMember", req.MemberId)
-	default:
-		return nil, errs.FailedToFind("chamaMember", err)
+	// Authorization
+	_, db, err := chamaMemberAPI.authorizer.AuthorizeMember(ctx, req.MemberId, roles.Members...)
+	if err != nil {
+		return nil, err
 	}
 
-	return models.ChamaMemberProto(db)
-}
-
-func (chamaMemberAPI *chamaMemberAPIServer) DeleteChamaMember(
-	ctx context.Context, req *chama.DeleteChamaMemberRequest,
-) (*emptypb.Empty, error) {
-	// Authorization
-	_, err := chamaMemberAPI.Auth.AuthorizeGroup(ctx, chamaMemberAPI.AllowedGroups...)
+	pb, err := models.ChamaMemberProto(db)
 	if err != nil {
 		return nil, err
 	}
 
-	// Validation
-	switch {
-	case req == nil:
-		return nil, errs.MissingField("request body")
-	case req.MemberId == "":
-		return nil, errs.MissingField("member id")
+	pb.OtherChamaIds, err = chamaMemberAPI.otherChamaIDs(db.ChamaID, db.IDNumber)
+	if err != nil {
+		return nil, err
 	}
 
-	err = chamaMemberAPI.SQLDB.Delete(&models.ChamaMember{}, "id = ?", req.MemberId).Error
+	pb.KycStatus, err = kyc.Status(chamaMemberAPI.SQLDB, pb.MemberId)
 	if err != nil {
-		return nil, errs.FailedToDelete("chama member", err)
+		return nil, err
 	}
 
-	return &emptypb.Empty{}, nil
+	return pb, nil
 }
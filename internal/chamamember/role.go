@@ -0,0 +1,65 @@
+package chamamember
+
+import (
+	"context"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+func (chamaMemberAPI *chamaMemberAPIServer) AssignChamaRole(
+	ctx context.Context, req *chama.AssignChamaRoleRequest,
+) (*chama.ChamaMember, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.MemberId == "":
+		return nil, errs.MissingField("member id")
+	case chama.ChamaRole_name[int32(req.Role)] == "":
+		return nil, errs.IncorrectVal("role")
+	}
+
+	// Authorization
+	_, memberDB, err := chamaMemberAPI.authorizer.AuthorizeMember(ctx, req.MemberId, roles.Chairman...)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Role != chama.ChamaRole_ROLE_MEMBER {
+		switch {
+		case !memberDB.Active:
+			return nil, errs.WrapMessage(codes.FailedPrecondition, "only active members can hold office")
+		case memberDB.UserID == "":
+			return nil, errs.WrapMessage(codes.FailedPrecondition, "member has no user account to hold office with")
+		}
+	}
+
+	err = chamaMemberAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		// An office has one holder at a time
+		if req.Role != chama.ChamaRole_ROLE_MEMBER {
+			err := tx.Model(&models.ChamaMember{}).
+				Where("chama_id = ? AND role = ? AND id != ?", memberDB.ChamaID, req.Role.String(), memberDB.ID).
+				Update("role", chama.ChamaRole_ROLE_MEMBER.String()).Error
+			if err != nil {
+				return errs.FailedToUpdate("chama member", err)
+			}
+		}
+
+		err := tx.Model(memberDB).Update("role", req.Role.String()).Error
+		if err != nil {
+			return errs.FailedToUpdate("chama member", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return models.ChamaMemberProto(memberDB)
+}
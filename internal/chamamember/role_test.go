@@ -0,0 +1,203 @@
+package chamamember
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/pkg/mocks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// userAuth authenticates every request as a plain user outside the admin groups
+type userAuth struct {
+	auth.API
+	userID string
+}
+
+func (userAuth *userAuth) AuthenticateRequestV2(context.Context) (*auth.Payload, error) {
+	return &auth.Payload{ID: userAuth.userID, Group: auth.DefaultUserGroup()}, nil
+}
+
+func (*userAuth) IsAdmin(string) bool {
+	return false
+}
+
+func createRoleMember(chamaID, userID string, role chama.ChamaRole) *models.ChamaMember {
+	pb := mockChamaMember()
+	pb.ChamaId = chamaID
+	pb.UserId = userID
+	pb.Active = true
+	memberDB, err := models.ChamaMemberModel(pb)
+	Expect(err).ShouldNot(HaveOccurred())
+	memberDB.Role = role.String()
+	Expect(ChamaMemberAPIServer.SQLDB.Create(memberDB).Error).ShouldNot(HaveOccurred())
+	return memberDB
+}
+
+var _ = Describe("AssignChamaRole", func() {
+	var (
+		assignReq *chama.AssignChamaRoleRequest
+		ctx       context.Context
+		chamaID   string
+	)
+
+	BeforeEach(func() {
+		chamaID = fmt.Sprint(randomdata.Number(1000, 999999))
+		assignReq = &chama.AssignChamaRoleRequest{
+			MemberId: "1",
+			Role:     chama.ChamaRole_TREASURER,
+		}
+		ctx = context.TODO()
+	})
+
+	Describe("AssignChamaRole with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			assignReq = nil
+			assignRes, err := ChamaMemberAPI.AssignChamaRole(ctx, assignReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(assignRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when member id is missing", func() {
+			assignReq.MemberId = ""
+			assignRes, err := ChamaMemberAPI.AssignChamaRole(ctx, assignReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(assignRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when role is unknown", func() {
+			assignReq.Role = chama.ChamaRole(99)
+			assignRes, err := ChamaMemberAPI.AssignChamaRole(ctx, assignReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(assignRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when member does not exist", func() {
+			assignReq.MemberId = "oops"
+			assignRes, err := ChamaMemberAPI.AssignChamaRole(ctx, assignReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(assignRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+		It("should fail when member has no user account", func() {
+			memberDB := createRoleMember(chamaID, "", chama.ChamaRole_ROLE_MEMBER)
+			assignReq.MemberId = fmt.Sprint(memberDB.ID)
+			assignRes, err := ChamaMemberAPI.AssignChamaRole(ctx, assignReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(assignRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+
+	Describe("AssignChamaRole with well formed request", func() {
+		It("should hand the office over from the previous holder", func() {
+			previousDB := createRoleMember(chamaID, randomdata.RandStringRunes(10), chama.ChamaRole_TREASURER)
+			memberDB := createRoleMember(chamaID, randomdata.RandStringRunes(10), chama.ChamaRole_ROLE_MEMBER)
+
+			assignReq.MemberId = fmt.Sprint(memberDB.ID)
+			assignRes, err := ChamaMemberAPI.AssignChamaRole(ctx, assignReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(assignRes.Role).Should(Equal(chama.ChamaRole_TREASURER))
+
+			Expect(ChamaMemberAPIServer.SQLDB.First(previousDB, previousDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(previousDB.Role).Should(Equal(chama.ChamaRole_ROLE_MEMBER.String()))
+		})
+	})
+})
+
+var _ = Describe("Chama roles", func() {
+	var (
+		ctx          context.Context
+		chamaID      string
+		otherChamaID string
+		userID       string
+		memberAPI    chama.ChamaMemberAPIServer
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		chamaID = fmt.Sprint(randomdata.Number(1000, 999999))
+		otherChamaID = fmt.Sprint(randomdata.Number(1000, 999999))
+		userID = randomdata.RandStringRunes(10)
+
+		var err error
+		memberAPI, err = NewChamaMemberAPI(ctx, &Options{
+			SQLDB:      ChamaMemberAPIServer.SQLDB,
+			Logger:     ChamaMemberAPIServer.Logger,
+			PageHasher: ChamaMemberAPIServer.PageHasher,
+			Auth:       &userAuth{API: mocks.AuthAPI, userID: userID},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("should let members read members of their chama only", func() {
+		createRoleMember(chamaID, userID, chama.ChamaRole_ROLE_MEMBER)
+		peerDB := createRoleMember(chamaID, "", chama.ChamaRole_ROLE_MEMBER)
+		strangerDB := createRoleMember(otherChamaID, "", chama.ChamaRole_ROLE_MEMBER)
+
+		getRes, err := memberAPI.GetChamaMember(ctx, &chama.GetChamaMemberRequest{MemberId: fmt.Sprint(peerDB.ID)})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(getRes).ShouldNot(BeNil())
+
+		getRes, err = memberAPI.GetChamaMember(ctx, &chama.GetChamaMemberRequest{MemberId: fmt.Sprint(strangerDB.ID)})
+		Expect(err).Should(HaveOccurred())
+		Expect(getRes).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+	})
+
+	It("should scope listing to the chamas of the actor", func() {
+		createRoleMember(chamaID, userID, chama.ChamaRole_ROLE_MEMBER)
+		createRoleMember(otherChamaID, "", chama.ChamaRole_ROLE_MEMBER)
+
+		listRes, err := memberAPI.ListChamaMembers(ctx, &chama.ListChamaMembersRequest{})
+		Expect(err).ShouldNot(HaveOccurred())
+		for _, memberPB := range listRes.ChamaMembers {
+			Expect(memberPB.ChamaId).Should(Equal(chamaID))
+		}
+
+		listRes, err = memberAPI.ListChamaMembers(ctx, &chama.ListChamaMembersRequest{
+			Filter: &chama.ChamaMemberFilter{ChamaIds: []string{otherChamaID}},
+		})
+		Expect(err).Should(HaveOccurred())
+		Expect(listRes).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+	})
+
+	It("should require an officer role to add members", func() {
+		actorDB := createRoleMember(chamaID, userID, chama.ChamaRole_ROLE_MEMBER)
+
+		memberPB := mockChamaMember()
+		memberPB.ChamaId = chamaID
+
+		createRes, err := memberAPI.CreateChamaMember(ctx, &chama.CreateChamaMemberRequest{ChamaMember: memberPB})
+		Expect(err).Should(HaveOccurred())
+		Expect(createRes).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+
+		Expect(ChamaMemberAPIServer.SQLDB.Model(actorDB).Update("role", chama.ChamaRole_SECRETARY.String()).Error).ShouldNot(HaveOccurred())
+
+		createRes, err = memberAPI.CreateChamaMember(ctx, &chama.CreateChamaMemberRequest{ChamaMember: memberPB})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(createRes).ShouldNot(BeNil())
+	})
+
+	It("should not honour roles held in another chama", func() {
+		createRoleMember(otherChamaID, userID, chama.ChamaRole_CHAIRMAN)
+		memberDB := createRoleMember(chamaID, randomdata.RandStringRunes(10), chama.ChamaRole_ROLE_MEMBER)
+
+		assignRes, err := memberAPI.AssignChamaRole(ctx, &chama.AssignChamaRoleRequest{
+			MemberId: fmt.Sprint(memberDB.ID),
+			Role:     chama.ChamaRole_TREASURER,
+		})
+		Expect(err).Should(HaveOccurred())
+		Expect(assignRes).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+	})
+})
@@ -0,0 +1,134 @@
+package chamamember
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+const joinDateLayout = "2006-01-02"
+
+// Loans that are no longer owed on even when they aren't fully settled
+var closedLoanStatuses = []string{
+	loan.LoanStatus_REFINANCED.String(),
+	loan.LoanStatus_WRITTEN_OFF.String(),
+	loan.LoanStatus_CANCELLED.String(),
+}
+
+// likeEscaper escapes the wildcards of LIKE patterns so that search terms match literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// filterMembers applies the member filter to a query on chama members
+func filterMembers(db *gorm.DB, filter *chama.ChamaMemberFilter, now time.Time) (*gorm.DB, error) {
+	if filter == nil {
+		return db, nil
+	}
+
+	if search := strings.TrimSpace(filter.Search); search != "" {
+		pattern := "%" + likeEscaper.Replace(search) + "%"
+		db = db.Where(
+			"(CONCAT(first_name, ' ', last_name) LIKE ? OR phone LIKE ? OR id_number LIKE ?)", pattern, pattern, pattern,
+		)
+	}
+
+	if len(filter.Statuses) != 0 {
+		db = db.Where("status IN (?)", filter.Statuses)
+	}
+
+	switch filter.Active {
+	case chama.MemberActiveFilter_MEMBERS_ACTIVE_ONLY:
+		db = db.Where("active = ?", true)
+	case chama.MemberActiveFilter_MEMBERS_INACTIVE_ONLY:
+		db = db.Where("active = ?", false)
+	}
+
+	// Join dates are whole days. The to date is included.
+	var from, to time.Time
+	var err error
+	if filter.JoinedFromDate != "" {
+		from, err = time.ParseInLocation(joinDateLayout, filter.JoinedFromDate, now.Location())
+		if err != nil {
+			return nil, errs.IncorrectVal("joined from date")
+		}
+		db = db.Where("created_at >= ?", from)
+	}
+	if filter.JoinedToDate != "" {
+		to, err = time.ParseInLocation(joinDateLayout, filter.JoinedToDate, now.Location())
+		if err != nil {
+			return nil, errs.IncorrectVal("joined to date")
+		}
+		if !from.IsZero() && to.Before(from) {
+			return nil, errs.WrapMessage(codes.InvalidArgument, "joined to date is before joined from date")
+		}
+		db = db.Where("created_at < ?", to.AddDate(0, 0, 1))
+	}
+
+	if filter.WithActiveLoan {
+		db = db.Where(
+			"EXISTS (SELECT 1 FROM loans WHERE loans.member_id = CAST(chama_members.id AS CHAR) "+
+				"AND loans.status NOT IN (?) AND loans.settled_amount < loans.loan_amount)",
+			closedLoanStatuses,
+		)
+	}
+
+	// A member is in arrears with an overdue loan installment, an overdue contribution or an unpaid contribution fine
+	if filter.InArrears {
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		db = db.Where(
+			"(EXISTS (SELECT 1 FROM loan_installments JOIN loans ON loans.id = loan_installments.loan_id "+
+				"WHERE loans.member_id = CAST(chama_members.id AS CHAR) AND loans.status NOT IN (?) "+
+				"AND loan_installments.due_date < ? AND loan_installments.amount_paid < loan_installments.amount_due) "+
+				"OR EXISTS (SELECT 1 FROM member_contributions WHERE member_contributions.member_id = CAST(chama_members.id AS CHAR) "+
+				"AND ((member_contributions.due_date < ? AND member_contributions.amount_paid < member_contributions.amount_due) "+
+				"OR member_contributions.fine_paid < member_contributions.fine_amount)))",
+			closedLoanStatuses, now, today,
+		)
+	}
+
+	return db, nil
+}
+
+// sortMembers orders a query on chama members and continues it after the last member of the previous page.
+// Members join in id order so the newest and oldest orders follow the id.
+func sortMembers(db *gorm.DB, sqlDB *gorm.DB, order chama.MemberSortOrder, lastID uint) (*gorm.DB, error) {
+	switch order {
+	case chama.MemberSortOrder_MEMBER_SORT_OLDEST:
+		db = db.Order("id ASC")
+		if lastID != 0 {
+			db = db.Where("id>?", lastID)
+		}
+	case chama.MemberSortOrder_MEMBER_SORT_NAME_ASC, chama.MemberSortOrder_MEMBER_SORT_NAME_DESC:
+		direction, op := "ASC", ">"
+		if order == chama.MemberSortOrder_MEMBER_SORT_NAME_DESC {
+			direction, op = "DESC", "<"
+		}
+		db = db.Order("first_name " + direction).Order("last_name " + direction).Order("id " + direction)
+		if lastID != 0 {
+			lastDB := &models.ChamaMember{}
+			err := sqlDB.Unscoped().Select("id, first_name, last_name").First(lastDB, "id = ?", lastID).Error
+			switch {
+			case err == nil:
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				return nil, errs.WrapMessage(codes.InvalidArgument, "page token is no longer valid")
+			default:
+				return nil, errs.FailedToFind("chama member", err)
+			}
+			db = db.Where("(first_name, last_name, id) "+op+" (?, ?, ?)", lastDB.FirstName, lastDB.LastName, lastDB.ID)
+		}
+	default:
+		db = db.Order("id DESC")
+		if lastID != 0 {
+			db = db.Where("id<?", lastID)
+		}
+	}
+
+	return db, nil
+}
@@ -0,0 +1,195 @@
+package chamamember
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Searching chama members", func() {
+	var (
+		ctx                            context.Context
+		chamaID                        string
+		kamau, otieno, achieng, mwangi *models.ChamaMember
+	)
+
+	createMember := func(firstName, lastName string, active bool, status string) *models.ChamaMember {
+		memberDB := &models.ChamaMember{
+			ChamaID:   chamaID,
+			FirstName: firstName,
+			LastName:  lastName,
+			Phone:     randomPhone(),
+			IDNumber:  fmt.Sprint(randomdata.Number(22222222, 44444444)),
+			Active:    active,
+			Status:    status,
+		}
+		Expect(ChamaMemberAPIServer.SQLDB.Create(memberDB).Error).ShouldNot(HaveOccurred())
+		return memberDB
+	}
+
+	memberIDs := func(listRes *chama.ListChamaMembersResponse) []string {
+		ids := make([]string, 0, len(listRes.ChamaMembers))
+		for _, memberPB := range listRes.ChamaMembers {
+			ids = append(ids, memberPB.MemberId)
+		}
+		return ids
+	}
+
+	list := func(filter *chama.ChamaMemberFilter) []string {
+		filter.ChamaIds = []string{chamaID}
+		listRes, err := ChamaMemberAPI.ListChamaMembers(ctx, &chama.ListChamaMembersRequest{Filter: filter})
+		Expect(err).ShouldNot(HaveOccurred())
+		return memberIDs(listRes)
+	}
+
+	id := func(memberDB *models.ChamaMember) string {
+		return fmt.Sprint(memberDB.ID)
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		chamaID = fmt.Sprint(randomdata.Number(1000, 9999999))
+
+		kamau = createMember("Wanjiru", "Kamau", true, "")
+		otieno = createMember("Otieno", "Odhiambo", false, models.MemberStatusExited)
+		achieng = createMember("Achieng", "Oduya", true, "")
+		mwangi = createMember("Peter", "Mwangi", true, "")
+
+		// Achieng has a running loan with an overdue installment
+		loanDB := &models.Loan{
+			ChamaID:      chamaID,
+			ProductID:    "1",
+			MemberID:     id(achieng),
+			LoaneeNames:  achieng.FirstName,
+			LoaneePhone:  achieng.Phone,
+			NationalID:   achieng.IDNumber,
+			Status:       loan.LoanStatus_FUNDS_TRANSFERED.String(),
+			DurationDays: 30,
+			LoanAmount:   1000,
+		}
+		Expect(ChamaMemberAPIServer.SQLDB.Create(loanDB).Error).ShouldNot(HaveOccurred())
+		Expect(ChamaMemberAPIServer.SQLDB.Create(&models.LoanInstallment{
+			LoanID:            loanDB.ID,
+			InstallmentNumber: 1,
+			AmountDue:         1000,
+			DueDate:           time.Now().AddDate(0, 0, -3),
+		}).Error).ShouldNot(HaveOccurred())
+
+		// Mwangi missed a contribution
+		Expect(ChamaMemberAPIServer.SQLDB.Create(&models.MemberContribution{
+			PlanID:    1,
+			ChamaID:   chamaID,
+			MemberID:  id(mwangi),
+			DueDate:   time.Now().AddDate(0, 0, -10),
+			AmountDue: 500,
+		}).Error).ShouldNot(HaveOccurred())
+
+		err := ChamaMemberAPIServer.SQLDB.Model(kamau).
+			Update("created_at", time.Date(2020, 1, 15, 10, 0, 0, 0, time.Local)).Error
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Describe("Listing members with malformed filters", func() {
+		It("should fail when the join date is wrong", func() {
+			listRes, err := ChamaMemberAPI.ListChamaMembers(ctx, &chama.ListChamaMembersRequest{
+				Filter: &chama.ChamaMemberFilter{JoinedFromDate: "15/01/2020"},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(listRes).Should(BeNil())
+		})
+		It("should fail when the join date range is reversed", func() {
+			listRes, err := ChamaMemberAPI.ListChamaMembers(ctx, &chama.ListChamaMembersRequest{
+				Filter: &chama.ChamaMemberFilter{JoinedFromDate: "2020-02-01", JoinedToDate: "2020-01-01"},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(listRes).Should(BeNil())
+		})
+	})
+
+	Describe("Searching", func() {
+		It("should find members by name", func() {
+			Expect(list(&chama.ChamaMemberFilter{Search: "kamau"})).Should(ConsistOf(id(kamau)))
+			Expect(list(&chama.ChamaMemberFilter{Search: "wanjiru kam"})).Should(ConsistOf(id(kamau)))
+			Expect(list(&chama.ChamaMemberFilter{Search: "Od"})).Should(ConsistOf(id(otieno), id(achieng)))
+		})
+		It("should find members by phone and id number", func() {
+			Expect(list(&chama.ChamaMemberFilter{Search: otieno.Phone})).Should(ConsistOf(id(otieno)))
+			Expect(list(&chama.ChamaMemberFilter{Search: achieng.IDNumber})).Should(ContainElement(id(achieng)))
+		})
+		It("should match wildcards literally", func() {
+			Expect(list(&chama.ChamaMemberFilter{Search: "%"})).Should(BeEmpty())
+		})
+	})
+
+	Describe("Filtering", func() {
+		It("should filter by status and active flag", func() {
+			Expect(list(&chama.ChamaMemberFilter{Statuses: []string{models.MemberStatusExited}})).Should(ConsistOf(id(otieno)))
+			Expect(list(&chama.ChamaMemberFilter{Active: chama.MemberActiveFilter_MEMBERS_INACTIVE_ONLY})).Should(ConsistOf(id(otieno)))
+			Expect(list(&chama.ChamaMemberFilter{Active: chama.MemberActiveFilter_MEMBERS_ACTIVE_ONLY})).Should(
+				ConsistOf(id(kamau), id(achieng), id(mwangi)),
+			)
+		})
+		It("should filter by join date", func() {
+			Expect(list(&chama.ChamaMemberFilter{JoinedFromDate: "2020-01-15", JoinedToDate: "2020-01-15"})).Should(ConsistOf(id(kamau)))
+			Expect(list(&chama.ChamaMemberFilter{JoinedToDate: "2020-12-31"})).Should(ConsistOf(id(kamau)))
+			Expect(list(&chama.ChamaMemberFilter{JoinedFromDate: "2021-01-01"})).Should(ConsistOf(id(otieno), id(achieng), id(mwangi)))
+		})
+		It("should filter members with an active loan", func() {
+			Expect(list(&chama.ChamaMemberFilter{WithActiveLoan: true})).Should(ConsistOf(id(achieng)))
+		})
+		It("should filter members in arrears", func() {
+			Expect(list(&chama.ChamaMemberFilter{InArrears: true})).Should(ConsistOf(id(achieng), id(mwangi)))
+			Expect(list(&chama.ChamaMemberFilter{InArrears: true, Search: "mwangi"})).Should(ConsistOf(id(mwangi)))
+		})
+	})
+
+	Describe("Sorting", func() {
+		It("should page through members by name", func() {
+			pageToken := ""
+			names := make([]string, 0, 4)
+			for {
+				listRes, err := ChamaMemberAPI.ListChamaMembers(ctx, &chama.ListChamaMembersRequest{
+					Filter:    &chama.ChamaMemberFilter{ChamaIds: []string{chamaID}},
+					PageToken: pageToken,
+					PageSize:  1,
+					SortOrder: chama.MemberSortOrder_MEMBER_SORT_NAME_ASC,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				for _, memberPB := range listRes.ChamaMembers {
+					names = append(names, memberPB.FirstName)
+				}
+				if listRes.NextPageToken == "" {
+					break
+				}
+				pageToken = listRes.NextPageToken
+			}
+			Expect(names).Should(Equal([]string{"Achieng", "Otieno", "Peter", "Wanjiru"}))
+		})
+		It("should sort by name descending and by age", func() {
+			listRes, err := ChamaMemberAPI.ListChamaMembers(ctx, &chama.ListChamaMembersRequest{
+				Filter:    &chama.ChamaMemberFilter{ChamaIds: []string{chamaID}},
+				SortOrder: chama.MemberSortOrder_MEMBER_SORT_NAME_DESC,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(memberIDs(listRes)).Should(Equal([]string{id(kamau), id(mwangi), id(otieno), id(achieng)}))
+
+			listRes, err = ChamaMemberAPI.ListChamaMembers(ctx, &chama.ListChamaMembersRequest{
+				Filter:    &chama.ChamaMemberFilter{ChamaIds: []string{chamaID}},
+				SortOrder: chama.MemberSortOrder_MEMBER_SORT_OLDEST,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(memberIDs(listRes)).Should(Equal([]string{id(kamau), id(otieno), id(achieng), id(mwangi)}))
+		})
+	})
+})
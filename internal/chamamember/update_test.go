@@ -84,6 +84,17 @@ var _ = Describe("UpdateChamaMember", func() {
 			})
 		})
 
+		Describe("Updating the exit status", func() {
+			It("should fail when the member is marked as exited", func() {
+				updateRes, err := ChamaMemberAPI.UpdateChamaMember(ctx, &chama.UpdateChamaMemberRequest{
+					ChamaMember: &chama.ChamaMember{MemberId: newChamaMember.MemberId, Status: models.MemberStatusExited},
+				})
+				Expect(err).Should(HaveOccurred())
+				Expect(updateRes).Should(BeNil())
+				Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+			})
+		})
+
 		Context("Lets get chama and check whether its updated", func() {
 			It("should succeed in getting chama member", func() {
 				chamaPB, err := ChamaMemberAPI.GetChamaMember(ctx, &chama.GetChamaMemberRequest{
@@ -105,4 +116,20 @@ var _ = Describe("UpdateChamaMember", func() {
 			})
 		})
 	})
+
+	Describe("Updating a member who has left the chama", func() {
+		It("should fail", func() {
+			memberDB, err := models.ChamaMemberModel(mockChamaMember())
+			Expect(err).ShouldNot(HaveOccurred())
+			memberDB.Status = models.MemberStatusExited
+			Expect(ChamaMemberAPIServer.SQLDB.Create(memberDB).Error).ShouldNot(HaveOccurred())
+
+			updateRes, err := ChamaMemberAPI.UpdateChamaMember(ctx, &chama.UpdateChamaMemberRequest{
+				ChamaMember: &chama.ChamaMember{MemberId: fmt.Sprint(memberDB.ID), Active: true, Status: "Rejoined"},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(updateRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
 })
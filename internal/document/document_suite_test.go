@@ -0,0 +1,13 @@
+package document
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDocument(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Document Suite")
+}
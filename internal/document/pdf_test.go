@@ -0,0 +1,41 @@
+package document
+
+import (
+	"bytes"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Text PDF", func() {
+	It("should render a single page document", func() {
+		bs := TextPDF("Loan statement", []string{"Loan 1", "Balance 100.00"})
+		Expect(bytes.HasPrefix(bs, []byte("%PDF-1.4\n"))).Should(BeTrue())
+		Expect(bytes.HasSuffix(bs, []byte("%%EOF\n"))).Should(BeTrue())
+		Expect(string(bs)).Should(ContainSubstring("/Count 1"))
+		Expect(string(bs)).Should(ContainSubstring("(Balance 100.00) '"))
+	})
+
+	It("should escape text", func() {
+		bs := TextPDF("Statement", []string{`Fee (upfront) \ naïve`})
+		Expect(string(bs)).Should(ContainSubstring(`(Fee \(upfront\) \\ na?ve) '`))
+	})
+
+	It("should start new pages when a page is full", func() {
+		lines := make([]string, 0, linesPerPage*2+1)
+		for i := 0; i < cap(lines); i++ {
+			lines = append(lines, fmt.Sprint("line ", i))
+		}
+		bs := TextPDF("Statement", lines)
+		Expect(string(bs)).Should(ContainSubstring("/Count 3"))
+	})
+
+	It("should point the cross reference table at each object", func() {
+		bs := TextPDF("Statement", []string{"line"})
+		for i := 1; i <= 6; i++ {
+			Expect(string(bs)).Should(ContainSubstring(fmt.Sprintf("\n%d 0 obj\n", i)))
+		}
+		Expect(string(bs)).Should(ContainSubstring("/Size 7"))
+	})
+})
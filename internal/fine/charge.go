@@ -0,0 +1,45 @@
+package fine
+
+import (
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"gorm.io/gorm"
+)
+
+// Names of the fine types the chama rules charge members with
+const (
+	MeetingAbsenceFine   = "Meeting absence"
+	MeetingLatenessFine  = "Late for meeting"
+	LateContributionFine = "Late contribution"
+)
+
+// Charge issues a fine to a member on behalf of the chama rules. The fine type is taken from the catalog of the chama
+// by the name of the fine, and added to the catalog the first time it is charged. A fine whose amount is already paid is
+// issued as paid.
+func Charge(tx *gorm.DB, fineDB *models.Fine) error {
+	fineTypeDB := &models.FineType{}
+	err := tx.Where(&models.FineType{ChamaID: fineDB.ChamaID, Name: fineDB.FineName}).
+		Attrs(&models.FineType{Amount: fineDB.Amount, AccountName: fineDB.AccountName, Active: true}).
+		FirstOrCreate(fineTypeDB).Error
+	if err != nil {
+		return errs.FailedToSave("fine type", err)
+	}
+
+	fineDB.FineTypeID = fineTypeDB.ID
+	fineDB.Status = chama.FineStatus_FINE_OUTSTANDING.String()
+	if fineDB.Amount-fineDB.PaidAmount <= amountTolerance {
+		paidAt := time.Now()
+		fineDB.Status = chama.FineStatus_FINE_PAID.String()
+		fineDB.PaidAt = &paidAt
+	}
+
+	err = tx.Create(fineDB).Error
+	if err != nil {
+		return errs.FailedToSave("fine", err)
+	}
+
+	return nil
+}
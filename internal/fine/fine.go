@@ -0,0 +1,510 @@
+package fine
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/pkg/utils/mdutil"
+
+	"github.com/speps/go-hashids"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"gorm.io/gorm"
+)
+
+type Options struct {
+	MoneyAccountAPI transaction.ChamaAccountAPIServer
+	TransactionAPI  transaction.TransactionAPIServer
+	SQLDB           *gorm.DB
+	PageHasher      *hashids.HashID
+	Logger          grpclog.LoggerV2
+	Auth            auth.API
+	AllowedGroups   []string
+}
+
+type fineAPIServer struct {
+	chama.UnimplementedFineAPIServer
+	*Options
+	authorizer *roles.Authorizer
+}
+
+// NewFineAPI creates the API for the fines catalog of chamas and the fines issued to their members
+func NewFineAPI(ctx context.Context, opt *Options) (chama.FineAPIServer, error) {
+	// Validation
+	switch {
+	case ctx == nil:
+		return nil, errors.New("missing context")
+	case opt == nil:
+		return nil, errors.New("missing options")
+	case opt.TransactionAPI == nil:
+		return nil, errors.New("missing transaction API")
+	case opt.MoneyAccountAPI == nil:
+		return nil, errors.New("missing chama account API")
+	case opt.SQLDB == nil:
+		return nil, errors.New("missing sql db")
+	case opt.Logger == nil:
+		return nil, errors.New("missing logger")
+	case opt.Auth == nil:
+		return nil, errors.New("missing auth API")
+	case opt.PageHasher == nil:
+		return nil, errors.New("missing pagination hasher")
+	default:
+		if len(opt.AllowedGroups) == 0 {
+			opt.AllowedGroups = opt.Auth.AdminGroups()
+		}
+	}
+
+	fineAPI := &fineAPIServer{
+		Options:    opt,
+		authorizer: roles.NewAuthorizer(opt.Auth, opt.SQLDB, opt.AllowedGroups),
+	}
+
+	return fineAPI, nil
+}
+
+const amountTolerance = 0.005
+
+func ValidateFineType(pb *chama.FineType) error {
+	switch {
+	case pb == nil:
+		return errs.MissingField("fine type")
+	case pb.ChamaId == "":
+		return errs.MissingField("chama id")
+	case pb.Name == "":
+		return errs.MissingField("fine name")
+	case pb.Amount <= 0:
+		return errs.IncorrectVal("fine amount")
+	case pb.AccountName == "":
+		return errs.MissingField("account name")
+	}
+	return nil
+}
+
+// checkFineAccount confirms the chama account fines of a type are paid into
+func (fineAPI *fineAPIServer) checkFineAccount(ctx context.Context, chamaID, accountName string) error {
+	_, err := fineAPI.MoneyAccountAPI.GetChamaAccount(mdutil.AddFromCtx(ctx), &transaction.GetChamaAccountRequest{
+		OwnerId:     chamaID,
+		AccountName: accountName,
+	})
+	return err
+}
+
+func (fineAPI *fineAPIServer) CreateFineType(
+	ctx context.Context, req *chama.CreateFineTypeRequest,
+) (*chama.FineType, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	default:
+		err := ValidateFineType(req.FineType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Authorization
+	_, err := fineAPI.authorizer.AuthorizeChama(ctx, req.FineType.ChamaId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fineAPI.checkFineAccount(ctx, req.FineType.ChamaId, req.FineType.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	fineTypeDB, err := models.FineTypeModel(req.FineType)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fineAPI.checkFineTypeName(fineTypeDB.ChamaID, fineTypeDB.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	fineTypeDB.Active = true
+
+	err = fineAPI.SQLDB.Create(fineTypeDB).Error
+	if err != nil {
+		return nil, errs.FailedToSave("fine type", err)
+	}
+
+	return models.FineTypeProto(fineTypeDB)
+}
+
+// checkFineTypeName ensures fine types of a chama have distinct names
+func (fineAPI *fineAPIServer) checkFineTypeName(chamaID, name string) error {
+	var count int64
+	err := fineAPI.SQLDB.Model(&models.FineType{}).Where("chama_id = ? AND name = ?", chamaID, name).Count(&count).Error
+	if err != nil {
+		return errs.FailedToFind("fine types", err)
+	}
+	if count > 0 {
+		return errs.DuplicateField("fine type name", name)
+	}
+	return nil
+}
+
+func (fineAPI *fineAPIServer) getFineType(fineTypeID string) (*models.FineType, error) {
+	fineTypeDB := &models.FineType{}
+	err := fineAPI.SQLDB.First(fineTypeDB, "id = ?", fineTypeID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("fine type", fineTypeID)
+	default:
+		return nil, errs.FailedToFind("fine type", err)
+	}
+	return fineTypeDB, nil
+}
+
+func (fineAPI *fineAPIServer) UpdateFineType(
+	ctx context.Context, req *chama.UpdateFineTypeRequest,
+) (*chama.FineType, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.FineType == nil:
+		return nil, errs.MissingField("fine type")
+	case req.FineType.FineTypeId == "":
+		return nil, errs.MissingField("fine type id")
+	case req.FineType.Amount < 0:
+		return nil, errs.IncorrectVal("fine amount")
+	}
+
+	fineTypeDB, err := fineAPI.getFineType(req.FineType.FineTypeId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	_, err = fineAPI.authorizer.AuthorizeChama(ctx, fineTypeDB.ChamaID, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.FineType.Name != "" && req.FineType.Name != fineTypeDB.Name {
+		err = fineAPI.checkFineTypeName(fineTypeDB.ChamaID, req.FineType.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.FineType.AccountName != "" {
+		err = fineAPI.checkFineAccount(ctx, fineTypeDB.ChamaID, req.FineType.AccountName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Fines already issued keep the terms they were issued with
+	err = fineAPI.SQLDB.Model(fineTypeDB).Updates(map[string]interface{}{
+		"name":         firstNonEmpty(req.FineType.Name, fineTypeDB.Name),
+		"description":  firstNonEmpty(req.FineType.Description, fineTypeDB.Description),
+		"amount":       firstNonZero(req.FineType.Amount, fineTypeDB.Amount),
+		"account_name": firstNonEmpty(req.FineType.AccountName, fineTypeDB.AccountName),
+		"active":       req.FineType.Active,
+	}).Error
+	if err != nil {
+		return nil, errs.FailedToUpdate("fine type", err)
+	}
+
+	fineTypeDB, err = fineAPI.getFineType(req.FineType.FineTypeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.FineTypeProto(fineTypeDB)
+}
+
+func firstNonEmpty(val, fallback string) string {
+	if val != "" {
+		return val
+	}
+	return fallback
+}
+
+func firstNonZero(val, fallback float64) float64 {
+	if val != 0 {
+		return val
+	}
+	return fallback
+}
+
+func (fineAPI *fineAPIServer) ListFineTypes(
+	ctx context.Context, req *chama.ListFineTypesRequest,
+) (*chama.ListFineTypesResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.ChamaId == "":
+		return nil, errs.MissingField("chama id")
+	}
+
+	// Authorization
+	_, err := fineAPI.authorizer.AuthorizeChama(ctx, req.ChamaId, roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
+	db := fineAPI.SQLDB.Where("chama_id = ?", req.ChamaId)
+	if req.ActiveOnly {
+		db = db.Where("active = ?", true)
+	}
+
+	fineTypes := make([]*models.FineType, 0)
+	err = db.Order("name ASC").Find(&fineTypes).Error
+	if err != nil {
+		return nil, errs.FailedToFind("fine types", err)
+	}
+
+	pbs := make([]*chama.FineType, 0, len(fineTypes))
+	for _, fineTypeDB := range fineTypes {
+		pb, err := models.FineTypeProto(fineTypeDB)
+		if err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+
+	return &chama.ListFineTypesResponse{FineTypes: pbs}, nil
+}
+
+func (fineAPI *fineAPIServer) IssueFine(
+	ctx context.Context, req *chama.IssueFineRequest,
+) (*chama.Fine, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.Fine == nil:
+		return nil, errs.MissingField("fine")
+	case req.Fine.MemberId == "":
+		return nil, errs.MissingField("member id")
+	case req.Fine.FineTypeId == "":
+		return nil, errs.MissingField("fine type id")
+	case req.Fine.Amount < 0:
+		return nil, errs.IncorrectVal("fine amount")
+	}
+
+	fineTypeDB, err := fineAPI.getFineType(req.Fine.FineTypeId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	actor, err := fineAPI.authorizer.AuthorizeChama(ctx, fineTypeDB.ChamaID, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fineTypeDB.Active {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "fine type is not active")
+	}
+
+	memberDB := &models.ChamaMember{}
+	err = fineAPI.SQLDB.First(memberDB, "id = ? AND chama_id = ?", req.Fine.MemberId, fineTypeDB.ChamaID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.WrapMessagef(codes.InvalidArgument, "member %s is not in the chama", req.Fine.MemberId)
+	default:
+		return nil, errs.FailedToFind("chama member", err)
+	}
+
+	fineDB := &models.Fine{
+		ChamaID:     fineTypeDB.ChamaID,
+		MemberID:    req.Fine.MemberId,
+		MemberNames: memberDB.FirstName + " " + memberDB.LastName,
+		FineTypeID:  fineTypeDB.ID,
+		FineName:    fineTypeDB.Name,
+		AccountName: fineTypeDB.AccountName,
+		Amount:      firstNonZero(req.Fine.Amount, fineTypeDB.Amount),
+		Reason:      req.Fine.Reason,
+		Status:      chama.FineStatus_FINE_OUTSTANDING.String(),
+		IssuedBy:    actor.ID,
+	}
+
+	err = fineAPI.SQLDB.Create(fineDB).Error
+	if err != nil {
+		return nil, errs.FailedToSave("fine", err)
+	}
+
+	return models.FineProto(fineDB)
+}
+
+func (fineAPI *fineAPIServer) getFine(fineID string) (*models.Fine, error) {
+	fineDB := &models.Fine{}
+	err := fineAPI.SQLDB.First(fineDB, "id = ?", fineID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("fine", fineID)
+	default:
+		return nil, errs.FailedToFind("fine", err)
+	}
+	return fineDB, nil
+}
+
+// authorizeFine gets a fine and authorizes an actor holding one of the roles in its chama
+func (fineAPI *fineAPIServer) authorizeFine(
+	ctx context.Context, fineID string, roles ...chama.ChamaRole,
+) (*auth.Payload, *models.Fine, error) {
+	fineDB, err := fineAPI.getFine(fineID)
+	if err != nil {
+		return nil, nil, err
+	}
+	actor, err := fineAPI.authorizer.AuthorizeChama(ctx, fineDB.ChamaID, roles...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return actor, fineDB, nil
+}
+
+func (fineAPI *fineAPIServer) GetFine(
+	ctx context.Context, req *chama.GetFineRequest,
+) (*chama.Fine, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.FineId == "":
+		return nil, errs.MissingField("fine id")
+	}
+
+	// Authorization
+	_, fineDB, err := fineAPI.authorizeFine(ctx, req.FineId, roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.FineProto(fineDB)
+}
+
+const defaultPageSize = 50
+
+// outstandingStatuses are statuses of fines that still have a balance to pay
+var outstandingStatuses = []string{
+	chama.FineStatus_FINE_OUTSTANDING.String(),
+	chama.FineStatus_FINE_WAIVER_PENDING.String(),
+}
+
+func (fineAPI *fineAPIServer) ListFines(
+	ctx context.Context, req *chama.ListFinesRequest,
+) (*chama.ListFinesResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("list request")
+	}
+
+	// Authorization
+	chamaIDs := []string{}
+	if req.ChamaId != "" {
+		chamaIDs = append(chamaIDs, req.ChamaId)
+	}
+	actor, chamaIDs, err := fineAPI.authorizer.ScopeChamas(ctx, chamaIDs, roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.GetPageSize()
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultPageSize
+	case pageSize > defaultPageSize:
+		if !fineAPI.Auth.IsAdmin(actor.Group) {
+			pageSize = defaultPageSize
+		}
+	}
+
+	var ID uint
+	pageToken := req.GetPageToken()
+	if pageToken != "" {
+		ids, err := fineAPI.PageHasher.DecodeInt64WithError(req.GetPageToken())
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		ID = uint(ids[0])
+	}
+
+	// Filters apply to both the page and the outstanding total
+	db := fineAPI.SQLDB.Model(&models.Fine{})
+	if chamaIDs != nil {
+		db = db.Where("chama_id IN (?)", chamaIDs)
+	}
+	if req.MemberId != "" {
+		db = db.Where("member_id = ?", req.MemberId)
+	}
+	if len(req.Statuses) != 0 {
+		statuses := make([]string, 0, len(req.Statuses))
+		for _, status := range req.Statuses {
+			statuses = append(statuses, status.String())
+		}
+		db = db.Where("status IN (?)", statuses)
+	}
+
+	var outstanding float64
+	err = db.Session(&gorm.Session{}).Where("status IN (?)", outstandingStatuses).
+		Select("COALESCE(SUM(amount - paid_amount), 0)").Scan(&outstanding).Error
+	if err != nil {
+		return nil, errs.FailedToFind("outstanding fines", err)
+	}
+
+	db = db.Session(&gorm.Session{}).Limit(int(pageSize + 1)).Order("id DESC")
+	if ID != 0 {
+		db = db.Where("id<?", ID)
+	}
+
+	dbs := make([]*models.Fine, 0, pageSize+1)
+	err = db.Find(&dbs).Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "LIST")
+	}
+
+	pbs := make([]*chama.Fine, 0, len(dbs))
+	for i, db := range dbs {
+		if i == int(pageSize) {
+			break
+		}
+
+		pb, err := models.FineProto(db)
+		if err != nil {
+			return nil, err
+		}
+
+		pbs = append(pbs, pb)
+
+		ID = db.ID
+	}
+
+	var token string
+	if len(dbs) > int(pageSize) {
+		// Next page token
+		token, err = fineAPI.PageHasher.EncodeInt64([]int64{int64(ID)})
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to generate next page token")
+		}
+	}
+
+	return &chama.ListFinesResponse{
+		Fines:             pbs,
+		NextPageToken:     token,
+		OutstandingAmount: roundAmount(outstanding),
+	}, nil
+}
+
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
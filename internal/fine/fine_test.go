@@ -0,0 +1,192 @@
+package fine
+
+import (
+	"context"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Fines catalog", func() {
+	var (
+		ctx       context.Context
+		chamaID   string
+		memberIDs []string
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		var err error
+		chamaID, memberIDs, err = createChama(1000, 1000)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Describe("CreateFineType with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			fineTypeRes, err := FineAPI.CreateFineType(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(fineTypeRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the amount is missing", func() {
+			fineTypePB := mockFineType(chamaID)
+			fineTypePB.Amount = 0
+			fineTypeRes, err := FineAPI.CreateFineType(ctx, &chama.CreateFineTypeRequest{FineType: fineTypePB})
+			Expect(err).Should(HaveOccurred())
+			Expect(fineTypeRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the account doesn't exist", func() {
+			fineTypePB := mockFineType(chamaID)
+			fineTypePB.AccountName = "welfare"
+			fineTypeRes, err := FineAPI.CreateFineType(ctx, &chama.CreateFineTypeRequest{FineType: fineTypePB})
+			Expect(err).Should(HaveOccurred())
+			Expect(fineTypeRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("Managing fine types", func() {
+		var fineTypePB *chama.FineType
+
+		BeforeEach(func() {
+			var err error
+			fineTypePB, err = FineAPI.CreateFineType(ctx, &chama.CreateFineTypeRequest{FineType: mockFineType(chamaID)})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fineTypePB.Active).Should(BeTrue())
+		})
+
+		It("should fail to create a fine type with the same name", func() {
+			fineTypeRes, err := FineAPI.CreateFineType(ctx, &chama.CreateFineTypeRequest{FineType: &chama.FineType{
+				ChamaId:     chamaID,
+				Name:        fineTypePB.Name,
+				Amount:      100,
+				AccountName: fineAccount,
+			}})
+			Expect(err).Should(HaveOccurred())
+			Expect(fineTypeRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.AlreadyExists))
+		})
+
+		It("should keep the terms of fines issued before an update", func() {
+			fineRes, err := FineAPI.IssueFine(ctx, &chama.IssueFineRequest{Fine: &chama.Fine{
+				MemberId:   memberIDs[0],
+				FineTypeId: fineTypePB.FineTypeId,
+			}})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fineRes.Amount).Should(BeNumerically("~", 300, 0.001))
+			Expect(fineRes.FineName).Should(Equal(fineTypePB.Name))
+			Expect(fineRes.Status).Should(Equal(chama.FineStatus_FINE_OUTSTANDING))
+
+			fineTypeRes, err := FineAPI.UpdateFineType(ctx, &chama.UpdateFineTypeRequest{FineType: &chama.FineType{
+				FineTypeId: fineTypePB.FineTypeId,
+				Amount:     500,
+				Active:     true,
+			}})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fineTypeRes.Amount).Should(BeNumerically("~", 500, 0.001))
+			Expect(fineTypeRes.Name).Should(Equal(fineTypePB.Name))
+
+			getRes, err := FineAPI.GetFine(ctx, &chama.GetFineRequest{FineId: fineRes.FineId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.Amount).Should(BeNumerically("~", 300, 0.001))
+		})
+
+		It("should not issue fines of inactive types", func() {
+			_, err := FineAPI.UpdateFineType(ctx, &chama.UpdateFineTypeRequest{FineType: &chama.FineType{
+				FineTypeId: fineTypePB.FineTypeId,
+			}})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			listRes, err := FineAPI.ListFineTypes(ctx, &chama.ListFineTypesRequest{ChamaId: chamaID, ActiveOnly: true})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.FineTypes).Should(BeEmpty())
+
+			fineRes, err := FineAPI.IssueFine(ctx, &chama.IssueFineRequest{Fine: &chama.Fine{
+				MemberId:   memberIDs[0],
+				FineTypeId: fineTypePB.FineTypeId,
+			}})
+			Expect(err).Should(HaveOccurred())
+			Expect(fineRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should not issue fines to members of another chama", func() {
+			fineRes, err := FineAPI.IssueFine(ctx, &chama.IssueFineRequest{Fine: &chama.Fine{
+				MemberId:   "0",
+				FineTypeId: fineTypePB.FineTypeId,
+			}})
+			Expect(err).Should(HaveOccurred())
+			Expect(fineRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("should list outstanding fines per member and per chama", func() {
+			for _, issue := range []struct {
+				memberID string
+				amount   float64
+			}{{memberIDs[0], 0}, {memberIDs[0], 150}, {memberIDs[1], 0}} {
+				_, err := FineAPI.IssueFine(ctx, &chama.IssueFineRequest{Fine: &chama.Fine{
+					MemberId:   issue.memberID,
+					FineTypeId: fineTypePB.FineTypeId,
+					Amount:     issue.amount,
+				}})
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			listRes, err := FineAPI.ListFines(ctx, &chama.ListFinesRequest{MemberId: memberIDs[0]})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Fines).Should(HaveLen(2))
+			Expect(listRes.OutstandingAmount).Should(BeNumerically("~", 450, 0.001))
+
+			listRes, err = FineAPI.ListFines(ctx, &chama.ListFinesRequest{
+				ChamaId:  chamaID,
+				Statuses: []chama.FineStatus{chama.FineStatus_FINE_OUTSTANDING},
+				PageSize: 2,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Fines).Should(HaveLen(2))
+			Expect(listRes.NextPageToken).ShouldNot(BeEmpty())
+			Expect(listRes.OutstandingAmount).Should(BeNumerically("~", 750, 0.001))
+		})
+	})
+
+	Describe("Charging fines by the chama rules", func() {
+		charge := func(memberID string, amount, paid float64) *models.Fine {
+			fineDB := &models.Fine{
+				ChamaID:     chamaID,
+				MemberID:    memberID,
+				FineName:    LateContributionFine,
+				AccountName: fineAccount,
+				Amount:      amount,
+				PaidAmount:  paid,
+			}
+			Expect(Charge(FineAPIServer.SQLDB, fineDB)).ShouldNot(HaveOccurred())
+			return fineDB
+		}
+
+		It("should add the fine type to the catalog once and issue the fines", func() {
+			outstanding := charge(memberIDs[0], 100, 0)
+			Expect(outstanding.Status).Should(Equal(chama.FineStatus_FINE_OUTSTANDING.String()))
+
+			paid := charge(memberIDs[1], 100, 100)
+			Expect(paid.Status).Should(Equal(chama.FineStatus_FINE_PAID.String()))
+			Expect(paid.PaidAt).ShouldNot(BeNil())
+			Expect(paid.FineTypeID).Should(Equal(outstanding.FineTypeID))
+
+			listRes, err := FineAPI.ListFineTypes(ctx, &chama.ListFineTypesRequest{ChamaId: chamaID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.FineTypes).Should(HaveLen(1))
+			Expect(listRes.FineTypes[0].Name).Should(Equal(LateContributionFine))
+
+			finesRes, err := FineAPI.ListFines(ctx, &chama.ListFinesRequest{ChamaId: chamaID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(finesRes.Fines).Should(HaveLen(2))
+			Expect(finesRes.OutstandingAmount).Should(BeNumerically("~", 100, 0.001))
+		})
+	})
+})
@@ -0,0 +1,286 @@
+package fine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	transaction_app "github.com/gidyon/machama-app/internal/transaction"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/pkg/utils/mdutil"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+func (fineAPI *fineAPIServer) PayFine(
+	ctx context.Context, req *chama.PayFineRequest,
+) (*chama.Fine, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.FineId == "":
+		return nil, errs.MissingField("fine id")
+	case req.Amount < 0:
+		return nil, errs.IncorrectVal("amount")
+	}
+
+	// Authorization
+	actor, fineDB, err := fineAPI.authorizeFine(ctx, req.FineId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	switch fineDB.Status {
+	case chama.FineStatus_FINE_PAID.String():
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "fine has already been paid")
+	case chama.FineStatus_FINE_WAIVED.String():
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "fine has been waived")
+	case chama.FineStatus_FINE_WAIVER_PENDING.String():
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "fine has a pending waiver")
+	}
+
+	// Late contribution fines are paid from member deposits along with the contributions
+	if fineDB.FineName == LateContributionFine {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "late contribution fines are paid by depositing contributions")
+	}
+
+	// The balance is paid when no amount is given
+	amount := roundAmount(fineDB.Amount - fineDB.PaidAmount)
+	if req.Amount > 0 {
+		amount = req.Amount
+	}
+
+	ctxExt := mdutil.AddFromCtx(ctx)
+
+	fineAccount, err := fineAPI.MoneyAccountAPI.GetChamaAccount(ctxExt, &transaction.GetChamaAccountRequest{
+		OwnerId:     fineDB.ChamaID,
+		AccountName: fineDB.AccountName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var memberAccount *transaction.ChamaAccount
+	if req.MemberAccountName != "" {
+		memberAccount, err = fineAPI.MoneyAccountAPI.GetChamaAccount(ctxExt, &transaction.GetChamaAccountRequest{
+			OwnerId:     fineDB.MemberID,
+			AccountName: req.MemberAccountName,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = ClaimPayment(fineAPI.SQLDB, fineDB, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	description := fmt.Sprintf("%s fine for %s", fineDB.FineName, fineDB.MemberNames)
+
+	if memberAccount != nil {
+		_, err = fineAPI.TransactionAPI.Withdraw(transaction_app.WithTransfer(ctxExt), &transaction.WithdrawRequest{
+			ActorId:     actor.ID,
+			AccountId:   memberAccount.AccountId,
+			Description: description,
+			Amount:      amount,
+		})
+		if err != nil {
+			fineAPI.releasePayment(fineDB.ID, amount)
+			return nil, err
+		}
+	}
+
+	_, err = fineAPI.TransactionAPI.Deposit(ctxExt, &transaction.DepositRequest{
+		ActorId:     actor.ID,
+		AccountId:   fineAccount.AccountId,
+		Description: description,
+		Amount:      amount,
+	})
+	if err != nil {
+		// Refund the member account before releasing the claim
+		if memberAccount != nil {
+			_, err2 := fineAPI.TransactionAPI.Deposit(ctxExt, &transaction.DepositRequest{
+				ActorId:     actor.ID,
+				AccountId:   memberAccount.AccountId,
+				Description: "Refund of " + description,
+				Amount:      amount,
+			})
+			if err2 != nil {
+				fineAPI.Logger.Errorf("failed to refund fine payment of %.2f to account %s: %v", amount, memberAccount.AccountId, err2)
+				return nil, err
+			}
+		}
+		fineAPI.releasePayment(fineDB.ID, amount)
+		return nil, err
+	}
+
+	fineDB, err = SettleFine(fineAPI.SQLDB, fineDB.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.FineProto(fineDB)
+}
+
+func (fineAPI *fineAPIServer) releasePayment(fineID uint, amount float64) {
+	err := ReleasePayment(fineAPI.SQLDB, fineID, amount)
+	if err != nil {
+		fineAPI.Logger.Errorf("failed to release payment of %.2f on fine %d: %v", amount, fineID, err)
+	}
+}
+
+// ClaimPayment claims a payment against the balance of an outstanding fine before any money is moved
+func ClaimPayment(db *gorm.DB, fineDB *models.Fine, amount float64) error {
+	res := db.Model(&models.Fine{}).
+		Where("id = ? AND status = ? AND paid_amount + ? <= amount + ?",
+			fineDB.ID, chama.FineStatus_FINE_OUTSTANDING.String(), amount, amountTolerance).
+		Update("paid_amount", gorm.Expr("paid_amount + ?", amount))
+	if res.Error != nil {
+		return errs.FailedToUpdate("fine", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return errs.WrapMessagef(
+			codes.FailedPrecondition, "payment exceeds the fine balance of %.2f", roundAmount(fineDB.Amount-fineDB.PaidAmount),
+		)
+	}
+	return nil
+}
+
+// ReleasePayment gives back a claimed payment whose money didn't move
+func ReleasePayment(db *gorm.DB, fineID uint, amount float64) error {
+	err := db.Model(&models.Fine{}).Where("id = ?", fineID).
+		Update("paid_amount", gorm.Expr("paid_amount - ?", amount)).Error
+	if err != nil {
+		return errs.FailedToUpdate("fine", err)
+	}
+	return nil
+}
+
+// SettleFine marks a fine whose claimed payments cover its amount as paid
+func SettleFine(db *gorm.DB, fineID uint) (*models.Fine, error) {
+	fineDB := &models.Fine{}
+	err := db.First(fineDB, "id = ?", fineID).Error
+	if err != nil {
+		return nil, errs.FailedToFind("fine", err)
+	}
+
+	if fineDB.Status != chama.FineStatus_FINE_OUTSTANDING.String() || fineDB.Amount-fineDB.PaidAmount > amountTolerance {
+		return fineDB, nil
+	}
+
+	paidAt := time.Now()
+	fineDB.Status = chama.FineStatus_FINE_PAID.String()
+	fineDB.PaidAt = &paidAt
+
+	err = db.Model(fineDB).Updates(map[string]interface{}{
+		"status":  fineDB.Status,
+		"paid_at": fineDB.PaidAt,
+	}).Error
+	if err != nil {
+		return nil, errs.FailedToUpdate("fine", err)
+	}
+
+	return fineDB, nil
+}
+
+func (fineAPI *fineAPIServer) RequestFineWaiver(
+	ctx context.Context, req *chama.RequestFineWaiverRequest,
+) (*chama.Fine, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.FineId == "":
+		return nil, errs.MissingField("fine id")
+	case req.Reason == "":
+		return nil, errs.MissingField("reason")
+	}
+
+	// Authorization
+	actor, fineDB, err := fineAPI.authorizeFine(ctx, req.FineId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	db := fineAPI.SQLDB.Model(&models.Fine{}).
+		Where("id = ? AND status = ?", fineDB.ID, chama.FineStatus_FINE_OUTSTANDING.String()).
+		Updates(map[string]interface{}{
+			"status":              chama.FineStatus_FINE_WAIVER_PENDING.String(),
+			"waiver_reason":       req.Reason,
+			"waiver_requested_by": actor.ID,
+		})
+	if db.Error != nil {
+		return nil, errs.FailedToUpdate("fine", db.Error)
+	}
+	if db.RowsAffected == 0 {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "only outstanding fines can be waived")
+	}
+
+	fineDB, err = fineAPI.getFine(req.FineId)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.FineProto(fineDB)
+}
+
+func (fineAPI *fineAPIServer) ApproveFineWaiver(
+	ctx context.Context, req *chama.ApproveFineWaiverRequest,
+) (*chama.Fine, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.FineId == "":
+		return nil, errs.MissingField("fine id")
+	}
+
+	// Authorization
+	actor, fineDB, err := fineAPI.authorizeFine(ctx, req.FineId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case fineDB.Status != chama.FineStatus_FINE_WAIVER_PENDING.String():
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "fine has no pending waiver")
+	case fineDB.WaiverRequestedBy == actor.ID:
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "waiver must be approved by another officer")
+	}
+
+	// A rejected waiver leaves the fine outstanding
+	updates := map[string]interface{}{
+		"status":              chama.FineStatus_FINE_OUTSTANDING.String(),
+		"waiver_reason":       "",
+		"waiver_requested_by": "",
+	}
+	if req.Approved {
+		updates = map[string]interface{}{
+			"status":    chama.FineStatus_FINE_WAIVED.String(),
+			"waived_by": actor.ID,
+		}
+	}
+
+	db := fineAPI.SQLDB.Model(&models.Fine{}).
+		Where("id = ? AND status = ?", fineDB.ID, chama.FineStatus_FINE_WAIVER_PENDING.String()).
+		Updates(updates)
+	if db.Error != nil {
+		return nil, errs.FailedToUpdate("fine", db.Error)
+	}
+	if db.RowsAffected == 0 {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "fine has no pending waiver")
+	}
+
+	fineDB, err = fineAPI.getFine(req.FineId)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.FineProto(fineDB)
+}
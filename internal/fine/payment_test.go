@@ -0,0 +1,140 @@
+package fine
+
+import (
+	"context"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Paying and waiving fines", func() {
+	var (
+		ctx        context.Context
+		chamaID    string
+		memberIDs  []string
+		fineTypeID string
+		fineID     string
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		var err error
+		chamaID, memberIDs, err = createChama(1000, 100)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		fineTypePB, err := FineAPI.CreateFineType(ctx, &chama.CreateFineTypeRequest{FineType: mockFineType(chamaID)})
+		Expect(err).ShouldNot(HaveOccurred())
+		fineTypeID = fineTypePB.FineTypeId
+
+		fineRes, err := FineAPI.IssueFine(ctx, &chama.IssueFineRequest{Fine: &chama.Fine{
+			MemberId:   memberIDs[0],
+			FineTypeId: fineTypeID,
+			Reason:     "Late for the annual meeting",
+		}})
+		Expect(err).ShouldNot(HaveOccurred())
+		fineID = fineRes.FineId
+	})
+
+	balance := func(ownerID, accountName string) float64 {
+		accountDB := &models.ChamaAccount{}
+		err := FineAPIServer.SQLDB.First(accountDB, "owner_id = ? AND account_name = ?", ownerID, accountName).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		return accountDB.AvailableAmount
+	}
+
+	Describe("Paying fines", func() {
+		It("should settle a fine in parts into the fines account", func() {
+			fineRes, err := FineAPI.PayFine(ctx, &chama.PayFineRequest{FineId: fineID, Amount: 100})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fineRes.PaidAmount).Should(BeNumerically("~", 100, 0.001))
+			Expect(fineRes.Status).Should(Equal(chama.FineStatus_FINE_OUTSTANDING))
+
+			fineRes, err = FineAPI.PayFine(ctx, &chama.PayFineRequest{FineId: fineID, MemberAccountName: memberAccount})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fineRes.PaidAmount).Should(BeNumerically("~", 300, 0.001))
+			Expect(fineRes.Status).Should(Equal(chama.FineStatus_FINE_PAID))
+			Expect(fineRes.PaidDate).ShouldNot(BeEmpty())
+
+			Expect(balance(memberIDs[0], memberAccount)).Should(BeNumerically("~", 800, 0.001))
+			Expect(balance(chamaID, fineAccount)).Should(BeNumerically("~", 300, 0.001))
+
+			fineRes, err = FineAPI.PayFine(ctx, &chama.PayFineRequest{FineId: fineID})
+			Expect(err).Should(HaveOccurred())
+			Expect(fineRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should fail when the payment exceeds the balance", func() {
+			fineRes, err := FineAPI.PayFine(ctx, &chama.PayFineRequest{FineId: fineID, Amount: 301})
+			Expect(err).Should(HaveOccurred())
+			Expect(fineRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should release the payment when the member can't afford it", func() {
+			fineRes, err := FineAPI.IssueFine(ctx, &chama.IssueFineRequest{Fine: &chama.Fine{
+				MemberId:   memberIDs[1],
+				FineTypeId: fineTypeID,
+			}})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			payRes, err := FineAPI.PayFine(ctx, &chama.PayFineRequest{FineId: fineRes.FineId, MemberAccountName: memberAccount})
+			Expect(err).Should(HaveOccurred())
+			Expect(payRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			getRes, err := FineAPI.GetFine(ctx, &chama.GetFineRequest{FineId: fineRes.FineId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.PaidAmount).Should(BeZero())
+			Expect(balance(memberIDs[1], memberAccount)).Should(BeNumerically("~", 100, 0.001))
+		})
+	})
+
+	Describe("Waiving fines", func() {
+		BeforeEach(func() {
+			fineRes, err := FineAPI.RequestFineWaiver(ctx, &chama.RequestFineWaiverRequest{
+				FineId: fineID,
+				Reason: "Member was bereaved",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fineRes.Status).Should(Equal(chama.FineStatus_FINE_WAIVER_PENDING))
+		})
+
+		It("should not be approved by the officer that requested it", func() {
+			fineRes, err := FineAPI.ApproveFineWaiver(ctx, &chama.ApproveFineWaiverRequest{FineId: fineID, Approved: true})
+			Expect(err).Should(HaveOccurred())
+			Expect(fineRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		Context("When requested by another officer", func() {
+			BeforeEach(func() {
+				err := FineAPIServer.SQLDB.Model(&models.Fine{}).Where("id = ?", fineID).
+					Update("waiver_requested_by", "another-officer").Error
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("should waive the fine when approved", func() {
+				fineRes, err := FineAPI.ApproveFineWaiver(ctx, &chama.ApproveFineWaiverRequest{FineId: fineID, Approved: true})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(fineRes.Status).Should(Equal(chama.FineStatus_FINE_WAIVED))
+				Expect(fineRes.WaivedBy).ShouldNot(BeEmpty())
+
+				listRes, err := FineAPI.ListFines(ctx, &chama.ListFinesRequest{MemberId: memberIDs[0]})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(listRes.OutstandingAmount).Should(BeZero())
+			})
+
+			It("should leave the fine outstanding when rejected", func() {
+				fineRes, err := FineAPI.ApproveFineWaiver(ctx, &chama.ApproveFineWaiverRequest{FineId: fineID})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(fineRes.Status).Should(Equal(chama.FineStatus_FINE_OUTSTANDING))
+				Expect(fineRes.WaiverReason).Should(BeEmpty())
+			})
+		})
+	})
+})
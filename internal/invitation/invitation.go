@@ -0,0 +1,457 @@
+package invitation
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/notification"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+
+	"github.com/speps/go-hashids"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"gorm.io/gorm"
+)
+
+type Options struct {
+	SQLDB         *gorm.DB
+	PageHasher    *hashids.HashID
+	Logger        grpclog.LoggerV2
+	Auth          auth.API
+	AllowedGroups []string
+	SMSSender     notification.Sender
+	EmailSender   notification.Sender
+	InvitationTTL time.Duration
+}
+
+type invitationAPIServer struct {
+	chama.UnimplementedInvitationAPIServer
+	*Options
+	authorizer *roles.Authorizer
+}
+
+// NewInvitationAPI creates the API for inviting people into chamas and onboarding them as members
+func NewInvitationAPI(ctx context.Context, opt *Options) (chama.InvitationAPIServer, error) {
+	// Validation
+	switch {
+	case ctx == nil:
+		return nil, errors.New("missing context")
+	case opt == nil:
+		return nil, errors.New("missing options")
+	case opt.SQLDB == nil:
+		return nil, errors.New("missing sql db")
+	case opt.Logger == nil:
+		return nil, errors.New("missing logger")
+	case opt.Auth == nil:
+		return nil, errors.New("missing auth API")
+	case opt.PageHasher == nil:
+		return nil, errors.New("missing pagination hasher")
+	case opt.SMSSender == nil && opt.EmailSender == nil:
+		return nil, errors.New("missing invitation sender")
+	default:
+		if len(opt.AllowedGroups) == 0 {
+			opt.AllowedGroups = opt.Auth.AdminGroups()
+		}
+		if opt.InvitationTTL == 0 {
+			opt.InvitationTTL = 72 * time.Hour
+		}
+	}
+
+	invitationAPI := &invitationAPIServer{
+		Options:    opt,
+		authorizer: roles.NewAuthorizer(opt.Auth, opt.SQLDB, opt.AllowedGroups),
+	}
+
+	return invitationAPI, nil
+}
+
+const (
+	codeDigits        = 6
+	maxFailedAttempts = 5
+)
+
+// newCode generates a one-time invitation code together with the hash that is stored
+func newCode() (string, string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", "", err
+	}
+	code := fmt.Sprintf("%0*d", codeDigits, n.Int64())
+	return code, hashCode(code), nil
+}
+
+func hashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// sendCode delivers the invitation code by SMS to invitations with a phone and by email otherwise
+func (invitationAPI *invitationAPIServer) sendCode(ctx context.Context, invitationDB *models.MemberInvitation, code string) error {
+	chamaDB := &models.Chama{}
+	err := invitationAPI.SQLDB.Select("id, name").First(chamaDB, "id = ?", invitationDB.ChamaID).Error
+	if err != nil {
+		return errs.FailedToFind("chama", err)
+	}
+
+	msg := &notification.Message{
+		Reference: fmt.Sprintf("invitation-%d-%d", invitationDB.ID, invitationDB.SendCount),
+		Subject:   fmt.Sprintf("Invitation to join %s", chamaDB.Name),
+		Body: fmt.Sprintf(
+			"You have been invited to join %s. Use invitation %d and code %s to complete your membership before %s.",
+			chamaDB.Name, invitationDB.ID, code, invitationDB.ExpiresAt.Format("2006-01-02 15:04"),
+		),
+	}
+
+	sender := invitationAPI.SMSSender
+	msg.Recipient = invitationDB.Phone
+	if invitationDB.Phone == "" || sender == nil {
+		sender = invitationAPI.EmailSender
+		msg.Recipient = invitationDB.Email
+	}
+
+	if sender == nil || msg.Recipient == "" {
+		return errs.WrapMessage(codes.FailedPrecondition, "no channel to deliver the invitation through")
+	}
+
+	err = sender.Send(ctx, msg)
+	if err != nil {
+		return errs.WrapErrorWithCodeAndMsg(codes.Unavailable, err, "failed to deliver invitation")
+	}
+
+	return nil
+}
+
+func (invitationAPI *invitationAPIServer) getInvitation(invitationID string) (*models.MemberInvitation, error) {
+	invitationDB := &models.MemberInvitation{}
+	err := invitationAPI.SQLDB.First(invitationDB, "id = ?", invitationID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("member invitation", invitationID)
+	default:
+		return nil, errs.FailedToFind("member invitation", err)
+	}
+	return invitationDB, nil
+}
+
+// authorizeInvitation gets the invitation after authorizing an officer of its chama
+func (invitationAPI *invitationAPIServer) authorizeInvitation(
+	ctx context.Context, invitationID string,
+) (*auth.Payload, *models.MemberInvitation, error) {
+	invitationDB, err := invitationAPI.getInvitation(invitationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actor, err := invitationAPI.authorizer.AuthorizeChama(ctx, invitationDB.ChamaID, roles.Officers...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return actor, invitationDB, nil
+}
+
+// expireInvitations marks pending invitations of a chama whose code has lapsed as expired
+func (invitationAPI *invitationAPIServer) expireInvitations(chamaID string) error {
+	err := invitationAPI.SQLDB.Model(&models.MemberInvitation{}).
+		Where("chama_id = ? AND status = ? AND expires_at < ?", chamaID, chama.InvitationStatus_INVITATION_PENDING.String(), time.Now()).
+		Update("status", chama.InvitationStatus_INVITATION_EXPIRED.String()).Error
+	if err != nil {
+		return errs.FailedToUpdate("member invitations", err)
+	}
+	return nil
+}
+
+func (invitationAPI *invitationAPIServer) InviteMember(
+	ctx context.Context, req *chama.InviteMemberRequest,
+) (*chama.MemberInvitation, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.ChamaId == "":
+		return nil, errs.MissingField("chama id")
+	case req.Phone == "" && req.Email == "":
+		return nil, errs.MissingField("phone or email")
+	}
+
+	// Authorization
+	actor, err := invitationAPI.authorizer.AuthorizeChama(ctx, req.ChamaId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = invitationAPI.SQLDB.First(&models.Chama{}, "id = ?", req.ChamaId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("chama", req.ChamaId)
+	default:
+		return nil, errs.FailedToFind("chama", err)
+	}
+
+	err = invitationAPI.expireInvitations(req.ChamaId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The same person is not invited twice nor invited once they are a member
+	var count int64
+	err = invitationAPI.SQLDB.Model(&models.ChamaMember{}).
+		Where("chama_id = ? AND ((phone != '' AND phone = ?) OR (email != '' AND email = ?))", req.ChamaId, req.Phone, req.Email).
+		Count(&count).Error
+	if err != nil {
+		return nil, errs.FailedToFind("chama members", err)
+	}
+	if count != 0 {
+		return nil, errs.WrapMessage(codes.AlreadyExists, "invitee is already a member of the chama")
+	}
+
+	err = invitationAPI.SQLDB.Model(&models.MemberInvitation{}).
+		Where("chama_id = ? AND status IN (?) AND ((phone != '' AND phone = ?) OR (email != '' AND email = ?))", req.ChamaId, []string{
+			chama.InvitationStatus_INVITATION_PENDING.String(), chama.InvitationStatus_INVITATION_SUBMITTED.String(),
+		}, req.Phone, req.Email).
+		Count(&count).Error
+	if err != nil {
+		return nil, errs.FailedToFind("member invitations", err)
+	}
+	if count != 0 {
+		return nil, errs.WrapMessage(codes.AlreadyExists, "invitee has an open invitation to the chama")
+	}
+
+	code, codeHash, err := newCode()
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate invitation code")
+	}
+
+	invitationDB := &models.MemberInvitation{
+		ChamaID:   req.ChamaId,
+		Phone:     req.Phone,
+		Email:     req.Email,
+		CodeHash:  codeHash,
+		Status:    chama.InvitationStatus_INVITATION_PENDING.String(),
+		InvitedBy: actor.ID,
+		SendCount: 1,
+		ExpiresAt: time.Now().Add(invitationAPI.InvitationTTL),
+	}
+
+	// The invitation is only kept when its code reaches the invitee
+	err = invitationAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(invitationDB).Error
+		if err != nil {
+			return errs.FailedToSave("member invitation", err)
+		}
+		return invitationAPI.sendCode(ctx, invitationDB, code)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return models.MemberInvitationProto(invitationDB)
+}
+
+func (invitationAPI *invitationAPIServer) ResendInvitation(
+	ctx context.Context, req *chama.ResendInvitationRequest,
+) (*chama.MemberInvitation, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.InvitationId == "":
+		return nil, errs.MissingField("invitation id")
+	}
+
+	// Authorization
+	_, invitationDB, err := invitationAPI.authorizeInvitation(ctx, req.InvitationId)
+	if err != nil {
+		return nil, err
+	}
+
+	code, codeHash, err := newCode()
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate invitation code")
+	}
+
+	// A new code replaces the previous one and restarts the expiry
+	err = invitationAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		db := tx.Model(&models.MemberInvitation{}).
+			Where("id = ? AND status IN (?)", invitationDB.ID, []string{
+				chama.InvitationStatus_INVITATION_PENDING.String(), chama.InvitationStatus_INVITATION_EXPIRED.String(),
+			}).
+			Updates(map[string]interface{}{
+				"code_hash":       codeHash,
+				"failed_attempts": 0,
+				"status":          chama.InvitationStatus_INVITATION_PENDING.String(),
+				"send_count":      gorm.Expr("send_count + 1"),
+				"expires_at":      time.Now().Add(invitationAPI.InvitationTTL),
+			})
+		if db.Error != nil {
+			return errs.FailedToUpdate("member invitation", db.Error)
+		}
+		if db.RowsAffected == 0 {
+			return errs.WrapMessage(codes.FailedPrecondition, "only pending or expired invitations can be resent")
+		}
+
+		err := tx.First(invitationDB, invitationDB.ID).Error
+		if err != nil {
+			return errs.FailedToFind("member invitation", err)
+		}
+
+		return invitationAPI.sendCode(ctx, invitationDB, code)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return models.MemberInvitationProto(invitationDB)
+}
+
+func (invitationAPI *invitationAPIServer) RevokeInvitation(
+	ctx context.Context, req *chama.RevokeInvitationRequest,
+) (*chama.MemberInvitation, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.InvitationId == "":
+		return nil, errs.MissingField("invitation id")
+	}
+
+	// Authorization
+	_, invitationDB, err := invitationAPI.authorizeInvitation(ctx, req.InvitationId)
+	if err != nil {
+		return nil, err
+	}
+
+	db := invitationAPI.SQLDB.Model(&models.MemberInvitation{}).
+		Where("id = ? AND status IN (?)", invitationDB.ID, []string{
+			chama.InvitationStatus_INVITATION_PENDING.String(),
+			chama.InvitationStatus_INVITATION_SUBMITTED.String(),
+			chama.InvitationStatus_INVITATION_EXPIRED.String(),
+		}).
+		Update("status", chama.InvitationStatus_INVITATION_REVOKED.String())
+	if db.Error != nil {
+		return nil, errs.FailedToUpdate("member invitation", db.Error)
+	}
+	if db.RowsAffected == 0 {
+		return nil, errs.WrapMessagef(codes.FailedPrecondition, "invitation has already been %s", invitationStatusWord(invitationDB.Status))
+	}
+
+	invitationDB.Status = chama.InvitationStatus_INVITATION_REVOKED.String()
+
+	return models.MemberInvitationProto(invitationDB)
+}
+
+func invitationStatusWord(status string) string {
+	switch status {
+	case chama.InvitationStatus_INVITATION_CONFIRMED.String():
+		return "confirmed"
+	case chama.InvitationStatus_INVITATION_REVOKED.String():
+		return "revoked"
+	default:
+		return "closed"
+	}
+}
+
+const defaultPageSize = 50
+
+func (invitationAPI *invitationAPIServer) ListInvitations(
+	ctx context.Context, req *chama.ListInvitationsRequest,
+) (*chama.ListInvitationsResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("list request")
+	case req.ChamaId == "":
+		return nil, errs.MissingField("chama id")
+	}
+
+	// Authorization
+	actor, err := invitationAPI.authorizer.AuthorizeChama(ctx, req.ChamaId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.GetPageSize()
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultPageSize
+	case pageSize > defaultPageSize:
+		if !invitationAPI.Auth.IsAdmin(actor.Group) {
+			pageSize = defaultPageSize
+		}
+	}
+
+	var ID uint
+	pageToken := req.GetPageToken()
+	if pageToken != "" {
+		ids, err := invitationAPI.PageHasher.DecodeInt64WithError(req.GetPageToken())
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		ID = uint(ids[0])
+	}
+
+	err = invitationAPI.expireInvitations(req.ChamaId)
+	if err != nil {
+		return nil, err
+	}
+
+	db := invitationAPI.SQLDB.Limit(int(pageSize+1)).Order("id DESC").Where("chama_id = ?", req.ChamaId)
+	if ID != 0 {
+		db = db.Where("id<?", ID)
+	}
+	if len(req.Statuses) != 0 {
+		statuses := make([]string, 0, len(req.Statuses))
+		for _, status := range req.Statuses {
+			statuses = append(statuses, status.String())
+		}
+		db = db.Where("status IN (?)", statuses)
+	}
+
+	dbs := make([]*models.MemberInvitation, 0, pageSize+1)
+	err = db.Find(&dbs).Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "LIST")
+	}
+
+	pbs := make([]*chama.MemberInvitation, 0, len(dbs))
+	for i, db := range dbs {
+		if i == int(pageSize) {
+			break
+		}
+
+		pb, err := models.MemberInvitationProto(db)
+		if err != nil {
+			return nil, err
+		}
+
+		pbs = append(pbs, pb)
+
+		ID = db.ID
+	}
+
+	var token string
+	if len(dbs) > int(pageSize) {
+		// Next page token
+		token, err = invitationAPI.PageHasher.EncodeInt64([]int64{int64(ID)})
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to generate next page token")
+		}
+	}
+
+	return &chama.ListInvitationsResponse{
+		Invitations:   pbs,
+		NextPageToken: token,
+	}, nil
+}
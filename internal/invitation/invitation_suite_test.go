@@ -0,0 +1,109 @@
+package invitation
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/notification"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2"
+	"github.com/gidyon/micro/v2/pkg/conn"
+	"github.com/gidyon/micro/v2/pkg/mocks"
+	"github.com/gidyon/micro/v2/utils/encryption"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+)
+
+func TestInvitation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Invitation Suite")
+}
+
+var (
+	InvitationAPIServer *invitationAPIServer
+	InvitationAPI       chama.InvitationAPIServer
+	smsSender           *notification.Fake
+	modelsStructs       = []interface{}{
+		&models.Chama{},
+		&models.ChamaMember{},
+		&models.MemberInvitation{},
+	}
+	schema = "machama"
+)
+
+func startDB() (*gorm.DB, error) {
+	return conn.OpenGormConn(&conn.DBOptions{
+		Dialect:  "mysql",
+		Address:  "localhost:3306",
+		User:     "root",
+		Password: "hakty11",
+		Schema:   schema,
+	})
+}
+
+var _ = BeforeSuite(func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rand.Seed(time.Now().UnixNano())
+
+	// Start real database
+	db, err := startDB()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	db = db.Debug()
+
+	err = db.Migrator().DropTable(modelsStructs...)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	err = db.Migrator().AutoMigrate(modelsStructs...)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	hasher, err := encryption.NewHasher(string([]byte(randomdata.RandStringRunes(32))))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	logger := micro.NewLogger("invitation", 0)
+
+	smsSender = notification.NewFake()
+
+	opt := &Options{
+		SQLDB:      db,
+		Logger:     logger,
+		PageHasher: hasher,
+		Auth:       mocks.AuthAPI,
+		SMSSender:  smsSender,
+	}
+
+	InvitationAPI, err = NewInvitationAPI(ctx, opt)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	var ok bool
+	InvitationAPIServer, ok = InvitationAPI.(*invitationAPIServer)
+	Expect(ok).Should(BeTrue())
+
+	_, err = NewInvitationAPI(ctx, nil)
+	Expect(err).Should(HaveOccurred())
+
+	opt.SQLDB = nil
+	_, err = NewInvitationAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.SQLDB = db
+	opt.Auth = nil
+	_, err = NewInvitationAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.Auth = mocks.AuthAPI
+	opt.SMSSender = nil
+	_, err = NewInvitationAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.SMSSender = smsSender
+	_, err = NewInvitationAPI(ctx, opt)
+	Expect(err).ShouldNot(HaveOccurred())
+})
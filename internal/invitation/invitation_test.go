@@ -0,0 +1,197 @@
+package invitation
+
+import (
+	"context"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Member invitations", func() {
+	var (
+		ctx     context.Context
+		chamaID string
+		phone   string
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		chamaID = createChama()
+		phone = randomPhone()
+	})
+
+	invite := func() *chama.MemberInvitation {
+		inviteRes, err := InvitationAPI.InviteMember(ctx, &chama.InviteMemberRequest{ChamaId: chamaID, Phone: phone})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(inviteRes.Status).Should(Equal(chama.InvitationStatus_INVITATION_PENDING))
+		return inviteRes
+	}
+
+	accept := func(invitationID, code string) (*chama.MemberInvitation, error) {
+		return InvitationAPI.AcceptInvitation(ctx, &chama.AcceptInvitationRequest{
+			InvitationId: invitationID,
+			Code:         code,
+			Profile:      mockProfile(),
+		})
+	}
+
+	Describe("InviteMember", func() {
+		It("should fail when the request is malformed", func() {
+			inviteRes, err := InvitationAPI.InviteMember(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(inviteRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+			inviteRes, err = InvitationAPI.InviteMember(ctx, &chama.InviteMemberRequest{ChamaId: chamaID})
+			Expect(err).Should(HaveOccurred())
+			Expect(inviteRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the chama does not exist", func() {
+			inviteRes, err := InvitationAPI.InviteMember(ctx, &chama.InviteMemberRequest{ChamaId: "0", Phone: phone})
+			Expect(err).Should(HaveOccurred())
+			Expect(inviteRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+		It("should send a code without returning it and refuse a second open invitation", func() {
+			inviteRes := invite()
+			Expect(inviteRes.SendCount).Should(BeEquivalentTo(1))
+			Expect(lastCode(phone)).ShouldNot(BeEmpty())
+
+			inviteRes, err := InvitationAPI.InviteMember(ctx, &chama.InviteMemberRequest{ChamaId: chamaID, Phone: phone})
+			Expect(err).Should(HaveOccurred())
+			Expect(inviteRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.AlreadyExists))
+		})
+		It("should not save the invitation when the code cannot be delivered", func() {
+			smsSender.FailSends(true)
+			defer smsSender.FailSends(false)
+
+			inviteRes, err := InvitationAPI.InviteMember(ctx, &chama.InviteMemberRequest{ChamaId: chamaID, Phone: phone})
+			Expect(err).Should(HaveOccurred())
+			Expect(inviteRes).Should(BeNil())
+
+			var count int64
+			Expect(InvitationAPIServer.SQLDB.Model(&models.MemberInvitation{}).Where("phone = ?", phone).Count(&count).Error).ShouldNot(HaveOccurred())
+			Expect(count).Should(BeZero())
+		})
+	})
+
+	Describe("AcceptInvitation", func() {
+		It("should reject an incorrect code and lock after too many attempts", func() {
+			inviteRes := invite()
+			for i := 0; i < maxFailedAttempts; i++ {
+				acceptRes, err := accept(inviteRes.InvitationId, "oops")
+				Expect(err).Should(HaveOccurred())
+				Expect(acceptRes).Should(BeNil())
+				Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+			}
+
+			acceptRes, err := accept(inviteRes.InvitationId, lastCode(phone))
+			Expect(err).Should(HaveOccurred())
+			Expect(acceptRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+		It("should fail when the invitation has expired", func() {
+			inviteRes := invite()
+			Expect(InvitationAPIServer.SQLDB.Model(&models.MemberInvitation{}).Where("id = ?", inviteRes.InvitationId).
+				Update("expires_at", time.Now().Add(-time.Minute)).Error).ShouldNot(HaveOccurred())
+
+			acceptRes, err := accept(inviteRes.InvitationId, lastCode(phone))
+			Expect(err).Should(HaveOccurred())
+			Expect(acceptRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			getRes, err := InvitationAPI.ListInvitations(ctx, &chama.ListInvitationsRequest{
+				ChamaId:  chamaID,
+				Statuses: []chama.InvitationStatus{chama.InvitationStatus_INVITATION_EXPIRED},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.Invitations).Should(HaveLen(1))
+		})
+	})
+
+	Describe("ResendInvitation", func() {
+		It("should replace the code of an expired invitation", func() {
+			inviteRes := invite()
+			oldCode := lastCode(phone)
+			Expect(InvitationAPIServer.SQLDB.Model(&models.MemberInvitation{}).Where("id = ?", inviteRes.InvitationId).
+				Updates(map[string]interface{}{
+					"expires_at": time.Now().Add(-time.Minute),
+					"status":     chama.InvitationStatus_INVITATION_EXPIRED.String(),
+				}).Error).ShouldNot(HaveOccurred())
+
+			resendRes, err := InvitationAPI.ResendInvitation(ctx, &chama.ResendInvitationRequest{InvitationId: inviteRes.InvitationId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resendRes.Status).Should(Equal(chama.InvitationStatus_INVITATION_PENDING))
+			Expect(resendRes.SendCount).Should(BeEquivalentTo(2))
+
+			newCode := lastCode(phone)
+			if newCode != oldCode {
+				_, err = accept(inviteRes.InvitationId, oldCode)
+				Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+			}
+
+			acceptRes, err := accept(inviteRes.InvitationId, newCode)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(acceptRes.Status).Should(Equal(chama.InvitationStatus_INVITATION_SUBMITTED))
+		})
+	})
+
+	Describe("RevokeInvitation", func() {
+		It("should close the invitation to further acceptance", func() {
+			inviteRes := invite()
+
+			revokeRes, err := InvitationAPI.RevokeInvitation(ctx, &chama.RevokeInvitationRequest{InvitationId: inviteRes.InvitationId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(revokeRes.Status).Should(Equal(chama.InvitationStatus_INVITATION_REVOKED))
+
+			acceptRes, err := accept(inviteRes.InvitationId, lastCode(phone))
+			Expect(err).Should(HaveOccurred())
+			Expect(acceptRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			revokeRes, err = InvitationAPI.RevokeInvitation(ctx, &chama.RevokeInvitationRequest{InvitationId: inviteRes.InvitationId})
+			Expect(err).Should(HaveOccurred())
+			Expect(revokeRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+
+	Describe("ConfirmInvitation", func() {
+		It("should only activate membership after the profile is submitted", func() {
+			inviteRes := invite()
+
+			confirmRes, err := InvitationAPI.ConfirmInvitation(ctx, &chama.ConfirmInvitationRequest{InvitationId: inviteRes.InvitationId})
+			Expect(err).Should(HaveOccurred())
+			Expect(confirmRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			acceptRes, err := accept(inviteRes.InvitationId, lastCode(phone))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(acceptRes.Profile.Phone).Should(Equal(phone))
+			Expect(acceptRes.Profile.ChamaId).Should(Equal(chamaID))
+
+			confirmRes, err = InvitationAPI.ConfirmInvitation(ctx, &chama.ConfirmInvitationRequest{InvitationId: inviteRes.InvitationId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(confirmRes.Status).Should(Equal(chama.InvitationStatus_INVITATION_CONFIRMED))
+			Expect(confirmRes.MemberId).ShouldNot(BeEmpty())
+
+			memberDB := &models.ChamaMember{}
+			Expect(InvitationAPIServer.SQLDB.First(memberDB, "id = ?", confirmRes.MemberId).Error).ShouldNot(HaveOccurred())
+			Expect(memberDB.Active).Should(BeTrue())
+			Expect(memberDB.ChamaID).Should(Equal(chamaID))
+			Expect(memberDB.UserID).ShouldNot(BeEmpty())
+
+			inviteAgainRes, err := InvitationAPI.InviteMember(ctx, &chama.InviteMemberRequest{ChamaId: chamaID, Phone: phone})
+			Expect(err).Should(HaveOccurred())
+			Expect(inviteAgainRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.AlreadyExists))
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package invitation
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func randomPhone() string {
+	return fmt.Sprintf("2547%d", randomdata.Number(10000000, 99999999))
+}
+
+func createChama() string {
+	chamaDB := &models.Chama{
+		CreatorID: randomdata.RandStringRunes(10),
+		Name:      randomdata.SillyName(),
+		Active:    true,
+	}
+	Expect(InvitationAPIServer.SQLDB.Create(chamaDB).Error).ShouldNot(HaveOccurred())
+	return fmt.Sprint(chamaDB.ID)
+}
+
+func mockProfile() *chama.ChamaMember {
+	return &chama.ChamaMember{
+		FirstName:  randomdata.FirstName(randomdata.Male),
+		LastName:   randomdata.LastName(),
+		IdNumber:   fmt.Sprint(randomdata.Number(10000000, 99999999)),
+		Residence:  randomdata.City(),
+		JobDetails: map[string]string{"employer": randomdata.SillyName()},
+		Kyc:        map[string]string{"kra_pin": randomdata.RandStringRunes(11)},
+		Beneficiaries: []*chama.TrustPerson{{
+			Name:  randomdata.FullName(randomdata.Female),
+			Phone: randomPhone(),
+		}},
+	}
+}
+
+var codeRe = regexp.MustCompile(`code (\d{6})`)
+
+// lastCode reads the invitation code from the last message delivered to the recipient
+func lastCode(recipient string) string {
+	msgs := smsSender.Messages()
+	for i := len(msgs) - 1; i >= 0; i-- {
+		if msgs[i].Recipient == recipient {
+			matches := codeRe.FindStringSubmatch(msgs[i].Body)
+			Expect(matches).Should(HaveLen(2))
+			return matches[1]
+		}
+	}
+	Fail("no invitation delivered to " + recipient)
+	return ""
+}
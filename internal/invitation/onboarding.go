@@ -0,0 +1,191 @@
+package invitation
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/chamamember"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+func (invitationAPI *invitationAPIServer) AcceptInvitation(
+	ctx context.Context, req *chama.AcceptInvitationRequest,
+) (*chama.MemberInvitation, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.InvitationId == "":
+		return nil, errs.MissingField("invitation id")
+	case req.Code == "":
+		return nil, errs.MissingField("invitation code")
+	case req.Profile == nil:
+		return nil, errs.MissingField("member profile")
+	}
+
+	// Authentication
+	actor, err := invitationAPI.Auth.AuthenticateRequestV2(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitationDB, err := invitationAPI.getInvitation(req.InvitationId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case invitationDB.Status == chama.InvitationStatus_INVITATION_PENDING.String() && time.Now().After(invitationDB.ExpiresAt):
+		err = invitationAPI.SQLDB.Model(invitationDB).Update("status", chama.InvitationStatus_INVITATION_EXPIRED.String()).Error
+		if err != nil {
+			return nil, errs.FailedToUpdate("member invitation", err)
+		}
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "invitation has expired")
+	case invitationDB.Status != chama.InvitationStatus_INVITATION_PENDING.String():
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "invitation is no longer open")
+	case invitationDB.FailedAttempts >= maxFailedAttempts:
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "too many incorrect codes; ask for the invitation to be resent")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashCode(req.Code)), []byte(invitationDB.CodeHash)) != 1 {
+		err = invitationAPI.SQLDB.Model(invitationDB).Update("failed_attempts", gorm.Expr("failed_attempts + 1")).Error
+		if err != nil {
+			return nil, errs.FailedToUpdate("member invitation", err)
+		}
+		return nil, errs.WrapMessage(codes.PermissionDenied, "incorrect invitation code")
+	}
+
+	// The invitee cannot change who or where they were invited
+	profile := req.Profile
+	profile.MemberId = ""
+	profile.ChamaId = invitationDB.ChamaID
+	profile.UserId = actor.ID
+	profile.Active = false
+	profile.Role = chama.ChamaRole_ROLE_MEMBER
+	if invitationDB.Phone != "" {
+		profile.Phone = invitationDB.Phone
+	}
+	if invitationDB.Email != "" {
+		profile.Email = invitationDB.Email
+	}
+
+	err = chamamember.ValidateChamaMember(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	err = chamamember.CheckMemberIdentity(invitationAPI.SQLDB, profile.ChamaId, profile.IdNumber, profile.Phone, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	bs, err := json.Marshal(profile)
+	if err != nil {
+		return nil, errs.FromJSONMarshal(err, "member profile")
+	}
+
+	submittedAt := time.Now()
+	db := invitationAPI.SQLDB.Model(&models.MemberInvitation{}).
+		Where("id = ? AND status = ?", invitationDB.ID, chama.InvitationStatus_INVITATION_PENDING.String()).
+		Updates(map[string]interface{}{
+			"profile":      bs,
+			"user_id":      actor.ID,
+			"submitted_at": submittedAt,
+			"status":       chama.InvitationStatus_INVITATION_SUBMITTED.String(),
+		})
+	if db.Error != nil {
+		return nil, errs.FailedToUpdate("member invitation", db.Error)
+	}
+	if db.RowsAffected == 0 {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "invitation is no longer open")
+	}
+
+	invitationDB.Profile = bs
+	invitationDB.UserID = actor.ID
+	invitationDB.SubmittedAt = &submittedAt
+	invitationDB.Status = chama.InvitationStatus_INVITATION_SUBMITTED.String()
+
+	return models.MemberInvitationProto(invitationDB)
+}
+
+func (invitationAPI *invitationAPIServer) ConfirmInvitation(
+	ctx context.Context, req *chama.ConfirmInvitationRequest,
+) (*chama.MemberInvitation, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.InvitationId == "":
+		return nil, errs.MissingField("invitation id")
+	}
+
+	// Authorization
+	actor, invitationDB, err := invitationAPI.authorizeInvitation(ctx, req.InvitationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if invitationDB.Status != chama.InvitationStatus_INVITATION_SUBMITTED.String() {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "only invitations with a submitted profile can be confirmed")
+	}
+
+	profile := &chama.ChamaMember{}
+	err = json.Unmarshal(invitationDB.Profile, profile)
+	if err != nil {
+		return nil, errs.FromJSONUnMarshal(err, "member profile")
+	}
+	profile.Active = true
+
+	memberDB, err := models.ChamaMemberModel(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	err = invitationAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		// Someone else may have registered the same identity since the profile was submitted
+		err := chamamember.CheckMemberIdentity(tx, memberDB.ChamaID, memberDB.IDNumber, memberDB.Phone, 0)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(memberDB).Error
+		if err != nil {
+			if errConflict := chamamember.IdentityConflict(err); errConflict != nil {
+				return errConflict
+			}
+			return errs.FailedToSave("chama member", err)
+		}
+
+		db := tx.Model(&models.MemberInvitation{}).
+			Where("id = ? AND status = ?", invitationDB.ID, chama.InvitationStatus_INVITATION_SUBMITTED.String()).
+			Updates(map[string]interface{}{
+				"member_id":    fmt.Sprint(memberDB.ID),
+				"confirmed_by": actor.ID,
+				"status":       chama.InvitationStatus_INVITATION_CONFIRMED.String(),
+			})
+		if db.Error != nil {
+			return errs.FailedToUpdate("member invitation", db.Error)
+		}
+		if db.RowsAffected == 0 {
+			return errs.WrapMessage(codes.FailedPrecondition, "invitation has already been closed")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invitationDB.MemberID = fmt.Sprint(memberDB.ID)
+	invitationDB.ConfirmedBy = actor.ID
+	invitationDB.Status = chama.InvitationStatus_INVITATION_CONFIRMED.String()
+
+	return models.MemberInvitationProto(invitationDB)
+}
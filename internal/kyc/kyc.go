@@ -0,0 +1,321 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gidyon/machama-app/internal/blob"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+
+	"github.com/speps/go-hashids"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"gorm.io/gorm"
+)
+
+type Options struct {
+	SQLDB           *gorm.DB
+	PageHasher      *hashids.HashID
+	Logger          grpclog.LoggerV2
+	Auth            auth.API
+	AllowedGroups   []string
+	BlobStore       blob.Store
+	MaxDocumentSize int
+}
+
+type kycAPIServer struct {
+	chama.UnimplementedKycAPIServer
+	*Options
+	authorizer *roles.Authorizer
+}
+
+// NewKycAPI creates the API for uploading and verifying the identity documents of members
+func NewKycAPI(ctx context.Context, opt *Options) (chama.KycAPIServer, error) {
+	// Validation
+	switch {
+	case ctx == nil:
+		return nil, errors.New("missing context")
+	case opt == nil:
+		return nil, errors.New("missing options")
+	case opt.SQLDB == nil:
+		return nil, errors.New("missing sql db")
+	case opt.Logger == nil:
+		return nil, errors.New("missing logger")
+	case opt.Auth == nil:
+		return nil, errors.New("missing auth API")
+	case opt.PageHasher == nil:
+		return nil, errors.New("missing pagination hasher")
+	case opt.BlobStore == nil:
+		return nil, errors.New("missing blob store")
+	default:
+		if len(opt.AllowedGroups) == 0 {
+			opt.AllowedGroups = opt.Auth.AdminGroups()
+		}
+		if opt.MaxDocumentSize == 0 {
+			opt.MaxDocumentSize = 5 << 20
+		}
+	}
+
+	kycAPI := &kycAPIServer{
+		Options:    opt,
+		authorizer: roles.NewAuthorizer(opt.Auth, opt.SQLDB, opt.AllowedGroups),
+	}
+
+	return kycAPI, nil
+}
+
+const defaultPageSize = 50
+
+// Status is the KYC status of a member. Members who never uploaded documents have not submitted.
+func Status(sqlDB *gorm.DB, memberID string) (chama.KycStatus, error) {
+	kycDB := &models.MemberKYC{}
+	err := sqlDB.Select("status").First(kycDB, "member_id = ?", memberID).Error
+	switch {
+	case err == nil:
+		return chama.KycStatus(chama.KycStatus_value[kycDB.Status]), nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return chama.KycStatus_KYC_NOT_SUBMITTED, nil
+	default:
+		return 0, errs.FailedToFind("member kyc", err)
+	}
+}
+
+// RequireVerified fails unless the documents of the member have been verified. Loans and payouts to members are gated on it.
+func RequireVerified(sqlDB *gorm.DB, memberID string) error {
+	status, err := Status(sqlDB, memberID)
+	if err != nil {
+		return err
+	}
+
+	switch status {
+	case chama.KycStatus_KYC_VERIFIED:
+		return nil
+	case chama.KycStatus_KYC_PENDING:
+		return errs.WrapMessagef(codes.FailedPrecondition, "KYC documents of member %s are awaiting verification", memberID)
+	case chama.KycStatus_KYC_REJECTED:
+		return errs.WrapMessagef(codes.FailedPrecondition, "KYC documents of member %s were rejected", memberID)
+	default:
+		return errs.WrapMessagef(codes.FailedPrecondition, "member %s has not submitted KYC documents", memberID)
+	}
+}
+
+// authorizeMember allows the member themselves and officers of their chama
+func (kycAPI *kycAPIServer) authorizeMember(ctx context.Context, memberID string) (*auth.Payload, *models.ChamaMember, error) {
+	actor, memberDB, err := kycAPI.authorizer.AuthorizeMember(ctx, memberID, roles.Members...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if memberDB.UserID == "" || memberDB.UserID != actor.ID {
+		_, err = kycAPI.authorizer.AuthorizeChama(ctx, memberDB.ChamaID, roles.Officers...)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return actor, memberDB, nil
+}
+
+// memberKYC gets the KYC of a member, which is blank until documents are uploaded
+func (kycAPI *kycAPIServer) memberKYC(memberDB *models.ChamaMember) (*models.MemberKYC, []*models.KYCDocument, error) {
+	memberID := fmt.Sprint(memberDB.ID)
+
+	kycDB := &models.MemberKYC{}
+	err := kycAPI.SQLDB.First(kycDB, "member_id = ?", memberID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		kycDB = &models.MemberKYC{
+			MemberID:    memberID,
+			ChamaID:     memberDB.ChamaID,
+			MemberNames: memberNames(memberDB),
+			Status:      chama.KycStatus_KYC_NOT_SUBMITTED.String(),
+		}
+	default:
+		return nil, nil, errs.FailedToFind("member kyc", err)
+	}
+
+	docs, err := kycAPI.documents(memberID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return kycDB, docs, nil
+}
+
+func (kycAPI *kycAPIServer) documents(memberID string) ([]*models.KYCDocument, error) {
+	docs := make([]*models.KYCDocument, 0, len(documentTypes))
+	err := kycAPI.SQLDB.Order("document_type").Find(&docs, "member_id = ?", memberID).Error
+	if err != nil {
+		return nil, errs.FailedToFind("kyc documents", err)
+	}
+	return docs, nil
+}
+
+func memberNames(memberDB *models.ChamaMember) string {
+	return fmt.Sprintf("%s %s", memberDB.FirstName, memberDB.LastName)
+}
+
+func (kycAPI *kycAPIServer) GetMemberKyc(ctx context.Context, req *chama.GetMemberKycRequest) (*chama.MemberKyc, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.MemberId == "":
+		return nil, errs.MissingField("member id")
+	}
+
+	// Authorization
+	_, memberDB, err := kycAPI.authorizeMember(ctx, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	kycDB, docs, err := kycAPI.memberKYC(memberDB)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.MemberKYCProto(kycDB, docs)
+}
+
+func (kycAPI *kycAPIServer) DownloadKycDocument(
+	ctx context.Context, req *chama.DownloadKycDocumentRequest,
+) (*chama.DownloadKycDocumentResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.DocumentId == "":
+		return nil, errs.MissingField("document id")
+	}
+
+	docDB := &models.KYCDocument{}
+	err := kycAPI.SQLDB.First(docDB, "id = ?", req.DocumentId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("kyc document", req.DocumentId)
+	default:
+		return nil, errs.FailedToFind("kyc document", err)
+	}
+
+	// Authorization
+	_, _, err = kycAPI.authorizeMember(ctx, docDB.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := kycAPI.BlobStore.Get(ctx, docDB.StorageKey)
+	switch {
+	case err == nil:
+	case errors.Is(err, blob.ErrNotFound):
+		return nil, errs.WrapMessagef(codes.NotFound, "file of kyc document %d is missing", docDB.ID)
+	default:
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to read kyc document")
+	}
+
+	docPB, err := models.KYCDocumentProto(docDB)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chama.DownloadKycDocumentResponse{
+		Document: docPB,
+		Content:  content,
+	}, nil
+}
+
+func (kycAPI *kycAPIServer) ListMemberKyc(ctx context.Context, req *chama.ListMemberKycRequest) (*chama.ListMemberKycResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("list request")
+	case req.ChamaId == "":
+		return nil, errs.MissingField("chama id")
+	}
+
+	// Authorization
+	actor, err := kycAPI.authorizer.AuthorizeChama(ctx, req.ChamaId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.GetPageSize()
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultPageSize
+	case pageSize > defaultPageSize:
+		if !kycAPI.Auth.IsAdmin(actor.Group) {
+			pageSize = defaultPageSize
+		}
+	}
+
+	var ID uint
+	pageToken := req.GetPageToken()
+	if pageToken != "" {
+		ids, err := kycAPI.PageHasher.DecodeInt64WithError(req.GetPageToken())
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		ID = uint(ids[0])
+	}
+
+	db := kycAPI.SQLDB.Limit(int(pageSize+1)).Order("id DESC").Where("chama_id = ?", req.ChamaId)
+	if ID != 0 {
+		db = db.Where("id<?", ID)
+	}
+	if len(req.Statuses) != 0 {
+		statuses := make([]string, 0, len(req.Statuses))
+		for _, status := range req.Statuses {
+			statuses = append(statuses, status.String())
+		}
+		db = db.Where("status IN (?)", statuses)
+	}
+
+	dbs := make([]*models.MemberKYC, 0, pageSize+1)
+	err = db.Find(&dbs).Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "LIST")
+	}
+
+	pbs := make([]*chama.MemberKyc, 0, len(dbs))
+	for i, db := range dbs {
+		if i == int(pageSize) {
+			break
+		}
+
+		docs, err := kycAPI.documents(db.MemberID)
+		if err != nil {
+			return nil, err
+		}
+
+		pb, err := models.MemberKYCProto(db, docs)
+		if err != nil {
+			return nil, err
+		}
+
+		pbs = append(pbs, pb)
+
+		ID = db.ID
+	}
+
+	var token string
+	if len(dbs) > int(pageSize) {
+		// Next page token
+		token, err = kycAPI.PageHasher.EncodeInt64([]int64{int64(ID)})
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to generate next page token")
+		}
+	}
+
+	return &chama.ListMemberKycResponse{
+		MemberKyc:     pbs,
+		NextPageToken: token,
+	}, nil
+}
@@ -0,0 +1,119 @@
+package kyc
+
+import (
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/machama-app/internal/blob"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2"
+	"github.com/gidyon/micro/v2/pkg/conn"
+	"github.com/gidyon/micro/v2/pkg/mocks"
+	"github.com/gidyon/micro/v2/utils/encryption"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+)
+
+func TestKyc(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Kyc Suite")
+}
+
+var (
+	KycAPIServer  *kycAPIServer
+	KycAPI        chama.KycAPIServer
+	blobDir       string
+	modelsStructs = []interface{}{
+		&models.ChamaMember{},
+		&models.MemberKYC{},
+		&models.KYCDocument{},
+	}
+	schema = "machama"
+)
+
+func startDB() (*gorm.DB, error) {
+	return conn.OpenGormConn(&conn.DBOptions{
+		Dialect:  "mysql",
+		Address:  "localhost:3306",
+		User:     "root",
+		Password: "hakty11",
+		Schema:   schema,
+	})
+}
+
+var _ = BeforeSuite(func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rand.Seed(time.Now().UnixNano())
+
+	// Start real database
+	db, err := startDB()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	db = db.Debug()
+
+	err = db.Migrator().DropTable(modelsStructs...)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	err = db.Migrator().AutoMigrate(modelsStructs...)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	hasher, err := encryption.NewHasher(string([]byte(randomdata.RandStringRunes(32))))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	logger := micro.NewLogger("kyc", 0)
+
+	blobDir, err = ioutil.TempDir("", "kyc")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	blobStore, err := blob.NewLocal(blobDir)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	opt := &Options{
+		SQLDB:      db,
+		Logger:     logger,
+		PageHasher: hasher,
+		Auth:       mocks.AuthAPI,
+		BlobStore:  blobStore,
+	}
+
+	KycAPI, err = NewKycAPI(ctx, opt)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	var ok bool
+	KycAPIServer, ok = KycAPI.(*kycAPIServer)
+	Expect(ok).Should(BeTrue())
+
+	_, err = NewKycAPI(ctx, nil)
+	Expect(err).Should(HaveOccurred())
+
+	opt.SQLDB = nil
+	_, err = NewKycAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.SQLDB = db
+	opt.Auth = nil
+	_, err = NewKycAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.Auth = mocks.AuthAPI
+	opt.BlobStore = nil
+	_, err = NewKycAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.BlobStore = blobStore
+	_, err = NewKycAPI(ctx, opt)
+	Expect(err).ShouldNot(HaveOccurred())
+})
+
+var _ = AfterSuite(func() {
+	os.RemoveAll(blobDir)
+})
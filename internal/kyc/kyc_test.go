@@ -0,0 +1,207 @@
+package kyc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Member KYC", func() {
+	var (
+		ctx      context.Context
+		memberDB *models.ChamaMember
+		memberID string
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		memberDB = createMember()
+		memberID = fmt.Sprint(memberDB.ID)
+	})
+
+	upload := func(docType chama.KycDocumentType, contentType string, content []byte) (*chama.MemberKyc, error) {
+		return KycAPI.UploadKycDocument(ctx, &chama.UploadKycDocumentRequest{
+			MemberId:     memberID,
+			DocumentType: docType,
+			ContentType:  contentType,
+			Content:      content,
+		})
+	}
+
+	uploadAll := func() *chama.MemberKyc {
+		_, err := upload(chama.KycDocumentType_KYC_ID_FRONT, "application/pdf", pdf("front"))
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = upload(chama.KycDocumentType_KYC_ID_BACK, "image/png", photo("back"))
+		Expect(err).ShouldNot(HaveOccurred())
+		kycRes, err := upload(chama.KycDocumentType_KYC_SELFIE, "image/png", photo("selfie"))
+		Expect(err).ShouldNot(HaveOccurred())
+		return kycRes
+	}
+
+	Describe("Uploading documents with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			kycRes, err := KycAPI.UploadKycDocument(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(kycRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when member id is missing", func() {
+			memberID = ""
+			kycRes, err := upload(chama.KycDocumentType_KYC_ID_FRONT, "image/png", photo("front"))
+			Expect(err).Should(HaveOccurred())
+			Expect(kycRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the document is empty", func() {
+			kycRes, err := upload(chama.KycDocumentType_KYC_ID_FRONT, "image/png", nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(kycRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the content is not what was declared", func() {
+			kycRes, err := upload(chama.KycDocumentType_KYC_ID_FRONT, "image/jpeg", photo("front"))
+			Expect(err).Should(HaveOccurred())
+			Expect(kycRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the format is not accepted", func() {
+			kycRes, err := upload(chama.KycDocumentType_KYC_ID_FRONT, "text/plain; charset=utf-8", []byte("front"))
+			Expect(err).Should(HaveOccurred())
+			Expect(kycRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the selfie is not a photo", func() {
+			kycRes, err := upload(chama.KycDocumentType_KYC_SELFIE, "application/pdf", pdf("selfie"))
+			Expect(err).Should(HaveOccurred())
+			Expect(kycRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the document is too large", func() {
+			content := photo(string(make([]byte, KycAPIServer.MaxDocumentSize)))
+			kycRes, err := upload(chama.KycDocumentType_KYC_ID_FRONT, "image/png", content)
+			Expect(err).Should(HaveOccurred())
+			Expect(kycRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Uploading documents", func() {
+		It("should not submit the documents until all are uploaded", func() {
+			kycRes, err := upload(chama.KycDocumentType_KYC_ID_FRONT, "image/png", photo("front"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(kycRes.Status).Should(Equal(chama.KycStatus_KYC_NOT_SUBMITTED))
+			Expect(kycRes.Documents).Should(HaveLen(1))
+
+			Expect(RequireVerified(KycAPIServer.SQLDB, memberID)).ShouldNot(Succeed())
+		})
+
+		It("should submit the documents for review once all are uploaded", func() {
+			kycRes := uploadAll()
+			Expect(kycRes.Status).Should(Equal(chama.KycStatus_KYC_PENDING))
+			Expect(kycRes.Documents).Should(HaveLen(3))
+			Expect(kycRes.SubmittedDate).ShouldNot(BeEmpty())
+		})
+
+		It("should replace a document uploaded again", func() {
+			uploadAll()
+
+			kycRes, err := upload(chama.KycDocumentType_KYC_SELFIE, "image/png", photo("new selfie"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(kycRes.Documents).Should(HaveLen(3))
+
+			var selfie *chama.KycDocument
+			for _, doc := range kycRes.Documents {
+				if doc.DocumentType == chama.KycDocumentType_KYC_SELFIE {
+					selfie = doc
+				}
+			}
+			Expect(selfie).ShouldNot(BeNil())
+
+			downloadRes, err := KycAPI.DownloadKycDocument(ctx, &chama.DownloadKycDocumentRequest{DocumentId: selfie.DocumentId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(downloadRes.Content).Should(Equal(photo("new selfie")))
+			Expect(downloadRes.Document.ContentType).Should(Equal("image/png"))
+		})
+	})
+
+	Describe("Reviewing documents", func() {
+		It("should fail to reject documents without a reason", func() {
+			uploadAll()
+			kycRes, err := KycAPI.ReviewMemberKyc(ctx, &chama.ReviewMemberKycRequest{MemberId: memberID})
+			Expect(err).Should(HaveOccurred())
+			Expect(kycRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("should fail to review documents that were not submitted", func() {
+			kycRes, err := KycAPI.ReviewMemberKyc(ctx, &chama.ReviewMemberKycRequest{MemberId: memberID, Verified: true})
+			Expect(err).Should(HaveOccurred())
+			Expect(kycRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should reject documents and take them for review again when they are uploaded again", func() {
+			uploadAll()
+
+			kycRes, err := KycAPI.ReviewMemberKyc(ctx, &chama.ReviewMemberKycRequest{
+				MemberId: memberID,
+				Notes:    "ID photo is blurred",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(kycRes.Status).Should(Equal(chama.KycStatus_KYC_REJECTED))
+			Expect(kycRes.ReviewerNotes).Should(Equal("ID photo is blurred"))
+			Expect(kycRes.ReviewedDate).ShouldNot(BeEmpty())
+
+			_, err = KycAPI.ReviewMemberKyc(ctx, &chama.ReviewMemberKycRequest{MemberId: memberID, Verified: true})
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			Expect(RequireVerified(KycAPIServer.SQLDB, memberID)).ShouldNot(Succeed())
+
+			kycRes, err = upload(chama.KycDocumentType_KYC_ID_FRONT, "image/png", photo("clear front"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(kycRes.Status).Should(Equal(chama.KycStatus_KYC_PENDING))
+		})
+
+		It("should verify documents", func() {
+			uploadAll()
+
+			kycRes, err := KycAPI.ReviewMemberKyc(ctx, &chama.ReviewMemberKycRequest{MemberId: memberID, Verified: true})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(kycRes.Status).Should(Equal(chama.KycStatus_KYC_VERIFIED))
+
+			Expect(RequireVerified(KycAPIServer.SQLDB, memberID)).Should(Succeed())
+
+			getRes, err := KycAPI.GetMemberKyc(ctx, &chama.GetMemberKycRequest{MemberId: memberID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.Status).Should(Equal(chama.KycStatus_KYC_VERIFIED))
+			Expect(getRes.Documents).Should(HaveLen(3))
+		})
+	})
+
+	Describe("Listing member KYC", func() {
+		It("should list members with documents awaiting verification", func() {
+			uploadAll()
+
+			listRes, err := KycAPI.ListMemberKyc(ctx, &chama.ListMemberKycRequest{
+				ChamaId:  memberDB.ChamaID,
+				Statuses: []chama.KycStatus{chama.KycStatus_KYC_PENDING},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.MemberKyc).Should(HaveLen(1))
+			Expect(listRes.MemberKyc[0].MemberId).Should(Equal(memberID))
+
+			listRes, err = KycAPI.ListMemberKyc(ctx, &chama.ListMemberKycRequest{
+				ChamaId:  memberDB.ChamaID,
+				Statuses: []chama.KycStatus{chama.KycStatus_KYC_VERIFIED},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.MemberKyc).Should(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,31 @@
+package kyc
+
+import (
+	"fmt"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/machama-app/internal/models"
+	. "github.com/onsi/gomega"
+)
+
+func createMember() *models.ChamaMember {
+	memberDB := &models.ChamaMember{
+		ChamaID:   fmt.Sprint(randomdata.Number(1000, 9999999)),
+		FirstName: randomdata.FirstName(randomdata.Female),
+		LastName:  randomdata.LastName(),
+		Phone:     fmt.Sprintf("2547%d", randomdata.Number(10000000, 99999999)),
+		IDNumber:  fmt.Sprint(randomdata.Number(22222222, 44444444)),
+		Active:    true,
+	}
+	Expect(KycAPIServer.SQLDB.Create(memberDB).Error).ShouldNot(HaveOccurred())
+	return memberDB
+}
+
+// photo returns the bytes of a PNG image that are unique to the label
+func photo(label string) []byte {
+	return append([]byte("\x89PNG\r\n\x1a\n"), label...)
+}
+
+func pdf(label string) []byte {
+	return append([]byte("%PDF-1.4\n"), label...)
+}
@@ -0,0 +1,61 @@
+package kyc
+
+import (
+	"context"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"google.golang.org/grpc/codes"
+)
+
+func (kycAPI *kycAPIServer) ReviewMemberKyc(ctx context.Context, req *chama.ReviewMemberKycRequest) (*chama.MemberKyc, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.MemberId == "":
+		return nil, errs.MissingField("member id")
+	case !req.Verified && req.Notes == "":
+		return nil, errs.MissingField("reason for rejecting the documents")
+	}
+
+	// Authorization
+	actor, memberDB, err := kycAPI.authorizer.AuthorizeMember(ctx, req.MemberId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	if memberDB.UserID != "" && memberDB.UserID == actor.ID {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "members cannot review their own documents")
+	}
+
+	status := chama.KycStatus_KYC_REJECTED
+	if req.Verified {
+		status = chama.KycStatus_KYC_VERIFIED
+	}
+
+	db := kycAPI.SQLDB.Model(&models.MemberKYC{}).
+		Where("member_id = ? AND status = ?", req.MemberId, chama.KycStatus_KYC_PENDING.String()).
+		Updates(map[string]interface{}{
+			"status":         status.String(),
+			"reviewer_id":    actor.ID,
+			"reviewer_notes": req.Notes,
+			"reviewed_at":    time.Now(),
+		})
+	if db.Error != nil {
+		return nil, errs.FailedToUpdate("member kyc", db.Error)
+	}
+	if db.RowsAffected == 0 {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "only documents awaiting verification can be reviewed")
+	}
+
+	kycDB, docs, err := kycAPI.memberKYC(memberDB)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.MemberKYCProto(kycDB, docs)
+}
@@ -0,0 +1,177 @@
+package kyc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+// documentTypes are the documents a member submits for verification
+var documentTypes = []chama.KycDocumentType{
+	chama.KycDocumentType_KYC_ID_FRONT,
+	chama.KycDocumentType_KYC_ID_BACK,
+	chama.KycDocumentType_KYC_SELFIE,
+}
+
+// contentTypes are the accepted file formats and whether they are photos. A selfie has to be a photo.
+var contentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"application/pdf": false,
+}
+
+func (kycAPI *kycAPIServer) UploadKycDocument(ctx context.Context, req *chama.UploadKycDocumentRequest) (*chama.MemberKyc, error) {
+	// Validation
+	var contentType string
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.MemberId == "":
+		return nil, errs.MissingField("member id")
+	case chama.KycDocumentType_name[int32(req.DocumentType)] == "":
+		return nil, errs.IncorrectVal("document type")
+	case req.ContentType == "":
+		return nil, errs.MissingField("content type")
+	case len(req.Content) == 0:
+		return nil, errs.MissingField("document content")
+	case len(req.Content) > kycAPI.MaxDocumentSize:
+		return nil, errs.WrapMessagef(codes.InvalidArgument, "document is larger than %d bytes", kycAPI.MaxDocumentSize)
+	default:
+		contentType = http.DetectContentType(req.Content)
+		photo, ok := contentTypes[contentType]
+		switch {
+		case !ok:
+			return nil, errs.WrapMessagef(codes.InvalidArgument, "documents of type %s are not accepted", contentType)
+		case contentType != req.ContentType:
+			return nil, errs.WrapMessagef(codes.InvalidArgument, "document content is %s and not %s", contentType, req.ContentType)
+		case req.DocumentType == chama.KycDocumentType_KYC_SELFIE && !photo:
+			return nil, errs.WrapMessage(codes.InvalidArgument, "selfie must be a photo")
+		}
+	}
+
+	// Authorization
+	actor, memberDB, err := kycAPI.authorizeMember(ctx, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	if memberDB.Status == models.MemberStatusExited || memberDB.Status == models.MemberStatusMerged {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "member is no longer in the chama")
+	}
+
+	memberID := fmt.Sprint(memberDB.ID)
+	sum := sha256.Sum256(req.Content)
+	storageKey := fmt.Sprintf("kyc/%s/%s-%d", memberID, req.DocumentType, time.Now().UnixNano())
+
+	// The file is stored first and removed again if it can't be recorded
+	err = kycAPI.BlobStore.Put(ctx, storageKey, req.Content)
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to store kyc document")
+	}
+
+	var replacedKey string
+
+	err = kycAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		docDB := &models.KYCDocument{}
+		err := tx.First(docDB, "member_id = ? AND document_type = ?", memberID, req.DocumentType.String()).Error
+		switch {
+		case err == nil:
+			replacedKey = docDB.StorageKey
+			err = tx.Model(docDB).Updates(map[string]interface{}{
+				"storage_key":  storageKey,
+				"content_type": contentType,
+				"size_bytes":   len(req.Content),
+				"checksum":     hex.EncodeToString(sum[:]),
+				"uploaded_by":  actor.ID,
+			}).Error
+			if err != nil {
+				return errs.FailedToUpdate("kyc document", err)
+			}
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			err = tx.Create(&models.KYCDocument{
+				MemberID:     memberID,
+				DocumentType: req.DocumentType.String(),
+				StorageKey:   storageKey,
+				ContentType:  contentType,
+				SizeBytes:    int64(len(req.Content)),
+				Checksum:     hex.EncodeToString(sum[:]),
+				UploadedBy:   actor.ID,
+			}).Error
+			if err != nil {
+				return errs.FailedToSave("kyc document", err)
+			}
+		default:
+			return errs.FailedToFind("kyc document", err)
+		}
+
+		kycDB := &models.MemberKYC{}
+		err = tx.First(kycDB, "member_id = ?", memberID).Error
+		switch {
+		case err == nil:
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			kycDB = &models.MemberKYC{
+				MemberID:    memberID,
+				ChamaID:     memberDB.ChamaID,
+				MemberNames: memberNames(memberDB),
+				Status:      chama.KycStatus_KYC_NOT_SUBMITTED.String(),
+			}
+			err = tx.Create(kycDB).Error
+			if err != nil {
+				return errs.FailedToSave("member kyc", err)
+			}
+		default:
+			return errs.FailedToFind("member kyc", err)
+		}
+
+		var uploaded int64
+		err = tx.Model(&models.KYCDocument{}).Where("member_id = ?", memberID).Count(&uploaded).Error
+		if err != nil {
+			return errs.FailedToFind("kyc documents", err)
+		}
+
+		// A complete set of documents goes for review again, including after it was verified or rejected
+		if uploaded == int64(len(documentTypes)) {
+			err = tx.Model(kycDB).Updates(map[string]interface{}{
+				"status":       chama.KycStatus_KYC_PENDING.String(),
+				"submitted_at": time.Now(),
+			}).Error
+			if err != nil {
+				return errs.FailedToUpdate("member kyc", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		kycAPI.deleteBlob(ctx, storageKey)
+		return nil, err
+	}
+
+	if replacedKey != "" {
+		kycAPI.deleteBlob(ctx, replacedKey)
+	}
+
+	kycDB, docs, err := kycAPI.memberKYC(memberDB)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.MemberKYCProto(kycDB, docs)
+}
+
+func (kycAPI *kycAPIServer) deleteBlob(ctx context.Context, key string) {
+	err := kycAPI.BlobStore.Delete(ctx, key)
+	if err != nil {
+		kycAPI.Logger.Errorf("failed to delete kyc document %s: %v", key, err)
+	}
+}
@@ -0,0 +1,314 @@
+package loan
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// approvalPolicy gets the approval policy of a loan product version
+func (loanAPI *loanAPIServer) approvalPolicy(productID string, version int32) (*loan.ApprovalPolicy, error) {
+	productPB, err := loanAPI.productTerms(productID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	// A single officer approval is enough by default
+	policy := productPB.GetApprovalPolicy()
+	if policy == nil {
+		policy = &loan.ApprovalPolicy{}
+	}
+	if policy.RequiredApprovals == 0 {
+		policy.RequiredApprovals = 1
+	}
+
+	return policy, nil
+}
+
+// approvalStatus evaluates the product approval policy against the approvals and votes recorded for a loan
+func (loanAPI *loanAPIServer) approvalStatus(loanPB *loan.Loan) (*loan.PendingApproval, error) {
+	policy, err := loanAPI.approvalPolicy(loanPB.ProductId, loanPB.ProductVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := make([]*models.LoanApproval, 0)
+	err = loanAPI.SQLDB.Order("id ASC").Find(&approvals, "loan_id = ?", loanPB.LoanId).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan approvals", err)
+	}
+
+	pb := &loan.PendingApproval{
+		Loan:               loanPB,
+		Approvals:          make([]*loan.LoanApproval, 0, len(approvals)),
+		RequiredApprovals:  policy.RequiredApprovals,
+		MemberVoteRequired: policy.MemberVoteMinAmount > 0 && loanPB.LoanAmount >= policy.MemberVoteMinAmount,
+	}
+
+	for _, approval := range approvals {
+		approvalPB, err := models.LoanApprovalProto(approval)
+		if err != nil {
+			return nil, err
+		}
+		pb.Approvals = append(pb.Approvals, approvalPB)
+	}
+
+	pb.PolicySatisfied = len(approvals) >= int(policy.RequiredApprovals)
+
+	if !pb.MemberVoteRequired {
+		return pb, nil
+	}
+
+	// Tally votes
+	votes := make([]*models.LoanVote, 0)
+	err = loanAPI.SQLDB.Find(&votes, "loan_id = ?", loanPB.LoanId).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan votes", err)
+	}
+
+	for _, vote := range votes {
+		if vote.InFavour {
+			pb.VotesFor++
+		} else {
+			pb.VotesAgainst++
+		}
+	}
+
+	var voters int64
+	err = loanAPI.SQLDB.Model(&models.ChamaMember{}).
+		Where("chama_id = ? AND active = ? AND id != ?", loanPB.ChamaId, true, loanPB.MemberId).
+		Count(&voters).Error
+	if err != nil {
+		return nil, errs.FailedToFind("eligible voters", err)
+	}
+	pb.EligibleVoters = int32(voters)
+
+	votesCast := pb.VotesFor + pb.VotesAgainst
+
+	quorumMet := votesCast > 0 && float32(votesCast)*100 >= policy.QuorumPercentage*float32(pb.EligibleVoters)
+
+	var thresholdMet bool
+	if policy.ApprovalThresholdPercentage == 0 {
+		thresholdMet = pb.VotesFor > pb.VotesAgainst
+	} else {
+		thresholdMet = float32(pb.VotesFor)*100 >= policy.ApprovalThresholdPercentage*float32(votesCast)
+	}
+
+	pb.PolicySatisfied = pb.PolicySatisfied && quorumMet && thresholdMet
+
+	return pb, nil
+}
+
+func (loanAPI *loanAPIServer) CastLoanVote(
+	ctx context.Context, req *loan.CastLoanVoteRequest,
+) (*emptypb.Empty, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.LoanId == "":
+		return nil, errs.MissingField("loan id")
+	case req.MemberId == "":
+		return nil, errs.MissingField("member id")
+	}
+
+	// Authorization
+	actor, err := loanAPI.authorizer.AuthorizeLoan(ctx, req.LoanId, roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get loan
+	loanPB, err := loanAPI.GetLoan(ctx, &loan.GetLoanRequest{LoanId: req.LoanId})
+	if err != nil {
+		return nil, err
+	}
+
+	if loanPB.Status != loan.LoanStatus_WAITING_APPROVAL {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan is not awaiting approval")
+	}
+
+	if loanPB.MemberId == req.MemberId {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "loanee cannot vote on own loan")
+	}
+
+	// Get member
+	memberDB := &models.ChamaMember{}
+	err = loanAPI.SQLDB.First(memberDB, "id = ?", req.MemberId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("chama member", req.MemberId)
+	default:
+		return nil, errs.FailedToFind("chama member", err)
+	}
+
+	if memberDB.ChamaID != loanPB.ChamaId || !memberDB.Active {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "member cannot vote on loans of the chama")
+	}
+
+	// Members only cast their own votes
+	if !loanAPI.authorizer.IsGlobal(actor) && memberDB.UserID != actor.ID {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "members can only cast their own vote")
+	}
+
+	approvalStatus, err := loanAPI.approvalStatus(loanPB)
+	if err != nil {
+		return nil, err
+	}
+
+	if !approvalStatus.MemberVoteRequired {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan does not require member vote")
+	}
+
+	loanID, err := loanIDFromString(req.LoanId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Members may change their vote until the loan is approved
+	err = loanAPI.SQLDB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "loan_id"}, {Name: "member_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"in_favour"}),
+	}).Create(&models.LoanVote{
+		LoanID:   loanID,
+		MemberID: req.MemberId,
+		InFavour: req.InFavour,
+	}).Error
+	if err != nil {
+		return nil, errs.FailedToSave("loan vote", err)
+	}
+
+	approvalStatus, err = loanAPI.approvalStatus(loanPB)
+	if err != nil {
+		return nil, err
+	}
+
+	if !approvalStatus.PolicySatisfied {
+		return &emptypb.Empty{}, nil
+	}
+
+	// Collateral may have been released since the officer approval
+	err = loanAPI.checkCollateralCoverage(loanPB)
+	if err != nil {
+		return nil, err
+	}
+
+	// The last vote releases funds from the account chosen by the latest approving officer
+	lastApproval := approvalStatus.Approvals[len(approvalStatus.Approvals)-1]
+
+	ctxExt, err := loanAPI.systemCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := loanAPI.claimLoan(loanID)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return &emptypb.Empty{}, nil
+	}
+
+	err = loanAPI.releaseFunds(ctxExt, lastApproval.ApproverId, lastApproval.AccountName, loanPB)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (loanAPI *loanAPIServer) ListPendingApprovals(
+	ctx context.Context, req *loan.ListPendingApprovalsRequest,
+) (*loan.ListPendingApprovalsResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("list request")
+	}
+
+	// Authorization
+	actor, chamaIDs, err := loanAPI.authorizer.ScopeChamas(ctx, req.ChamaIds, roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.GetPageSize()
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultPageSize
+	case pageSize > defaultPageSize:
+		if !loanAPI.Auth.IsAdmin(actor.Group) {
+			pageSize = defaultPageSize
+		}
+	}
+
+	var ID uint
+	pageToken := req.GetPageToken()
+	if pageToken != "" {
+		ids, err := loanAPI.PageHasher.DecodeInt64WithError(req.GetPageToken())
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		ID = uint(ids[0])
+	}
+
+	db := loanAPI.SQLDB.Limit(int(pageSize+1)).Order("id DESC").
+		Where("status = ?", loan.LoanStatus_WAITING_APPROVAL.String())
+	if ID != 0 {
+		db = db.Where("id<?", ID)
+	}
+
+	if chamaIDs != nil {
+		db = db.Where("chama_id IN (?)", chamaIDs)
+	}
+
+	dbs := make([]*models.Loan, 0, pageSize+1)
+	err = db.Find(&dbs).Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "LIST")
+	}
+
+	pbs := make([]*loan.PendingApproval, 0, len(dbs))
+	for i, db := range dbs {
+		if i == int(pageSize) {
+			break
+		}
+
+		loanPB, err := models.LoanProto(db)
+		if err != nil {
+			return nil, err
+		}
+
+		pb, err := loanAPI.approvalStatus(loanPB)
+		if err != nil {
+			return nil, err
+		}
+
+		pbs = append(pbs, pb)
+
+		ID = db.ID
+	}
+
+	var token string
+	if len(dbs) > int(pageSize) {
+		// Next page token
+		token, err = loanAPI.PageHasher.EncodeInt64([]int64{int64(ID)})
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to generate next page token")
+		}
+	}
+
+	return &loan.ListPendingApprovalsResponse{
+		PendingApprovals: pbs,
+		NextPageToken:    token,
+	}, nil
+}
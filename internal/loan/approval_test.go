@@ -0,0 +1,254 @@
+package loan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Loan approval workflow", func() {
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+	})
+
+	// createPendingLoan saves a loan awaiting approval under a product with the given policy
+	createPendingLoan := func(policy *loan.ApprovalPolicy, voters int) *loan.Loan {
+		loanPB := mockLoan()
+		loanPB.LoanAmount = 1000
+		Expect(createLoanPrerequisites(loanPB, nil)).ShouldNot(HaveOccurred())
+
+		bs, err := json.Marshal(policy)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = LoanAPIServer.SQLDB.Model(&models.LoanProduct{}).Where("id = ?", loanPB.ProductId).
+			Update("approval_policy", bs).Error
+		Expect(err).ShouldNot(HaveOccurred())
+
+		for i := 0; i < voters; i++ {
+			Expect(LoanAPIServer.SQLDB.Create(mockChamaMember(loanPB.ChamaId)).Error).ShouldNot(HaveOccurred())
+		}
+
+		loanDB, err := models.LoanModel(loanPB)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(LoanAPIServer.SQLDB.Create(loanDB).Error).ShouldNot(HaveOccurred())
+
+		loanPB.LoanId = fmt.Sprint(loanDB.ID)
+
+		return loanPB
+	}
+
+	Describe("CastLoanVote with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			voteRes, err := LoanAPI.CastLoanVote(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(voteRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when loan id is missing", func() {
+			voteRes, err := LoanAPI.CastLoanVote(ctx, &loan.CastLoanVoteRequest{MemberId: "1"})
+			Expect(err).Should(HaveOccurred())
+			Expect(voteRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when member id is missing", func() {
+			voteRes, err := LoanAPI.CastLoanVote(ctx, &loan.CastLoanVoteRequest{LoanId: "1"})
+			Expect(err).Should(HaveOccurred())
+			Expect(voteRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Evaluating approval policies", func() {
+		It("should require a single approval by default", func() {
+			loanPB := createPendingLoan(&loan.ApprovalPolicy{}, 0)
+
+			approvalStatus, err := LoanAPIServer.approvalStatus(loanPB)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(approvalStatus.RequiredApprovals).Should(BeEquivalentTo(1))
+			Expect(approvalStatus.PolicySatisfied).Should(BeFalse())
+			Expect(approvalStatus.MemberVoteRequired).Should(BeFalse())
+		})
+
+		It("should wait for the required number of officers", func() {
+			loanPB := createPendingLoan(&loan.ApprovalPolicy{RequiredApprovals: 2}, 0)
+
+			err := LoanAPIServer.SQLDB.Create(&models.LoanApproval{
+				LoanID:      loanIDOf(loanPB),
+				ApproverID:  "1",
+				AccountName: "loans",
+			}).Error
+			Expect(err).ShouldNot(HaveOccurred())
+
+			approvalStatus, err := LoanAPIServer.approvalStatus(loanPB)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(approvalStatus.Approvals).Should(HaveLen(1))
+			Expect(approvalStatus.PolicySatisfied).Should(BeFalse())
+
+			err = LoanAPIServer.SQLDB.Create(&models.LoanApproval{
+				LoanID:      loanIDOf(loanPB),
+				ApproverID:  "2",
+				AccountName: "loans",
+			}).Error
+			Expect(err).ShouldNot(HaveOccurred())
+
+			approvalStatus, err = LoanAPIServer.approvalStatus(loanPB)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(approvalStatus.PolicySatisfied).Should(BeTrue())
+		})
+	})
+
+	Describe("Claiming loans for release", func() {
+		It("should let only one approval release funds", func() {
+			loanPB := createPendingLoan(&loan.ApprovalPolicy{}, 0)
+
+			claimed, err := LoanAPIServer.claimLoan(loanIDOf(loanPB))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(claimed).Should(BeTrue())
+
+			claimed, err = LoanAPIServer.claimLoan(loanIDOf(loanPB))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(claimed).Should(BeFalse())
+
+			// Approving or voting on a claimed loan is rejected
+			_, err = LoanAPI.ApproveLoan(ctx, &loan.ApproveLoanRequest{LoanId: loanPB.LoanId, AccountName: "loans"})
+			Expect(status.Code(err)).Should(Equal(codes.AlreadyExists))
+
+			_, err = LoanAPI.CastLoanVote(ctx, &loan.CastLoanVoteRequest{LoanId: loanPB.LoanId, MemberId: "1", InFavour: true})
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should return a released loan to approval", func() {
+			loanPB := createPendingLoan(&loan.ApprovalPolicy{}, 0)
+
+			claimed, err := LoanAPIServer.claimLoan(loanIDOf(loanPB))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(claimed).Should(BeTrue())
+
+			LoanAPIServer.releaseLoan(loanPB.LoanId)
+
+			claimed, err = LoanAPIServer.claimLoan(loanIDOf(loanPB))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(claimed).Should(BeTrue())
+		})
+	})
+
+	Describe("Member voting on large loans", func() {
+		var loanPB *loan.Loan
+
+		It("should reject votes when member vote is not required", func() {
+			loanPB = createPendingLoan(&loan.ApprovalPolicy{MemberVoteMinAmount: 5000}, 1)
+
+			voter := mockChamaMember(loanPB.ChamaId)
+			Expect(LoanAPIServer.SQLDB.Create(voter).Error).ShouldNot(HaveOccurred())
+
+			voteRes, err := LoanAPI.CastLoanVote(ctx, &loan.CastLoanVoteRequest{
+				LoanId:   loanPB.LoanId,
+				MemberId: fmt.Sprint(voter.ID),
+				InFavour: true,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+			Expect(voteRes).Should(BeNil())
+		})
+
+		It("should reject votes from the loanee", func() {
+			loanPB = createPendingLoan(&loan.ApprovalPolicy{
+				MemberVoteMinAmount: 500,
+				QuorumPercentage:    50,
+			}, 0)
+
+			voteRes, err := LoanAPI.CastLoanVote(ctx, &loan.CastLoanVoteRequest{
+				LoanId:   loanPB.LoanId,
+				MemberId: loanPB.MemberId,
+				InFavour: true,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+			Expect(voteRes).Should(BeNil())
+		})
+
+		It("should count votes towards quorum and threshold", func() {
+			voters := make([]*models.ChamaMember, 0, 4)
+			for i := 0; i < 4; i++ {
+				voter := mockChamaMember(loanPB.ChamaId)
+				Expect(LoanAPIServer.SQLDB.Create(voter).Error).ShouldNot(HaveOccurred())
+				voters = append(voters, voter)
+			}
+
+			err := LoanAPIServer.SQLDB.Create(&models.LoanApproval{
+				LoanID:      loanIDOf(loanPB),
+				ApproverID:  "1",
+				AccountName: "loans",
+			}).Error
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = LoanAPI.CastLoanVote(ctx, &loan.CastLoanVoteRequest{
+				LoanId:   loanPB.LoanId,
+				MemberId: fmt.Sprint(voters[0].ID),
+				InFavour: false,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			approvalStatus, err := LoanAPIServer.approvalStatus(loanPB)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(approvalStatus.MemberVoteRequired).Should(BeTrue())
+			Expect(approvalStatus.VotesAgainst).Should(BeEquivalentTo(1))
+			Expect(approvalStatus.PolicySatisfied).Should(BeFalse())
+
+			// Changing a vote replaces the earlier one
+			_, err = LoanAPI.CastLoanVote(ctx, &loan.CastLoanVoteRequest{
+				LoanId:   loanPB.LoanId,
+				MemberId: fmt.Sprint(voters[0].ID),
+				InFavour: true,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			approvalStatus, err = LoanAPIServer.approvalStatus(loanPB)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(approvalStatus.VotesFor).Should(BeEquivalentTo(1))
+			Expect(approvalStatus.VotesAgainst).Should(BeEquivalentTo(0))
+			Expect(approvalStatus.PolicySatisfied).Should(BeFalse())
+		})
+	})
+
+	Describe("Listing pending approvals", func() {
+		It("should fail when the request is nil", func() {
+			listRes, err := LoanAPI.ListPendingApprovals(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(listRes).Should(BeNil())
+		})
+
+		It("should list loans awaiting approval", func() {
+			loanPB := createPendingLoan(&loan.ApprovalPolicy{RequiredApprovals: 3}, 0)
+
+			listRes, err := LoanAPI.ListPendingApprovals(ctx, &loan.ListPendingApprovalsRequest{
+				ChamaIds: []string{loanPB.ChamaId},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.PendingApprovals).ShouldNot(BeEmpty())
+
+			for _, pendingPB := range listRes.PendingApprovals {
+				Expect(pendingPB.Loan.ChamaId).Should(Equal(loanPB.ChamaId))
+				Expect(pendingPB.Loan.Status).Should(Equal(loan.LoanStatus_WAITING_APPROVAL))
+			}
+		})
+	})
+})
+
+func loanIDOf(loanPB *loan.Loan) uint {
+	loanID, err := loanIDFromString(loanPB.LoanId)
+	Expect(err).ShouldNot(HaveOccurred())
+	return loanID
+}
@@ -0,0 +1,222 @@
+package loan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+// checkCollateralCoverage confirms the loan is secured as required by its product. Collateral of a parent loan counts towards its top up.
+func (loanAPI *loanAPIServer) checkCollateralCoverage(loanPB *loan.Loan) error {
+	productPB, err := loanAPI.productTerms(loanPB.ProductId, loanPB.ProductVersion)
+	if err != nil {
+		return err
+	}
+
+	if productPB.CollateralCoverage == 0 || loanPB.LoanAmount == 0 {
+		return nil
+	}
+
+	loanIDs := []string{loanPB.LoanId}
+	if loanPB.ParentLoanId != "" {
+		loanIDs = append(loanIDs, loanPB.ParentLoanId)
+	}
+
+	var value float64
+	err = loanAPI.SQLDB.Model(&models.LoanCollateral{}).Select("COALESCE(SUM(estimated_value), 0)").
+		Where("loan_id IN (?) AND lien_status != ?", loanIDs, loan.LienStatus_LIEN_RELEASED.String()).
+		Scan(&value).Error
+	if err != nil {
+		return errs.FailedToFind("loan collateral", err)
+	}
+
+	coverage := value / loanPB.LoanAmount * 100
+	if coverage < float64(productPB.CollateralCoverage) {
+		return errs.WrapMessagef(
+			codes.FailedPrecondition,
+			"collateral covers %.2f%% of the loan, product requires %.2f%%", coverage, productPB.CollateralCoverage,
+		)
+	}
+
+	return nil
+}
+
+// releaseSettledCollateral releases the liens on a loan once it has been repaid in full
+func releaseSettledCollateral(tx *gorm.DB, loanID uint) error {
+	loanDB := &models.Loan{}
+	err := tx.First(loanDB, "id = ?", loanID).Error
+	if err != nil {
+		return errs.FailedToFind("loan", err)
+	}
+
+	var amountDue float64
+	err = tx.Model(&models.LoanInstallment{}).Select("COALESCE(SUM(amount_due), 0)").
+		Where("loan_id = ?", loanID).Scan(&amountDue).Error
+	if err != nil {
+		return errs.FailedToFind("loan installments", err)
+	}
+
+	if amountDue == 0 || roundAmount(amountDue+loanDB.PenaltyAmount-loanDB.SettledAmount) > 0 {
+		return nil
+	}
+
+	err = tx.Model(&models.LoanCollateral{}).
+		Where("loan_id = ? AND lien_status != ?", loanID, loan.LienStatus_LIEN_RELEASED.String()).
+		Updates(map[string]interface{}{
+			"lien_status": loan.LienStatus_LIEN_RELEASED.String(),
+			"released_at": time.Now(),
+		}).Error
+	if err != nil {
+		return errs.FailedToUpdate("loan collateral", err)
+	}
+
+	return nil
+}
+
+// moveCollateral passes the liens of a refinanced loan to the loan that took over its balance
+func moveCollateral(tx *gorm.DB, fromLoanID, toLoanID interface{}) error {
+	err := tx.Model(&models.LoanCollateral{}).
+		Where("loan_id = ? AND lien_status != ?", fromLoanID, loan.LienStatus_LIEN_RELEASED.String()).
+		Update("loan_id", toLoanID).Error
+	if err != nil {
+		return errs.FailedToUpdate("loan collateral", err)
+	}
+	return nil
+}
+
+func (loanAPI *loanAPIServer) AddLoanCollateral(
+	ctx context.Context, req *loan.AddLoanCollateralRequest,
+) (*loan.Collateral, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.Collateral == nil:
+		return nil, errs.MissingField("collateral")
+	case req.Collateral.LoanId == "":
+		return nil, errs.MissingField("loan id")
+	case req.Collateral.Description == "":
+		return nil, errs.MissingField("collateral description")
+	case req.Collateral.EstimatedValue <= 0:
+		return nil, errs.IncorrectVal("collateral estimated value")
+	case req.Collateral.LienStatus == loan.LienStatus_LIEN_RELEASED:
+		return nil, errs.IncorrectVal("lien status")
+	}
+
+	// Authorization
+	_, err := loanAPI.authorizer.AuthorizeLoan(ctx, req.Collateral.LoanId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	loanDB, err := runningLoan(loanAPI.SQLDB, req.Collateral.LoanId)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := models.LoanCollateralModel(req.Collateral)
+	if err != nil {
+		return nil, err
+	}
+
+	db.LoanID = loanDB.ID
+
+	err = loanAPI.SQLDB.Create(db).Error
+	if err != nil {
+		return nil, errs.FailedToSave("loan collateral", err)
+	}
+
+	return models.LoanCollateralProto(db)
+}
+
+func (loanAPI *loanAPIServer) UpdateCollateralLien(
+	ctx context.Context, req *loan.UpdateCollateralLienRequest,
+) (*emptypb.Empty, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.CollateralId == "":
+		return nil, errs.MissingField("collateral id")
+	}
+
+	db := &models.LoanCollateral{}
+	err := loanAPI.SQLDB.First(db, "id = ?", req.CollateralId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("loan collateral", req.CollateralId)
+	default:
+		return nil, errs.FailedToFind("loan collateral", err)
+	}
+
+	// Authorization
+	_, err = loanAPI.authorizer.AuthorizeLoan(ctx, fmt.Sprint(db.LoanID), roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	if db.LienStatus == loan.LienStatus_LIEN_RELEASED.String() {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "collateral lien has been released")
+	}
+
+	updates := map[string]interface{}{
+		"lien_status": req.LienStatus.String(),
+	}
+	if req.LienStatus == loan.LienStatus_LIEN_RELEASED {
+		updates["released_at"] = time.Now()
+	}
+
+	err = loanAPI.SQLDB.Model(db).Updates(updates).Error
+	if err != nil {
+		return nil, errs.FailedToUpdate("loan collateral", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (loanAPI *loanAPIServer) ListLoanCollateral(
+	ctx context.Context, req *loan.ListLoanCollateralRequest,
+) (*loan.ListLoanCollateralResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.LoanId == "":
+		return nil, errs.MissingField("loan id")
+	}
+
+	// Authorization
+	_, err := loanAPI.authorizer.AuthorizeLoan(ctx, req.LoanId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	dbs := make([]*models.LoanCollateral, 0)
+	err = loanAPI.SQLDB.Order("id ASC").Find(&dbs, "loan_id = ?", req.LoanId).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan collateral", err)
+	}
+
+	pbs := make([]*loan.Collateral, 0, len(dbs))
+	for _, db := range dbs {
+		pb, err := models.LoanCollateralProto(db)
+		if err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+
+	return &loan.ListLoanCollateralResponse{
+		Collaterals: pbs,
+	}, nil
+}
@@ -0,0 +1,170 @@
+package loan
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Loan collateral", func() {
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+	})
+
+	mockCollateral := func(loanID string, value float64) *loan.Collateral {
+		return &loan.Collateral{
+			LoanId:             loanID,
+			CollateralType:     loan.CollateralType_LOGBOOK,
+			Description:        "Motor vehicle logbook",
+			EstimatedValue:     value,
+			DocumentReferences: []string{"docs/logbook.pdf"},
+		}
+	}
+
+	Describe("AddLoanCollateral with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			addRes, err := LoanAPI.AddLoanCollateral(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(addRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when collateral is nil", func() {
+			addRes, err := LoanAPI.AddLoanCollateral(ctx, &loan.AddLoanCollateralRequest{})
+			Expect(err).Should(HaveOccurred())
+			Expect(addRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when loan id is missing", func() {
+			addRes, err := LoanAPI.AddLoanCollateral(ctx, &loan.AddLoanCollateralRequest{
+				Collateral: mockCollateral("", 500),
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(addRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when estimated value is not positive", func() {
+			addRes, err := LoanAPI.AddLoanCollateral(ctx, &loan.AddLoanCollateralRequest{
+				Collateral: mockCollateral("1", 0),
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(addRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when loan does not exist", func() {
+			addRes, err := LoanAPI.AddLoanCollateral(ctx, &loan.AddLoanCollateralRequest{
+				Collateral: mockCollateral("0", 500),
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(addRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("Securing a loan", func() {
+		var (
+			loanPB *loan.Loan
+		)
+
+		It("should reject approval when collateral is below the product coverage", func() {
+			loanPB = mockLoan()
+			loanPB.LoanAmount = 1000
+			Expect(createLoanPrerequisites(loanPB, nil)).ShouldNot(HaveOccurred())
+
+			err := LoanAPIServer.SQLDB.Model(&models.LoanProduct{}).Where("id = ?", loanPB.ProductId).
+				Update("collateral_coverage", 120).Error
+			Expect(err).ShouldNot(HaveOccurred())
+
+			loanDB, err := models.LoanModel(loanPB)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(LoanAPIServer.SQLDB.Create(loanDB).Error).ShouldNot(HaveOccurred())
+
+			loanPB.LoanId = fmt.Sprint(loanDB.ID)
+
+			_, err = LoanAPI.AddLoanCollateral(ctx, &loan.AddLoanCollateralRequest{
+				Collateral: mockCollateral(loanPB.LoanId, 500),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			approveRes, err := LoanAPI.ApproveLoan(ctx, &loan.ApproveLoanRequest{
+				LoanId:      loanPB.LoanId,
+				AccountName: "loans",
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(approveRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should pass the coverage check once enough collateral is pledged", func() {
+			_, err := LoanAPI.AddLoanCollateral(ctx, &loan.AddLoanCollateralRequest{
+				Collateral: mockCollateral(loanPB.LoanId, 700),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(LoanAPIServer.checkCollateralCoverage(loanPB)).ShouldNot(HaveOccurred())
+
+			listRes, err := LoanAPI.ListLoanCollateral(ctx, &loan.ListLoanCollateralRequest{LoanId: loanPB.LoanId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Collaterals).Should(HaveLen(2))
+			Expect(listRes.Collaterals[0].LienStatus).Should(Equal(loan.LienStatus_LIEN_PENDING))
+			Expect(listRes.Collaterals[0].DocumentReferences).Should(ConsistOf("docs/logbook.pdf"))
+		})
+
+		It("should not count released collateral", func() {
+			listRes, err := LoanAPI.ListLoanCollateral(ctx, &loan.ListLoanCollateralRequest{LoanId: loanPB.LoanId})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = LoanAPI.UpdateCollateralLien(ctx, &loan.UpdateCollateralLienRequest{
+				CollateralId: listRes.Collaterals[1].CollateralId,
+				LienStatus:   loan.LienStatus_LIEN_RELEASED,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = LoanAPIServer.checkCollateralCoverage(loanPB)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			_, err = LoanAPI.UpdateCollateralLien(ctx, &loan.UpdateCollateralLienRequest{
+				CollateralId: listRes.Collaterals[1].CollateralId,
+				LienStatus:   loan.LienStatus_LIEN_REGISTERED,
+			})
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+
+	Describe("Repaying a secured loan", func() {
+		It("should release the liens once the loan is settled", func() {
+			loanPB, err := createDisbursedLoan(mockLoan(), time.Now())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			collateralPB, err := LoanAPI.AddLoanCollateral(ctx, &loan.AddLoanCollateralRequest{
+				Collateral: mockCollateral(loanPB.LoanId, 1500),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = LoanAPI.RepayLoan(ctx, &loan.RepayLoanRequest{LoanId: loanPB.LoanId, Amount: 500})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			collateralDB := &models.LoanCollateral{}
+			Expect(LoanAPIServer.SQLDB.First(collateralDB, "id = ?", collateralPB.CollateralId).Error).ShouldNot(HaveOccurred())
+			Expect(collateralDB.LienStatus).Should(Equal(loan.LienStatus_LIEN_PENDING.String()))
+
+			_, err = LoanAPI.RepayLoan(ctx, &loan.RepayLoanRequest{LoanId: loanPB.LoanId, Amount: 600})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(LoanAPIServer.SQLDB.First(collateralDB, "id = ?", collateralPB.CollateralId).Error).ShouldNot(HaveOccurred())
+			Expect(collateralDB.LienStatus).Should(Equal(loan.LienStatus_LIEN_RELEASED.String()))
+			Expect(collateralDB.ReleasedAt).ShouldNot(BeNil())
+		})
+	})
+})
@@ -20,6 +20,7 @@ var _ = Describe("CreateLoan", func() {
 		createReq = &loan.CreateLoanRequest{
 			Loan: mockLoan(),
 		}
+		createReq.Loan.LoanAmount = 1000
 		ctx = context.TODO()
 	})
 
@@ -80,10 +81,39 @@ var _ = Describe("CreateLoan", func() {
 			Expect(createRes).Should(BeNil())
 			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
 		})
+		It("should fail when loan amount is zero", func() {
+			createReq.Loan.LoanAmount = 0
+			createRes, err := LoanAPI.CreateLoan(ctx, createReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(createRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when loan amount is negative", func() {
+			createReq.Loan.LoanAmount = -500
+			createRes, err := LoanAPI.CreateLoan(ctx, createReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(createRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
 	})
 
 	Describe("CreateLoan with wellformed request", func() {
+		It("should fail when loan product does not exist", func() {
+			createReq.Loan.ProductId = "oops"
+			createRes, err := LoanAPI.CreateLoan(ctx, createReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(createRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+		It("should fail when member is not eligible", func() {
+			Expect(createLoanPrerequisites(createReq.Loan, []byte(`{"min_membership_days":180}`))).ShouldNot(HaveOccurred())
+			createRes, err := LoanAPI.CreateLoan(ctx, createReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(createRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
 		It("should succeed", func() {
+			Expect(createLoanPrerequisites(createReq.Loan, nil)).ShouldNot(HaveOccurred())
 			createRes, err := LoanAPI.CreateLoan(ctx, createReq)
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(createRes).ShouldNot(BeNil())
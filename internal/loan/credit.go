@@ -0,0 +1,224 @@
+package loan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"gorm.io/gorm"
+)
+
+const (
+	creditHistoryMonths  = 12
+	creditTenureMonths   = 24
+	creditDefaultDays    = 30
+	creditNeutralScore   = 50
+	creditDefaultPenalty = 50
+)
+
+// Contribution of each factor to the credit score. The weights add up to one.
+const (
+	contributionWeight = 0.3
+	repaymentWeight    = 0.4
+	guarantorWeight    = 0.15
+	tenureWeight       = 0.15
+)
+
+func (loanAPI *loanAPIServer) GetMemberCreditProfile(
+	ctx context.Context, req *loan.GetMemberCreditProfileRequest,
+) (*loan.MemberCreditProfile, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.MemberId == "":
+		return nil, errs.MissingField("member id")
+	}
+
+	// Authorization
+	_, _, err := loanAPI.authorizer.AuthorizeMember(ctx, req.MemberId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	memberDB := &models.ChamaMember{}
+	err = loanAPI.SQLDB.First(memberDB, "id = ?", req.MemberId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("chama member", req.MemberId)
+	default:
+		return nil, errs.FailedToFind("chama member", err)
+	}
+
+	return loanAPI.creditProfile(memberDB, time.Now())
+}
+
+// creditProfile scores a member from 0 to 100 using their history in the chama
+func (loanAPI *loanAPIServer) creditProfile(memberDB *models.ChamaMember, now time.Time) (*loan.MemberCreditProfile, error) {
+	memberID := fmt.Sprint(memberDB.ID)
+
+	contribution, err := loanAPI.contributionRegularity(memberDB, now)
+	if err != nil {
+		return nil, err
+	}
+
+	repayment, err := loanAPI.repaymentTimeliness(memberID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	guarantor, err := loanAPI.guarantorDefaults(memberDB, now)
+	if err != nil {
+		return nil, err
+	}
+
+	factors := []*loan.CreditFactor{contribution, repayment, guarantor, memberTenure(memberDB, now)}
+
+	var score float64
+	for _, factor := range factors {
+		score += float64(factor.Score * factor.Weight)
+	}
+
+	return &loan.MemberCreditProfile{
+		MemberId:     memberID,
+		ChamaId:      memberDB.ChamaID,
+		CreditScore:  int32(math.Round(score)),
+		Factors:      factors,
+		ComputedDate: now.Format(chargeDateLayout),
+	}, nil
+}
+
+// contributionRegularity is the share of recent months in which the member made a deposit
+func (loanAPI *loanAPIServer) contributionRegularity(memberDB *models.ChamaMember, now time.Time) (*loan.CreditFactor, error) {
+	months := monthsBetween(memberDB.CreatedAt, now) + 1
+	if months > creditHistoryMonths {
+		months = creditHistoryMonths
+	}
+
+	year, month, _ := now.Date()
+	since := time.Date(year, month-time.Month(months-1), 1, 0, 0, 0, 0, now.Location())
+
+	var activeMonths int64
+	err := loanAPI.SQLDB.Model(&models.Transaction{}).
+		Select("COUNT(DISTINCT DATE_FORMAT(transactions.created_at, '%Y-%m'))").
+		Joins("JOIN chama_accounts ON chama_accounts.id = transactions.account_id").
+		Where("chama_accounts.owner_id = ? AND transactions.transaction_type = ?", fmt.Sprint(memberDB.ID), transaction.TransactionType_DEPOSIT.String()).
+		Where("transactions.created_at >= ? AND transactions.created_at < ?", since, now).
+		Scan(&activeMonths).Error
+	if err != nil {
+		return nil, errs.FailedToFind("member contributions", err)
+	}
+
+	return &loan.CreditFactor{
+		Name:   "contribution_regularity",
+		Score:  float32(math.Min(float64(activeMonths)/float64(months)*100, 100)),
+		Weight: contributionWeight,
+		Detail: fmt.Sprintf("deposits made in %d of the last %d months", activeMonths, months),
+	}, nil
+}
+
+// repaymentTimeliness is the share of due installments paid in full without attracting a penalty
+func (loanAPI *loanAPIServer) repaymentTimeliness(memberID string, now time.Time) (*loan.CreditFactor, error) {
+	db := loanAPI.SQLDB.Model(&models.LoanInstallment{}).
+		Joins("JOIN loans ON loans.id = loan_installments.loan_id").
+		Where("loans.member_id = ? AND loans.status != ?", memberID, loan.LoanStatus_REFINANCED.String()).
+		Where("loan_installments.due_date < ?", now)
+
+	var due int64
+	err := db.Session(&gorm.Session{}).Count(&due).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan installments", err)
+	}
+
+	factor := &loan.CreditFactor{
+		Name:   "repayment_timeliness",
+		Score:  creditNeutralScore,
+		Weight: repaymentWeight,
+		Detail: "no repayment history",
+	}
+
+	if due == 0 {
+		return factor, nil
+	}
+
+	var onTime int64
+	err = db.Session(&gorm.Session{}).
+		Where("loan_installments.amount_paid >= loan_installments.amount_due").
+		Where(
+			"NOT EXISTS (SELECT 1 FROM loan_charges WHERE loan_charges.installment_id = loan_installments.id AND loan_charges.charge_type = ?)",
+			models.LoanChargePenalty,
+		).
+		Count(&onTime).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan installments", err)
+	}
+
+	factor.Score = float32(float64(onTime) / float64(due) * 100)
+	factor.Detail = fmt.Sprintf("%d of %d due installments paid on time", onTime, due)
+
+	return factor, nil
+}
+
+// guarantorDefaults penalizes the member for loans in default taken by members they guarantee
+func (loanAPI *loanAPIServer) guarantorDefaults(memberDB *models.ChamaMember, now time.Time) (*loan.CreditFactor, error) {
+	guaranteedIDs := make([]string, 0)
+	err := loanAPI.SQLDB.Model(&models.ChamaMember{}).
+		Where("chama_id = ? AND id != ?", memberDB.ChamaID, memberDB.ID).
+		Where("JSON_CONTAINS(guarantees, JSON_OBJECT('phone', ?))", memberDB.Phone).
+		Pluck("CAST(id AS CHAR)", &guaranteedIDs).Error
+	if err != nil {
+		return nil, errs.FailedToFind("guaranteed members", err)
+	}
+
+	var defaults int64
+	if len(guaranteedIDs) != 0 {
+		err = loanAPI.SQLDB.Model(&models.Loan{}).
+			Where("member_id IN (?) AND status != ?", guaranteedIDs, loan.LoanStatus_REFINANCED.String()).
+			Where(
+				"(status = ? OR EXISTS (SELECT 1 FROM loan_installments WHERE loan_installments.loan_id = loans.id AND loan_installments.due_date < ? AND loan_installments.amount_paid < loan_installments.amount_due))",
+				loan.LoanStatus_WRITTEN_OFF.String(), now.AddDate(0, 0, -creditDefaultDays),
+			).
+			Count(&defaults).Error
+		if err != nil {
+			return nil, errs.FailedToFind("guaranteed loans", err)
+		}
+	}
+
+	return &loan.CreditFactor{
+		Name:   "guarantor_defaults",
+		Score:  float32(math.Max(100-float64(defaults*creditDefaultPenalty), 0)),
+		Weight: guarantorWeight,
+		Detail: fmt.Sprintf("%d defaulted loans among %d guaranteed members", defaults, len(guaranteedIDs)),
+	}, nil
+}
+
+// memberTenure grows with the length of membership up to the full score at two years
+func memberTenure(memberDB *models.ChamaMember, now time.Time) *loan.CreditFactor {
+	months := monthsBetween(memberDB.CreatedAt, now)
+	return &loan.CreditFactor{
+		Name:   "tenure",
+		Score:  float32(math.Min(float64(months)/creditTenureMonths*100, 100)),
+		Weight: tenureWeight,
+		Detail: fmt.Sprintf("member for %d months", months),
+	}
+}
+
+// monthsBetween is the number of whole months from start to end
+func monthsBetween(start, end time.Time) int {
+	months := (end.Year()-start.Year())*12 + int(end.Month()-start.Month())
+	if end.Day() < start.Day() {
+		months--
+	}
+	if months < 0 {
+		return 0
+	}
+	return months
+}
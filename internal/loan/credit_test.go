@@ -0,0 +1,172 @@
+package loan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Member credit profile", func() {
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+	})
+
+	createMember := func(chamaID string) *models.ChamaMember {
+		memberDB := mockChamaMember(chamaID)
+		Expect(LoanAPIServer.SQLDB.Create(memberDB).Error).ShouldNot(HaveOccurred())
+		return memberDB
+	}
+
+	creditFactor := func(profilePB *loan.MemberCreditProfile, name string) *loan.CreditFactor {
+		for _, factor := range profilePB.Factors {
+			if factor.Name == name {
+				return factor
+			}
+		}
+		Fail("missing credit factor " + name)
+		return nil
+	}
+
+	Describe("GetMemberCreditProfile with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			profileRes, err := LoanAPI.GetMemberCreditProfile(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(profileRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when member id is missing", func() {
+			profileRes, err := LoanAPI.GetMemberCreditProfile(ctx, &loan.GetMemberCreditProfileRequest{})
+			Expect(err).Should(HaveOccurred())
+			Expect(profileRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when member does not exist", func() {
+			profileRes, err := LoanAPI.GetMemberCreditProfile(ctx, &loan.GetMemberCreditProfileRequest{MemberId: "0"})
+			Expect(err).Should(HaveOccurred())
+			Expect(profileRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("Scoring members", func() {
+		var (
+			memberDB *models.ChamaMember
+		)
+
+		It("should give a new member a neutral repayment score", func() {
+			memberDB = createMember(randomID())
+
+			profilePB, err := LoanAPI.GetMemberCreditProfile(ctx, &loan.GetMemberCreditProfileRequest{
+				MemberId: fmt.Sprint(memberDB.ID),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(profilePB.Factors).Should(HaveLen(4))
+			Expect(creditFactor(profilePB, "repayment_timeliness").Score).Should(BeEquivalentTo(creditNeutralScore))
+			Expect(creditFactor(profilePB, "guarantor_defaults").Score).Should(BeEquivalentTo(100))
+			Expect(creditFactor(profilePB, "tenure").Score).Should(BeZero())
+			Expect(profilePB.CreditScore).Should(BeEquivalentTo(35))
+		})
+
+		It("should reward regular contributions", func() {
+			accountDB := &models.ChamaAccount{
+				OwnerID:     fmt.Sprint(memberDB.ID),
+				AccountName: "savings",
+				AccountType: transaction.AccountType_SAVINGS_ACCOUNT.String(),
+				Active:      true,
+			}
+			Expect(LoanAPIServer.SQLDB.Create(accountDB).Error).ShouldNot(HaveOccurred())
+
+			err := LoanAPIServer.SQLDB.Create(&models.Transaction{
+				ActorID:           fmt.Sprint(memberDB.ID),
+				AccountID:         fmt.Sprint(accountDB.ID),
+				Description:       "monthly contribution",
+				TransactionType:   transaction.TransactionType_DEPOSIT.String(),
+				TransactionAmount: 500,
+			}).Error
+			Expect(err).ShouldNot(HaveOccurred())
+
+			profilePB, err := LoanAPIServer.creditProfile(memberDB, time.Now().Add(time.Minute))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(creditFactor(profilePB, "contribution_regularity").Score).Should(BeEquivalentTo(100))
+			Expect(profilePB.CreditScore).Should(BeEquivalentTo(65))
+		})
+
+		It("should penalize unpaid installments", func() {
+			pb := mockLoan()
+			pb.ChamaId = memberDB.ChamaID
+			loanPB, err := createDisbursedLoan(pb, time.Now().AddDate(0, 0, -60))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = LoanAPIServer.SQLDB.Model(&models.Loan{}).Where("id = ?", loanPB.LoanId).
+				Update("member_id", fmt.Sprint(memberDB.ID)).Error
+			Expect(err).ShouldNot(HaveOccurred())
+
+			profilePB, err := LoanAPIServer.creditProfile(memberDB, time.Now())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(creditFactor(profilePB, "repayment_timeliness").Score).Should(BeZero())
+		})
+
+		It("should penalize defaults by guaranteed members", func() {
+			guaranteedDB := mockChamaMember(memberDB.ChamaID)
+			bs, err := json.Marshal([]*chama.TrustPerson{{Name: memberDB.FirstName, Phone: memberDB.Phone}})
+			Expect(err).ShouldNot(HaveOccurred())
+			guaranteedDB.Guarantees = bs
+			Expect(LoanAPIServer.SQLDB.Create(guaranteedDB).Error).ShouldNot(HaveOccurred())
+
+			loanDB, err := models.LoanModel(mockLoan())
+			Expect(err).ShouldNot(HaveOccurred())
+			loanDB.MemberID = fmt.Sprint(guaranteedDB.ID)
+			loanDB.Status = loan.LoanStatus_WRITTEN_OFF.String()
+			Expect(LoanAPIServer.SQLDB.Create(loanDB).Error).ShouldNot(HaveOccurred())
+
+			profilePB, err := LoanAPIServer.creditProfile(memberDB, time.Now())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(creditFactor(profilePB, "guarantor_defaults").Score).Should(BeEquivalentTo(100 - creditDefaultPenalty))
+		})
+	})
+
+	Describe("Credit score eligibility threshold", func() {
+		It("should reject members below the product minimum score", func() {
+			memberDB := createMember(randomID())
+
+			bs, err := json.Marshal(&loan.EligibilityRules{MinCreditScore: 90})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			productDB := mockLoanProduct(memberDB.ChamaID)
+			productDB.EligibilityRules = bs
+			Expect(LoanAPIServer.SQLDB.Create(productDB).Error).ShouldNot(HaveOccurred())
+
+			checkRes, err := LoanAPI.CheckEligibility(ctx, &loan.CheckEligibilityRequest{
+				ProductId: fmt.Sprint(productDB.ID),
+				MemberId:  fmt.Sprint(memberDB.ID),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(checkRes.Eligible).Should(BeFalse())
+			Expect(checkRes.CreditScore).Should(BeNumerically("<", 90))
+			Expect(checkRes.Reasons).Should(ContainElement(ContainSubstring("credit score")))
+		})
+	})
+
+	Describe("Counting membership months", func() {
+		It("should count whole months only", func() {
+			start := time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)
+			Expect(monthsBetween(start, time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC))).Should(Equal(0))
+			Expect(monthsBetween(start, time.Date(2020, 3, 31, 0, 0, 0, 0, time.UTC))).Should(Equal(2))
+			Expect(monthsBetween(start, time.Date(2019, 3, 31, 0, 0, 0, 0, time.UTC))).Should(Equal(0))
+		})
+	})
+})
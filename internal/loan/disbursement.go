@@ -0,0 +1,266 @@
+package loan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/payout"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"gorm.io/gorm"
+)
+
+// disburse sends withdrawn loan funds to the loanee through the payout provider
+func (loanAPI *loanAPIServer) disburse(ctx context.Context, actorID, accountID string, loanPB *loan.Loan) error {
+	loanID, err := loanIDFromString(loanPB.LoanId)
+	if err != nil {
+		return err
+	}
+
+	payoutDB := &models.LoanPayout{
+		LoanID:    loanID,
+		ActorID:   actorID,
+		AccountID: accountID,
+		Phone:     loanPB.LoaneePhone,
+		Amount:    disbursableAmount(loanPB),
+		Status:    models.PayoutPending,
+	}
+
+	err = loanAPI.SQLDB.Create(payoutDB).Error
+	if err != nil {
+		return errs.FailedToSave("loan payout", err)
+	}
+
+	err = loanAPI.updateLoanStatus(loanID, loan.LoanStatus_WAITING_FUNDS_TRANSFER)
+	if err != nil {
+		return err
+	}
+
+	// Results are matched by the payout id since they can arrive before the provider reference is saved
+	providerRef, err := loanAPI.PayoutProvider.Disburse(ctx, &payout.Request{
+		Reference: fmt.Sprint(payoutDB.ID),
+		Phone:     loanPB.LoaneePhone,
+		Amount:    disbursableAmount(loanPB),
+		Remarks:   fmt.Sprintf("Loan %s disbursement", loanPB.LoanId),
+	})
+	if err != nil {
+		errReverse := loanAPI.reversePayout(ctx, payoutDB, err.Error())
+		if errReverse != nil {
+			loanAPI.Logger.Errorf("failed to reverse payout %d: %v", payoutDB.ID, errReverse)
+		}
+		return errs.WrapErrorWithMsg(err, "failed to disburse loan")
+	}
+
+	err = loanAPI.SQLDB.Model(payoutDB).Update("provider_reference", providerRef).Error
+	if err != nil {
+		return errs.FailedToUpdate("loan payout", err)
+	}
+
+	return nil
+}
+
+func (loanAPI *loanAPIServer) runPayoutResults(ctx context.Context) {
+	ticker := time.NewTicker(loanAPI.PayoutReconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case result := <-loanAPI.PayoutProvider.Results():
+			err := loanAPI.completePayout(ctx, result)
+			if err != nil {
+				loanAPI.Logger.Errorf("failed to complete loan payout: %v", err)
+			}
+		case <-ticker.C:
+			err := loanAPI.reconcilePayouts()
+			if err != nil {
+				loanAPI.Logger.Errorf("failed to reconcile loan payouts: %v", err)
+			}
+		}
+	}
+}
+
+// completePayout applies a payout result. Results for payouts that are no longer pending are ignored.
+func (loanAPI *loanAPIServer) completePayout(ctx context.Context, result *payout.Result) error {
+	if result == nil {
+		return errs.NilObject("payout result")
+	}
+
+	payoutDB := &models.LoanPayout{}
+
+	var err error
+	if result.Reference != "" {
+		err = loanAPI.SQLDB.First(payoutDB, "id = ?", result.Reference).Error
+	} else {
+		err = loanAPI.SQLDB.First(payoutDB, "provider_reference = ?", result.ProviderReference).Error
+	}
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return errs.DoesNotExist("loan payout", result.Reference+result.ProviderReference)
+	default:
+		return errs.FailedToFind("loan payout", err)
+	}
+
+	if !result.Succeeded {
+		return loanAPI.reversePayout(ctx, payoutDB, result.Reason)
+	}
+
+	// The cash has reached the loanee, so the payout and the loan are marked as such before anything else can fail
+	err = loanAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		// Claim the payout so that duplicate results are ignored
+		db := tx.Model(&models.LoanPayout{}).
+			Where("id = ? AND status = ?", payoutDB.ID, models.PayoutPending).
+			Updates(map[string]interface{}{
+				"status":             models.PayoutSucceeded,
+				"provider_reference": result.ProviderReference,
+			})
+		if db.Error != nil {
+			return errs.FailedToUpdate("loan payout", db.Error)
+		}
+		if db.RowsAffected == 0 {
+			return nil
+		}
+
+		err := tx.Model(&models.Loan{}).Where("id = ?", payoutDB.LoanID).
+			Update("status", loan.LoanStatus_FUNDS_TRANSFERED.String()).Error
+		if err != nil {
+			return errs.FailedToUpdate("loan status", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return loanAPI.finishPayout(payoutDB.ID)
+}
+
+// finishPayout closes the parent of a top up and saves the loan schedule once its payout has succeeded. Payouts that
+// fail to finish stay succeeded and are finished by the reconciliation that runs with payout results.
+func (loanAPI *loanAPIServer) finishPayout(payoutID uint) error {
+	payoutDB := &models.LoanPayout{}
+	err := loanAPI.SQLDB.First(payoutDB, "id = ? AND status = ?", payoutID, models.PayoutSucceeded).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil
+	default:
+		return errs.FailedToFind("loan payout", err)
+	}
+
+	loanID := fmt.Sprint(payoutDB.LoanID)
+	paidAt := payoutDB.UpdatedAt
+
+	err = loanAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		// Top ups take over the balance of the parent loan once the funds reach the loanee
+		closed, err := closeParentLoan(tx, loanID)
+		if err != nil {
+			return err
+		}
+		if !closed {
+			return nil
+		}
+
+		// Nothing was carried over so the loanee owes only the cash paid out, the payout is flagged for officers to reconcile
+		err = tx.Model(payoutDB).Update("reconcile_reason", "topped up loan was closed before the top up was paid out").Error
+		if err != nil {
+			return errs.FailedToUpdate("loan payout", err)
+		}
+		loanAPI.Logger.Warningf("top up loan %s was paid out after its parent loan was closed", loanID)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = loanAPI.createSchedule(loanID, paidAt)
+	if err != nil {
+		return err
+	}
+
+	err = loanAPI.SQLDB.Model(payoutDB).Update("status", models.PayoutCompleted).Error
+	if err != nil {
+		return errs.FailedToUpdate("loan payout", err)
+	}
+
+	return nil
+}
+
+// reconcilePayouts finishes payouts that succeeded but whose loans were not completed
+func (loanAPI *loanAPIServer) reconcilePayouts() error {
+	payoutIDs := make([]uint, 0)
+	err := loanAPI.SQLDB.Model(&models.LoanPayout{}).Where("status = ?", models.PayoutSucceeded).
+		Pluck("id", &payoutIDs).Error
+	if err != nil {
+		return errs.FailedToFind("loan payouts", err)
+	}
+
+	for _, payoutID := range payoutIDs {
+		err = loanAPI.finishPayout(payoutID)
+		if err != nil {
+			loanAPI.Logger.Errorf("failed to finish loan payout %d: %v", payoutID, err)
+		}
+	}
+
+	return nil
+}
+
+// reversePayout refunds the release of a loan whose payout failed. Loan fees are reversed with the cash paid out, so the
+// chama accounts are left as they were before the loan was approved and the loan can be approved again.
+func (loanAPI *loanAPIServer) reversePayout(ctx context.Context, payoutDB *models.LoanPayout, reason string) error {
+	db := loanAPI.SQLDB.Model(&models.LoanPayout{}).
+		Where("id = ? AND status = ?", payoutDB.ID, models.PayoutPending).
+		Updates(map[string]interface{}{
+			"status":         models.PayoutReversed,
+			"failure_reason": reason,
+		})
+	if db.Error != nil {
+		return errs.FailedToUpdate("loan payout", db.Error)
+	}
+	if db.RowsAffected == 0 {
+		return nil
+	}
+
+	err := loanAPI.refundRelease(ctx, payoutDB.ActorID, payoutDB.AccountID, fmt.Sprint(payoutDB.LoanID), payoutDB.Amount)
+	if err != nil {
+		// The payout stays pending so that the refund is retried when the result is delivered again
+		errRelease := loanAPI.SQLDB.Model(&models.LoanPayout{}).Where("id = ?", payoutDB.ID).
+			Update("status", models.PayoutPending).Error
+		if errRelease != nil {
+			loanAPI.Logger.Errorf("failed to release payout %d: %v", payoutDB.ID, errRelease)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// systemCtx returns a context authorized as an administrator for work that happens outside a request
+func (loanAPI *loanAPIServer) systemCtx(ctx context.Context) (context.Context, error) {
+	token, err := loanAPI.Auth.GenToken(ctx, &auth.Payload{
+		ID:    "system",
+		Names: "system",
+		Group: loanAPI.Auth.AdminGroups()[0],
+	}, time.Now().Add(time.Minute))
+	if err != nil {
+		return nil, errs.FailedToGetToken(err)
+	}
+
+	return loanAPI.Auth.AuthorizeFunc(auth.AddTokenMD(ctx, token))
+}
+
+func (loanAPI *loanAPIServer) updateLoanStatus(loanID uint, status loan.LoanStatus) error {
+	err := loanAPI.SQLDB.Model(&models.Loan{}).Where("id = ?", loanID).Update("status", status.String()).Error
+	if err != nil {
+		return errs.FailedToUpdate("loan status", err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package loan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/payout"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Loan disbursement", func() {
+	var (
+		ctx       context.Context
+		accountDB *models.ChamaAccount
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+	})
+
+	createWithdrawnLoan := func() *loan.Loan {
+		loanPB := mockLoan()
+		loanPB.LoanAmount = 500
+		Expect(createLoanPrerequisites(loanPB, nil)).ShouldNot(HaveOccurred())
+
+		loanDB, err := models.LoanModel(loanPB)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		loanDB.Approved = true
+		loanDB.Status = loan.LoanStatus_FUNDS_WITHDRAWN_ACCOUNT.String()
+		Expect(LoanAPIServer.SQLDB.Create(loanDB).Error).ShouldNot(HaveOccurred())
+
+		loanPB.LoanId = fmt.Sprint(loanDB.ID)
+
+		return loanPB
+	}
+
+	getLoan := func(loanID string) *models.Loan {
+		db := &models.Loan{}
+		Expect(LoanAPIServer.SQLDB.First(db, "id = ?", loanID).Error).ShouldNot(HaveOccurred())
+		return db
+	}
+
+	Context("Lets create chama account first", func() {
+		It("should succeed", func() {
+			accountDB = &models.ChamaAccount{
+				OwnerID:     randomID(),
+				AccountName: "loans",
+				AccountType: transaction.AccountType_SAVINGS_ACCOUNT.String(),
+				Active:      true,
+			}
+			Expect(LoanAPIServer.SQLDB.Create(accountDB).Error).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Describe("Disbursing a loan successfully", func() {
+		var loanPB *loan.Loan
+
+		It("should send the payout and wait for funds transfer", func() {
+			PayoutProvider.FailPayouts(false)
+			loanPB = createWithdrawnLoan()
+
+			err := LoanAPIServer.disburse(ctx, "1", fmt.Sprint(accountDB.ID), loanPB)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(getLoan(loanPB.LoanId).Status).Should(Equal(loan.LoanStatus_WAITING_FUNDS_TRANSFER.String()))
+		})
+
+		It("should transfer funds when payout succeeds", func() {
+			result := <-PayoutProvider.Results()
+			Expect(result.Succeeded).Should(BeTrue())
+
+			Expect(LoanAPIServer.completePayout(ctx, result)).ShouldNot(HaveOccurred())
+
+			Expect(getLoan(loanPB.LoanId).Status).Should(Equal(loan.LoanStatus_FUNDS_TRANSFERED.String()))
+
+			var count int64
+			Expect(LoanAPIServer.SQLDB.Model(&models.LoanInstallment{}).
+				Where("loan_id = ?", loanPB.LoanId).Count(&count).Error).ShouldNot(HaveOccurred())
+			Expect(count).ShouldNot(BeZero())
+
+			payoutDB := &models.LoanPayout{}
+			Expect(LoanAPIServer.SQLDB.First(payoutDB, "loan_id = ?", loanPB.LoanId).Error).ShouldNot(HaveOccurred())
+			Expect(payoutDB.Status).Should(Equal(models.PayoutCompleted))
+		})
+
+		It("should ignore duplicate results", func() {
+			payoutDB := &models.LoanPayout{}
+			Expect(LoanAPIServer.SQLDB.First(payoutDB, "loan_id = ?", loanPB.LoanId).Error).ShouldNot(HaveOccurred())
+
+			err := LoanAPIServer.completePayout(ctx, &payout.Result{
+				Reference: fmt.Sprint(payoutDB.ID),
+				Succeeded: false,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getLoan(loanPB.LoanId).Status).Should(Equal(loan.LoanStatus_FUNDS_TRANSFERED.String()))
+		})
+	})
+
+	Describe("Reconciling a payout that succeeded", func() {
+		It("should save the schedule of a loan whose payout did not finish", func() {
+			loanPB := createWithdrawnLoan()
+
+			loanID, err := loanIDFromString(loanPB.LoanId)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			payoutDB := &models.LoanPayout{
+				LoanID:    loanID,
+				ActorID:   "1",
+				AccountID: fmt.Sprint(accountDB.ID),
+				Phone:     loanPB.LoaneePhone,
+				Amount:    loanPB.LoanAmount,
+				Status:    models.PayoutSucceeded,
+			}
+			Expect(LoanAPIServer.SQLDB.Create(payoutDB).Error).ShouldNot(HaveOccurred())
+
+			Expect(LoanAPIServer.reconcilePayouts()).ShouldNot(HaveOccurred())
+
+			var count int64
+			Expect(LoanAPIServer.SQLDB.Model(&models.LoanInstallment{}).
+				Where("loan_id = ?", loanPB.LoanId).Count(&count).Error).ShouldNot(HaveOccurred())
+			Expect(count).ShouldNot(BeZero())
+
+			Expect(LoanAPIServer.SQLDB.First(payoutDB, "id = ?", payoutDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(payoutDB.Status).Should(Equal(models.PayoutCompleted))
+		})
+	})
+
+	Describe("Disbursing a loan that fails", func() {
+		var loanPB *loan.Loan
+
+		It("should send the payout", func() {
+			PayoutProvider.FailPayouts(true)
+			loanPB = createWithdrawnLoan()
+
+			err := LoanAPIServer.disburse(ctx, "1", fmt.Sprint(accountDB.ID), loanPB)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should reverse the withdrawal when payout fails", func() {
+			result := <-PayoutProvider.Results()
+			Expect(result.Succeeded).Should(BeFalse())
+
+			Expect(LoanAPIServer.completePayout(ctx, result)).ShouldNot(HaveOccurred())
+
+			Expect(getLoan(loanPB.LoanId).Status).Should(Equal(loan.LoanStatus_WAITING_APPROVAL.String()))
+
+			payoutDB := &models.LoanPayout{}
+			Expect(LoanAPIServer.SQLDB.First(payoutDB, "loan_id = ?", loanPB.LoanId).Error).ShouldNot(HaveOccurred())
+			Expect(payoutDB.Status).Should(Equal(models.PayoutReversed))
+
+			db := &models.ChamaAccount{}
+			Expect(LoanAPIServer.SQLDB.First(db, "id = ?", accountDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(db.AvailableAmount).Should(BeNumerically("==", loanPB.LoanAmount))
+		})
+	})
+})
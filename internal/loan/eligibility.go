@@ -0,0 +1,181 @@
+package loan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/kyc"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"gorm.io/gorm"
+)
+
+func (loanAPI *loanAPIServer) CheckEligibility(
+	ctx context.Context, req *loan.CheckEligibilityRequest,
+) (*loan.CheckEligibilityResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.ProductId == "":
+		return nil, errs.MissingField("product id")
+	case req.MemberId == "":
+		return nil, errs.MissingField("member id")
+	case req.LoanAmount < 0:
+		return nil, errs.IncorrectVal("loan amount")
+	}
+
+	// Authorization
+	_, _, err := loanAPI.authorizer.AuthorizeMember(ctx, req.MemberId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return loanAPI.checkEligibility(req.ProductId, req.MemberId, req.LoanAmount)
+}
+
+// checkEligibility evaluates the product eligibility rules for a member. A zero amount only computes the maximum amount.
+func (loanAPI *loanAPIServer) checkEligibility(productID, memberID string, amount float64) (*loan.CheckEligibilityResponse, error) {
+	// Get loan product
+	productDB := &models.LoanProduct{}
+	err := loanAPI.SQLDB.First(productDB, "id = ?", productID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("loan product", productID)
+	default:
+		return nil, errs.FailedToFind("loan product", err)
+	}
+
+	productPB, err := models.LoanProductProto(productDB)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get member
+	memberDB := &models.ChamaMember{}
+	err = loanAPI.SQLDB.First(memberDB, "id = ?", memberID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("chama member", memberID)
+	default:
+		return nil, errs.FailedToFind("chama member", err)
+	}
+
+	reasons := make([]string, 0)
+
+	if memberDB.ChamaID != productDB.ChamaID {
+		reasons = append(reasons, "member does not belong to the chama offering the product")
+	}
+
+	if productDB.Archived {
+		reasons = append(reasons, "loan product has been archived")
+	}
+
+	kycStatus, err := kyc.Status(loanAPI.SQLDB, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if kycStatus != chama.KycStatus_KYC_VERIFIED {
+		reasons = append(reasons, "member KYC documents have not been verified")
+	}
+
+	maxAmount := productDB.LoanMaximumAmount
+
+	rules := productPB.GetEligibilityRules()
+	if rules != nil {
+		// Savings multiplier
+		if rules.MaxSavingsMultiplier > 0 {
+			var savings float64
+			err = loanAPI.SQLDB.Model(&models.ChamaAccount{}).
+				Where("owner_id = ? AND account_type = ?", memberID, transaction.AccountType_SAVINGS_ACCOUNT.String()).
+				Select("COALESCE(SUM(available_amount), 0)").Scan(&savings).Error
+			if err != nil {
+				return nil, errs.FailedToFind("member savings", err)
+			}
+			savingsLimit := savings * float64(rules.MaxSavingsMultiplier)
+			if maxAmount == 0 || savingsLimit < maxAmount {
+				maxAmount = savingsLimit
+			}
+		}
+
+		// Membership period
+		if rules.MinMembershipDays > 0 {
+			memberSince := memberDB.CreatedAt.AddDate(0, 0, int(rules.MinMembershipDays))
+			if memberSince.After(time.Now()) {
+				reasons = append(reasons, fmt.Sprintf("membership is less than %d days", rules.MinMembershipDays))
+			}
+		}
+
+		// Loans in arrears
+		if rules.RejectLoansInArrears {
+			var arrears int64
+			err = loanAPI.SQLDB.Model(&models.LoanInstallment{}).
+				Joins("JOIN loans ON loans.id = loan_installments.loan_id").
+				Where("loans.member_id = ? AND loans.status NOT IN (?)", memberID, closedLoanStatuses).
+				Where("loan_installments.due_date < ?", time.Now()).
+				Where("loan_installments.amount_paid < loan_installments.amount_due").
+				Count(&arrears).Error
+			if err != nil {
+				return nil, errs.FailedToFind("loans in arrears", err)
+			}
+			if arrears > 0 {
+				reasons = append(reasons, "member has a loan in arrears")
+			}
+		}
+
+		// Active loans for product
+		if rules.MaxActiveLoans > 0 {
+			var active int64
+			err = loanAPI.SQLDB.Model(&models.Loan{}).
+				Where("member_id = ? AND product_id = ? AND settled_amount < loan_amount", memberID, productID).
+				Where("status NOT IN (?)", closedLoanStatuses).
+				Count(&active).Error
+			if err != nil {
+				return nil, errs.FailedToFind("active loans", err)
+			}
+			if active >= int64(rules.MaxActiveLoans) {
+				reasons = append(reasons, fmt.Sprintf("member has reached the limit of %d active loans for the product", rules.MaxActiveLoans))
+			}
+		}
+	}
+
+	// Credit score
+	var creditScore int32
+	if rules.GetMinCreditScore() > 0 {
+		profilePB, err := loanAPI.creditProfile(memberDB, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		creditScore = profilePB.CreditScore
+		if creditScore < rules.MinCreditScore {
+			reasons = append(reasons, fmt.Sprintf("credit score of %d is below the product minimum of %d", creditScore, rules.MinCreditScore))
+		}
+	}
+
+	maxAmount = math.Max(maxAmount, 0)
+
+	if amount > 0 {
+		switch {
+		case amount < productDB.LoanMinimumAmount:
+			reasons = append(reasons, fmt.Sprintf("loan amount is below the product minimum of %.2f", productDB.LoanMinimumAmount))
+		case (maxAmount > 0 || rules.GetMaxSavingsMultiplier() > 0) && amount > maxAmount:
+			reasons = append(reasons, fmt.Sprintf("loan amount exceeds the maximum eligible amount of %.2f", maxAmount))
+		}
+	}
+
+	return &loan.CheckEligibilityResponse{
+		Eligible:      len(reasons) == 0,
+		MaximumAmount: maxAmount,
+		Reasons:       reasons,
+		CreditScore:   creditScore,
+	}, nil
+}
@@ -0,0 +1,154 @@
+package loan
+
+import (
+	"context"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("CheckEligibility", func() {
+	var (
+		checkReq *loan.CheckEligibilityRequest
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		checkReq = &loan.CheckEligibilityRequest{
+			ProductId:  randomID(),
+			MemberId:   randomID(),
+			LoanAmount: 1000,
+		}
+		ctx = context.TODO()
+	})
+
+	Describe("CheckEligibility with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			checkReq = nil
+			checkRes, err := LoanAPI.CheckEligibility(ctx, checkReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(checkRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when product id is missing", func() {
+			checkReq.ProductId = ""
+			checkRes, err := LoanAPI.CheckEligibility(ctx, checkReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(checkRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when member id is missing", func() {
+			checkReq.MemberId = ""
+			checkRes, err := LoanAPI.CheckEligibility(ctx, checkReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(checkRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when loan amount is negative", func() {
+			checkReq.LoanAmount = -10
+			checkRes, err := LoanAPI.CheckEligibility(ctx, checkReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(checkRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when loan product does not exist", func() {
+			checkReq.ProductId = "oops"
+			checkRes, err := LoanAPI.CheckEligibility(ctx, checkReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(checkRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("CheckEligibility with well formed request", func() {
+		var loanPB *loan.Loan
+
+		Context("Lets create loan product, member and savings first", func() {
+			It("should succeed", func() {
+				loanPB = mockLoan()
+				Expect(createLoanPrerequisites(
+					loanPB, []byte(`{"max_savings_multiplier":3,"max_active_loans":1}`),
+				)).ShouldNot(HaveOccurred())
+
+				Expect(LoanAPIServer.SQLDB.Create(&models.ChamaAccount{
+					OwnerID:         loanPB.MemberId,
+					AccountName:     "savings",
+					AccountType:     transaction.AccountType_SAVINGS_ACCOUNT.String(),
+					AvailableAmount: 1000,
+					Active:          true,
+				}).Error).ShouldNot(HaveOccurred())
+			})
+		})
+
+		Describe("Checking eligibility", func() {
+			It("should be eligible for amount within savings multiplier", func() {
+				checkRes, err := LoanAPI.CheckEligibility(ctx, &loan.CheckEligibilityRequest{
+					ProductId:  loanPB.ProductId,
+					MemberId:   loanPB.MemberId,
+					LoanAmount: 3000,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(checkRes.Eligible).Should(BeTrue())
+				Expect(checkRes.MaximumAmount).Should(BeNumerically("==", 3000))
+			})
+			It("should not be eligible for amount above savings multiplier", func() {
+				checkRes, err := LoanAPI.CheckEligibility(ctx, &loan.CheckEligibilityRequest{
+					ProductId:  loanPB.ProductId,
+					MemberId:   loanPB.MemberId,
+					LoanAmount: 3001,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(checkRes.Eligible).Should(BeFalse())
+				Expect(checkRes.Reasons).Should(HaveLen(1))
+			})
+			It("should not be eligible while KYC documents await verification", func() {
+				err := LoanAPIServer.SQLDB.Model(&models.MemberKYC{}).Where("member_id = ?", loanPB.MemberId).
+					Update("status", chama.KycStatus_KYC_PENDING.String()).Error
+				Expect(err).ShouldNot(HaveOccurred())
+
+				checkRes, err := LoanAPI.CheckEligibility(ctx, &loan.CheckEligibilityRequest{
+					ProductId:  loanPB.ProductId,
+					MemberId:   loanPB.MemberId,
+					LoanAmount: 1000,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(checkRes.Eligible).Should(BeFalse())
+				Expect(checkRes.Reasons).Should(ContainElement("member KYC documents have not been verified"))
+
+				loanPB.LoanAmount = 1000
+				_, err = LoanAPI.CreateLoan(ctx, &loan.CreateLoanRequest{Loan: loanPB})
+				Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+				err = LoanAPIServer.SQLDB.Model(&models.MemberKYC{}).Where("member_id = ?", loanPB.MemberId).
+					Update("status", chama.KycStatus_KYC_VERIFIED.String()).Error
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+
+		Context("Lets create an active loan for the member", func() {
+			It("should succeed", func() {
+				loanPB.LoanAmount = 1000
+				_, err := LoanAPI.CreateLoan(ctx, &loan.CreateLoanRequest{Loan: loanPB})
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+
+		Describe("Checking eligibility with an active loan", func() {
+			It("should not be eligible for another loan of the product", func() {
+				checkRes, err := LoanAPI.CheckEligibility(ctx, &loan.CheckEligibilityRequest{
+					ProductId:  loanPB.ProductId,
+					MemberId:   loanPB.MemberId,
+					LoanAmount: 1000,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(checkRes.Eligible).Should(BeFalse())
+			})
+		})
+	})
+})
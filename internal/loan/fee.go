@@ -0,0 +1,226 @@
+package loan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// feeCharge is a product fee worked out for a particular loan
+type feeCharge struct {
+	fee    *loan.LoanFee
+	amount float64
+}
+
+// loanFees works out the product fees on a loan amount, returning the fees deducted from the cash paid out and those added to the loan
+func loanFees(fees []*loan.LoanFee, loanAmount float64) (charges []*feeCharge, deducted, capitalized float64) {
+	charges = make([]*feeCharge, 0, len(fees))
+	for _, fee := range fees {
+		amount := fee.Amount
+		if fee.Calculation == loan.FeeCalculation_FEE_PERCENTAGE {
+			amount = roundAmount(loanAmount * fee.Amount / 100)
+		}
+		if amount <= 0 {
+			continue
+		}
+
+		if fee.Treatment == loan.FeeTreatment_FEE_CAPITALIZED {
+			capitalized += amount
+		} else {
+			deducted += amount
+		}
+
+		charges = append(charges, &feeCharge{fee: fee, amount: amount})
+	}
+	return charges, roundAmount(deducted), roundAmount(capitalized)
+}
+
+// recordFees works out the fees on a loan the first time its funds are released and saves them with the amounts of the
+// loan before any money moves. Loans released again keep the fees recorded the first time.
+func (loanAPI *loanAPIServer) recordFees(loanPB *loan.Loan) error {
+	return loanAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		loanDB := &models.Loan{}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(loanDB, "id = ?", loanPB.LoanId).Error
+		if err != nil {
+			return errs.FailedToFind("loan", err)
+		}
+
+		var recorded int64
+		err = tx.Model(&models.LoanFeeCharge{}).Where("loan_id = ?", loanDB.ID).Count(&recorded).Error
+		if err != nil {
+			return errs.FailedToFind("loan fee charges", err)
+		}
+
+		if recorded == 0 && loanDB.FeeAmount == 0 {
+			productPB, err := loanAPI.productTerms(loanPB.ProductId, loanPB.ProductVersion)
+			if err != nil {
+				return err
+			}
+
+			charges, deducted, capitalized := loanFees(productPB.Fees, loanDB.LoanAmount)
+
+			loanDB.LoanAmount = roundAmount(loanDB.LoanAmount + capitalized)
+			loanDB.FeeAmount = roundAmount(deducted + capitalized)
+
+			for _, charge := range charges {
+				err = tx.Create(&models.LoanFeeCharge{
+					LoanID:      loanDB.ID,
+					FeeName:     charge.fee.Name,
+					AccountName: charge.fee.AccountName,
+					Amount:      charge.amount,
+				}).Error
+				if err != nil {
+					return errs.FailedToSave("loan fee charge", err)
+				}
+			}
+		}
+
+		loanPB.LoanAmount = loanDB.LoanAmount
+		loanPB.CarriedOverAmount = loanDB.CarriedOver
+		loanPB.FeeAmount = loanDB.FeeAmount
+		loanPB.NetAmount = disbursableAmount(loanPB)
+		if loanPB.NetAmount <= 0 {
+			return errs.WrapMessagef(codes.FailedPrecondition, "loan fees of %.2f leave nothing to disburse", loanPB.FeeAmount)
+		}
+
+		err = tx.Model(loanDB).Updates(map[string]interface{}{
+			"loan_amount": loanPB.LoanAmount,
+			"fee_amount":  loanPB.FeeAmount,
+			"net_amount":  loanPB.NetAmount,
+		}).Error
+		if err != nil {
+			return errs.FailedToUpdate("loan", err)
+		}
+
+		return nil
+	})
+}
+
+// unpostedFees is the total of the fees on a loan that are yet to be posted to their income accounts
+func (loanAPI *loanAPIServer) unpostedFees(loanID string) (float64, error) {
+	var amount float64
+	err := loanAPI.SQLDB.Model(&models.LoanFeeCharge{}).Select("COALESCE(SUM(amount), 0)").
+		Where("loan_id = ? AND posted = ?", loanID, false).Scan(&amount).Error
+	if err != nil {
+		return 0, errs.FailedToFind("loan fee charges", err)
+	}
+	return roundAmount(amount), nil
+}
+
+// postFees deposits the fees charged on a loan into the chama income accounts named by the fees. Each charge is claimed
+// before its deposit so that it is posted once however many times funds are released.
+func (loanAPI *loanAPIServer) postFees(ctx context.Context, actorID string, loanPB *loan.Loan) error {
+	charges := make([]*models.LoanFeeCharge, 0)
+	err := loanAPI.SQLDB.Find(&charges, "loan_id = ? AND posted = ?", loanPB.LoanId, false).Error
+	if err != nil {
+		return errs.FailedToFind("loan fee charges", err)
+	}
+
+	for _, chargeDB := range charges {
+		accountPB, err := loanAPI.MoneyAccountAPI.GetChamaAccount(ctx, &transaction.GetChamaAccountRequest{
+			OwnerId:     loanPB.ChamaId,
+			AccountName: chargeDB.AccountName,
+		})
+		if err != nil {
+			return err
+		}
+
+		db := loanAPI.SQLDB.Model(&models.LoanFeeCharge{}).Where("id = ? AND posted = ?", chargeDB.ID, false).
+			Updates(map[string]interface{}{
+				"posted":     true,
+				"account_id": accountPB.AccountId,
+			})
+		if db.Error != nil {
+			return errs.FailedToUpdate("loan fee charge", db.Error)
+		}
+		if db.RowsAffected == 0 {
+			continue
+		}
+
+		_, err = loanAPI.TransactionAPI.Deposit(ctx, &transaction.DepositRequest{
+			ActorId:     actorID,
+			AccountId:   accountPB.AccountId,
+			Description: fmt.Sprintf("%s on loan %s", chargeDB.FeeName, loanPB.LoanId),
+			Amount:      chargeDB.Amount,
+		})
+		if err != nil {
+			errRelease := loanAPI.SQLDB.Model(chargeDB).Update("posted", false).Error
+			if errRelease != nil {
+				loanAPI.Logger.Errorf("failed to release fee charge %d: %v", chargeDB.ID, errRelease)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// refundRelease reverses the release of loan funds: fees posted to income accounts are taken back and the cash paid out
+// together with the fees is returned to the account funds were withdrawn from. The loan goes back to approval and is
+// charged the same fees when released again.
+func (loanAPI *loanAPIServer) refundRelease(ctx context.Context, actorID, accountID, loanID string, netAmount float64) error {
+	ctxExt, err := loanAPI.systemCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	charges := make([]*models.LoanFeeCharge, 0)
+	err = loanAPI.SQLDB.Find(&charges, "loan_id = ?", loanID).Error
+	if err != nil {
+		return errs.FailedToFind("loan fee charges", err)
+	}
+
+	refund := netAmount
+	for _, chargeDB := range charges {
+		refund += chargeDB.Amount
+
+		db := loanAPI.SQLDB.Model(&models.LoanFeeCharge{}).Where("id = ? AND posted = ?", chargeDB.ID, true).
+			Update("posted", false)
+		if db.Error != nil {
+			return errs.FailedToUpdate("loan fee charge", db.Error)
+		}
+		if db.RowsAffected == 0 {
+			continue
+		}
+
+		_, err = loanAPI.TransactionAPI.Withdraw(ctxExt, &transaction.WithdrawRequest{
+			ActorId:     actorID,
+			AccountId:   chargeDB.AccountID,
+			Description: fmt.Sprintf("Reversal of %s on loan %s", chargeDB.FeeName, loanID),
+			Amount:      chargeDB.Amount,
+		})
+		if err != nil {
+			errRelease := loanAPI.SQLDB.Model(chargeDB).Update("posted", true).Error
+			if errRelease != nil {
+				loanAPI.Logger.Errorf("failed to release fee charge %d: %v", chargeDB.ID, errRelease)
+			}
+			return err
+		}
+	}
+
+	_, err = loanAPI.TransactionAPI.Deposit(ctxExt, &transaction.DepositRequest{
+		ActorId:     actorID,
+		AccountId:   accountID,
+		Description: fmt.Sprintf("Reversal of loan %s release", loanID),
+		Amount:      roundAmount(refund),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = loanAPI.SQLDB.Model(&models.Loan{}).Where("id = ?", loanID).Updates(map[string]interface{}{
+		"approved": false,
+		"status":   loan.LoanStatus_WAITING_APPROVAL.String(),
+	}).Error
+	if err != nil {
+		return errs.FailedToUpdate("loan", err)
+	}
+
+	return nil
+}
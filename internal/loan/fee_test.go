@@ -0,0 +1,216 @@
+package loan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Loan fees", func() {
+	var (
+		ctx  context.Context
+		fees []*loan.LoanFee
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		fees = []*loan.LoanFee{
+			{
+				Name:        "Processing fee",
+				Calculation: loan.FeeCalculation_FEE_FLAT,
+				Amount:      50,
+				Treatment:   loan.FeeTreatment_FEE_DEDUCTED,
+				AccountName: "fees",
+			},
+			{
+				Name:        "Credit life insurance",
+				Calculation: loan.FeeCalculation_FEE_PERCENTAGE,
+				Amount:      2,
+				Treatment:   loan.FeeTreatment_FEE_CAPITALIZED,
+				AccountName: "insurance",
+			},
+		}
+	})
+
+	createAccount := func(chamaID, name string, amount float64) *models.ChamaAccount {
+		accountDB := &models.ChamaAccount{
+			OwnerID:         chamaID,
+			AccountName:     name,
+			AccountType:     transaction.AccountType_SAVINGS_ACCOUNT.String(),
+			Withdrawable:    true,
+			AvailableAmount: amount,
+			Active:          true,
+		}
+		Expect(LoanAPIServer.SQLDB.Create(accountDB).Error).ShouldNot(HaveOccurred())
+		return accountDB
+	}
+
+	getAccount := func(accountID uint) *models.ChamaAccount {
+		accountDB := &models.ChamaAccount{}
+		Expect(LoanAPIServer.SQLDB.First(accountDB, "id = ?", accountID).Error).ShouldNot(HaveOccurred())
+		return accountDB
+	}
+
+	createPendingLoan := func(fees []*loan.LoanFee) *loan.Loan {
+		loanPB := mockLoan()
+		loanPB.LoanAmount = 1000
+		Expect(createLoanPrerequisites(loanPB, nil)).ShouldNot(HaveOccurred())
+
+		bs, err := json.Marshal(fees)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = LoanAPIServer.SQLDB.Model(&models.LoanProduct{}).Where("id = ?", loanPB.ProductId).
+			Update("fees", bs).Error
+		Expect(err).ShouldNot(HaveOccurred())
+
+		loanDB, err := models.LoanModel(loanPB)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(LoanAPIServer.SQLDB.Create(loanDB).Error).ShouldNot(HaveOccurred())
+
+		loanPB.LoanId = fmt.Sprint(loanDB.ID)
+
+		return loanPB
+	}
+
+	Describe("Working out fees", func() {
+		It("should separate deducted and capitalized fees", func() {
+			charges, deducted, capitalized := loanFees(fees, 1000)
+			Expect(charges).Should(HaveLen(2))
+			Expect(deducted).Should(BeNumerically("~", 50, 0.001))
+			Expect(capitalized).Should(BeNumerically("~", 20, 0.001))
+		})
+
+		It("should charge nothing without fees", func() {
+			charges, deducted, capitalized := loanFees(nil, 1000)
+			Expect(charges).Should(BeEmpty())
+			Expect(deducted).Should(BeZero())
+			Expect(capitalized).Should(BeZero())
+		})
+	})
+
+	Describe("Releasing funds with fees", func() {
+		It("should post fees to income accounts and disburse the net amount", func() {
+			PayoutProvider.FailPayouts(false)
+
+			loanPB := createPendingLoan(fees)
+			loansDB := createAccount(loanPB.ChamaId, fmt.Sprintf("loans-%s", loanPB.LoanId), 5000)
+			feesDB := createAccount(loanPB.ChamaId, "fees", 0)
+			insuranceDB := createAccount(loanPB.ChamaId, "insurance", 0)
+
+			err := LoanAPIServer.releaseFunds(ctx, "1", loansDB.AccountName, loanPB)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// Gross includes the capitalized insurance while the net excludes all fees
+			loanDB := &models.Loan{}
+			Expect(LoanAPIServer.SQLDB.First(loanDB, "id = ?", loanPB.LoanId).Error).ShouldNot(HaveOccurred())
+			Expect(loanDB.LoanAmount).Should(BeNumerically("~", 1020, 0.001))
+			Expect(loanDB.FeeAmount).Should(BeNumerically("~", 70, 0.001))
+			Expect(loanDB.NetAmount).Should(BeNumerically("~", 950, 0.001))
+
+			Expect(getAccount(loansDB.ID).AvailableAmount).Should(BeNumerically("~", 3980, 0.001))
+			Expect(getAccount(feesDB.ID).AvailableAmount).Should(BeNumerically("~", 50, 0.001))
+			Expect(getAccount(insuranceDB.ID).AvailableAmount).Should(BeNumerically("~", 20, 0.001))
+
+			result := <-PayoutProvider.Results()
+			Expect(LoanAPIServer.completePayout(ctx, result)).ShouldNot(HaveOccurred())
+
+			payoutDB := &models.LoanPayout{}
+			Expect(LoanAPIServer.SQLDB.First(payoutDB, "loan_id = ?", loanPB.LoanId).Error).ShouldNot(HaveOccurred())
+			Expect(payoutDB.Amount).Should(BeNumerically("~", 950, 0.001))
+		})
+
+		It("should refund cash and fees when the payout fails and charge the same fees when released again", func() {
+			loanPB := createPendingLoan(fees)
+			loansDB := createAccount(loanPB.ChamaId, fmt.Sprintf("loans-%s", loanPB.LoanId), 5000)
+			feesDB := createAccount(loanPB.ChamaId, "fees", 0)
+			insuranceDB := createAccount(loanPB.ChamaId, "insurance", 0)
+
+			PayoutProvider.FailPayouts(true)
+
+			claimed, err := LoanAPIServer.claimLoan(loanIDOf(loanPB))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(claimed).Should(BeTrue())
+
+			err = LoanAPIServer.releaseFunds(ctx, "1", loansDB.AccountName, loanPB)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			result := <-PayoutProvider.Results()
+			Expect(LoanAPIServer.completePayout(ctx, result)).ShouldNot(HaveOccurred())
+
+			// Duplicate results don't refund twice
+			Expect(LoanAPIServer.completePayout(ctx, result)).ShouldNot(HaveOccurred())
+
+			Expect(getAccount(loansDB.ID).AvailableAmount).Should(BeNumerically("~", 5000, 0.001))
+			Expect(getAccount(feesDB.ID).AvailableAmount).Should(BeNumerically("~", 0, 0.001))
+			Expect(getAccount(insuranceDB.ID).AvailableAmount).Should(BeNumerically("~", 0, 0.001))
+
+			loanDB := &models.Loan{}
+			Expect(LoanAPIServer.SQLDB.First(loanDB, "id = ?", loanPB.LoanId).Error).ShouldNot(HaveOccurred())
+			Expect(loanDB.Status).Should(Equal(loan.LoanStatus_WAITING_APPROVAL.String()))
+
+			PayoutProvider.FailPayouts(false)
+
+			loanPB, err = LoanAPI.GetLoan(ctx, &loan.GetLoanRequest{LoanId: loanPB.LoanId})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			claimed, err = LoanAPIServer.claimLoan(loanIDOf(loanPB))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(claimed).Should(BeTrue())
+
+			err = LoanAPIServer.releaseFunds(ctx, "1", loansDB.AccountName, loanPB)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(LoanAPIServer.completePayout(ctx, <-PayoutProvider.Results())).ShouldNot(HaveOccurred())
+
+			// Capitalized fees are not added to the loan again
+			Expect(LoanAPIServer.SQLDB.First(loanDB, "id = ?", loanPB.LoanId).Error).ShouldNot(HaveOccurred())
+			Expect(loanDB.LoanAmount).Should(BeNumerically("~", 1020, 0.001))
+			Expect(loanDB.FeeAmount).Should(BeNumerically("~", 70, 0.001))
+			Expect(loanDB.NetAmount).Should(BeNumerically("~", 950, 0.001))
+
+			Expect(getAccount(loansDB.ID).AvailableAmount).Should(BeNumerically("~", 3980, 0.001))
+			Expect(getAccount(feesDB.ID).AvailableAmount).Should(BeNumerically("~", 50, 0.001))
+			Expect(getAccount(insuranceDB.ID).AvailableAmount).Should(BeNumerically("~", 20, 0.001))
+		})
+
+		It("should post each fee once", func() {
+			loanPB := createPendingLoan(fees)
+			feesDB := createAccount(loanPB.ChamaId, "fees", 0)
+			insuranceDB := createAccount(loanPB.ChamaId, "insurance", 0)
+
+			Expect(LoanAPIServer.recordFees(loanPB)).ShouldNot(HaveOccurred())
+			Expect(LoanAPIServer.recordFees(loanPB)).ShouldNot(HaveOccurred())
+			Expect(loanPB.LoanAmount).Should(BeNumerically("~", 1020, 0.001))
+
+			Expect(LoanAPIServer.postFees(ctx, "1", loanPB)).ShouldNot(HaveOccurred())
+			Expect(LoanAPIServer.postFees(ctx, "1", loanPB)).ShouldNot(HaveOccurred())
+
+			Expect(getAccount(feesDB.ID).AvailableAmount).Should(BeNumerically("~", 50, 0.001))
+			Expect(getAccount(insuranceDB.ID).AvailableAmount).Should(BeNumerically("~", 20, 0.001))
+
+			fees, err := LoanAPIServer.unpostedFees(loanPB.LoanId)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fees).Should(BeZero())
+		})
+
+		It("should fail when fees exceed the amount to disburse", func() {
+			fees[0].Amount = 2000
+
+			loanPB := createPendingLoan(fees)
+			loansDB := createAccount(loanPB.ChamaId, fmt.Sprintf("loans-%s", loanPB.LoanId), 5000)
+
+			err := LoanAPIServer.releaseFunds(ctx, "1", loansDB.AccountName, loanPB)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+			Expect(getAccount(loansDB.ID).AvailableAmount).Should(BeNumerically("~", 5000, 0.001))
+		})
+	})
+})
@@ -0,0 +1,164 @@
+package loan
+
+import (
+	"context"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+func (loanAPI *loanAPIServer) GrantRepaymentHoliday(
+	ctx context.Context, req *loan.GrantRepaymentHolidayRequest,
+) (*loan.RepaymentHoliday, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.LoanId == "":
+		return nil, errs.MissingField("loan id")
+	case req.Periods <= 0:
+		return nil, errs.IncorrectVal("holiday periods")
+	case req.Reason == "":
+		return nil, errs.MissingField("holiday reason")
+	}
+
+	// Authorization
+	actor, err := loanAPI.authorizer.AuthorizeLoan(ctx, req.LoanId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	loanDB, err := runningLoan(loanAPI.SQLDB, req.LoanId)
+	if err != nil {
+		return nil, err
+	}
+
+	productPB, err := loanAPI.productTerms(loanDB.ProductID, loanDB.ProductVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := productPB.GracePolicy
+	if policy.GetMaxHolidayPeriods() == 0 {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan product does not offer repayment holidays")
+	}
+
+	var granted int32
+	err = loanAPI.SQLDB.Model(&models.LoanHoliday{}).Select("COALESCE(SUM(periods), 0)").
+		Where("loan_id = ?", loanDB.ID).Scan(&granted).Error
+	if err != nil {
+		return nil, errs.FailedToFind("repayment holidays", err)
+	}
+
+	if granted+req.Periods > policy.MaxHolidayPeriods {
+		return nil, errs.WrapMessagef(
+			codes.FailedPrecondition,
+			"loan has %d of %d holiday periods left", policy.MaxHolidayPeriods-granted, policy.MaxHolidayPeriods,
+		)
+	}
+
+	shiftDays := req.Periods * schedulePeriodDays(loanDB.DurationDays, productPB.RepaymentPeriodDays)
+
+	// Interest for the holiday is added to the deferred installments when the product capitalizes it
+	var interest float64
+	if policy.CapitalizeInterest {
+		interest = periodInterest(loanDB, shiftDays)
+	}
+
+	holidayDB := &models.LoanHoliday{
+		LoanID:         loanDB.ID,
+		ActorID:        actor.ID,
+		Periods:        req.Periods,
+		ShiftDays:      shiftDays,
+		InterestAmount: interest,
+		Reason:         req.Reason,
+	}
+
+	err = loanAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		installments := make([]*models.LoanInstallment, 0)
+		err := tx.Order("installment_number ASC").
+			Find(&installments, "loan_id = ? AND due_date >= ? AND amount_paid < amount_due", loanDB.ID, startOfDay(time.Now())).Error
+		if err != nil {
+			return errs.FailedToFind("loan installments", err)
+		}
+
+		if len(installments) == 0 {
+			return errs.WrapMessage(codes.FailedPrecondition, "loan has no upcoming installments to defer")
+		}
+
+		interestPart := roundAmount(interest / float64(len(installments)))
+		for i, installment := range installments {
+			if i == len(installments)-1 {
+				// Last installment absorbs rounding differences
+				interestPart = roundAmount(interest - interestPart*float64(len(installments)-1))
+			}
+			err = tx.Model(installment).Updates(map[string]interface{}{
+				"due_date":     installment.DueDate.AddDate(0, 0, int(shiftDays)),
+				"interest_due": roundAmount(installment.InterestDue + interestPart),
+				"amount_due":   roundAmount(installment.AmountDue + interestPart),
+			}).Error
+			if err != nil {
+				return errs.FailedToUpdate("loan installment", err)
+			}
+		}
+
+		err = tx.Model(loanDB).Update("duration_days", loanDB.DurationDays+shiftDays).Error
+		if err != nil {
+			return errs.FailedToUpdate("loan", err)
+		}
+
+		err = tx.Create(holidayDB).Error
+		if err != nil {
+			return errs.FailedToSave("repayment holiday", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return models.LoanHolidayProto(holidayDB)
+}
+
+func (loanAPI *loanAPIServer) ListRepaymentHolidays(
+	ctx context.Context, req *loan.ListRepaymentHolidaysRequest,
+) (*loan.ListRepaymentHolidaysResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.LoanId == "":
+		return nil, errs.MissingField("loan id")
+	}
+
+	// Authorization
+	_, err := loanAPI.authorizer.AuthorizeLoan(ctx, req.LoanId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	dbs := make([]*models.LoanHoliday, 0)
+	err = loanAPI.SQLDB.Order("id ASC").Find(&dbs, "loan_id = ?", req.LoanId).Error
+	if err != nil {
+		return nil, errs.FailedToFind("repayment holidays", err)
+	}
+
+	pbs := make([]*loan.RepaymentHoliday, 0, len(dbs))
+	for _, db := range dbs {
+		pb, err := models.LoanHolidayProto(db)
+		if err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+
+	return &loan.ListRepaymentHolidaysResponse{
+		Holidays: pbs,
+	}, nil
+}
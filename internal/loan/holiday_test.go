@@ -0,0 +1,163 @@
+package loan
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Grace periods and repayment holidays", func() {
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+	})
+
+	scheduleLoan := func() *models.Loan {
+		return &models.Loan{
+			DurationDays: 120,
+			InterestRate: 10,
+			LoanAmount:   1200,
+		}
+	}
+
+	// createLoan saves a disbursed loan of 1000 at 10 percent with a single installment due in 30 days
+	createLoan := func(policy *loan.GracePolicy) *loan.Loan {
+		pb := mockLoan()
+		pb.DurationDays = 30
+		loanPB, err := createDisbursedLoan(pb, time.Now())
+		Expect(err).ShouldNot(HaveOccurred())
+
+		bs, err := json.Marshal(policy)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = LoanAPIServer.SQLDB.Model(&models.LoanProduct{}).Where("id = ?", loanPB.ProductId).
+			Update("grace_policy", bs).Error
+		Expect(err).ShouldNot(HaveOccurred())
+
+		return loanPB
+	}
+
+	Describe("Generating schedules with a grace period", func() {
+		It("should defer principal during an interest only grace period", func() {
+			installments := generateSchedule(scheduleLoan(), 30, &loan.GracePolicy{
+				GraceType:    loan.GraceType_INTEREST_ONLY,
+				GracePeriods: 2,
+			}, time.Now())
+			Expect(installments).Should(HaveLen(4))
+			Expect(installments[0].PrincipalDue).Should(BeZero())
+			Expect(installments[1].PrincipalDue).Should(BeZero())
+			Expect(installments[2].PrincipalDue).Should(BeNumerically("~", 600, 0.001))
+			Expect(installments[3].PrincipalDue).Should(BeNumerically("~", 600, 0.001))
+			Expect(installments[0].InterestDue).Should(BeNumerically("~", 30, 0.001))
+		})
+
+		It("should shift the schedule during a moratorium", func() {
+			start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+			installments := generateSchedule(scheduleLoan(), 30, &loan.GracePolicy{
+				GraceType:          loan.GraceType_FULL_MORATORIUM,
+				GracePeriods:       2,
+				CapitalizeInterest: true,
+			}, start)
+			Expect(installments).Should(HaveLen(4))
+			Expect(installments[0].DueDate).Should(Equal(start.AddDate(0, 0, 90)))
+
+			var interest float64
+			for _, installment := range installments {
+				interest += installment.InterestDue
+			}
+			Expect(interest).Should(BeNumerically("~", 180, 0.001))
+		})
+	})
+
+	Describe("GrantRepaymentHoliday with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			holidayRes, err := LoanAPI.GrantRepaymentHoliday(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(holidayRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when periods are missing", func() {
+			holidayRes, err := LoanAPI.GrantRepaymentHoliday(ctx, &loan.GrantRepaymentHolidayRequest{
+				LoanId: "1",
+				Reason: "harvest",
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(holidayRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when reason is missing", func() {
+			holidayRes, err := LoanAPI.GrantRepaymentHoliday(ctx, &loan.GrantRepaymentHolidayRequest{
+				LoanId:  "1",
+				Periods: 1,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(holidayRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Granting repayment holidays", func() {
+		It("should fail when the product does not offer holidays", func() {
+			loanPB := createLoan(&loan.GracePolicy{})
+
+			holidayRes, err := LoanAPI.GrantRepaymentHoliday(ctx, &loan.GrantRepaymentHolidayRequest{
+				LoanId:  loanPB.LoanId,
+				Periods: 1,
+				Reason:  "late harvest",
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(holidayRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should shift installments, capitalize interest and keep an audit trail", func() {
+			loanPB := createLoan(&loan.GracePolicy{MaxHolidayPeriods: 1, CapitalizeInterest: true})
+
+			before := &models.LoanInstallment{}
+			Expect(LoanAPIServer.SQLDB.First(before, "loan_id = ?", loanPB.LoanId).Error).ShouldNot(HaveOccurred())
+
+			holidayRes, err := LoanAPI.GrantRepaymentHoliday(ctx, &loan.GrantRepaymentHolidayRequest{
+				LoanId:  loanPB.LoanId,
+				Periods: 1,
+				Reason:  "late harvest",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(holidayRes.ShiftDays).Should(BeEquivalentTo(30))
+			Expect(holidayRes.InterestAmount).Should(BeNumerically("~", 100, 0.001))
+
+			after := &models.LoanInstallment{}
+			Expect(LoanAPIServer.SQLDB.First(after, "id = ?", before.ID).Error).ShouldNot(HaveOccurred())
+			Expect(after.DueDate.Sub(before.DueDate)).Should(Equal(30 * 24 * time.Hour))
+			Expect(after.AmountDue).Should(BeNumerically("~", before.AmountDue+100, 0.001))
+
+			loanDB := &models.Loan{}
+			Expect(LoanAPIServer.SQLDB.First(loanDB, "id = ?", loanPB.LoanId).Error).ShouldNot(HaveOccurred())
+			Expect(loanDB.DurationDays).Should(BeEquivalentTo(60))
+
+			listRes, err := LoanAPI.ListRepaymentHolidays(ctx, &loan.ListRepaymentHolidaysRequest{LoanId: loanPB.LoanId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Holidays).Should(HaveLen(1))
+			Expect(listRes.Holidays[0].Reason).Should(Equal("late harvest"))
+
+			// The product allows a single holiday period
+			holidayRes, err = LoanAPI.GrantRepaymentHoliday(ctx, &loan.GrantRepaymentHolidayRequest{
+				LoanId:  loanPB.LoanId,
+				Periods: 1,
+				Reason:  "drought",
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(holidayRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+})
@@ -2,9 +2,11 @@ package loan
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Pallinder/go-randomdata"
 	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
 	"github.com/gidyon/machama-app/pkg/api/loan"
 )
 
@@ -30,6 +32,77 @@ func mockLoan() *loan.Loan {
 	}
 }
 
+func mockLoanProduct(chamaID string) *models.LoanProduct {
+	return &models.LoanProduct{
+		ChamaID:          chamaID,
+		Name:             randomdata.SillyName(),
+		Description:      randomdata.Paragraph(),
+		InterestRate:     float32(randomdata.Decimal(3, 30)),
+		LoanDurationDays: int32(randomdata.Number(10, 100)),
+	}
+}
+
+func mockChamaMember(chamaID string) *models.ChamaMember {
+	return &models.ChamaMember{
+		ChamaID:   chamaID,
+		FirstName: randomdata.FirstName(randomdata.Male),
+		LastName:  randomdata.LastName(),
+		Phone:     randomPhone(),
+		IDNumber:  fmt.Sprint(randomdata.Number(22222222, 44444444)),
+		Active:    true,
+	}
+}
+
+// createLoanPrerequisites saves a loan product and a chama member and points the loan to them
+func createLoanPrerequisites(pb *loan.Loan, rules []byte) error {
+	productDB := mockLoanProduct(pb.ChamaId)
+	productDB.EligibilityRules = rules
+	err := LoanAPIServer.SQLDB.Create(productDB).Error
+	if err != nil {
+		return err
+	}
+
+	memberDB := mockChamaMember(pb.ChamaId)
+	err = LoanAPIServer.SQLDB.Create(memberDB).Error
+	if err != nil {
+		return err
+	}
+
+	pb.ProductId = fmt.Sprint(productDB.ID)
+	pb.MemberId = fmt.Sprint(memberDB.ID)
+
+	return LoanAPIServer.SQLDB.Create(&models.MemberKYC{
+		MemberID: pb.MemberId,
+		ChamaID:  pb.ChamaId,
+		Status:   chama.KycStatus_KYC_VERIFIED.String(),
+	}).Error
+}
+
+// createDisbursedLoan saves a disbursed loan of 1000 at 10 percent interest with its schedule starting from start
+func createDisbursedLoan(pb *loan.Loan, start time.Time) (*loan.Loan, error) {
+	pb.LoanAmount = 1000
+	pb.InterestRate = 10
+	pb.Status = loan.LoanStatus_FUNDS_TRANSFERED
+	err := createLoanPrerequisites(pb, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	loanDB, err := models.LoanModel(pb)
+	if err != nil {
+		return nil, err
+	}
+
+	err = LoanAPIServer.SQLDB.Create(loanDB).Error
+	if err != nil {
+		return nil, err
+	}
+
+	pb.LoanId = fmt.Sprint(loanDB.ID)
+
+	return pb, LoanAPIServer.createSchedule(pb.LoanId, start)
+}
+
 func laodMockData(count int) error {
 	dbs := make([]*models.Loan, 0, count)
 	for i := 0; i < count; i++ {
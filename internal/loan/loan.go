@@ -4,8 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/notification"
+	"github.com/gidyon/machama-app/internal/payout"
+	"github.com/gidyon/machama-app/internal/roles"
 	"github.com/gidyon/machama-app/pkg/api/loan"
 	"github.com/gidyon/machama-app/pkg/api/transaction"
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
@@ -17,21 +23,31 @@ import (
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Options struct {
-	MoneyAccountAPI transaction.ChamaAccountAPIServer
-	TransactionAPI  transaction.TransactionAPIServer
-	SQLDB           *gorm.DB
-	PageHasher      *hashids.HashID
-	Logger          grpclog.LoggerV2
-	Auth            auth.API
-	AllowedGroups   []string
+	MoneyAccountAPI         transaction.ChamaAccountAPIServer
+	TransactionAPI          transaction.TransactionAPIServer
+	SQLDB                   *gorm.DB
+	PageHasher              *hashids.HashID
+	Logger                  grpclog.LoggerV2
+	Auth                    auth.API
+	AllowedGroups           []string
+	PenaltyAccrualInterval  time.Duration
+	PayoutProvider          payout.Provider
+	PayoutReconcileInterval time.Duration
+	ProvisionRates          *loan.ProvisionRates
+	SMSSender               notification.Sender
+	EmailSender             notification.Sender
+	ReminderInterval        time.Duration
+	ReminderDaysBefore      int32
 }
 
 type loanAPIServer struct {
 	loan.UnimplementedLoanAPIServer
 	*Options
+	authorizer *roles.Authorizer
 }
 
 func NewLoanAPI(ctx context.Context, opt *Options) (loan.LoanAPIServer, error) {
@@ -57,15 +73,57 @@ func NewLoanAPI(ctx context.Context, opt *Options) (loan.LoanAPIServer, error) {
 		if len(opt.AllowedGroups) == 0 {
 			opt.AllowedGroups = opt.Auth.AdminGroups()
 		}
+		if opt.PenaltyAccrualInterval == 0 {
+			opt.PenaltyAccrualInterval = time.Hour
+		}
+		if opt.ProvisionRates == nil {
+			opt.ProvisionRates = defaultProvisionRates
+		}
+		if opt.PayoutReconcileInterval == 0 {
+			opt.PayoutReconcileInterval = 10 * time.Minute
+		}
+		if opt.ReminderInterval == 0 {
+			opt.ReminderInterval = time.Hour
+		}
+		if opt.ReminderDaysBefore <= 0 {
+			opt.ReminderDaysBefore = 3
+		}
+	}
+
+	err := validateProvisionRates(opt.ProvisionRates)
+	if err != nil {
+		return nil, err
 	}
 
 	loanAPI := &loanAPIServer{
-		Options: opt,
+		Options:    opt,
+		authorizer: roles.NewAuthorizer(opt.Auth, opt.SQLDB, opt.AllowedGroups),
+	}
+
+	// Accrue penalties on overdue loans in background
+	go loanAPI.runPenaltyAccrual(ctx)
+
+	// Complete loan disbursements in background
+	if opt.PayoutProvider != nil {
+		go loanAPI.runPayoutResults(ctx)
+	}
+
+	// Send repayment reminders in background
+	if opt.SMSSender != nil || opt.EmailSender != nil {
+		go loanAPI.runReminders(ctx)
 	}
 
 	return loanAPI, nil
 }
 
+func loanIDFromString(loanID string) (uint, error) {
+	v, err := strconv.ParseUint(loanID, 10, 64)
+	if err != nil {
+		return 0, errs.IncorrectVal("loan id")
+	}
+	return uint(v), nil
+}
+
 func ValidateLoan(pb *loan.Loan) error {
 	switch {
 	case pb == nil:
@@ -82,6 +140,8 @@ func ValidateLoan(pb *loan.Loan) error {
 		return errs.MissingField("loanee phone")
 	case pb.NationalId == "":
 		return errs.MissingField("loanee national id")
+	case pb.LoanAmount <= 0:
+		return errs.IncorrectVal("loan amount")
 	}
 	return nil
 }
@@ -89,28 +149,58 @@ func ValidateLoan(pb *loan.Loan) error {
 func (loanAPI *loanAPIServer) CreateLoan(
 	ctx context.Context, req *loan.CreateLoanRequest,
 ) (*emptypb.Empty, error) {
-	// Authorization
-	_, err := loanAPI.Auth.AuthorizeGroup(ctx, loanAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validate
 	switch {
 	case req == nil:
 		return nil, errs.NilObject("request body")
 	default:
-		err = ValidateLoan(req.Loan)
+		err := ValidateLoan(req.Loan)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	// Authorization
+	_, memberDB, err := loanAPI.authorizer.AuthorizeMember(ctx, req.Loan.MemberId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	if memberDB.ChamaID != req.Loan.ChamaId {
+		return nil, errs.WrapMessagef(codes.InvalidArgument, "member %s is not in chama %s", req.Loan.MemberId, req.Loan.ChamaId)
+	}
+
+	// Check eligibility
+	eligibility, err := loanAPI.checkEligibility(req.Loan.ProductId, req.Loan.MemberId, req.Loan.LoanAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	if !eligibility.Eligible {
+		return nil, errs.WrapMessagef(codes.FailedPrecondition, "member not eligible for loan: %s", strings.Join(eligibility.Reasons, "; "))
+	}
+
+	// Loans are pinned to the current product terms
+	productPB, err := loanAPI.productTerms(req.Loan.ProductId, 0)
+	if err != nil {
+		return nil, err
+	}
+
 	db, err := models.LoanModel(req.Loan)
 	if err != nil {
 		return nil, err
 	}
 
+	db.Approved = false
+	db.Status = loan.LoanStatus_WAITING_APPROVAL.String()
+	db.ProductVersion = productPB.Version
+	if db.InterestRate == 0 {
+		db.InterestRate = productPB.InterestRate
+	}
+	if db.DurationDays == 0 {
+		db.DurationDays = productPB.LoanDurationDays
+	}
+
 	err = loanAPI.SQLDB.Create(db).Error
 	if err != nil {
 		return nil, errs.FailedToSave("loan", err)
@@ -122,12 +212,6 @@ func (loanAPI *loanAPIServer) CreateLoan(
 func (loanAPI *loanAPIServer) UpdateLoan(
 	ctx context.Context, req *loan.UpdateLoanRequest,
 ) (*emptypb.Empty, error) {
-	// Authorization
-	_, err := loanAPI.Auth.AuthorizeGroup(ctx, loanAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validate
 	switch {
 	case req == nil:
@@ -138,12 +222,35 @@ func (loanAPI *loanAPIServer) UpdateLoan(
 		return nil, errs.MissingField("loan id")
 	}
 
+	// Authorization
+	_, err := loanAPI.authorizer.AuthorizeLoan(ctx, req.Loan.LoanId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	loanDB := &models.Loan{}
+	err = loanAPI.SQLDB.First(loanDB, "id = ?", req.Loan.LoanId).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan", err)
+	}
+
+	// Repayments are recorded on the loan statement and loans keep the terms of the product version they were created under
+	switch {
+	case req.Loan.SettledAmount != 0 && req.Loan.SettledAmount != loanDB.SettledAmount:
+		return nil, errs.WrapMessage(codes.InvalidArgument, "loan repayments are made through RepayLoan")
+	case loanDB.ProductVersion != 0 && termsChanged(req.Loan, loanDB):
+		return nil, errs.WrapMessagef(codes.FailedPrecondition, "loan terms are pinned to version %d of the product", loanDB.ProductVersion)
+	}
+
 	db, err := models.LoanModel(req.Loan)
 	if err != nil {
 		return nil, err
 	}
 
-	err = loanAPI.SQLDB.Where("id = ?", req.Loan.LoanId).Updates(db).Error
+	// Status changes through approval and balances through repayments, penalties, fees and write offs only
+	err = loanAPI.SQLDB.Where("id = ?", req.Loan.LoanId).
+		Omit("status", "settled_amount", "penalty_amount", "carried_over", "written_off", "fee_amount", "net_amount").
+		Updates(db).Error
 	if err != nil {
 		return nil, errs.FailedToUpdate("loan", err)
 	}
@@ -151,23 +258,37 @@ func (loanAPI *loanAPIServer) UpdateLoan(
 	return &emptypb.Empty{}, nil
 }
 
+// termsChanged checks whether an update sets loan terms other than the ones the loan has
+func termsChanged(pb *loan.Loan, db *models.Loan) bool {
+	switch {
+	case pb.ProductId != "" && pb.ProductId != db.ProductID:
+	case pb.ProductVersion != 0 && pb.ProductVersion != db.ProductVersion:
+	case pb.InterestRate != 0 && pb.InterestRate != db.InterestRate:
+	case pb.DurationDays != 0 && pb.DurationDays != db.DurationDays:
+	case pb.LoanAmount != 0 && pb.LoanAmount != db.LoanAmount:
+	default:
+		return false
+	}
+	return true
+}
+
 const defaultPageSize = 50
 
 func (loanAPI *loanAPIServer) ListLoans(
 	ctx context.Context, req *loan.ListLoansRequest,
 ) (*loan.ListLoansResponse, error) {
-	// Authorization
-	actor, err := loanAPI.Auth.AuthorizeGroup(ctx, loanAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validation
 	switch {
 	case req == nil:
 		return nil, errs.NilObject("list request")
 	}
 
+	// Authorization
+	actor, chamaIDs, err := loanAPI.authorizer.ScopeChamas(ctx, req.GetFilter().GetChamaIds(), roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
 	pageSize := req.GetPageSize()
 	switch {
 	case pageSize <= 0:
@@ -194,13 +315,16 @@ func (loanAPI *loanAPIServer) ListLoans(
 	}
 
 	// Apply tv filters
+	if chamaIDs != nil {
+		db = db.Where("chama_id IN (?)", chamaIDs)
+	}
 	if req.Filter != nil {
-		if len(req.Filter.ChamaIds) != 0 {
-			db = db.Where("chama_id IN (?)", req.Filter.ChamaIds)
-		}
 		if len(req.Filter.ProductIds) != 0 {
 			db = db.Where("product_id IN (?)", req.Filter.ProductIds)
 		}
+		if len(req.Filter.ParentLoanIds) != 0 {
+			db = db.Where("parent_loan_id IN (?)", req.Filter.ParentLoanIds)
+		}
 	}
 
 	dbs := make([]*models.Loan, 0, pageSize+1)
@@ -245,12 +369,6 @@ func (loanAPI *loanAPIServer) ListLoans(
 func (loanAPI *loanAPIServer) GetLoan(
 	ctx context.Context, req *loan.GetLoanRequest,
 ) (*loan.Loan, error) {
-	// Authorization
-	_, err := loanAPI.Auth.AuthorizeGroup(ctx, loanAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validation
 	switch {
 	case req == nil:
@@ -259,6 +377,12 @@ func (loanAPI *loanAPIServer) GetLoan(
 		return nil, errs.MissingField("loan id")
 	}
 
+	// Authorization
+	_, err := loanAPI.authorizer.AuthorizeLoan(ctx, req.LoanId, roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
 	db := &models.Loan{}
 
 	err = loanAPI.SQLDB.First(db, "id = ?", req.LoanId).Error
@@ -270,24 +394,45 @@ func (loanAPI *loanAPIServer) GetLoan(
 		return nil, errs.FailedToFind("loan", err)
 	}
 
-	return models.LoanProto(db)
+	loanPB, err := models.LoanProto(db)
+	if err != nil {
+		return nil, err
+	}
+
+	installments := make([]*models.LoanInstallment, 0)
+	err = loanAPI.SQLDB.Order("installment_number ASC").Find(&installments, "loan_id = ?", db.ID).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan installments", err)
+	}
+
+	for _, installment := range installments {
+		installmentPB, err := models.LoanInstallmentProto(installment)
+		if err != nil {
+			return nil, err
+		}
+		loanPB.Installments = append(loanPB.Installments, installmentPB)
+	}
+
+	return loanPB, nil
 }
 
 func (loanAPI *loanAPIServer) ApproveLoan(
 	ctx context.Context, req *loan.ApproveLoanRequest,
 ) (*emptypb.Empty, error) {
-	// Authorization
-	actor, err := loanAPI.Auth.AuthorizeGroup(ctx, loanAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validation
 	switch {
 	case req == nil:
 		return nil, errs.MissingField("request body")
 	case req.LoanId == "":
 		return nil, errs.MissingField("loan id")
+	case req.AccountName == "":
+		return nil, errs.MissingField("account name")
+	}
+
+	// Authorization
+	actor, err := loanAPI.authorizer.AuthorizeLoan(ctx, req.LoanId, roles.Officers...)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get loan
@@ -296,17 +441,121 @@ func (loanAPI *loanAPIServer) ApproveLoan(
 		return nil, err
 	}
 
-	if loanPB.Status == loan.LoanStatus_FUNDS_TRANSFERED {
+	switch loanPB.Status {
+	case loan.LoanStatus_WAITING_APPROVAL:
+	case loan.LoanStatus_FUNDS_TRANSFERED:
 		return nil, errs.WrapMessage(codes.AlreadyExists, "loan funds have been disbursed")
+	case loan.LoanStatus_APPROVED, loan.LoanStatus_FUNDS_WITHDRAWN_ACCOUNT, loan.LoanStatus_WAITING_FUNDS_TRANSFER:
+		return nil, errs.WrapMessage(codes.AlreadyExists, "loan funds are being disbursed")
+	case loan.LoanStatus_REFINANCED:
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan has been refinanced")
+	case loan.LoanStatus_WRITTEN_OFF:
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan has been written off")
+	case loan.LoanStatus_CANCELLED:
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan has been cancelled")
+	default:
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan is not awaiting approval")
+	}
+
+	err = loanAPI.checkCollateralCoverage(loanPB)
+	if err != nil {
+		return nil, err
+	}
+
+	loanID, err := loanIDFromString(req.LoanId)
+	if err != nil {
+		return nil, err
 	}
 
-	// Update loan
-	err = loanAPI.SQLDB.Model(&models.Loan{}).Updates(map[string]interface{}{
-		"approved": true,
-		"status":   loan.LoanStatus_APPROVED.String(),
+	// Record the officer approval
+	err = loanAPI.SQLDB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "loan_id"}, {Name: "approver_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"account_name", "comment"}),
+	}).Create(&models.LoanApproval{
+		LoanID:        loanID,
+		ApproverID:    actor.ID,
+		ApproverNames: actor.Names,
+		AccountName:   req.AccountName,
+		Comment:       req.Comment,
 	}).Error
 	if err != nil {
-		return nil, errs.FailedToUpdate("loan", err)
+		return nil, errs.FailedToSave("loan approval", err)
+	}
+
+	// Funds move only when the approval policy is satisfied
+	approvalStatus, err := loanAPI.approvalStatus(loanPB)
+	if err != nil {
+		return nil, err
+	}
+
+	if !approvalStatus.PolicySatisfied {
+		return &emptypb.Empty{}, nil
+	}
+
+	// Funds are released by whichever approval claims the loan first
+	claimed, err := loanAPI.claimLoan(loanID)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return &emptypb.Empty{}, nil
+	}
+
+	err = loanAPI.releaseFunds(ctx, actor.ID, req.AccountName, loanPB)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// claimLoan moves a loan awaiting approval to approved. It reports false when the loan has already left approval.
+func (loanAPI *loanAPIServer) claimLoan(loanID uint) (bool, error) {
+	db := loanAPI.SQLDB.Model(&models.Loan{}).
+		Where("id = ? AND status = ?", loanID, loan.LoanStatus_WAITING_APPROVAL.String()).
+		Updates(map[string]interface{}{
+			"approved": true,
+			"status":   loan.LoanStatus_APPROVED.String(),
+		})
+	if db.Error != nil {
+		return false, errs.FailedToUpdate("loan", db.Error)
+	}
+	return db.RowsAffected == 1, nil
+}
+
+// releaseLoan returns a claimed loan to approval so that it can be approved again
+func (loanAPI *loanAPIServer) releaseLoan(loanID string) {
+	err := loanAPI.SQLDB.Model(&models.Loan{}).
+		Where("id = ? AND status = ?", loanID, loan.LoanStatus_APPROVED.String()).
+		Updates(map[string]interface{}{
+			"approved": false,
+			"status":   loan.LoanStatus_WAITING_APPROVAL.String(),
+		}).Error
+	if err != nil {
+		loanAPI.Logger.Errorf("failed to return loan %s to approval: %v", loanID, err)
+	}
+}
+
+// releaseFunds withdraws the loan amount from the chama account and disburses it to the loanee. The loan must have been
+// claimed; it goes back to approval when funds could not be withdrawn and the release is refunded when a later step fails.
+func (loanAPI *loanAPIServer) releaseFunds(ctx context.Context, actorID, accountName string, loanPB *loan.Loan) (err error) {
+	withdrawn := false
+	defer func() {
+		if err != nil && !withdrawn {
+			loanAPI.releaseLoan(loanPB.LoanId)
+		}
+	}()
+
+	// The fee split is saved before money moves so that releasing the loan again charges the same fees
+	err = loanAPI.recordFees(loanPB)
+	if err != nil {
+		return err
+	}
+
+	// Fees are withdrawn with the cash paid out and posted to their income accounts
+	fees, err := loanAPI.unpostedFees(loanPB.LoanId)
+	if err != nil {
+		return err
 	}
 
 	ctxExt := mdutil.AddFromCtx(ctx)
@@ -314,42 +563,61 @@ func (loanAPI *loanAPIServer) ApproveLoan(
 	// Get account
 	accountPB, err := loanAPI.MoneyAccountAPI.GetChamaAccount(ctxExt, &transaction.GetChamaAccountRequest{
 		OwnerId:     loanPB.ChamaId,
-		AccountName: req.AccountName,
+		AccountName: accountName,
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Withdraw from account
 	_, err = loanAPI.TransactionAPI.Withdraw(ctxExt, &transaction.WithdrawRequest{
-		ActorId:     actor.ID,
+		ActorId:     actorID,
 		AccountId:   accountPB.AccountId,
 		Description: fmt.Sprintf("Loan approval for %s", loanPB.LoaneeNames),
-		Amount:      loanPB.LoanAmount,
+		Amount:      roundAmount(loanPB.NetAmount + fees),
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	// Update loan
-	err = loanAPI.SQLDB.Model(&models.Loan{}).Updates(map[string]interface{}{
-		"approved": true,
-		"status":   loan.LoanStatus_FUNDS_WITHDRAWN_ACCOUNT.String(),
-	}).Error
+	withdrawn = true
+
+	err = loanAPI.postFees(ctxExt, actorID, loanPB)
+	if err == nil {
+		err = loanAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+			err := tx.Model(&models.Loan{}).Where("id = ?", loanPB.LoanId).
+				Update("status", loan.LoanStatus_FUNDS_WITHDRAWN_ACCOUNT.String()).Error
+			if err != nil {
+				return errs.FailedToUpdate("loan", err)
+			}
+
+			// Top ups take over the balance of the parent loan once the funds reach the loanee
+			if loanAPI.PayoutProvider != nil {
+				return nil
+			}
+			closed, err := closeParentLoan(tx, loanPB.LoanId)
+			if err != nil {
+				return err
+			}
+			if closed {
+				return errs.WrapMessagef(codes.FailedPrecondition, "topped up loan %s has been closed", loanPB.ParentLoanId)
+			}
+			return nil
+		})
+	}
 	if err != nil {
-		return nil, errs.FailedToUpdate("loan", err)
+		errRefund := loanAPI.refundRelease(ctx, actorID, accountPB.AccountId, loanPB.LoanId, loanPB.NetAmount)
+		if errRefund != nil {
+			loanAPI.Logger.Errorf("failed to refund release of loan %s: %v", loanPB.LoanId, errRefund)
+		}
+		return err
 	}
 
-	// B2C Transfer
-
-	// Update loan
-	// err = loanAPI.SQLDB.Model(&models.Loan{}).Updates(map[string]interface{}{
-	// 	"approved": true,
-	// 	"status":   loan.LoanStatus_WAITING_FUNDS_TRANSFER.String(),
-	// }).Error
-	// if err != nil {
-	// 	return nil, errs.FailedToUpdate("loan", err)
-	// }
+	// Funds are disbursed manually when there is no payout provider
+	if loanAPI.PayoutProvider == nil {
+		return loanAPI.createSchedule(loanPB.LoanId, time.Now())
+	}
 
-	return &emptypb.Empty{}, nil
+	// B2C Transfer
+	return loanAPI.disburse(ctx, actorID, accountPB.AccountId, loanPB)
 }
@@ -8,8 +8,11 @@ import (
 
 	"github.com/Pallinder/go-randomdata"
 	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/moneyaccount"
+	"github.com/gidyon/machama-app/internal/notification"
+	"github.com/gidyon/machama-app/internal/payout"
+	transaction_app "github.com/gidyon/machama-app/internal/transaction"
 	"github.com/gidyon/machama-app/pkg/api/loan"
-	"github.com/gidyon/machama-app/pkg/api/transaction"
 	"github.com/gidyon/micro/v2"
 	"github.com/gidyon/micro/v2/pkg/conn"
 	"github.com/gidyon/micro/v2/pkg/mocks"
@@ -25,10 +28,31 @@ func TestChama(t *testing.T) {
 }
 
 var (
-	LoanAPIServer *loanAPIServer
-	LoanAPI       loan.LoanAPIServer
-	modelsStructs = []interface{}{
+	LoanAPIServer  *loanAPIServer
+	LoanAPI        loan.LoanAPIServer
+	PayoutProvider *payout.Fake
+	SMSSender      *notification.Fake
+	modelsStructs  = []interface{}{
 		&models.Loan{},
+		&models.LoanProduct{},
+		&models.ChamaMember{},
+		&models.ChamaAccount{},
+		&models.LoanInstallment{},
+		&models.LoanCharge{},
+		&models.LoanPayout{},
+		&models.LoanFeeCharge{},
+		&models.LoanApproval{},
+		&models.LoanVote{},
+		&models.LoanWriteOff{},
+		&models.LoanPayoffQuote{},
+		&models.LoanProductVersion{},
+		&models.LoanCollateral{},
+		&models.LoanHoliday{},
+		&models.LoanRepayment{},
+		&models.NotificationTemplate{},
+		&models.NotificationDelivery{},
+		&models.Transaction{},
+		&models.MemberKYC{},
 	}
 	schema = "machama"
 )
@@ -69,13 +93,34 @@ var _ = BeforeSuite(func() {
 
 	authAPI := mocks.AuthAPI
 
+	moneyAccountAPI, err := moneyaccount.NewChamaAccountAPI(ctx, &moneyaccount.Options{
+		SQLDB:      db,
+		PageHasher: hasher,
+		Logger:     logger,
+		Auth:       authAPI,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	transactionAPI, err := transaction_app.NewTransactionAPI(ctx, &transaction_app.Options{
+		SQLDB:      db,
+		PageHasher: hasher,
+		Logger:     logger,
+		Auth:       authAPI,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	PayoutProvider = payout.NewFake()
+	SMSSender = notification.NewFake()
+
 	opt := &Options{
-		MoneyAccountAPI: transaction.UnimplementedChamaAccountAPIServer{},
-		TransactionAPI:  transaction.UnimplementedTransactionAPIServer{},
+		MoneyAccountAPI: moneyAccountAPI,
+		TransactionAPI:  transactionAPI,
 		SQLDB:           db,
 		Logger:          logger,
 		PageHasher:      hasher,
 		Auth:            authAPI,
+		PayoutProvider:  PayoutProvider,
+		SMSSender:       SMSSender,
 	}
 
 	// Create ussdlog API
@@ -96,12 +141,12 @@ var _ = BeforeSuite(func() {
 	_, err = NewLoanAPI(ctx, opt)
 	Expect(err).Should(HaveOccurred())
 
-	opt.MoneyAccountAPI = transaction.UnimplementedChamaAccountAPIServer{}
+	opt.MoneyAccountAPI = moneyAccountAPI
 	opt.TransactionAPI = nil
 	_, err = NewLoanAPI(ctx, opt)
 	Expect(err).Should(HaveOccurred())
 
-	opt.TransactionAPI = transaction.UnimplementedTransactionAPIServer{}
+	opt.TransactionAPI = transactionAPI
 	opt.SQLDB = nil
 	_, err = NewLoanAPI(ctx, opt)
 	Expect(err).Should(HaveOccurred())
@@ -0,0 +1,359 @@
+package loan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// amountTolerance absorbs rounding differences when comparing money amounts
+const amountTolerance = 0.005
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// settlementPolicy gets the early settlement policy of a loan product version
+func (loanAPI *loanAPIServer) settlementPolicy(productID string, version int32) (*loan.EarlySettlementPolicy, error) {
+	productPB, err := loanAPI.productTerms(productID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	// Full interest is owed by default
+	if productPB.EarlySettlementPolicy == nil {
+		return &loan.EarlySettlementPolicy{}, nil
+	}
+
+	return productPB.EarlySettlementPolicy, nil
+}
+
+// quotePayoff computes what settles the loan in full on asOf. Repayments on installments go to interest before principal.
+func (loanAPI *loanAPIServer) quotePayoff(loanDB *models.Loan, asOf time.Time) (*models.LoanPayoffQuote, error) {
+	installments := make([]*models.LoanInstallment, 0)
+	err := loanAPI.SQLDB.Order("installment_number ASC").Find(&installments, "loan_id = ?", loanDB.ID).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan installments", err)
+	}
+
+	if len(installments) == 0 {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan has no repayment schedule")
+	}
+
+	policy, err := loanAPI.settlementPolicy(loanDB.ProductID, loanDB.ProductVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var amountDue, totalInterest, principal, interest float64
+	for _, installment := range installments {
+		interestPaid := math.Min(installment.AmountPaid, installment.InterestDue)
+		principalPaid := math.Min(math.Max(installment.AmountPaid-installment.InterestDue, 0), installment.PrincipalDue)
+
+		amountDue += installment.AmountDue
+		totalInterest += installment.InterestDue
+		principal += installment.PrincipalDue - principalPaid
+		interest += installment.InterestDue - interestPaid
+	}
+
+	// Repayments beyond the schedule have gone to penalties
+	penalty := math.Max(loanDB.PenaltyAmount-math.Max(loanDB.SettledAmount-amountDue, 0), 0)
+
+	if roundAmount(principal+interest+penalty) == 0 {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan has been fully settled")
+	}
+
+	// Flat interest is earned evenly over the loan duration
+	durationDays := math.Max(float64(loanDB.DurationDays), 1)
+	start := installments[len(installments)-1].DueDate.AddDate(0, 0, -int(loanDB.DurationDays))
+	earned := math.Min(math.Max(asOf.Sub(start).Hours()/24/durationDays, 0), 1)
+	unearned := math.Min(totalInterest*(1-earned), interest)
+
+	var rebate, fee float64
+	switch policy.SettlementType {
+	case loan.EarlySettlementType_PRO_RATA_INTEREST:
+		rebate = unearned
+	case loan.EarlySettlementType_INTEREST_REBATE:
+		rebate = unearned * float64(policy.RebatePercentage) / 100
+		fee = policy.FeeAmount + principal*float64(policy.FeePercentage)/100
+	}
+
+	return &models.LoanPayoffQuote{
+		LoanID:           loanDB.ID,
+		SettlementType:   policy.SettlementType.String(),
+		AsOfDate:         asOf.Format(chargeDateLayout),
+		ExpiresAt:        startOfDay(asOf).AddDate(0, 0, 1+int(policy.QuoteValidityDays)),
+		PrincipalAmount:  roundAmount(principal),
+		InterestAmount:   roundAmount(interest),
+		PenaltyAmount:    roundAmount(penalty),
+		RebateAmount:     roundAmount(rebate),
+		FeeAmount:        roundAmount(fee),
+		SettlementAmount: roundAmount(principal + interest + penalty - rebate + fee),
+		SettledAmount:    loanDB.SettledAmount,
+	}, nil
+}
+
+// runningLoan gets a loan that can still be repaid
+func runningLoan(db *gorm.DB, loanID string) (*models.Loan, error) {
+	loanDB := &models.Loan{}
+	err := db.First(loanDB, "id = ?", loanID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("loan", loanID)
+	default:
+		return nil, errs.FailedToFind("loan", err)
+	}
+
+	switch loanDB.Status {
+	case loan.LoanStatus_REFINANCED.String():
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan has been refinanced")
+	case loan.LoanStatus_WRITTEN_OFF.String():
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan has been written off")
+	case loan.LoanStatus_CANCELLED.String():
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan has been cancelled")
+	}
+
+	return loanDB, nil
+}
+
+func (loanAPI *loanAPIServer) GetPayoffQuote(
+	ctx context.Context, req *loan.GetPayoffQuoteRequest,
+) (*loan.PayoffQuote, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.LoanId == "":
+		return nil, errs.MissingField("loan id")
+	}
+
+	// Authorization
+	_, err := loanAPI.authorizer.AuthorizeLoan(ctx, req.LoanId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	asOf := time.Now()
+	if req.AsOfDate != "" {
+		asOf, err = time.ParseInLocation(chargeDateLayout, req.AsOfDate, time.Local)
+		if err != nil {
+			return nil, errs.IncorrectVal("as of date")
+		}
+		if asOf.Before(startOfDay(time.Now())) {
+			return nil, errs.WrapMessage(codes.InvalidArgument, "as of date cannot be in the past")
+		}
+	}
+
+	loanDB, err := runningLoan(loanAPI.SQLDB, req.LoanId)
+	if err != nil {
+		return nil, err
+	}
+
+	quoteDB, err := loanAPI.quotePayoff(loanDB, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	err = loanAPI.SQLDB.Create(quoteDB).Error
+	if err != nil {
+		return nil, errs.FailedToSave("payoff quote", err)
+	}
+
+	return models.LoanPayoffQuoteProto(quoteDB)
+}
+
+func (loanAPI *loanAPIServer) RepayLoan(
+	ctx context.Context, req *loan.RepayLoanRequest,
+) (*loan.Loan, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.LoanId == "":
+		return nil, errs.MissingField("loan id")
+	case req.Amount <= 0:
+		return nil, errs.IncorrectVal("amount")
+	}
+
+	// Authorization
+	actor, err := loanAPI.authorizer.AuthorizeLoan(ctx, req.LoanId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	// The loan and quote are read under lock so that concurrent repayments apply one after the other
+	err = loanAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		loanDB, err := runningLoan(tx.Clauses(clause.Locking{Strength: "UPDATE"}), req.LoanId)
+		if err != nil {
+			return err
+		}
+
+		if req.QuoteId == "" {
+			outstanding, err := outstandingBalance(tx, loanDB)
+			if err != nil {
+				return err
+			}
+
+			if req.Amount > outstanding+amountTolerance {
+				return errs.WrapMessagef(codes.FailedPrecondition, "amount exceeds the outstanding balance of %.2f", outstanding)
+			}
+
+			return loanAPI.applyRepayment(tx, loanDB, &models.LoanRepayment{
+				ActorID: actor.ID,
+				Amount:  req.Amount,
+			})
+		}
+
+		quoteDB := &models.LoanPayoffQuote{}
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(quoteDB, "id = ? AND loan_id = ?", req.QuoteId, loanDB.ID).Error
+		switch {
+		case err == nil:
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return errs.DoesNotExist("payoff quote", req.QuoteId)
+		default:
+			return errs.FailedToFind("payoff quote", err)
+		}
+
+		switch {
+		case quoteDB.Used:
+			return errs.WrapMessage(codes.FailedPrecondition, "payoff quote has already been used")
+		case time.Now().After(quoteDB.ExpiresAt):
+			return errs.WrapMessage(codes.FailedPrecondition, "payoff quote has expired")
+		case math.Abs(loanDB.SettledAmount-quoteDB.SettledAmount) > amountTolerance:
+			return errs.WrapMessage(codes.FailedPrecondition, "loan has been repaid since the quote was issued")
+		case math.Abs(req.Amount-quoteDB.SettlementAmount) > amountTolerance:
+			return errs.WrapMessagef(codes.FailedPrecondition, "amount must match the quoted settlement of %.2f", quoteDB.SettlementAmount)
+		}
+
+		err = waiveInterest(tx, loanDB.ID, quoteDB.RebateAmount)
+		if err != nil {
+			return err
+		}
+
+		if quoteDB.FeeAmount > 0 {
+			err = tx.Create(&models.LoanCharge{
+				LoanID:      loanDB.ID,
+				ChargeType:  models.LoanChargeEarlySettlementFee,
+				ChargeDate:  time.Now().Format(chargeDateLayout),
+				Description: fmt.Sprintf("Early settlement fee on quote %d", quoteDB.ID),
+				Amount:      quoteDB.FeeAmount,
+			}).Error
+			if err != nil {
+				return errs.FailedToSave("loan charge", err)
+			}
+		}
+
+		var amountDue float64
+		err = tx.Model(&models.LoanInstallment{}).Select("COALESCE(SUM(amount_due), 0)").
+			Where("loan_id = ?", loanDB.ID).Scan(&amountDue).Error
+		if err != nil {
+			return errs.FailedToFind("loan installments", err)
+		}
+
+		// What is paid beyond the schedule settles penalties, the fee included. Penalties accrued after the quote are waived.
+		settledAmount := roundAmount(loanDB.SettledAmount + req.Amount)
+		err = tx.Model(loanDB).Update("penalty_amount", math.Max(roundAmount(settledAmount-amountDue), 0)).Error
+		if err != nil {
+			return errs.FailedToUpdate("loan", err)
+		}
+
+		err = loanAPI.applyRepayment(tx, loanDB, &models.LoanRepayment{
+			ActorID: actor.ID,
+			QuoteID: quoteDB.ID,
+			Amount:  req.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(quoteDB).Update("used", true).Error
+		if err != nil {
+			return errs.FailedToUpdate("payoff quote", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return loanAPI.GetLoan(ctx, &loan.GetLoanRequest{LoanId: req.LoanId})
+}
+
+// applyRepayment sets the settled amount of the loan, allocates it to the installments, records the repayment
+// and releases collateral of settled loans. What is not allocated to the schedule settles penalties.
+func (loanAPI *loanAPIServer) applyRepayment(tx *gorm.DB, loanDB *models.Loan, repaymentDB *models.LoanRepayment) error {
+	settledAmount := roundAmount(loanDB.SettledAmount + repaymentDB.Amount)
+
+	err := tx.Model(loanDB).Update("settled_amount", settledAmount).Error
+	if err != nil {
+		return errs.FailedToUpdate("loan", err)
+	}
+
+	principal, interest, err := allocateRepayments(tx, loanDB.ID, settledAmount)
+	if err != nil {
+		return err
+	}
+
+	repaymentDB.LoanID = loanDB.ID
+	repaymentDB.PrincipalAmount = principal
+	repaymentDB.InterestAmount = interest
+	repaymentDB.PenaltyAmount = math.Max(roundAmount(repaymentDB.Amount-principal-interest), 0)
+
+	err = tx.Create(repaymentDB).Error
+	if err != nil {
+		return errs.FailedToSave("loan repayment", err)
+	}
+
+	return releaseSettledCollateral(tx, loanDB.ID)
+}
+
+// waiveInterest removes unpaid interest from the last installments first
+func waiveInterest(tx *gorm.DB, loanID uint, amount float64) error {
+	if amount <= 0 {
+		return nil
+	}
+
+	installments := make([]*models.LoanInstallment, 0)
+	err := tx.Order("installment_number DESC").Find(&installments, "loan_id = ?", loanID).Error
+	if err != nil {
+		return errs.FailedToFind("loan installments", err)
+	}
+
+	remaining := amount
+	for _, installment := range installments {
+		if remaining <= 0 {
+			break
+		}
+
+		unpaid := installment.InterestDue - math.Min(installment.AmountPaid, installment.InterestDue)
+		waived := math.Min(remaining, unpaid)
+		if waived <= 0 {
+			continue
+		}
+		remaining -= waived
+
+		err = tx.Model(installment).Updates(map[string]interface{}{
+			"interest_due": roundAmount(installment.InterestDue - waived),
+			"amount_due":   roundAmount(installment.AmountDue - waived),
+		}).Error
+		if err != nil {
+			return errs.FailedToUpdate("loan installment", err)
+		}
+	}
+
+	return nil
+}
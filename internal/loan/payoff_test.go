@@ -0,0 +1,227 @@
+package loan
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Loan payoff", func() {
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+	})
+
+	createLoan := func(policy *loan.EarlySettlementPolicy) *loan.Loan {
+		loanPB, err := createDisbursedLoan(mockLoan(), time.Now())
+		Expect(err).ShouldNot(HaveOccurred())
+
+		bs, err := json.Marshal(policy)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = LoanAPIServer.SQLDB.Model(&models.LoanProduct{}).Where("id = ?", loanPB.ProductId).
+			Update("settlement_policy", bs).Error
+		Expect(err).ShouldNot(HaveOccurred())
+
+		return loanPB
+	}
+
+	Describe("GetPayoffQuote with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			quoteRes, err := LoanAPI.GetPayoffQuote(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(quoteRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when loan id is missing", func() {
+			quoteRes, err := LoanAPI.GetPayoffQuote(ctx, &loan.GetPayoffQuoteRequest{})
+			Expect(err).Should(HaveOccurred())
+			Expect(quoteRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when as of date is in the past", func() {
+			quoteRes, err := LoanAPI.GetPayoffQuote(ctx, &loan.GetPayoffQuoteRequest{
+				LoanId:   "1",
+				AsOfDate: time.Now().AddDate(0, 0, -3).Format(chargeDateLayout),
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(quoteRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("RepayLoan with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			repayRes, err := LoanAPI.RepayLoan(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(repayRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when amount is missing", func() {
+			repayRes, err := LoanAPI.RepayLoan(ctx, &loan.RepayLoanRequest{LoanId: "1"})
+			Expect(err).Should(HaveOccurred())
+			Expect(repayRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Settling with full interest", func() {
+		It("should quote the whole schedule", func() {
+			loanPB := createLoan(&loan.EarlySettlementPolicy{})
+
+			quoteRes, err := LoanAPI.GetPayoffQuote(ctx, &loan.GetPayoffQuoteRequest{LoanId: loanPB.LoanId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(quoteRes.SettlementAmount).Should(BeNumerically("~", 1100, 0.01))
+			Expect(quoteRes.RebateAmount).Should(BeZero())
+		})
+	})
+
+	Describe("Settling with pro rata interest", func() {
+		var (
+			loanPB  *loan.Loan
+			quotePB *loan.PayoffQuote
+		)
+
+		It("should rebate unearned interest", func() {
+			loanPB = createLoan(&loan.EarlySettlementPolicy{
+				SettlementType: loan.EarlySettlementType_PRO_RATA_INTEREST,
+			})
+
+			var err error
+			quotePB, err = LoanAPI.GetPayoffQuote(ctx, &loan.GetPayoffQuoteRequest{LoanId: loanPB.LoanId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(quotePB.RebateAmount).Should(BeNumerically(">", 90))
+			Expect(quotePB.SettlementAmount).Should(BeNumerically("<", 1010))
+		})
+
+		It("should fail when the amount does not match the quote", func() {
+			repayRes, err := LoanAPI.RepayLoan(ctx, &loan.RepayLoanRequest{
+				LoanId:  loanPB.LoanId,
+				QuoteId: quotePB.QuoteId,
+				Amount:  quotePB.SettlementAmount - 50,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(repayRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should settle the loan in full with the quoted amount", func() {
+			repayRes, err := LoanAPI.RepayLoan(ctx, &loan.RepayLoanRequest{
+				LoanId:  loanPB.LoanId,
+				QuoteId: quotePB.QuoteId,
+				Amount:  quotePB.SettlementAmount,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repayRes.SettledAmount).Should(BeNumerically("~", quotePB.SettlementAmount, 0.01))
+
+			for _, installment := range repayRes.Installments {
+				Expect(installment.AmountPaid).Should(BeNumerically("~", installment.AmountDue, 0.01))
+			}
+		})
+
+		It("should fail when the quote has already been used", func() {
+			repayRes, err := LoanAPI.RepayLoan(ctx, &loan.RepayLoanRequest{
+				LoanId:  loanPB.LoanId,
+				QuoteId: quotePB.QuoteId,
+				Amount:  quotePB.SettlementAmount,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(repayRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+
+	Describe("Settling concurrently with one quote", func() {
+		It("should apply the quote once", func() {
+			loanPB := createLoan(&loan.EarlySettlementPolicy{})
+
+			quotePB, err := LoanAPI.GetPayoffQuote(ctx, &loan.GetPayoffQuoteRequest{LoanId: loanPB.LoanId})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var (
+				wg   sync.WaitGroup
+				errs = make([]error, 2)
+				req  = &loan.RepayLoanRequest{LoanId: loanPB.LoanId, QuoteId: quotePB.QuoteId, Amount: quotePB.SettlementAmount}
+			)
+			for i := range errs {
+				wg.Add(1)
+				go func(i int) {
+					defer GinkgoRecover()
+					defer wg.Done()
+					_, errs[i] = LoanAPI.RepayLoan(ctx, req)
+				}(i)
+			}
+			wg.Wait()
+
+			failed := 0
+			for _, err := range errs {
+				if err != nil {
+					Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+					failed++
+				}
+			}
+			Expect(failed).Should(Equal(1))
+
+			installments := make([]*models.LoanInstallment, 0)
+			err = LoanAPIServer.SQLDB.Find(&installments, "loan_id = ?", loanPB.LoanId).Error
+			Expect(err).ShouldNot(HaveOccurred())
+
+			paid := 0.0
+			for _, installmentDB := range installments {
+				paid += installmentDB.AmountPaid
+			}
+			Expect(paid).Should(BeNumerically("~", quotePB.SettlementAmount, 0.01))
+		})
+	})
+
+	Describe("Settling with an expired quote", func() {
+		It("should fail", func() {
+			loanPB := createLoan(&loan.EarlySettlementPolicy{
+				SettlementType:   loan.EarlySettlementType_INTEREST_REBATE,
+				RebatePercentage: 50,
+				FeeAmount:        20,
+			})
+
+			quotePB, err := LoanAPI.GetPayoffQuote(ctx, &loan.GetPayoffQuoteRequest{LoanId: loanPB.LoanId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(quotePB.FeeAmount).Should(BeNumerically("~", 20, 0.01))
+
+			err = LoanAPIServer.SQLDB.Model(&models.LoanPayoffQuote{}).Where("id = ?", quotePB.QuoteId).
+				Update("expires_at", time.Now().Add(-time.Hour)).Error
+			Expect(err).ShouldNot(HaveOccurred())
+
+			repayRes, err := LoanAPI.RepayLoan(ctx, &loan.RepayLoanRequest{
+				LoanId:  loanPB.LoanId,
+				QuoteId: quotePB.QuoteId,
+				Amount:  quotePB.SettlementAmount,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(repayRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+
+	Describe("Repaying without a quote", func() {
+		It("should allocate the amount to the installments", func() {
+			loanPB := createLoan(&loan.EarlySettlementPolicy{})
+
+			repayRes, err := LoanAPI.RepayLoan(ctx, &loan.RepayLoanRequest{
+				LoanId: loanPB.LoanId,
+				Amount: 300,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(repayRes.SettledAmount).Should(BeNumerically("~", 300, 0.01))
+		})
+	})
+})
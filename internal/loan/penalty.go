@@ -0,0 +1,149 @@
+package loan
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const chargeDateLayout = "2006-01-02"
+
+func (loanAPI *loanAPIServer) runPenaltyAccrual(ctx context.Context) {
+	ticker := time.NewTicker(loanAPI.PenaltyAccrualInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := loanAPI.accruePenalties(time.Now())
+			if err != nil {
+				loanAPI.Logger.Errorf("failed to accrue loan penalties: %v", err)
+			}
+		}
+	}
+}
+
+// accruePenalties charges product penalties on overdue installments. Each charge is keyed by its date so that running it more than once a day has no effect.
+func (loanAPI *loanAPIServer) accruePenalties(now time.Time) error {
+	installments := make([]*models.LoanInstallment, 0)
+	err := loanAPI.SQLDB.Model(&models.LoanInstallment{}).Select("loan_installments.*").
+		Joins("JOIN loans ON loans.id = loan_installments.loan_id").
+		Where("loan_installments.due_date < ? AND loan_installments.amount_paid < loan_installments.amount_due", now).
+		Where("loans.status NOT IN (?)", closedLoanStatuses).
+		Order("loan_installments.loan_id ASC, loan_installments.installment_number ASC").Find(&installments).Error
+	if err != nil {
+		return errs.FailedToFind("overdue installments", err)
+	}
+
+	policies := make(map[string]*loan.PenaltyPolicy)
+
+	for _, installment := range installments {
+		loanDB := &models.Loan{}
+		err = loanAPI.SQLDB.First(loanDB, "id = ?", installment.LoanID).Error
+		if err != nil {
+			return errs.FailedToFind("loan", err)
+		}
+
+		policyKey := fmt.Sprintf("%s/%d", loanDB.ProductID, loanDB.ProductVersion)
+		policy, ok := policies[policyKey]
+		if !ok {
+			productPB, err := loanAPI.productTerms(loanDB.ProductID, loanDB.ProductVersion)
+			if err != nil {
+				return err
+			}
+			policy = productPB.PenaltyPolicy
+			policies[policyKey] = policy
+		}
+
+		amount, chargeDate := penaltyCharge(policy, installment, now)
+		if amount <= 0 {
+			continue
+		}
+
+		err = loanAPI.applyPenalty(loanDB, installment, policy, amount, chargeDate)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// penaltyCharge computes the penalty due on an overdue installment and the date the charge is keyed on
+func penaltyCharge(policy *loan.PenaltyPolicy, installment *models.LoanInstallment, now time.Time) (float64, time.Time) {
+	if policy == nil || policy.PenaltyType == loan.PenaltyType_PENALTY_TYPE_UNSPECIFIED {
+		return 0, now
+	}
+
+	daysOverdue := int(now.Sub(installment.DueDate).Hours() / 24)
+	if daysOverdue <= int(policy.GraceDays) {
+		return 0, now
+	}
+
+	penaltyStart := installment.DueDate.AddDate(0, 0, int(policy.GraceDays)+1)
+	overdueAmount := installment.AmountDue - installment.AmountPaid
+
+	switch policy.PenaltyType {
+	case loan.PenaltyType_FLAT:
+		return policy.Amount, penaltyStart
+	case loan.PenaltyType_PERCENTAGE_PER_DAY:
+		return roundAmount(overdueAmount * policy.Amount / 100), now
+	case loan.PenaltyType_PERCENTAGE_PER_PERIOD:
+		periodDays := int(policy.PeriodDays)
+		if periodDays <= 0 {
+			periodDays = 1
+		}
+		period := (daysOverdue - int(policy.GraceDays) - 1) / periodDays
+		return roundAmount(overdueAmount * policy.Amount / 100), penaltyStart.AddDate(0, 0, period*periodDays)
+	}
+
+	return 0, now
+}
+
+func (loanAPI *loanAPIServer) applyPenalty(
+	loanDB *models.Loan, installment *models.LoanInstallment, policy *loan.PenaltyPolicy, amount float64, chargeDate time.Time,
+) error {
+	if policy.Cap > 0 {
+		amount = math.Min(amount, policy.Cap-loanDB.PenaltyAmount)
+		if amount <= 0 {
+			return nil
+		}
+	}
+
+	return loanAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		db := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.LoanCharge{
+			LoanID:        loanDB.ID,
+			InstallmentID: installment.ID,
+			ChargeType:    models.LoanChargePenalty,
+			ChargeDate:    chargeDate.Format(chargeDateLayout),
+			Description:   fmt.Sprintf("%s penalty on installment %d", policy.PenaltyType.String(), installment.InstallmentNumber),
+			Amount:        amount,
+		})
+		if db.Error != nil {
+			return errs.FailedToSave("loan charge", db.Error)
+		}
+
+		// Already charged
+		if db.RowsAffected == 0 {
+			return nil
+		}
+
+		err := tx.Model(loanDB).Update("penalty_amount", gorm.Expr("penalty_amount + ?", amount)).Error
+		if err != nil {
+			return errs.FailedToUpdate("loan penalty", err)
+		}
+
+		loanDB.PenaltyAmount += amount
+
+		return nil
+	})
+}
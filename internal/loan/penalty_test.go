@@ -0,0 +1,73 @@
+package loan
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Penalty accrual", func() {
+	var (
+		loanDB *models.Loan
+		policy = []byte(`{"penalty_type":2,"amount":1,"cap":50}`)
+	)
+
+	Context("Lets create an overdue loan first", func() {
+		It("should succeed", func() {
+			loanPB := mockLoan()
+			loanPB.LoanAmount = 1000
+			loanPB.DurationDays = 30
+			loanPB.InterestRate = 10
+			Expect(createLoanPrerequisites(loanPB, nil)).ShouldNot(HaveOccurred())
+
+			Expect(LoanAPIServer.SQLDB.Model(&models.LoanProduct{}).Where("id = ?", loanPB.ProductId).
+				Update("penalty_policy", policy).Error).ShouldNot(HaveOccurred())
+
+			var err error
+			loanDB, err = models.LoanModel(loanPB)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			loanDB.Approved = true
+			loanDB.Status = loan.LoanStatus_FUNDS_WITHDRAWN_ACCOUNT.String()
+			Expect(LoanAPIServer.SQLDB.Create(loanDB).Error).ShouldNot(HaveOccurred())
+
+			err = LoanAPIServer.createSchedule(fmt.Sprint(loanDB.ID), time.Now().AddDate(0, 0, -35))
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Describe("Accruing penalties", func() {
+		It("should charge overdue installments", func() {
+			Expect(LoanAPIServer.accruePenalties(time.Now())).ShouldNot(HaveOccurred())
+
+			charges := make([]*models.LoanCharge, 0)
+			Expect(LoanAPIServer.SQLDB.Find(&charges, "loan_id = ?", loanDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(charges).Should(HaveLen(1))
+			Expect(charges[0].Amount).Should(BeNumerically("==", 11))
+		})
+		It("should be idempotent within the same day", func() {
+			Expect(LoanAPIServer.accruePenalties(time.Now())).ShouldNot(HaveOccurred())
+
+			charges := make([]*models.LoanCharge, 0)
+			Expect(LoanAPIServer.SQLDB.Find(&charges, "loan_id = ?", loanDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(charges).Should(HaveLen(1))
+
+			db := &models.Loan{}
+			Expect(LoanAPIServer.SQLDB.First(db, "id = ?", loanDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(db.PenaltyAmount).Should(BeNumerically("==", 11))
+		})
+		It("should not exceed the penalty cap", func() {
+			for i := 1; i <= 10; i++ {
+				Expect(LoanAPIServer.accruePenalties(time.Now().AddDate(0, 0, i))).ShouldNot(HaveOccurred())
+			}
+
+			db := &models.Loan{}
+			Expect(LoanAPIServer.SQLDB.First(db, "id = ?", loanDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(db.PenaltyAmount).Should(BeNumerically("==", 50))
+		})
+	})
+})
@@ -0,0 +1,41 @@
+package loan
+
+import (
+	"errors"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"gorm.io/gorm"
+)
+
+// productTerms gets the terms of a loan product at the version a loan was created under
+func (loanAPI *loanAPIServer) productTerms(productID string, version int32) (*loan.LoanProduct, error) {
+	if version > 0 {
+		versionDB := &models.LoanProductVersion{}
+		err := loanAPI.SQLDB.First(versionDB, "product_id = ? AND version = ?", productID, version).Error
+		switch {
+		case err == nil:
+			versionPB, err := models.LoanProductVersionProto(versionDB)
+			if err != nil {
+				return nil, err
+			}
+			return versionPB.Terms, nil
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, errs.FailedToFind("loan product version", err)
+		}
+	}
+
+	// Loans created before product versioning follow the current terms
+	productDB := &models.LoanProduct{}
+	err := loanAPI.SQLDB.First(productDB, "id = ?", productID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("loan product", productID)
+	default:
+		return nil, errs.FailedToFind("loan product", err)
+	}
+
+	return models.LoanProductProto(productDB)
+}
@@ -0,0 +1,168 @@
+package loan
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+// defaultProvisionRates are the percentages of outstanding balances provisioned per days past due bucket
+var defaultProvisionRates = &loan.ProvisionRates{
+	Current:    1,
+	Dpd_1_30:   5,
+	Dpd_31_90:  25,
+	DpdOver_90: 100,
+}
+
+func validateProvisionRates(rates *loan.ProvisionRates) error {
+	for _, rate := range []float32{rates.Current, rates.Dpd_1_30, rates.Dpd_31_90, rates.DpdOver_90} {
+		if rate < 0 || rate > 100 {
+			return errs.IncorrectVal("provision percentage")
+		}
+	}
+	return nil
+}
+
+func daysPastDueBucket(daysPastDue int) loan.DaysPastDueBucket {
+	switch {
+	case daysPastDue <= 0:
+		return loan.DaysPastDueBucket_CURRENT
+	case daysPastDue <= 30:
+		return loan.DaysPastDueBucket_DPD_1_30
+	case daysPastDue <= 90:
+		return loan.DaysPastDueBucket_DPD_31_90
+	default:
+		return loan.DaysPastDueBucket_DPD_OVER_90
+	}
+}
+
+func provisionRate(rates *loan.ProvisionRates, bucket loan.DaysPastDueBucket) float32 {
+	switch bucket {
+	case loan.DaysPastDueBucket_DPD_1_30:
+		return rates.Dpd_1_30
+	case loan.DaysPastDueBucket_DPD_31_90:
+		return rates.Dpd_31_90
+	case loan.DaysPastDueBucket_DPD_OVER_90:
+		return rates.DpdOver_90
+	default:
+		return rates.Current
+	}
+}
+
+// loanExposure is the schedule position of a running loan
+type loanExposure struct {
+	ID            uint
+	PenaltyAmount float64
+	SettledAmount float64
+	AmountDue     float64
+	OldestOverdue *time.Time
+}
+
+func (loanAPI *loanAPIServer) GetProvisioningReport(
+	ctx context.Context, req *loan.GetProvisioningReportRequest,
+) (*loan.ProvisioningReport, error) {
+	// Validation
+	if req == nil {
+		return nil, errs.NilObject("report request")
+	}
+
+	// Authorization
+	_, chamaIDs, err := loanAPI.authorizer.ScopeChamas(ctx, req.ChamaIds, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	asOf := time.Now()
+	reportDate := asOf.Format(chargeDateLayout)
+	if req.AsOfDate != "" {
+		date, err := time.Parse(chargeDateLayout, req.AsOfDate)
+		if err != nil {
+			return nil, errs.IncorrectVal("as of date")
+		}
+		// The report covers the whole of the day
+		asOf = date.AddDate(0, 0, 1)
+		reportDate = req.AsOfDate
+	}
+
+	rates := loanAPI.ProvisionRates
+	if req.ProvisionRates != nil {
+		err = validateProvisionRates(req.ProvisionRates)
+		if err != nil {
+			return nil, err
+		}
+		rates = req.ProvisionRates
+	}
+
+	db := loanAPI.SQLDB.Table("loans").
+		Select(
+			"loans.id, loans.penalty_amount, loans.settled_amount, SUM(loan_installments.amount_due) AS amount_due, "+
+				"MIN(CASE WHEN loan_installments.due_date < ? AND loan_installments.amount_paid < loan_installments.amount_due "+
+				"THEN loan_installments.due_date END) AS oldest_overdue", asOf,
+		).
+		Joins("JOIN loan_installments ON loan_installments.loan_id = loans.id").
+		Where("loans.status NOT IN (?) AND loans.created_at < ?", closedLoanStatuses, asOf).
+		Group("loans.id, loans.penalty_amount, loans.settled_amount")
+	if chamaIDs != nil {
+		db = db.Where("loans.chama_id IN (?)", chamaIDs)
+	}
+
+	exposures := make([]*loanExposure, 0)
+	err = db.Scan(&exposures).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan balances", err)
+	}
+
+	buckets := make([]*loan.ProvisionBucket, 0, len(loan.DaysPastDueBucket_name))
+	for i := 0; i < len(loan.DaysPastDueBucket_name); i++ {
+		bucket := loan.DaysPastDueBucket(i)
+		buckets = append(buckets, &loan.ProvisionBucket{
+			Bucket:              bucket,
+			ProvisionPercentage: provisionRate(rates, bucket),
+		})
+	}
+
+	report := &loan.ProvisioningReport{
+		AsOfDate: reportDate,
+		Buckets:  buckets,
+	}
+
+	for _, exposure := range exposures {
+		outstanding := math.Max(roundAmount(exposure.AmountDue+exposure.PenaltyAmount-exposure.SettledAmount), 0)
+		if outstanding == 0 {
+			continue
+		}
+
+		var daysPastDue int
+		if exposure.OldestOverdue != nil {
+			daysPastDue = int(math.Ceil(asOf.Sub(*exposure.OldestOverdue).Hours() / 24))
+		}
+
+		bucket := buckets[daysPastDueBucket(daysPastDue)]
+		bucket.Loans++
+		bucket.OutstandingAmount = roundAmount(bucket.OutstandingAmount + outstanding)
+	}
+
+	for _, bucket := range buckets {
+		bucket.ProvisionAmount = roundAmount(bucket.OutstandingAmount * float64(bucket.ProvisionPercentage) / 100)
+		report.TotalOutstanding = roundAmount(report.TotalOutstanding + bucket.OutstandingAmount)
+		report.TotalProvision = roundAmount(report.TotalProvision + bucket.ProvisionAmount)
+	}
+
+	// Losses already recognised
+	writtenOffDB := loanAPI.SQLDB.Table("loans").Select("COALESCE(SUM(written_off), 0)").
+		Where("status = ? AND updated_at < ?", loan.LoanStatus_WRITTEN_OFF.String(), asOf)
+	if chamaIDs != nil {
+		writtenOffDB = writtenOffDB.Where("chama_id IN (?)", chamaIDs)
+	}
+
+	err = writtenOffDB.Scan(&report.WrittenOffAmount).Error
+	if err != nil {
+		return nil, errs.FailedToFind("written off loans", err)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,89 @@
+package loan
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("GetProvisioningReport", func() {
+	var (
+		reportReq *loan.GetProvisioningReportRequest
+		ctx       context.Context
+		chamaID   = fmt.Sprintf("p%d", randomdata.Number(100000, 999999))
+	)
+
+	BeforeEach(func() {
+		reportReq = &loan.GetProvisioningReportRequest{
+			ChamaIds: []string{chamaID},
+		}
+		ctx = context.TODO()
+	})
+
+	Describe("GetProvisioningReport with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			reportRes, err := LoanAPI.GetProvisioningReport(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(reportRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the as of date is malformed", func() {
+			reportReq.AsOfDate = "yesterday"
+			reportRes, err := LoanAPI.GetProvisioningReport(ctx, reportReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(reportRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when a provision percentage is out of range", func() {
+			reportReq.ProvisionRates = &loan.ProvisionRates{DpdOver_90: 150}
+			reportRes, err := LoanAPI.GetProvisioningReport(ctx, reportReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(reportRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("GetProvisioningReport with well formed request", func() {
+		Context("Lets create current and overdue loans first", func() {
+			It("should succeed", func() {
+				for _, start := range []time.Time{time.Now(), time.Now().AddDate(0, 0, -200)} {
+					loanPB := mockLoan()
+					loanPB.ChamaId = chamaID
+					_, err := createDisbursedLoan(loanPB, start)
+					Expect(err).ShouldNot(HaveOccurred())
+				}
+			})
+		})
+
+		It("should bucket outstanding balances by days past due", func() {
+			reportRes, err := LoanAPI.GetProvisioningReport(ctx, reportReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(reportRes.Buckets).Should(HaveLen(4))
+
+			current := reportRes.Buckets[loan.DaysPastDueBucket_CURRENT]
+			Expect(current.Loans).Should(BeEquivalentTo(1))
+			Expect(current.OutstandingAmount).Should(BeNumerically("~", 1100, 0.01))
+			Expect(current.ProvisionAmount).Should(BeNumerically("~", 11, 0.01))
+
+			overdue := reportRes.Buckets[loan.DaysPastDueBucket_DPD_OVER_90]
+			Expect(overdue.Loans).Should(BeEquivalentTo(1))
+			Expect(overdue.ProvisionAmount).Should(BeNumerically("~", 1100, 0.01))
+
+			Expect(reportRes.TotalOutstanding).Should(BeNumerically("~", 2200, 0.01))
+		})
+
+		It("should apply provision rates from the request", func() {
+			reportReq.ProvisionRates = &loan.ProvisionRates{DpdOver_90: 50}
+			reportRes, err := LoanAPI.GetProvisioningReport(ctx, reportReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(reportRes.TotalProvision).Should(BeNumerically("~", 550, 0.01))
+		})
+	})
+})
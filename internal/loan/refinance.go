@@ -0,0 +1,331 @@
+package loan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// closedLoanStatuses are statuses of loans whose balance no longer runs on their own schedule or that were never taken
+var closedLoanStatuses = []string{
+	loan.LoanStatus_REFINANCED.String(),
+	loan.LoanStatus_WRITTEN_OFF.String(),
+	loan.LoanStatus_CANCELLED.String(),
+}
+
+// outstandingBalance is what the loanee still owes on the loan schedule, penalties included. Loans without a repayment
+// schedule haven't been disbursed and can't be repaid, which member exits also go by.
+func outstandingBalance(tx *gorm.DB, loanDB *models.Loan) (float64, error) {
+	var amountDue float64
+	err := tx.Model(&models.LoanInstallment{}).Select("COALESCE(SUM(amount_due), 0)").
+		Where("loan_id = ?", loanDB.ID).Scan(&amountDue).Error
+	if err != nil {
+		return 0, errs.FailedToFind("loan installments", err)
+	}
+
+	if amountDue == 0 {
+		return 0, errs.WrapMessage(codes.FailedPrecondition, "loan has not been disbursed")
+	}
+
+	return math.Max(roundAmount(amountDue+loanDB.PenaltyAmount-loanDB.SettledAmount), 0), nil
+}
+
+// refinanceableLoan gets a disbursed loan that still has a balance and no other loan carrying it over
+func (loanAPI *loanAPIServer) refinanceableLoan(loanID string) (*models.Loan, float64, error) {
+	loanDB := &models.Loan{}
+	err := loanAPI.SQLDB.First(loanDB, "id = ?", loanID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, 0, errs.DoesNotExist("loan", loanID)
+	default:
+		return nil, 0, errs.FailedToFind("loan", err)
+	}
+
+	switch loanDB.Status {
+	case loan.LoanStatus_REFINANCED.String():
+		return nil, 0, errs.WrapMessage(codes.FailedPrecondition, "loan has already been refinanced")
+	case loan.LoanStatus_WRITTEN_OFF.String():
+		return nil, 0, errs.WrapMessage(codes.FailedPrecondition, "loan has been written off")
+	case loan.LoanStatus_CANCELLED.String():
+		return nil, 0, errs.WrapMessage(codes.FailedPrecondition, "loan has been cancelled")
+	}
+
+	// Cancelled top ups no longer carry the loan over
+	var children int64
+	err = loanAPI.SQLDB.Model(&models.Loan{}).Where("parent_loan_id = ? AND status NOT IN (?)", loanID, closedLoanStatuses).
+		Count(&children).Error
+	if err != nil {
+		return nil, 0, errs.FailedToFind("loans", err)
+	}
+	if children > 0 {
+		return nil, 0, errs.WrapMessage(codes.FailedPrecondition, "loan already has a pending top up")
+	}
+
+	outstanding, err := outstandingBalance(loanAPI.SQLDB, loanDB)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if outstanding == 0 {
+		return nil, 0, errs.WrapMessage(codes.FailedPrecondition, "loan has been fully settled")
+	}
+
+	return loanDB, outstanding, nil
+}
+
+// childLoan creates a loan record that carries over the balance of its parent
+func childLoan(parentDB *models.Loan, outstanding float64) *models.Loan {
+	return &models.Loan{
+		ChamaID:        parentDB.ChamaID,
+		ProductID:      parentDB.ProductID,
+		MemberID:       parentDB.MemberID,
+		LoaneeNames:    parentDB.LoaneeNames,
+		LoaneePhone:    parentDB.LoaneePhone,
+		NationalID:     parentDB.NationalID,
+		LoaneeEmail:    parentDB.LoaneeEmail,
+		LoanAmount:     outstanding,
+		ParentLoanID:   fmt.Sprint(parentDB.ID),
+		ProductVersion: parentDB.ProductVersion,
+		CarriedOver:    outstanding,
+	}
+}
+
+func (loanAPI *loanAPIServer) TopUpLoan(
+	ctx context.Context, req *loan.TopUpLoanRequest,
+) (*loan.Loan, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.LoanId == "":
+		return nil, errs.MissingField("loan id")
+	case req.TopUpAmount <= 0:
+		return nil, errs.IncorrectVal("top up amount")
+	case req.DurationDays < 0:
+		return nil, errs.IncorrectVal("duration days")
+	}
+
+	// Authorization
+	_, err := loanAPI.authorizer.AuthorizeLoan(ctx, req.LoanId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	parentDB, outstanding, err := loanAPI.refinanceableLoan(req.LoanId)
+	if err != nil {
+		return nil, err
+	}
+
+	var arrears int64
+	err = loanAPI.SQLDB.Model(&models.LoanInstallment{}).
+		Where("loan_id = ? AND due_date < ? AND amount_paid < amount_due", parentDB.ID, time.Now()).
+		Count(&arrears).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan installments", err)
+	}
+	if arrears > 0 {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan in arrears cannot be topped up, restructure it instead")
+	}
+
+	productDB := &models.LoanProduct{}
+	err = loanAPI.SQLDB.First(productDB, "id = ?", parentDB.ProductID).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan product", err)
+	}
+
+	if productDB.Archived {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan product has been archived")
+	}
+
+	// Top ups are new loans under the current product terms
+	loanDB := childLoan(parentDB, outstanding)
+	loanDB.ProductVersion = productDB.Version
+	loanDB.LoanAmount = roundAmount(outstanding + req.TopUpAmount)
+	loanDB.InterestRate = productDB.InterestRate
+	loanDB.DurationDays = productDB.LoanDurationDays
+	loanDB.Status = loan.LoanStatus_WAITING_APPROVAL.String()
+	if req.DurationDays > 0 {
+		loanDB.DurationDays = req.DurationDays
+	}
+
+	if productDB.LoanMaximumAmount > 0 && loanDB.LoanAmount > productDB.LoanMaximumAmount {
+		return nil, errs.WrapMessagef(
+			codes.FailedPrecondition, "topped up amount exceeds the product maximum of %.2f", productDB.LoanMaximumAmount,
+		)
+	}
+
+	// The parent is closed once the top up funds are released
+	err = loanAPI.SQLDB.Create(loanDB).Error
+	if err != nil {
+		return nil, errs.FailedToSave("loan", err)
+	}
+
+	return models.LoanProto(loanDB)
+}
+
+func (loanAPI *loanAPIServer) CancelLoan(
+	ctx context.Context, req *loan.CancelLoanRequest,
+) (*loan.Loan, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.LoanId == "":
+		return nil, errs.MissingField("loan id")
+	}
+
+	// Authorization
+	_, err := loanAPI.authorizer.AuthorizeLoan(ctx, req.LoanId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Loans being approved are claimed so only loans still awaiting approval can be cancelled
+	db := loanAPI.SQLDB.Model(&models.Loan{}).
+		Where("id = ? AND status = ?", req.LoanId, loan.LoanStatus_WAITING_APPROVAL.String()).
+		Update("status", loan.LoanStatus_CANCELLED.String())
+	if db.Error != nil {
+		return nil, errs.FailedToUpdate("loan", db.Error)
+	}
+	if db.RowsAffected == 0 {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "only loans awaiting approval can be cancelled")
+	}
+
+	loanDB := &models.Loan{}
+	err = loanAPI.SQLDB.First(loanDB, "id = ?", req.LoanId).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan", err)
+	}
+
+	return models.LoanProto(loanDB)
+}
+
+func (loanAPI *loanAPIServer) RestructureLoan(
+	ctx context.Context, req *loan.RestructureLoanRequest,
+) (*loan.Loan, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.LoanId == "":
+		return nil, errs.MissingField("loan id")
+	case req.DurationDays <= 0:
+		return nil, errs.MissingField("duration days")
+	case req.InterestRate < 0:
+		return nil, errs.IncorrectVal("interest rate")
+	}
+
+	// Authorization
+	_, err := loanAPI.authorizer.AuthorizeLoan(ctx, req.LoanId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	parentDB, outstanding, err := loanAPI.refinanceableLoan(req.LoanId)
+	if err != nil {
+		return nil, err
+	}
+
+	productPB, err := loanAPI.productTerms(parentDB.ProductID, parentDB.ProductVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	// No new funds are released so the restructured loan takes over the parent disbursement
+	loanDB := childLoan(parentDB, outstanding)
+	loanDB.InterestRate = req.InterestRate
+	loanDB.DurationDays = req.DurationDays
+	loanDB.Approved = parentDB.Approved
+	loanDB.Status = parentDB.Status
+
+	err = loanAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(loanDB).Error
+		if err != nil {
+			return errs.FailedToSave("loan", err)
+		}
+
+		err = tx.Create(generateSchedule(loanDB, productPB.RepaymentPeriodDays, nil, time.Now())).Error
+		if err != nil {
+			return errs.FailedToSave("loan installments", err)
+		}
+
+		err = tx.Model(parentDB).Update("status", loan.LoanStatus_REFINANCED.String()).Error
+		if err != nil {
+			return errs.FailedToUpdate("loan", err)
+		}
+
+		return moveCollateral(tx, parentDB.ID, loanDB.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return models.LoanProto(loanDB)
+}
+
+// closeParentLoan refinances the parent of a top up loan, carrying over its balance as at now. It runs in the unit of
+// work that completes the release of the top up funds so that the parent keeps running when they are not released.
+// A parent closed while the top up awaited its funds has nothing carried over from it and closed is reported.
+func closeParentLoan(tx *gorm.DB, loanID string) (closed bool, err error) {
+	loanDB := &models.Loan{}
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(loanDB, "id = ?", loanID).Error
+	if err != nil {
+		return false, errs.FailedToFind("loan", err)
+	}
+
+	if loanDB.ParentLoanID == "" {
+		return false, nil
+	}
+
+	parentDB := &models.Loan{}
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(parentDB, "id = ?", loanDB.ParentLoanID).Error
+	if err != nil {
+		return false, errs.FailedToFind("loan", err)
+	}
+
+	db := tx.Model(&models.Loan{}).Where("id = ? AND status NOT IN (?)", parentDB.ID, closedLoanStatuses).
+		Update("status", loan.LoanStatus_REFINANCED.String())
+	if db.Error != nil {
+		return false, errs.FailedToUpdate("loan", db.Error)
+	}
+	closed = db.RowsAffected == 0
+
+	outstanding := 0.0
+	if !closed {
+		outstanding, err = outstandingBalance(tx, parentDB)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	// The parent may have been repaid while the top up awaited approval
+	err = tx.Model(loanDB).Updates(map[string]interface{}{
+		"loan_amount":  roundAmount(loanDB.LoanAmount - loanDB.CarriedOver + outstanding),
+		"carried_over": outstanding,
+	}).Error
+	if err != nil {
+		return false, errs.FailedToUpdate("loan", err)
+	}
+
+	if closed {
+		return true, nil
+	}
+
+	return false, moveCollateral(tx, parentDB.ID, loanDB.ID)
+}
+
+// disbursableAmount is the part of the loan paid out in cash, net of fees
+func disbursableAmount(loanPB *loan.Loan) float64 {
+	return roundAmount(loanPB.LoanAmount - loanPB.CarriedOverAmount - loanPB.FeeAmount)
+}
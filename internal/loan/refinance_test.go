@@ -0,0 +1,224 @@
+package loan
+
+import (
+	"context"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Loan top up and restructuring", func() {
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+	})
+
+	createDisbursedLoan := func() *loan.Loan {
+		loanPB, err := createDisbursedLoan(mockLoan(), time.Now())
+		Expect(err).ShouldNot(HaveOccurred())
+		return loanPB
+	}
+
+	getLoan := func(loanID string) *models.Loan {
+		db := &models.Loan{}
+		Expect(LoanAPIServer.SQLDB.First(db, "id = ?", loanID).Error).ShouldNot(HaveOccurred())
+		return db
+	}
+
+	Describe("Top up with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			topUpRes, err := LoanAPI.TopUpLoan(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(topUpRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when loan id is missing", func() {
+			topUpRes, err := LoanAPI.TopUpLoan(ctx, &loan.TopUpLoanRequest{TopUpAmount: 100})
+			Expect(err).Should(HaveOccurred())
+			Expect(topUpRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when top up amount is missing", func() {
+			topUpRes, err := LoanAPI.TopUpLoan(ctx, &loan.TopUpLoanRequest{LoanId: "1"})
+			Expect(err).Should(HaveOccurred())
+			Expect(topUpRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when loan does not exist", func() {
+			topUpRes, err := LoanAPI.TopUpLoan(ctx, &loan.TopUpLoanRequest{LoanId: "oops", TopUpAmount: 100})
+			Expect(err).Should(HaveOccurred())
+			Expect(topUpRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("Topping up a running loan", func() {
+		var parentPB *loan.Loan
+
+		It("should carry over the outstanding balance", func() {
+			parentPB = createDisbursedLoan()
+
+			topUpRes, err := LoanAPI.TopUpLoan(ctx, &loan.TopUpLoanRequest{
+				LoanId:      parentPB.LoanId,
+				TopUpAmount: 500,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(topUpRes.ParentLoanId).Should(Equal(parentPB.LoanId))
+			Expect(topUpRes.CarriedOverAmount).Should(BeNumerically("~", 1100, 0.01))
+			Expect(topUpRes.LoanAmount).Should(BeNumerically("~", 1600, 0.01))
+			Expect(topUpRes.Status).Should(Equal(loan.LoanStatus_WAITING_APPROVAL))
+			Expect(disbursableAmount(topUpRes)).Should(BeNumerically("~", 500, 0.01))
+		})
+
+		It("should fail when the loan already has a pending top up", func() {
+			topUpRes, err := LoanAPI.TopUpLoan(ctx, &loan.TopUpLoanRequest{
+				LoanId:      parentPB.LoanId,
+				TopUpAmount: 500,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(topUpRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should list the top up under the parent loan", func() {
+			listRes, err := LoanAPI.ListLoans(ctx, &loan.ListLoansRequest{
+				Filter: &loan.LoanFilter{ParentLoanIds: []string{parentPB.LoanId}},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Loans).Should(HaveLen(1))
+		})
+	})
+
+	Describe("Cancelling a pending top up", func() {
+		var parentPB, topUpPB *loan.Loan
+
+		It("should cancel a top up awaiting approval", func() {
+			parentPB = createDisbursedLoan()
+
+			var err error
+			topUpPB, err = LoanAPI.TopUpLoan(ctx, &loan.TopUpLoanRequest{
+				LoanId:      parentPB.LoanId,
+				TopUpAmount: 500,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			cancelRes, err := LoanAPI.CancelLoan(ctx, &loan.CancelLoanRequest{LoanId: topUpPB.LoanId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cancelRes.Status).Should(Equal(loan.LoanStatus_CANCELLED))
+			Expect(getLoan(parentPB.LoanId).Status).ShouldNot(Equal(loan.LoanStatus_REFINANCED.String()))
+		})
+
+		It("should fail when the loan is no longer awaiting approval", func() {
+			cancelRes, err := LoanAPI.CancelLoan(ctx, &loan.CancelLoanRequest{LoanId: topUpPB.LoanId})
+			Expect(err).Should(HaveOccurred())
+			Expect(cancelRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should allow topping up the loan again", func() {
+			topUpRes, err := LoanAPI.TopUpLoan(ctx, &loan.TopUpLoanRequest{
+				LoanId:      parentPB.LoanId,
+				TopUpAmount: 500,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(topUpRes.ParentLoanId).Should(Equal(parentPB.LoanId))
+		})
+	})
+
+	Describe("Paying out a top up whose parent was closed", func() {
+		It("should complete the top up without carrying over the closed parent", func() {
+			parentPB := createDisbursedLoan()
+
+			topUpPB, err := LoanAPI.TopUpLoan(ctx, &loan.TopUpLoanRequest{
+				LoanId:      parentPB.LoanId,
+				TopUpAmount: 500,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// The parent is written off while the top up is paid out
+			Expect(LoanAPIServer.SQLDB.Model(&models.Loan{}).Where("id = ?", parentPB.LoanId).
+				Update("status", loan.LoanStatus_WRITTEN_OFF.String()).Error).ShouldNot(HaveOccurred())
+			Expect(LoanAPIServer.SQLDB.Model(&models.Loan{}).Where("id = ?", topUpPB.LoanId).
+				Update("status", loan.LoanStatus_FUNDS_WITHDRAWN_ACCOUNT.String()).Error).ShouldNot(HaveOccurred())
+
+			PayoutProvider.FailPayouts(false)
+			Expect(LoanAPIServer.disburse(ctx, "1", "1", topUpPB)).ShouldNot(HaveOccurred())
+
+			result := <-PayoutProvider.Results()
+			Expect(result.Succeeded).Should(BeTrue())
+			Expect(LoanAPIServer.completePayout(ctx, result)).ShouldNot(HaveOccurred())
+
+			topUpDB := getLoan(topUpPB.LoanId)
+			Expect(topUpDB.Status).Should(Equal(loan.LoanStatus_FUNDS_TRANSFERED.String()))
+			Expect(topUpDB.CarriedOver).Should(BeZero())
+			Expect(topUpDB.LoanAmount).Should(BeNumerically("~", 500, 0.01))
+			Expect(getLoan(parentPB.LoanId).Status).Should(Equal(loan.LoanStatus_WRITTEN_OFF.String()))
+
+			var count int64
+			Expect(LoanAPIServer.SQLDB.Model(&models.LoanInstallment{}).
+				Where("loan_id = ?", topUpPB.LoanId).Count(&count).Error).ShouldNot(HaveOccurred())
+			Expect(count).ShouldNot(BeZero())
+
+			payoutDB := &models.LoanPayout{}
+			Expect(LoanAPIServer.SQLDB.First(payoutDB, "loan_id = ?", topUpPB.LoanId).Error).ShouldNot(HaveOccurred())
+			Expect(payoutDB.Status).Should(Equal(models.PayoutCompleted))
+			Expect(payoutDB.ReconcileReason).ShouldNot(BeEmpty())
+		})
+	})
+
+	Describe("Restructure with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			restructureRes, err := LoanAPI.RestructureLoan(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(restructureRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when duration is missing", func() {
+			restructureRes, err := LoanAPI.RestructureLoan(ctx, &loan.RestructureLoanRequest{LoanId: "1"})
+			Expect(err).Should(HaveOccurred())
+			Expect(restructureRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Restructuring a loan", func() {
+		var parentPB *loan.Loan
+
+		It("should stretch the outstanding balance over a new schedule", func() {
+			parentPB = createDisbursedLoan()
+
+			restructureRes, err := LoanAPI.RestructureLoan(ctx, &loan.RestructureLoanRequest{
+				LoanId:       parentPB.LoanId,
+				DurationDays: 180,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(restructureRes.ParentLoanId).Should(Equal(parentPB.LoanId))
+			Expect(restructureRes.LoanAmount).Should(BeNumerically("~", 1100, 0.01))
+			Expect(restructureRes.Status).Should(Equal(loan.LoanStatus_FUNDS_TRANSFERED))
+
+			Expect(getLoan(parentPB.LoanId).Status).Should(Equal(loan.LoanStatus_REFINANCED.String()))
+
+			getRes, err := LoanAPI.GetLoan(ctx, &loan.GetLoanRequest{LoanId: restructureRes.LoanId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.Installments).ShouldNot(BeEmpty())
+		})
+
+		It("should fail when the loan has already been refinanced", func() {
+			restructureRes, err := LoanAPI.RestructureLoan(ctx, &loan.RestructureLoanRequest{
+				LoanId:       parentPB.LoanId,
+				DurationDays: 180,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(restructureRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+})
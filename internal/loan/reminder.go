@@ -0,0 +1,476 @@
+package loan
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"text/template"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/notification"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/pkg/utils/mdutil"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const reminderSubject = "Loan repayment reminder"
+
+var defaultReminderBodies = map[loan.NotificationEvent]string{
+	loan.NotificationEvent_INSTALLMENT_UPCOMING: "Dear {{.Names}}, installment {{.Installment}} of loan {{.LoanID}} amounting to {{printf \"%.2f\" .AmountDue}} is due on {{.DueDate}}.",
+	loan.NotificationEvent_INSTALLMENT_DUE:      "Dear {{.Names}}, installment {{.Installment}} of loan {{.LoanID}} amounting to {{printf \"%.2f\" .AmountDue}} is due today.",
+	loan.NotificationEvent_LOAN_IN_ARREARS:      "Dear {{.Names}}, installment {{.Installment}} of loan {{.LoanID}} amounting to {{printf \"%.2f\" .AmountDue}} was due on {{.DueDate}} and is {{.DaysOverdue}} days overdue. Please pay to avoid penalties.",
+}
+
+// reminderData is the data available to notification templates
+type reminderData struct {
+	Names       string
+	LoanID      string
+	Installment int32
+	AmountDue   float64
+	DueDate     string
+	DaysOverdue int
+}
+
+func renderReminder(body string, data *reminderData) (string, error) {
+	tmpl, err := template.New("reminder").Option("missingkey=error").Parse(body)
+	if err != nil {
+		return "", errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse notification template")
+	}
+
+	buf := &bytes.Buffer{}
+	err = tmpl.Execute(buf, data)
+	if err != nil {
+		return "", errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to render notification template")
+	}
+
+	return buf.String(), nil
+}
+
+func (loanAPI *loanAPIServer) runReminders(ctx context.Context) {
+	ticker := time.NewTicker(loanAPI.ReminderInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := loanAPI.dispatchReminders(ctx, time.Now())
+			if err != nil {
+				loanAPI.Logger.Errorf("failed to dispatch loan reminders: %v", err)
+			}
+		}
+	}
+}
+
+// reminderEvent is the notification an unpaid installment calls for today, if any
+func reminderEvent(installment *models.LoanInstallment, today time.Time, daysBefore int32) loan.NotificationEvent {
+	dueDay := startOfDay(installment.DueDate)
+	switch {
+	case dueDay.Before(today):
+		return loan.NotificationEvent_LOAN_IN_ARREARS
+	case dueDay.Equal(today):
+		return loan.NotificationEvent_INSTALLMENT_DUE
+	case !dueDay.After(today.AddDate(0, 0, int(daysBefore))):
+		return loan.NotificationEvent_INSTALLMENT_UPCOMING
+	}
+	return loan.NotificationEvent_NOTIFICATION_EVENT_UNSPECIFIED
+}
+
+// notificationTemplate gets the template a chama uses for an event, falling back to the default template
+func (loanAPI *loanAPIServer) notificationTemplate(
+	chamaID string, event loan.NotificationEvent, channel loan.NotificationChannel,
+) (*loan.NotificationTemplate, error) {
+	db := &models.NotificationTemplate{}
+	err := loanAPI.SQLDB.First(db, "chama_id = ? AND event = ? AND channel = ?", chamaID, event.String(), channel.String()).Error
+	switch {
+	case err == nil:
+		return models.NotificationTemplateProto(db)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return &loan.NotificationTemplate{
+			ChamaId:    chamaID,
+			Event:      event,
+			Channel:    channel,
+			Body:       defaultReminderBodies[event],
+			DaysBefore: loanAPI.ReminderDaysBefore,
+		}, nil
+	default:
+		return nil, errs.FailedToFind("notification template", err)
+	}
+}
+
+// dispatchReminders notifies borrowers of upcoming, due and overdue installments. Each installment gets every notification at most once per channel.
+func (loanAPI *loanAPIServer) dispatchReminders(ctx context.Context, now time.Time) error {
+	today := startOfDay(now)
+
+	var maxDaysBefore int32
+	err := loanAPI.SQLDB.Model(&models.NotificationTemplate{}).Select("COALESCE(MAX(days_before), 0)").
+		Where("event = ?", loan.NotificationEvent_INSTALLMENT_UPCOMING.String()).Scan(&maxDaysBefore).Error
+	if err != nil {
+		return errs.FailedToFind("notification templates", err)
+	}
+	if maxDaysBefore < loanAPI.ReminderDaysBefore {
+		maxDaysBefore = loanAPI.ReminderDaysBefore
+	}
+
+	installments := make([]*models.LoanInstallment, 0)
+	err = loanAPI.SQLDB.Model(&models.LoanInstallment{}).Select("loan_installments.*").
+		Joins("JOIN loans ON loans.id = loan_installments.loan_id").
+		Where("loan_installments.due_date < ? AND loan_installments.amount_paid < loan_installments.amount_due", today.AddDate(0, 0, int(maxDaysBefore)+1)).
+		Where("loans.status NOT IN (?)", closedLoanStatuses).
+		Order("loan_installments.loan_id ASC, loan_installments.installment_number ASC").Find(&installments).Error
+	if err != nil {
+		return errs.FailedToFind("unpaid installments", err)
+	}
+
+	if len(installments) == 0 {
+		return nil
+	}
+
+	ctxExt, err := loanAPI.systemCtx(ctx)
+	if err != nil {
+		return err
+	}
+	ctxExt = mdutil.AddFromCtx(ctxExt)
+
+	senders := map[loan.NotificationChannel]notification.Sender{}
+	if loanAPI.SMSSender != nil {
+		senders[loan.NotificationChannel_CHANNEL_SMS] = loanAPI.SMSSender
+	}
+	if loanAPI.EmailSender != nil {
+		senders[loan.NotificationChannel_CHANNEL_EMAIL] = loanAPI.EmailSender
+	}
+
+	loans := make(map[uint]*models.Loan)
+
+	for _, installment := range installments {
+		loanDB, ok := loans[installment.LoanID]
+		if !ok {
+			loanDB = &models.Loan{}
+			err = loanAPI.SQLDB.First(loanDB, "id = ?", installment.LoanID).Error
+			if err != nil {
+				return errs.FailedToFind("loan", err)
+			}
+			loans[installment.LoanID] = loanDB
+		}
+
+		for channel, sender := range senders {
+			recipient := loanDB.LoaneePhone
+			if channel == loan.NotificationChannel_CHANNEL_EMAIL {
+				recipient = loanDB.LoaneeEmail
+			}
+			if recipient == "" {
+				continue
+			}
+
+			err = loanAPI.sendReminder(ctxExt, sender, channel, recipient, loanDB, installment, today)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (loanAPI *loanAPIServer) sendReminder(
+	ctx context.Context,
+	sender notification.Sender,
+	channel loan.NotificationChannel,
+	recipient string,
+	loanDB *models.Loan,
+	installment *models.LoanInstallment,
+	today time.Time,
+) error {
+	// The upcoming reminder lead time is configured on its template
+	upcomingPB, err := loanAPI.notificationTemplate(loanDB.ChamaID, loan.NotificationEvent_INSTALLMENT_UPCOMING, channel)
+	if err != nil {
+		return err
+	}
+
+	event := reminderEvent(installment, today, upcomingPB.DaysBefore)
+	if event == loan.NotificationEvent_NOTIFICATION_EVENT_UNSPECIFIED {
+		return nil
+	}
+
+	templatePB := upcomingPB
+	if event != loan.NotificationEvent_INSTALLMENT_UPCOMING {
+		templatePB, err = loanAPI.notificationTemplate(loanDB.ChamaID, event, channel)
+		if err != nil {
+			return err
+		}
+	}
+
+	if templatePB.Disabled {
+		return nil
+	}
+
+	// Delivered notifications are not sent again while failed ones are retried
+	deliveryDB := &models.NotificationDelivery{}
+	err = loanAPI.SQLDB.First(
+		deliveryDB, "installment_id = ? AND event = ? AND channel = ?", installment.ID, event.String(), channel.String(),
+	).Error
+	switch {
+	case err == nil:
+		if deliveryDB.Status != loan.DeliveryStatus_DELIVERY_FAILED.String() {
+			return nil
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+	default:
+		return errs.FailedToFind("notification delivery", err)
+	}
+
+	message, err := renderReminder(templatePB.Body, &reminderData{
+		Names:       loanDB.LoaneeNames,
+		LoanID:      fmt.Sprint(loanDB.ID),
+		Installment: installment.InstallmentNumber,
+		AmountDue:   roundAmount(installment.AmountDue - installment.AmountPaid),
+		DueDate:     installment.DueDate.Format(chargeDateLayout),
+		DaysOverdue: int(today.Sub(startOfDay(installment.DueDate)).Hours() / 24),
+	})
+	if err != nil {
+		return err
+	}
+
+	if deliveryDB.ID == 0 {
+		deliveryDB = &models.NotificationDelivery{
+			ChamaID:       loanDB.ChamaID,
+			LoanID:        loanDB.ID,
+			InstallmentID: installment.ID,
+			Installment:   installment.InstallmentNumber,
+			Event:         event.String(),
+			Channel:       channel.String(),
+			Status:        loan.DeliveryStatus_DELIVERY_PENDING.String(),
+		}
+		db := loanAPI.SQLDB.Clauses(clause.OnConflict{DoNothing: true}).Create(deliveryDB)
+		if db.Error != nil {
+			return errs.FailedToSave("notification delivery", db.Error)
+		}
+		// Another dispatcher got to it first
+		if db.RowsAffected == 0 {
+			return nil
+		}
+	}
+
+	updates := map[string]interface{}{
+		"recipient":      recipient,
+		"message":        message,
+		"status":         loan.DeliveryStatus_DELIVERY_SENT.String(),
+		"failure_reason": "",
+	}
+
+	err = sender.Send(ctx, &notification.Message{
+		Reference: fmt.Sprint(deliveryDB.ID),
+		Recipient: recipient,
+		Subject:   reminderSubject,
+		Body:      message,
+	})
+	if err != nil {
+		reason := err.Error()
+		if len(reason) > 200 {
+			reason = reason[:200]
+		}
+		updates["status"] = loan.DeliveryStatus_DELIVERY_FAILED.String()
+		updates["failure_reason"] = reason
+	}
+
+	err = loanAPI.SQLDB.Model(deliveryDB).Updates(updates).Error
+	if err != nil {
+		return errs.FailedToUpdate("notification delivery", err)
+	}
+
+	return nil
+}
+
+func (loanAPI *loanAPIServer) SetNotificationTemplate(
+	ctx context.Context, req *loan.SetNotificationTemplateRequest,
+) (*loan.NotificationTemplate, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.Template == nil:
+		return nil, errs.MissingField("notification template")
+	case req.Template.ChamaId == "":
+		return nil, errs.MissingField("chama id")
+	case req.Template.Event == loan.NotificationEvent_NOTIFICATION_EVENT_UNSPECIFIED:
+		return nil, errs.MissingField("notification event")
+	case req.Template.Body == "" && !req.Template.Disabled:
+		return nil, errs.MissingField("template body")
+	case req.Template.DaysBefore < 0:
+		return nil, errs.IncorrectVal("days before")
+	}
+
+	// Authorization
+	_, err := loanAPI.authorizer.AuthorizeChama(ctx, req.Template.ChamaId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Template.Body != "" {
+		_, err = renderReminder(req.Template.Body, &reminderData{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := models.NotificationTemplateModel(req.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	err = loanAPI.SQLDB.Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"body", "days_before", "disabled", "updated_at"}),
+	}).Create(db).Error
+	if err != nil {
+		return nil, errs.FailedToSave("notification template", err)
+	}
+
+	return loanAPI.notificationTemplate(req.Template.ChamaId, req.Template.Event, req.Template.Channel)
+}
+
+func (loanAPI *loanAPIServer) ListNotificationTemplates(
+	ctx context.Context, req *loan.ListNotificationTemplatesRequest,
+) (*loan.ListNotificationTemplatesResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.ChamaId == "":
+		return nil, errs.MissingField("chama id")
+	}
+
+	// Authorization
+	_, err := loanAPI.authorizer.AuthorizeChama(ctx, req.ChamaId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Templates in effect for the chama, defaults included
+	pbs := make([]*loan.NotificationTemplate, 0)
+	for channel := range loan.NotificationChannel_name {
+		for event := range defaultReminderBodies {
+			pb, err := loanAPI.notificationTemplate(req.ChamaId, event, loan.NotificationChannel(channel))
+			if err != nil {
+				return nil, err
+			}
+			pbs = append(pbs, pb)
+		}
+	}
+
+	sort.Slice(pbs, func(i, j int) bool {
+		if pbs[i].Channel != pbs[j].Channel {
+			return pbs[i].Channel < pbs[j].Channel
+		}
+		return pbs[i].Event < pbs[j].Event
+	})
+
+	return &loan.ListNotificationTemplatesResponse{
+		Templates: pbs,
+	}, nil
+}
+
+func (loanAPI *loanAPIServer) ListNotificationDeliveries(
+	ctx context.Context, req *loan.ListNotificationDeliveriesRequest,
+) (*loan.ListNotificationDeliveriesResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("list request")
+	}
+
+	deliveryChamas := make([]string, 0, 1)
+	if req.GetFilter().GetChamaId() != "" {
+		deliveryChamas = append(deliveryChamas, req.Filter.ChamaId)
+	}
+
+	// Authorization
+	actor, chamaIDs, err := loanAPI.authorizer.ScopeChamas(ctx, deliveryChamas, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.GetPageSize()
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultPageSize
+	case pageSize > defaultPageSize:
+		if !loanAPI.Auth.IsAdmin(actor.Group) {
+			pageSize = defaultPageSize
+		}
+	}
+
+	var ID uint
+	pageToken := req.GetPageToken()
+	if pageToken != "" {
+		ids, err := loanAPI.PageHasher.DecodeInt64WithError(req.GetPageToken())
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		ID = uint(ids[0])
+	}
+
+	db := loanAPI.SQLDB.Limit(int(pageSize + 1)).Order("id DESC")
+	if ID != 0 {
+		db = db.Where("id<?", ID)
+	}
+
+	if chamaIDs != nil {
+		db = db.Where("chama_id IN (?)", chamaIDs)
+	}
+	if req.Filter != nil {
+		if req.Filter.LoanId != "" {
+			db = db.Where("loan_id = ?", req.Filter.LoanId)
+		}
+		if len(req.Filter.Statuses) != 0 {
+			statuses := make([]string, 0, len(req.Filter.Statuses))
+			for _, status := range req.Filter.Statuses {
+				statuses = append(statuses, status.String())
+			}
+			db = db.Where("status IN (?)", statuses)
+		}
+	}
+
+	dbs := make([]*models.NotificationDelivery, 0, pageSize+1)
+	err = db.Find(&dbs).Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "LIST")
+	}
+
+	pbs := make([]*loan.NotificationDelivery, 0, len(dbs))
+	for i, db := range dbs {
+		if i == int(pageSize) {
+			break
+		}
+
+		pb, err := models.NotificationDeliveryProto(db)
+		if err != nil {
+			return nil, err
+		}
+
+		pbs = append(pbs, pb)
+
+		ID = db.ID
+	}
+
+	var token string
+	if len(dbs) > int(pageSize) {
+		// Next page token
+		token, err = loanAPI.PageHasher.EncodeInt64([]int64{int64(ID)})
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to generate next page token")
+		}
+	}
+
+	return &loan.ListNotificationDeliveriesResponse{
+		NextPageToken: token,
+		Deliveries:    pbs,
+	}, nil
+}
@@ -0,0 +1,211 @@
+package loan
+
+import (
+	"context"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/notification"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Repayment reminders", func() {
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+	})
+
+	// createLoanDueIn saves a disbursed loan with a single installment due in the given number of days
+	createLoanDueIn := func(days int) *loan.Loan {
+		pb := mockLoan()
+		pb.DurationDays = 10
+		loanPB, err := createDisbursedLoan(pb, time.Now().AddDate(0, 0, days-10))
+		Expect(err).ShouldNot(HaveOccurred())
+		return loanPB
+	}
+
+	loanDeliveries := func(loanID string) []*loan.NotificationDelivery {
+		listRes, err := LoanAPI.ListNotificationDeliveries(ctx, &loan.ListNotificationDeliveriesRequest{
+			Filter: &loan.NotificationDeliveryFilter{LoanId: loanID},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		return listRes.Deliveries
+	}
+
+	messagesTo := func(phone string) []*notification.Message {
+		msgs := make([]*notification.Message, 0)
+		for _, msg := range SMSSender.Messages() {
+			if msg.Recipient == phone {
+				msgs = append(msgs, msg)
+			}
+		}
+		return msgs
+	}
+
+	Describe("SetNotificationTemplate with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			setRes, err := LoanAPI.SetNotificationTemplate(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(setRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when chama id is missing", func() {
+			setRes, err := LoanAPI.SetNotificationTemplate(ctx, &loan.SetNotificationTemplateRequest{
+				Template: &loan.NotificationTemplate{
+					Event: loan.NotificationEvent_INSTALLMENT_DUE,
+					Body:  "Pay up",
+				},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(setRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when event is missing", func() {
+			setRes, err := LoanAPI.SetNotificationTemplate(ctx, &loan.SetNotificationTemplateRequest{
+				Template: &loan.NotificationTemplate{
+					ChamaId: "1",
+					Body:    "Pay up",
+				},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(setRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the template references unknown fields", func() {
+			setRes, err := LoanAPI.SetNotificationTemplate(ctx, &loan.SetNotificationTemplateRequest{
+				Template: &loan.NotificationTemplate{
+					ChamaId: "1",
+					Event:   loan.NotificationEvent_INSTALLMENT_DUE,
+					Body:    "Dear {{.Nickname}}",
+				},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(setRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Dispatching reminders", func() {
+		It("should remind borrowers of upcoming installments", func() {
+			loanPB := createLoanDueIn(2)
+
+			Expect(LoanAPIServer.dispatchReminders(ctx, time.Now())).ShouldNot(HaveOccurred())
+
+			deliveries := loanDeliveries(loanPB.LoanId)
+			Expect(deliveries).Should(HaveLen(1))
+			Expect(deliveries[0].Event).Should(Equal(loan.NotificationEvent_INSTALLMENT_UPCOMING))
+			Expect(deliveries[0].Status).Should(Equal(loan.DeliveryStatus_DELIVERY_SENT))
+			Expect(messagesTo(loanPB.LoaneePhone)).Should(HaveLen(1))
+		})
+
+		It("should remind borrowers on the due date only once", func() {
+			loanPB := createLoanDueIn(0)
+
+			Expect(LoanAPIServer.dispatchReminders(ctx, time.Now())).ShouldNot(HaveOccurred())
+			Expect(LoanAPIServer.dispatchReminders(ctx, time.Now())).ShouldNot(HaveOccurred())
+
+			deliveries := loanDeliveries(loanPB.LoanId)
+			Expect(deliveries).Should(HaveLen(1))
+			Expect(deliveries[0].Event).Should(Equal(loan.NotificationEvent_INSTALLMENT_DUE))
+			Expect(messagesTo(loanPB.LoaneePhone)).Should(HaveLen(1))
+		})
+
+		It("should notify borrowers when the loan falls into arrears", func() {
+			loanPB := createLoanDueIn(-5)
+
+			Expect(LoanAPIServer.dispatchReminders(ctx, time.Now())).ShouldNot(HaveOccurred())
+
+			deliveries := loanDeliveries(loanPB.LoanId)
+			Expect(deliveries).Should(HaveLen(1))
+			Expect(deliveries[0].Event).Should(Equal(loan.NotificationEvent_LOAN_IN_ARREARS))
+			Expect(deliveries[0].Message).Should(ContainSubstring("5 days overdue"))
+		})
+
+		It("should not remind borrowers of installments that are far off", func() {
+			loanPB := createLoanDueIn(8)
+
+			Expect(LoanAPIServer.dispatchReminders(ctx, time.Now())).ShouldNot(HaveOccurred())
+			Expect(loanDeliveries(loanPB.LoanId)).Should(BeEmpty())
+		})
+
+		It("should log failed deliveries and retry them", func() {
+			loanPB := createLoanDueIn(0)
+
+			SMSSender.FailSends(true)
+			Expect(LoanAPIServer.dispatchReminders(ctx, time.Now())).ShouldNot(HaveOccurred())
+			SMSSender.FailSends(false)
+
+			deliveries := loanDeliveries(loanPB.LoanId)
+			Expect(deliveries).Should(HaveLen(1))
+			Expect(deliveries[0].Status).Should(Equal(loan.DeliveryStatus_DELIVERY_FAILED))
+			Expect(deliveries[0].FailureReason).ShouldNot(BeEmpty())
+
+			Expect(LoanAPIServer.dispatchReminders(ctx, time.Now())).ShouldNot(HaveOccurred())
+
+			deliveries = loanDeliveries(loanPB.LoanId)
+			Expect(deliveries).Should(HaveLen(1))
+			Expect(deliveries[0].Status).Should(Equal(loan.DeliveryStatus_DELIVERY_SENT))
+			Expect(messagesTo(loanPB.LoaneePhone)).Should(HaveLen(1))
+		})
+	})
+
+	Describe("Chama templates", func() {
+		It("should use the chama template and lead time", func() {
+			loanPB := createLoanDueIn(6)
+
+			templatePB, err := LoanAPI.SetNotificationTemplate(ctx, &loan.SetNotificationTemplateRequest{
+				Template: &loan.NotificationTemplate{
+					ChamaId:    loanPB.ChamaId,
+					Event:      loan.NotificationEvent_INSTALLMENT_UPCOMING,
+					Body:       "Habari {{.Names}}, lipa {{printf \"%.2f\" .AmountDue}} kufikia {{.DueDate}}",
+					DaysBefore: 7,
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(templatePB.TemplateId).ShouldNot(BeEmpty())
+
+			Expect(LoanAPIServer.dispatchReminders(ctx, time.Now())).ShouldNot(HaveOccurred())
+
+			msgs := messagesTo(loanPB.LoaneePhone)
+			Expect(msgs).Should(HaveLen(1))
+			Expect(msgs[0].Body).Should(HavePrefix("Habari " + loanPB.LoaneeNames))
+
+			listRes, err := LoanAPI.ListNotificationTemplates(ctx, &loan.ListNotificationTemplatesRequest{
+				ChamaId: loanPB.ChamaId,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Templates).Should(HaveLen(6))
+		})
+
+		It("should not send notifications the chama has disabled", func() {
+			loanPB := createLoanDueIn(-1)
+
+			_, err := LoanAPI.SetNotificationTemplate(ctx, &loan.SetNotificationTemplateRequest{
+				Template: &loan.NotificationTemplate{
+					ChamaId:  loanPB.ChamaId,
+					Event:    loan.NotificationEvent_LOAN_IN_ARREARS,
+					Disabled: true,
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(LoanAPIServer.dispatchReminders(ctx, time.Now())).ShouldNot(HaveOccurred())
+			Expect(loanDeliveries(loanPB.LoanId)).Should(BeEmpty())
+
+			_, err = LoanAPI.SetNotificationTemplate(ctx, &loan.SetNotificationTemplateRequest{
+				Template: &loan.NotificationTemplate{
+					ChamaId: loanPB.ChamaId,
+					Event:   loan.NotificationEvent_LOAN_IN_ARREARS,
+					Body:    defaultReminderBodies[loan.NotificationEvent_LOAN_IN_ARREARS],
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,149 @@
+package loan
+
+import (
+	"math"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"gorm.io/gorm"
+)
+
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// schedulePeriodDays is the number of days between installments of a loan
+func schedulePeriodDays(durationDays, periodDays int32) int32 {
+	if durationDays <= 0 {
+		durationDays = 1
+	}
+	if periodDays <= 0 || periodDays > durationDays {
+		return durationDays
+	}
+	return periodDays
+}
+
+// periodInterest is the flat interest the loan accrues over a number of days
+func periodInterest(loanDB *models.Loan, days int32) float64 {
+	if loanDB.DurationDays <= 0 {
+		return 0
+	}
+	return roundAmount(loanDB.LoanAmount * float64(loanDB.InterestRate) / 100 * float64(days) / float64(loanDB.DurationDays))
+}
+
+// generateSchedule splits the loan principal and its flat interest into equal installments starting from start.
+// An interest only grace period defers principal to the later installments while a moratorium defers the whole schedule.
+func generateSchedule(loanDB *models.Loan, periodDays int32, grace *loan.GracePolicy, start time.Time) []*models.LoanInstallment {
+	durationDays := loanDB.DurationDays
+	if durationDays <= 0 {
+		durationDays = 1
+	}
+	periodDays = schedulePeriodDays(durationDays, periodDays)
+
+	count := int(math.Ceil(float64(durationDays) / float64(periodDays)))
+
+	principal := loanDB.LoanAmount
+	interest := roundAmount(loanDB.LoanAmount * float64(loanDB.InterestRate) / 100)
+
+	interestOnly := 0
+	switch grace.GetGraceType() {
+	case loan.GraceType_INTEREST_ONLY:
+		interestOnly = int(math.Min(float64(grace.GracePeriods), float64(count-1)))
+	case loan.GraceType_FULL_MORATORIUM:
+		moratoriumDays := grace.GracePeriods * periodDays
+		start = start.AddDate(0, 0, int(moratoriumDays))
+		if grace.CapitalizeInterest {
+			interest = roundAmount(interest + periodInterest(loanDB, moratoriumDays))
+		}
+	}
+
+	principalPart := roundAmount(principal / float64(count-interestOnly))
+	interestPart := roundAmount(interest / float64(count))
+
+	installments := make([]*models.LoanInstallment, 0, count)
+	for i := 1; i <= count; i++ {
+		dueDays := i * int(periodDays)
+		installmentPrincipal := principalPart
+		if i <= interestOnly {
+			installmentPrincipal = 0
+		}
+		if i == count {
+			dueDays = int(durationDays)
+			// Last installment absorbs rounding differences
+			installmentPrincipal = roundAmount(principal - principalPart*float64(count-interestOnly-1))
+			interestPart = roundAmount(interest - interestPart*float64(count-1))
+		}
+		installments = append(installments, &models.LoanInstallment{
+			LoanID:            loanDB.ID,
+			InstallmentNumber: int32(i),
+			PrincipalDue:      installmentPrincipal,
+			InterestDue:       interestPart,
+			AmountDue:         roundAmount(installmentPrincipal + interestPart),
+			DueDate:           start.AddDate(0, 0, dueDays),
+		})
+	}
+
+	return installments
+}
+
+// createSchedule saves the repayment schedule of a loan if it doesn't have one
+func (loanAPI *loanAPIServer) createSchedule(loanID string, start time.Time) error {
+	loanDB := &models.Loan{}
+	err := loanAPI.SQLDB.First(loanDB, "id = ?", loanID).Error
+	if err != nil {
+		return errs.FailedToFind("loan", err)
+	}
+
+	var count int64
+	err = loanAPI.SQLDB.Model(&models.LoanInstallment{}).Where("loan_id = ?", loanDB.ID).Count(&count).Error
+	if err != nil {
+		return errs.FailedToFind("loan installments", err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	productPB, err := loanAPI.productTerms(loanDB.ProductID, loanDB.ProductVersion)
+	if err != nil {
+		return err
+	}
+
+	err = loanAPI.SQLDB.Create(generateSchedule(loanDB, productPB.RepaymentPeriodDays, productPB.GracePolicy, start)).Error
+	if err != nil {
+		return errs.FailedToSave("loan installments", err)
+	}
+
+	return nil
+}
+
+// allocateRepayments distributes the settled amount across the loan installments in order of due date.
+// Interest of an installment is paid before its principal. It returns the principal and interest newly paid.
+func allocateRepayments(tx *gorm.DB, loanID uint, settledAmount float64) (principal, interest float64, err error) {
+	installments := make([]*models.LoanInstallment, 0)
+	err = tx.Order("installment_number ASC").Find(&installments, "loan_id = ?", loanID).Error
+	if err != nil {
+		return 0, 0, errs.FailedToFind("loan installments", err)
+	}
+
+	remaining := settledAmount
+	for _, installment := range installments {
+		paid := math.Min(math.Max(remaining, 0), installment.AmountDue)
+		remaining -= paid
+		if paid == installment.AmountPaid {
+			continue
+		}
+
+		interestPaid := math.Min(paid, installment.InterestDue) - math.Min(installment.AmountPaid, installment.InterestDue)
+		interest += interestPaid
+		principal += paid - installment.AmountPaid - interestPaid
+
+		err = tx.Model(installment).Update("amount_paid", roundAmount(paid)).Error
+		if err != nil {
+			return 0, 0, errs.FailedToUpdate("loan installment", err)
+		}
+	}
+
+	return roundAmount(principal), roundAmount(interest), nil
+}
@@ -0,0 +1,414 @@
+package loan
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/document"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"gorm.io/gorm"
+)
+
+// statementEntry is a statement line with the time used to order it
+type statementEntry struct {
+	at    time.Time
+	entry *loan.StatementEntry
+}
+
+func (loanAPI *loanAPIServer) GetLoanStatement(
+	ctx context.Context, req *loan.GetLoanStatementRequest,
+) (*loan.GetLoanStatementResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.LoanId == "" && req.MemberId == "":
+		return nil, errs.MissingField("loan id or member id")
+	}
+
+	// Authorization
+	var err error
+	if req.LoanId != "" {
+		_, err = loanAPI.authorizer.AuthorizeLoan(ctx, req.LoanId, roles.Officers...)
+	} else {
+		_, _, err = loanAPI.authorizer.AuthorizeMember(ctx, req.MemberId, roles.Officers...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	loans := make([]*models.Loan, 0)
+	if req.LoanId != "" {
+		loanDB := &models.Loan{}
+		err = loanAPI.SQLDB.First(loanDB, "id = ?", req.LoanId).Error
+		switch {
+		case err == nil:
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, errs.DoesNotExist("loan", req.LoanId)
+		default:
+			return nil, errs.FailedToFind("loan", err)
+		}
+		loans = append(loans, loanDB)
+	} else {
+		err = loanAPI.SQLDB.Order("id ASC").Find(&loans, "member_id = ?", req.MemberId).Error
+		if err != nil {
+			return nil, errs.FailedToFind("loans", err)
+		}
+	}
+
+	statement, err := loanAPI.loanStatement(loans, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	statement.LoanId = req.LoanId
+	if statement.MemberId == "" {
+		statement.MemberId = req.MemberId
+	}
+
+	res := &loan.GetLoanStatementResponse{
+		Statement: statement,
+	}
+
+	name := "loan-statement-" + req.LoanId
+	if req.LoanId == "" {
+		name = "member-loan-statement-" + req.MemberId
+	}
+
+	switch req.Format {
+	case loan.StatementFormat_STATEMENT_CSV:
+		res.Document, err = statementCSV(statement)
+		if err != nil {
+			return nil, err
+		}
+		res.ContentType = "text/csv"
+		res.FileName = name + ".csv"
+	case loan.StatementFormat_STATEMENT_PDF:
+		res.Document = document.TextPDF("Loan statement", statementLines(statement))
+		res.ContentType = "application/pdf"
+		res.FileName = name + ".pdf"
+	}
+
+	return res, nil
+}
+
+// loanStatement lists the activity of disbursed loans in date order with a running balance.
+// Interest accrues on the due date of its installment except on closed loans, whose whole schedule fell due when they closed.
+func (loanAPI *loanAPIServer) loanStatement(loans []*models.Loan, now time.Time) (*loan.LoanStatement, error) {
+	statement := &loan.LoanStatement{
+		StatementDate: now.Format(chargeDateLayout),
+		Entries:       make([]*loan.StatementEntry, 0),
+	}
+
+	if len(loans) == 0 {
+		return statement, nil
+	}
+
+	statement.MemberId = loans[0].MemberID
+	statement.LoaneeNames = loans[0].LoaneeNames
+
+	loanIDs := make([]uint, 0, len(loans))
+	for _, loanDB := range loans {
+		loanIDs = append(loanIDs, loanDB.ID)
+	}
+
+	installments := make([]*models.LoanInstallment, 0)
+	err := loanAPI.SQLDB.Order("loan_id ASC, installment_number ASC").Find(&installments, "loan_id IN (?)", loanIDs).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan installments", err)
+	}
+
+	charges := make([]*models.LoanCharge, 0)
+	err = loanAPI.SQLDB.Find(&charges, "loan_id IN (?)", loanIDs).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan charges", err)
+	}
+
+	repayments := make([]*models.LoanRepayment, 0)
+	err = loanAPI.SQLDB.Find(&repayments, "loan_id IN (?)", loanIDs).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan repayments", err)
+	}
+
+	parentIDs := make([]string, 0, len(loans))
+	for _, loanDB := range loans {
+		parentIDs = append(parentIDs, fmt.Sprint(loanDB.ID))
+	}
+
+	children := make([]*models.Loan, 0)
+	err = loanAPI.SQLDB.Find(&children, "parent_loan_id IN (?) AND carried_over > 0", parentIDs).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loans", err)
+	}
+
+	schedules := make(map[uint][]*models.LoanInstallment, len(loans))
+	for _, installment := range installments {
+		schedules[installment.LoanID] = append(schedules[installment.LoanID], installment)
+	}
+
+	carriedOver := make(map[string]*models.Loan, len(children))
+	for _, child := range children {
+		carriedOver[child.ParentLoanID] = child
+	}
+
+	entries := make([]*statementEntry, 0)
+	for _, loanDB := range loans {
+		schedule := schedules[loanDB.ID]
+		if len(schedule) == 0 {
+			// Loan has not been disbursed
+			continue
+		}
+
+		loanID := fmt.Sprint(loanDB.ID)
+		closed := loanDB.Status == loan.LoanStatus_REFINANCED.String() || loanDB.Status == loan.LoanStatus_WRITTEN_OFF.String()
+
+		description := fmt.Sprintf("Loan disbursed, %.2f paid out net of %.2f fees", loanDB.NetAmount, loanDB.FeeAmount)
+		if loanDB.CarriedOver > 0 {
+			description += fmt.Sprintf(" and %.2f carried over from loan %s", loanDB.CarriedOver, loanDB.ParentLoanID)
+		}
+		entries = append(entries, &statementEntry{
+			at: schedule[0].CreatedAt,
+			entry: &loan.StatementEntry{
+				LoanId:          loanID,
+				EntryType:       loan.StatementEntryType_ENTRY_DISBURSEMENT,
+				Description:     description,
+				Debit:           loanDB.LoanAmount,
+				PrincipalAmount: loanDB.LoanAmount,
+			},
+		})
+		statement.TotalDisbursed += loanDB.LoanAmount
+
+		for _, installment := range schedule {
+			if installment.InterestDue == 0 || (!closed && installment.DueDate.After(now)) {
+				continue
+			}
+			at := installment.DueDate
+			if closed && at.After(loanDB.UpdatedAt) {
+				at = loanDB.UpdatedAt
+			}
+			entries = append(entries, &statementEntry{
+				at: at,
+				entry: &loan.StatementEntry{
+					LoanId:         loanID,
+					EntryType:      loan.StatementEntryType_ENTRY_INTEREST,
+					Description:    fmt.Sprintf("Interest on installment %d", installment.InstallmentNumber),
+					Debit:          installment.InterestDue,
+					InterestAmount: installment.InterestDue,
+				},
+			})
+			statement.TotalInterest += installment.InterestDue
+		}
+
+		switch {
+		case loanDB.Status == loan.LoanStatus_WRITTEN_OFF.String() && loanDB.WrittenOff > 0:
+			entries = append(entries, &statementEntry{
+				at: loanDB.UpdatedAt,
+				entry: &loan.StatementEntry{
+					LoanId:      loanID,
+					EntryType:   loan.StatementEntryType_ENTRY_WRITE_OFF,
+					Description: "Loan written off",
+					Credit:      loanDB.WrittenOff,
+				},
+			})
+		case carriedOver[loanID] != nil:
+			entries = append(entries, &statementEntry{
+				at: loanDB.UpdatedAt,
+				entry: &loan.StatementEntry{
+					LoanId:      loanID,
+					EntryType:   loan.StatementEntryType_ENTRY_CARRIED_OVER,
+					Description: fmt.Sprintf("Balance carried over to loan %d", carriedOver[loanID].ID),
+					Credit:      carriedOver[loanID].CarriedOver,
+				},
+			})
+		}
+
+		if !closed {
+			outstanding, err := outstandingBalance(loanAPI.SQLDB, loanDB)
+			if err != nil {
+				return nil, err
+			}
+			statement.OutstandingBalance += outstanding
+
+			for _, installment := range schedule {
+				if installment.AmountPaid >= installment.AmountDue {
+					continue
+				}
+				if statement.NextInstallment == nil || installment.DueDate.Format(chargeDateLayout) < statement.NextInstallment.DueDate {
+					statement.NextInstallment, err = models.LoanInstallmentProto(installment)
+					if err != nil {
+						return nil, err
+					}
+				}
+				break
+			}
+		}
+	}
+
+	for _, charge := range charges {
+		chargeDate, err := time.ParseInLocation(chargeDateLayout, charge.ChargeDate, now.Location())
+		if err != nil {
+			chargeDate = charge.CreatedAt
+		}
+		entries = append(entries, &statementEntry{
+			at: chargeDate,
+			entry: &loan.StatementEntry{
+				LoanId:        fmt.Sprint(charge.LoanID),
+				EntryType:     loan.StatementEntryType_ENTRY_CHARGE,
+				Description:   charge.Description,
+				Debit:         charge.Amount,
+				PenaltyAmount: charge.Amount,
+			},
+		})
+		statement.TotalCharges += charge.Amount
+	}
+
+	for _, repayment := range repayments {
+		description := "Repayment"
+		if repayment.QuoteID != 0 {
+			description = fmt.Sprintf("Settlement on payoff quote %d", repayment.QuoteID)
+		}
+		entries = append(entries, &statementEntry{
+			at: repayment.CreatedAt,
+			entry: &loan.StatementEntry{
+				LoanId:          fmt.Sprint(repayment.LoanID),
+				EntryType:       loan.StatementEntryType_ENTRY_REPAYMENT,
+				Description:     description,
+				Credit:          repayment.Amount,
+				PrincipalAmount: repayment.PrincipalAmount,
+				InterestAmount:  repayment.InterestAmount,
+				PenaltyAmount:   repayment.PenaltyAmount,
+			},
+		})
+		statement.TotalRepaid += repayment.Amount
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if !entries[i].at.Equal(entries[j].at) {
+			return entries[i].at.Before(entries[j].at)
+		}
+		return entries[i].entry.EntryType < entries[j].entry.EntryType
+	})
+
+	var balance float64
+	for _, entry := range entries {
+		balance = roundAmount(balance + entry.entry.Debit - entry.entry.Credit)
+		entry.entry.EntryDate = entry.at.Format(chargeDateLayout)
+		entry.entry.RunningBalance = balance
+		statement.Entries = append(statement.Entries, entry.entry)
+	}
+
+	statement.TotalDisbursed = roundAmount(statement.TotalDisbursed)
+	statement.TotalInterest = roundAmount(statement.TotalInterest)
+	statement.TotalCharges = roundAmount(statement.TotalCharges)
+	statement.TotalRepaid = roundAmount(statement.TotalRepaid)
+	statement.OutstandingBalance = roundAmount(statement.OutstandingBalance)
+
+	return statement, nil
+}
+
+// statementCSV exports the statement entries followed by its summary
+func statementCSV(statement *loan.LoanStatement) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+
+	records := [][]string{
+		{"date", "loan_id", "type", "description", "debit", "credit", "principal", "interest", "penalty", "balance"},
+	}
+	for _, entry := range statement.Entries {
+		records = append(records, []string{
+			entry.EntryDate,
+			entry.LoanId,
+			entryTypeName(entry.EntryType),
+			entry.Description,
+			fmt.Sprintf("%.2f", entry.Debit),
+			fmt.Sprintf("%.2f", entry.Credit),
+			fmt.Sprintf("%.2f", entry.PrincipalAmount),
+			fmt.Sprintf("%.2f", entry.InterestAmount),
+			fmt.Sprintf("%.2f", entry.PenaltyAmount),
+			fmt.Sprintf("%.2f", entry.RunningBalance),
+		})
+	}
+
+	records = append(records, []string{})
+	for _, line := range statementSummary(statement) {
+		records = append(records, []string{line[0], line[1]})
+	}
+
+	err := w.WriteAll(records)
+	if err != nil {
+		return nil, errs.WrapErrorWithMsg(err, "failed to write statement csv")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// statementLines lays out the statement as fixed width text
+func statementLines(statement *loan.LoanStatement) []string {
+	lines := []string{
+		"LOAN STATEMENT",
+		fmt.Sprintf("Member: %s (%s)", statement.LoaneeNames, statement.MemberId),
+	}
+	if statement.LoanId != "" {
+		lines = append(lines, "Loan: "+statement.LoanId)
+	}
+	lines = append(lines,
+		"Statement date: "+statement.StatementDate,
+		"",
+		fmt.Sprintf("%-10s %-6s %-12s %-50s %12s %12s %12s", "Date", "Loan", "Type", "Description", "Debit", "Credit", "Balance"),
+		strings.Repeat("-", 120),
+	)
+
+	for _, entry := range statement.Entries {
+		description := entry.Description
+		if len(description) > 50 {
+			description = description[:47] + "..."
+		}
+		lines = append(lines, fmt.Sprintf(
+			"%-10s %-6s %-12s %-50s %12.2f %12.2f %12.2f",
+			entry.EntryDate, entry.LoanId, entryTypeName(entry.EntryType), description, entry.Debit, entry.Credit, entry.RunningBalance,
+		))
+		if entry.EntryType == loan.StatementEntryType_ENTRY_REPAYMENT {
+			lines = append(lines, fmt.Sprintf(
+				"%31s principal %.2f, interest %.2f, penalty %.2f", "", entry.PrincipalAmount, entry.InterestAmount, entry.PenaltyAmount,
+			))
+		}
+	}
+
+	lines = append(lines, strings.Repeat("-", 120))
+	for _, line := range statementSummary(statement) {
+		lines = append(lines, fmt.Sprintf("%-22s %s", line[0]+":", line[1]))
+	}
+
+	return lines
+}
+
+func statementSummary(statement *loan.LoanStatement) [][2]string {
+	next := "none"
+	if statement.NextInstallment != nil {
+		installment := statement.NextInstallment
+		next = fmt.Sprintf(
+			"%.2f due %s on loan %s", installment.AmountDue-installment.AmountPaid, installment.DueDate, installment.LoanId,
+		)
+	}
+	return [][2]string{
+		{"Total disbursed", fmt.Sprintf("%.2f", statement.TotalDisbursed)},
+		{"Total interest", fmt.Sprintf("%.2f", statement.TotalInterest)},
+		{"Total charges", fmt.Sprintf("%.2f", statement.TotalCharges)},
+		{"Total repaid", fmt.Sprintf("%.2f", statement.TotalRepaid)},
+		{"Outstanding balance", fmt.Sprintf("%.2f", statement.OutstandingBalance)},
+		{"Next installment", next},
+	}
+}
+
+// entryTypeName is the entry type without its enum prefix
+func entryTypeName(entryType loan.StatementEntryType) string {
+	return strings.TrimPrefix(entryType.String(), "ENTRY_")
+}
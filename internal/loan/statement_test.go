@@ -0,0 +1,124 @@
+package loan
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"time"
+
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Getting loan statements", func() {
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+	})
+
+	Describe("GetLoanStatement with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			statementRes, err := LoanAPI.GetLoanStatement(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(statementRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when loan id and member id are missing", func() {
+			statementRes, err := LoanAPI.GetLoanStatement(ctx, &loan.GetLoanStatementRequest{})
+			Expect(err).Should(HaveOccurred())
+			Expect(statementRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the loan does not exist", func() {
+			statementRes, err := LoanAPI.GetLoanStatement(ctx, &loan.GetLoanStatementRequest{LoanId: "0"})
+			Expect(err).Should(HaveOccurred())
+			Expect(statementRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("Statement of a repaid loan", func() {
+		var loanPB *loan.Loan
+
+		BeforeEach(func() {
+			// A loan of 1000 at 10 percent with its single installment overdue by 10 days
+			pb := mockLoan()
+			pb.DurationDays = 30
+			var err error
+			loanPB, err = createDisbursedLoan(pb, time.Now().AddDate(0, 0, -40))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = LoanAPI.RepayLoan(ctx, &loan.RepayLoanRequest{
+				LoanId: loanPB.LoanId,
+				Amount: 300,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should list disbursement, interest and the allocated repayment", func() {
+			statementRes, err := LoanAPI.GetLoanStatement(ctx, &loan.GetLoanStatementRequest{LoanId: loanPB.LoanId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(statementRes.Document).Should(BeEmpty())
+
+			statement := statementRes.Statement
+			Expect(statement.Entries).Should(HaveLen(3))
+			Expect(statement.Entries[0].EntryType).Should(Equal(loan.StatementEntryType_ENTRY_DISBURSEMENT))
+			Expect(statement.Entries[0].Debit).Should(BeNumerically("~", 1000, 0.001))
+			Expect(statement.Entries[1].EntryType).Should(Equal(loan.StatementEntryType_ENTRY_INTEREST))
+			Expect(statement.Entries[1].Debit).Should(BeNumerically("~", 100, 0.001))
+
+			repayment := statement.Entries[2]
+			Expect(repayment.EntryType).Should(Equal(loan.StatementEntryType_ENTRY_REPAYMENT))
+			Expect(repayment.Credit).Should(BeNumerically("~", 300, 0.001))
+			Expect(repayment.InterestAmount).Should(BeNumerically("~", 100, 0.001))
+			Expect(repayment.PrincipalAmount).Should(BeNumerically("~", 200, 0.001))
+			Expect(repayment.RunningBalance).Should(BeNumerically("~", 800, 0.001))
+
+			Expect(statement.TotalRepaid).Should(BeNumerically("~", 300, 0.001))
+			Expect(statement.OutstandingBalance).Should(BeNumerically("~", 800, 0.001))
+			Expect(statement.NextInstallment).ShouldNot(BeNil())
+			Expect(statement.NextInstallment.InstallmentNumber).Should(BeEquivalentTo(1))
+		})
+
+		It("should get the statement of the member", func() {
+			statementRes, err := LoanAPI.GetLoanStatement(ctx, &loan.GetLoanStatementRequest{MemberId: loanPB.MemberId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(statementRes.Statement.MemberId).Should(Equal(loanPB.MemberId))
+			Expect(statementRes.Statement.Entries).Should(HaveLen(3))
+			Expect(statementRes.Statement.Entries[0].LoanId).Should(Equal(loanPB.LoanId))
+		})
+
+		It("should export the statement as csv", func() {
+			statementRes, err := LoanAPI.GetLoanStatement(ctx, &loan.GetLoanStatementRequest{
+				LoanId: loanPB.LoanId,
+				Format: loan.StatementFormat_STATEMENT_CSV,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(statementRes.ContentType).Should(Equal("text/csv"))
+			Expect(statementRes.FileName).Should(HaveSuffix(".csv"))
+
+			r := csv.NewReader(bytes.NewReader(statementRes.Document))
+			r.FieldsPerRecord = -1
+			records, err := r.ReadAll()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(records[0][0]).Should(Equal("date"))
+			Expect(records[3][2]).Should(Equal("REPAYMENT"))
+		})
+
+		It("should export the statement as pdf", func() {
+			statementRes, err := LoanAPI.GetLoanStatement(ctx, &loan.GetLoanStatementRequest{
+				LoanId: loanPB.LoanId,
+				Format: loan.StatementFormat_STATEMENT_PDF,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(statementRes.ContentType).Should(Equal("application/pdf"))
+			Expect(bytes.HasPrefix(statementRes.Document, []byte("%PDF-"))).Should(BeTrue())
+		})
+	})
+})
@@ -104,5 +104,47 @@ var _ = Describe("UpdateLoan", func() {
 				Expect(loanPB.LoaneeNames).ShouldNot(Equal(initialLoan.LoaneeNames))
 			})
 		})
+
+		Describe("Updating loan balances", func() {
+			It("should fail when settled amount is set", func() {
+				updateRes, err := LoanAPI.UpdateLoan(ctx, &loan.UpdateLoanRequest{
+					Loan: &loan.Loan{LoanId: newLoan.LoanId, SettledAmount: 500},
+				})
+				Expect(err).Should(HaveOccurred())
+				Expect(updateRes).Should(BeNil())
+				Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Describe("Updating a loan pinned to a product version", func() {
+		var loanID string
+
+		Context("Lets create a pinned loan", func() {
+			It("should succeed", func() {
+				loanPB := mockLoan()
+				loanPB.ProductVersion = 1
+				db, err := models.LoanModel(loanPB)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(LoanAPIServer.SQLDB.Create(db).Error).ShouldNot(HaveOccurred())
+				loanID = fmt.Sprint(db.ID)
+			})
+		})
+
+		It("should fail when the terms are changed", func() {
+			updateRes, err := LoanAPI.UpdateLoan(ctx, &loan.UpdateLoanRequest{
+				Loan: &loan.Loan{LoanId: loanID, InterestRate: 99, DurationDays: 7},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(updateRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should update the loanee details", func() {
+			_, err := LoanAPI.UpdateLoan(ctx, &loan.UpdateLoanRequest{
+				Loan: &loan.Loan{LoanId: loanID, LoaneeNames: "pinned loanee"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
 	})
 })
@@ -0,0 +1,144 @@
+package loan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/pkg/utils/mdutil"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (loanAPI *loanAPIServer) WriteOffLoan(
+	ctx context.Context, req *loan.WriteOffLoanRequest,
+) (*loan.WriteOffLoanResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.LoanId == "":
+		return nil, errs.MissingField("loan id")
+	case req.AccountName == "":
+		return nil, errs.MissingField("account name")
+	case req.Reason == "":
+		return nil, errs.MissingField("reason")
+	}
+
+	// Authorization
+	actor, err := loanAPI.authorizer.AuthorizeLoan(ctx, req.LoanId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get loan
+	loanDB := &models.Loan{}
+	err = loanAPI.SQLDB.First(loanDB, "id = ?", req.LoanId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("loan", req.LoanId)
+	default:
+		return nil, errs.FailedToFind("loan", err)
+	}
+
+	switch loanDB.Status {
+	case loan.LoanStatus_WRITTEN_OFF.String():
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan has already been written off")
+	case loan.LoanStatus_REFINANCED.String():
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan has been refinanced")
+	}
+
+	outstanding, err := outstandingBalance(loanAPI.SQLDB, loanDB)
+	if err != nil {
+		return nil, err
+	}
+
+	if outstanding == 0 {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan has been fully settled")
+	}
+
+	// Record the officer approval
+	err = loanAPI.SQLDB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "loan_id"}, {Name: "approver_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"account_name", "reason"}),
+	}).Create(&models.LoanWriteOff{
+		LoanID:        loanDB.ID,
+		ApproverID:    actor.ID,
+		ApproverNames: actor.Names,
+		AccountName:   req.AccountName,
+		Reason:        req.Reason,
+	}).Error
+	if err != nil {
+		return nil, errs.FailedToSave("loan write off", err)
+	}
+
+	// Write offs need as many officers as loan approvals
+	policy, err := loanAPI.approvalPolicy(loanDB.ProductID, loanDB.ProductVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	writeOffs := make([]*models.LoanWriteOff, 0)
+	err = loanAPI.SQLDB.Order("id ASC").Find(&writeOffs, "loan_id = ?", loanDB.ID).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan write offs", err)
+	}
+
+	res := &loan.WriteOffLoanResponse{
+		RequiredApprovals: policy.RequiredApprovals,
+		Approvals:         make([]*loan.LoanWriteOff, 0, len(writeOffs)),
+	}
+
+	for _, writeOff := range writeOffs {
+		writeOffPB, err := models.LoanWriteOffProto(writeOff)
+		if err != nil {
+			return nil, err
+		}
+		res.Approvals = append(res.Approvals, writeOffPB)
+	}
+
+	if len(writeOffs) < int(policy.RequiredApprovals) {
+		return res, nil
+	}
+
+	ctxExt := mdutil.AddFromCtx(ctx)
+
+	// Post the loss against the provision or expense account
+	accountPB, err := loanAPI.MoneyAccountAPI.GetChamaAccount(ctxExt, &transaction.GetChamaAccountRequest{
+		OwnerId:     loanDB.ChamaID,
+		AccountName: req.AccountName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = loanAPI.TransactionAPI.Withdraw(ctxExt, &transaction.WithdrawRequest{
+		ActorId:     actor.ID,
+		AccountId:   accountPB.AccountId,
+		Description: fmt.Sprintf("Loan write off for %s: %s", loanDB.LoaneeNames, req.Reason),
+		Amount:      outstanding,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = loanAPI.SQLDB.Model(loanDB).Updates(map[string]interface{}{
+		"status":      loan.LoanStatus_WRITTEN_OFF.String(),
+		"written_off": outstanding,
+	}).Error
+	if err != nil {
+		return nil, errs.FailedToUpdate("loan", err)
+	}
+
+	res.WrittenOff = true
+	res.WrittenOffAmount = outstanding
+
+	return res, nil
+}
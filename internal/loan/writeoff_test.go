@@ -0,0 +1,113 @@
+package loan
+
+import (
+	"context"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("WriteOffLoan", func() {
+	var (
+		writeOffReq *loan.WriteOffLoanRequest
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		writeOffReq = &loan.WriteOffLoanRequest{
+			LoanId:      "1",
+			AccountName: "provisions",
+			Reason:      "loanee cannot be traced",
+		}
+		ctx = context.TODO()
+	})
+
+	Describe("WriteOffLoan with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			writeOffReq = nil
+			writeOffRes, err := LoanAPI.WriteOffLoan(ctx, writeOffReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(writeOffRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when loan id is missing", func() {
+			writeOffReq.LoanId = ""
+			writeOffRes, err := LoanAPI.WriteOffLoan(ctx, writeOffReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(writeOffRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when account name is missing", func() {
+			writeOffReq.AccountName = ""
+			writeOffRes, err := LoanAPI.WriteOffLoan(ctx, writeOffReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(writeOffRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when reason is missing", func() {
+			writeOffReq.Reason = ""
+			writeOffRes, err := LoanAPI.WriteOffLoan(ctx, writeOffReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(writeOffRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when loan does not exist", func() {
+			writeOffReq.LoanId = "oops"
+			writeOffRes, err := LoanAPI.WriteOffLoan(ctx, writeOffReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(writeOffRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("WriteOffLoan with well formed request", func() {
+		var loanPB *loan.Loan
+
+		Context("Lets create the loan and provision account first", func() {
+			It("should succeed", func() {
+				var err error
+				loanPB, err = createDisbursedLoan(mockLoan(), time.Now().AddDate(0, 0, -200))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				accountDB := &models.ChamaAccount{
+					OwnerID:         loanPB.ChamaId,
+					AccountName:     writeOffReq.AccountName,
+					AccountType:     transaction.AccountType_SAVINGS_ACCOUNT.String(),
+					AvailableAmount: 5000,
+					Withdrawable:    true,
+					Active:          true,
+				}
+				Expect(LoanAPIServer.SQLDB.Create(accountDB).Error).ShouldNot(HaveOccurred())
+			})
+		})
+
+		Describe("Writing off the loan", func() {
+			It("should post the outstanding balance as a loss", func() {
+				writeOffReq.LoanId = loanPB.LoanId
+				writeOffRes, err := LoanAPI.WriteOffLoan(ctx, writeOffReq)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(writeOffRes.WrittenOff).Should(BeTrue())
+				Expect(writeOffRes.WrittenOffAmount).Should(BeNumerically("~", 1100, 0.01))
+				Expect(writeOffRes.Approvals).Should(HaveLen(1))
+
+				getRes, err := LoanAPI.GetLoan(ctx, &loan.GetLoanRequest{LoanId: loanPB.LoanId})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(getRes.Status).Should(Equal(loan.LoanStatus_WRITTEN_OFF))
+			})
+
+			It("should fail when the loan has already been written off", func() {
+				writeOffReq.LoanId = loanPB.LoanId
+				writeOffRes, err := LoanAPI.WriteOffLoan(ctx, writeOffReq)
+				Expect(err).Should(HaveOccurred())
+				Expect(writeOffRes).Should(BeNil())
+				Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+			})
+		})
+	})
+})
@@ -59,6 +59,25 @@ var _ = Describe("CreateLoanProduct", func() {
 			Expect(createRes).Should(BeNil())
 			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
 		})
+		It("should fail when a fee has no income account", func() {
+			createReq.LoanProduct.Fees = []*loan.LoanFee{{Name: "Processing fee", Amount: 100}}
+			createRes, err := LoanProductAPI.CreateLoanProduct(ctx, createReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(createRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when a percentage fee is above 100", func() {
+			createReq.LoanProduct.Fees = []*loan.LoanFee{{
+				Name:        "Insurance",
+				Calculation: loan.FeeCalculation_FEE_PERCENTAGE,
+				Amount:      120,
+				AccountName: "insurance",
+			}}
+			createRes, err := LoanProductAPI.CreateLoanProduct(ctx, createReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(createRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
 	})
 
 	Describe("CreateLoanProduct with wellformed request", func() {
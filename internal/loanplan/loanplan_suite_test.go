@@ -28,6 +28,9 @@ var (
 	LoanProductAPI       loan.LoanProductAPIServer
 	modelsStructs        = []interface{}{
 		&models.LoanProduct{},
+		&models.Loan{},
+		&models.LoanInstallment{},
+		&models.LoanProductVersion{},
 	}
 	schema = "machama"
 )
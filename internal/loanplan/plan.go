@@ -3,8 +3,11 @@ package loanproduct
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/chama"
 	"github.com/gidyon/machama-app/pkg/api/loan"
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
 	"github.com/gidyon/micro/v2/utils/errs"
@@ -27,6 +30,7 @@ type Options struct {
 type loanProductAPIServer struct {
 	loan.UnimplementedLoanProductAPIServer
 	*Options
+	authorizer *roles.Authorizer
 }
 
 func NewLoanProductAPI(ctx context.Context, opt *Options) (loan.LoanProductAPIServer, error) {
@@ -51,7 +55,8 @@ func NewLoanProductAPI(ctx context.Context, opt *Options) (loan.LoanProductAPISe
 	}
 
 	LoanProductAPI := &loanProductAPIServer{
-		Options: opt,
+		Options:    opt,
+		authorizer: roles.NewAuthorizer(opt.Auth, opt.SQLDB, opt.AllowedGroups),
 	}
 
 	return LoanProductAPI, nil
@@ -66,6 +71,128 @@ func ValidateLoanProduct(pb *loan.LoanProduct) error {
 		return errs.MissingField("plan name")
 	case pb.InterestRate == 0:
 		return errs.MissingField("interest rate")
+	case pb.RepaymentPeriodDays < 0:
+		return errs.IncorrectVal("repayment period days")
+	case pb.CollateralCoverage < 0:
+		return errs.IncorrectVal("collateral coverage")
+	}
+	if pb.EligibilityRules != nil {
+		err := ValidateEligibilityRules(pb.EligibilityRules)
+		if err != nil {
+			return err
+		}
+	}
+	if pb.PenaltyPolicy != nil {
+		err := ValidatePenaltyPolicy(pb.PenaltyPolicy)
+		if err != nil {
+			return err
+		}
+	}
+	if pb.ApprovalPolicy != nil {
+		err := ValidateApprovalPolicy(pb.ApprovalPolicy)
+		if err != nil {
+			return err
+		}
+	}
+	if pb.EarlySettlementPolicy != nil {
+		err := ValidateEarlySettlementPolicy(pb.EarlySettlementPolicy)
+		if err != nil {
+			return err
+		}
+	}
+	if pb.GracePolicy != nil {
+		err := ValidateGracePolicy(pb.GracePolicy)
+		if err != nil {
+			return err
+		}
+	}
+	return ValidateLoanFees(pb.Fees)
+}
+
+func ValidateGracePolicy(pb *loan.GracePolicy) error {
+	switch {
+	case pb.GracePeriods < 0:
+		return errs.IncorrectVal("grace periods")
+	case pb.GraceType != loan.GraceType_GRACE_NONE && pb.GracePeriods == 0:
+		return errs.MissingField("grace periods")
+	case pb.MaxHolidayPeriods < 0:
+		return errs.IncorrectVal("max holiday periods")
+	}
+	return nil
+}
+
+func ValidateLoanFees(pbs []*loan.LoanFee) error {
+	for _, pb := range pbs {
+		switch {
+		case pb == nil:
+			return errs.NilObject("loan fee")
+		case pb.Name == "":
+			return errs.MissingField("fee name")
+		case pb.AccountName == "":
+			return errs.MissingField("fee account name")
+		case pb.Amount <= 0:
+			return errs.IncorrectVal("fee amount")
+		case pb.Calculation == loan.FeeCalculation_FEE_PERCENTAGE && pb.Amount > 100:
+			return errs.IncorrectVal("fee percentage")
+		}
+	}
+	return nil
+}
+
+func ValidateEarlySettlementPolicy(pb *loan.EarlySettlementPolicy) error {
+	switch {
+	case pb.RebatePercentage < 0 || pb.RebatePercentage > 100:
+		return errs.IncorrectVal("rebate percentage")
+	case pb.FeeAmount < 0:
+		return errs.IncorrectVal("early settlement fee amount")
+	case pb.FeePercentage < 0 || pb.FeePercentage > 100:
+		return errs.IncorrectVal("early settlement fee percentage")
+	case pb.QuoteValidityDays < 0:
+		return errs.IncorrectVal("quote validity days")
+	}
+	return nil
+}
+
+func ValidateApprovalPolicy(pb *loan.ApprovalPolicy) error {
+	switch {
+	case pb.RequiredApprovals < 0:
+		return errs.IncorrectVal("required approvals")
+	case pb.MemberVoteMinAmount < 0:
+		return errs.IncorrectVal("member vote minimum amount")
+	case pb.QuorumPercentage < 0 || pb.QuorumPercentage > 100:
+		return errs.IncorrectVal("quorum percentage")
+	case pb.ApprovalThresholdPercentage < 0 || pb.ApprovalThresholdPercentage > 100:
+		return errs.IncorrectVal("approval threshold percentage")
+	}
+	return nil
+}
+
+func ValidatePenaltyPolicy(pb *loan.PenaltyPolicy) error {
+	switch {
+	case pb.Amount < 0:
+		return errs.IncorrectVal("penalty amount")
+	case pb.Cap < 0:
+		return errs.IncorrectVal("penalty cap")
+	case pb.GraceDays < 0:
+		return errs.IncorrectVal("penalty grace days")
+	case pb.PeriodDays < 0:
+		return errs.IncorrectVal("penalty period days")
+	case pb.PenaltyType == loan.PenaltyType_PERCENTAGE_PER_PERIOD && pb.PeriodDays == 0:
+		return errs.MissingField("penalty period days")
+	}
+	return nil
+}
+
+func ValidateEligibilityRules(pb *loan.EligibilityRules) error {
+	switch {
+	case pb.MaxSavingsMultiplier < 0:
+		return errs.IncorrectVal("max savings multiplier")
+	case pb.MinMembershipDays < 0:
+		return errs.IncorrectVal("min membership days")
+	case pb.MaxActiveLoans < 0:
+		return errs.IncorrectVal("max active loans")
+	case pb.MinCreditScore < 0 || pb.MinCreditScore > 100:
+		return errs.IncorrectVal("min credit score")
 	}
 	return nil
 }
@@ -73,45 +200,67 @@ func ValidateLoanProduct(pb *loan.LoanProduct) error {
 func (LoanProductAPI *loanProductAPIServer) CreateLoanProduct(
 	ctx context.Context, req *loan.CreateLoanProductRequest,
 ) (*emptypb.Empty, error) {
-	// Authorization
-	_, err := LoanProductAPI.Auth.AuthorizeGroup(ctx, LoanProductAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validate
 	switch {
 	case req == nil:
 		return nil, errs.NilObject("request body")
 	default:
-		err = ValidateLoanProduct(req.LoanProduct)
+		err := ValidateLoanProduct(req.LoanProduct)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	// Authorization
+	_, err := LoanProductAPI.authorizer.AuthorizeChama(ctx, req.LoanProduct.ChamaId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
 	db, err := models.LoanProductModel(req.LoanProduct)
 	if err != nil {
 		return nil, err
 	}
 
-	err = LoanProductAPI.SQLDB.Create(db).Error
+	db.Version = 1
+
+	err = LoanProductAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(db).Error
+		if err != nil {
+			return errs.FailedToSave("LoanProduct", err)
+		}
+		return saveVersion(tx, db)
+	})
 	if err != nil {
-		return nil, errs.FailedToSave("LoanProduct", err)
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
-func (LoanProductAPI *loanProductAPIServer) UpdateLoanProduct(
-	ctx context.Context, req *loan.UpdateLoanProductRequest,
-) (*emptypb.Empty, error) {
-	// Authorization
-	_, err := LoanProductAPI.Auth.AuthorizeGroup(ctx, LoanProductAPI.AllowedGroups...)
+// saveVersion snapshots the terms of the product at its current version
+func saveVersion(tx *gorm.DB, db *models.LoanProduct) error {
+	pb, err := models.LoanProductProto(db)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	versionDB, err := models.LoanProductVersionModel(pb)
+	if err != nil {
+		return err
 	}
 
+	err = tx.Create(versionDB).Error
+	if err != nil {
+		return errs.FailedToSave("LoanProduct version", err)
+	}
+
+	return nil
+}
+
+func (LoanProductAPI *loanProductAPIServer) UpdateLoanProduct(
+	ctx context.Context, req *loan.UpdateLoanProductRequest,
+) (*emptypb.Empty, error) {
 	// Validate
 	switch {
 	case req == nil:
@@ -120,6 +269,52 @@ func (LoanProductAPI *loanProductAPIServer) UpdateLoanProduct(
 		return nil, errs.MissingField("loan product")
 	case req.LoanProduct.ProductId == "":
 		return nil, errs.MissingField("product id")
+	case req.LoanProduct.CollateralCoverage < 0:
+		return nil, errs.IncorrectVal("collateral coverage")
+	case req.LoanProduct.EligibilityRules != nil:
+		err := ValidateEligibilityRules(req.LoanProduct.EligibilityRules)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Authorization
+	_, _, err := LoanProductAPI.authorizeProduct(ctx, req.LoanProduct.ProductId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.LoanProduct.PenaltyPolicy != nil {
+		err = ValidatePenaltyPolicy(req.LoanProduct.PenaltyPolicy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.LoanProduct.ApprovalPolicy != nil {
+		err = ValidateApprovalPolicy(req.LoanProduct.ApprovalPolicy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.LoanProduct.EarlySettlementPolicy != nil {
+		err = ValidateEarlySettlementPolicy(req.LoanProduct.EarlySettlementPolicy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.LoanProduct.GracePolicy != nil {
+		err = ValidateGracePolicy(req.LoanProduct.GracePolicy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = ValidateLoanFees(req.LoanProduct.Fees)
+	if err != nil {
+		return nil, err
 	}
 
 	db, err := models.LoanProductModel(req.LoanProduct)
@@ -127,9 +322,34 @@ func (LoanProductAPI *loanProductAPIServer) UpdateLoanProduct(
 		return nil, err
 	}
 
-	err = LoanProductAPI.SQLDB.Where("id = ?", req.LoanProduct.ProductId).Updates(db).Error
+	// Loans keep the terms of the version they were created under. Products stay in the chama they were created in
+	err = LoanProductAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("id = ?", req.LoanProduct.ProductId).Omit("version", "archived", "chama_id").Updates(db).Error
+		if err != nil {
+			return errs.FailedToUpdate("LoanProduct", err)
+		}
+
+		productDB := &models.LoanProduct{}
+		err = tx.First(productDB, "id = ?", req.LoanProduct.ProductId).Error
+		switch {
+		case err == nil:
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return errs.DoesNotExist("LoanProduct", req.LoanProduct.ProductId)
+		default:
+			return errs.FailedToFind("LoanProduct", err)
+		}
+
+		productDB.Version++
+
+		err = tx.Model(productDB).Update("version", productDB.Version).Error
+		if err != nil {
+			return errs.FailedToUpdate("LoanProduct", err)
+		}
+
+		return saveVersion(tx, productDB)
+	})
 	if err != nil {
-		return nil, errs.FailedToUpdate("LoanProduct", err)
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
@@ -140,18 +360,18 @@ const defaultPageSize = 50
 func (LoanProductAPI *loanProductAPIServer) ListLoanProducts(
 	ctx context.Context, req *loan.ListLoanProductsRequest,
 ) (*loan.ListLoanProductsResponse, error) {
-	// Authorization
-	actor, err := LoanProductAPI.Auth.AuthorizeGroup(ctx, LoanProductAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validation
 	switch {
 	case req == nil:
 		return nil, errs.NilObject("list request")
 	}
 
+	// Authorization
+	actor, chamaIDs, err := LoanProductAPI.authorizer.ScopeChamas(ctx, req.GetFilter().GetChamaIds(), roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
 	pageSize := req.GetPageSize()
 	switch {
 	case pageSize <= 0:
@@ -178,10 +398,8 @@ func (LoanProductAPI *loanProductAPIServer) ListLoanProducts(
 	}
 
 	// Apply tv filters
-	if req.Filter != nil {
-		if len(req.Filter.ChamaIds) != 0 {
-			db = db.Where("chama_id IN (?)", req.Filter.ChamaIds)
-		}
+	if chamaIDs != nil {
+		db = db.Where("chama_id IN (?)", chamaIDs)
 	}
 
 	dbs := make([]*models.LoanProduct, 0, pageSize+1)
@@ -198,6 +416,12 @@ func (LoanProductAPI *loanProductAPIServer) ListLoanProducts(
 			break
 		}
 
+		portfolio, err := LoanProductAPI.productPortfolio(db, time.Now(), 0)
+		if err != nil {
+			return nil, err
+		}
+		setCounters(db, portfolio)
+
 		pb, err := models.LoanProductProto(db)
 		if err != nil {
 			return nil, err
@@ -226,12 +450,6 @@ func (LoanProductAPI *loanProductAPIServer) ListLoanProducts(
 func (LoanProductAPI *loanProductAPIServer) GetLoanProduct(
 	ctx context.Context, req *loan.GetLoanProductRequest,
 ) (*loan.LoanProduct, error) {
-	// Authorization
-	_, err := LoanProductAPI.Auth.AuthorizeGroup(ctx, LoanProductAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validation
 	switch {
 	case req == nil:
@@ -242,7 +460,7 @@ func (LoanProductAPI *loanProductAPIServer) GetLoanProduct(
 
 	db := &models.LoanProduct{}
 
-	err = LoanProductAPI.SQLDB.First(db, "id = ?", req.ProductId).Error
+	err := LoanProductAPI.SQLDB.First(db, "id = ?", req.ProductId).Error
 	switch {
 	case err == nil:
 	case errors.Is(err, gorm.ErrRecordNotFound):
@@ -251,18 +469,46 @@ func (LoanProductAPI *loanProductAPIServer) GetLoanProduct(
 		return nil, errs.FailedToFind("LoanProduct", err)
 	}
 
-	return models.LoanProductProto(db)
-}
-
-func (LoanProductAPI *loanProductAPIServer) DeleteLoanProduct(
-	ctx context.Context, req *loan.DeleteLoanProductRequest,
-) (*emptypb.Empty, error) {
 	// Authorization
-	_, err := LoanProductAPI.Auth.AuthorizeGroup(ctx, LoanProductAPI.AllowedGroups...)
+	_, err = LoanProductAPI.authorizer.AuthorizeChama(ctx, db.ChamaID, roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Counters come from the loans of the product
+	portfolio, err := LoanProductAPI.productPortfolio(db, time.Now(), 0)
 	if err != nil {
 		return nil, err
 	}
+	setCounters(db, portfolio)
+
+	pb, err := models.LoanProductProto(db)
+	if err != nil {
+		return nil, err
+	}
+
+	// Version history
+	versions := make([]*models.LoanProductVersion, 0)
+	err = LoanProductAPI.SQLDB.Order("version DESC").Find(&versions, "product_id = ?", db.ID).Error
+	if err != nil {
+		return nil, errs.FailedToFind("LoanProduct versions", err)
+	}
 
+	pb.Versions = make([]*loan.LoanProductVersion, 0, len(versions))
+	for _, versionDB := range versions {
+		versionPB, err := models.LoanProductVersionProto(versionDB)
+		if err != nil {
+			return nil, err
+		}
+		pb.Versions = append(pb.Versions, versionPB)
+	}
+
+	return pb, nil
+}
+
+func (LoanProductAPI *loanProductAPIServer) DeleteLoanProduct(
+	ctx context.Context, req *loan.DeleteLoanProductRequest,
+) (*emptypb.Empty, error) {
 	// Validation
 	switch {
 	case req == nil:
@@ -271,6 +517,27 @@ func (LoanProductAPI *loanProductAPIServer) DeleteLoanProduct(
 		return nil, errs.MissingField("plan id")
 	}
 
+	// Authorization
+	_, _, err := LoanProductAPI.authorizeProduct(ctx, req.ProductId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	var activeLoans int64
+	err = LoanProductAPI.SQLDB.Model(&models.Loan{}).
+		Where("product_id = ? AND settled_amount < loan_amount", req.ProductId).
+		Where("status NOT IN (?)", []string{
+			loan.LoanStatus_REFINANCED.String(), loan.LoanStatus_WRITTEN_OFF.String(), loan.LoanStatus_CANCELLED.String(),
+		}).
+		Count(&activeLoans).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loans", err)
+	}
+
+	if activeLoans > 0 {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "loan product has active loans, archive it instead")
+	}
+
 	err = LoanProductAPI.SQLDB.Delete(&models.LoanProduct{}, "id = ?", req.ProductId).Error
 	switch {
 	case err == nil:
@@ -282,3 +549,49 @@ func (LoanProductAPI *loanProductAPIServer) DeleteLoanProduct(
 
 	return &emptypb.Empty{}, nil
 }
+
+func (LoanProductAPI *loanProductAPIServer) ArchiveLoanProduct(
+	ctx context.Context, req *loan.ArchiveLoanProductRequest,
+) (*emptypb.Empty, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.ProductId == "":
+		return nil, errs.MissingField("plan id")
+	}
+
+	// Authorization
+	_, _, err := LoanProductAPI.authorizeProduct(ctx, req.ProductId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Archived products take no new loans
+	err = LoanProductAPI.SQLDB.Model(&models.LoanProduct{}).Where("id = ?", req.ProductId).Update("archived", req.Archived).Error
+	if err != nil {
+		return nil, errs.FailedToUpdate("LoanProduct", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// authorizeProduct gets a loan product and authorizes an actor holding one of the roles in its chama
+func (LoanProductAPI *loanProductAPIServer) authorizeProduct(
+	ctx context.Context, productID string, roles ...chama.ChamaRole,
+) (*auth.Payload, *models.LoanProduct, error) {
+	productDB := &models.LoanProduct{}
+	err := LoanProductAPI.SQLDB.First(productDB, "id = ?", productID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, nil, errs.DoesNotExist("LoanProduct", productID)
+	default:
+		return nil, nil, errs.FailedToFind("LoanProduct", err)
+	}
+	actor, err := LoanProductAPI.authorizer.AuthorizeChama(ctx, productDB.ChamaID, roles...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return actor, productDB, nil
+}
@@ -0,0 +1,246 @@
+package loanproduct
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+const (
+	reportDateLayout    = "2006-01-02"
+	defaultTopBorrowers = 5
+)
+
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+func percentage(part, whole float64) float32 {
+	if whole == 0 {
+		return 0
+	}
+	return float32(math.Round(part/whole*10000) / 100)
+}
+
+// closedLoan reports whether the loan balance has moved to another loan, been written off or was never taken
+func closedLoan(loanDB *models.Loan) bool {
+	switch loanDB.Status {
+	case loan.LoanStatus_REFINANCED.String(), loan.LoanStatus_WRITTEN_OFF.String(), loan.LoanStatus_CANCELLED.String():
+		return true
+	}
+	return false
+}
+
+// productPortfolio computes the loan book of a product from its loans and their installments as at asOf.
+// Repayments are allocated to interest before principal and are taken at their current value.
+func (LoanProductAPI *loanProductAPIServer) productPortfolio(
+	productDB *models.LoanProduct, asOf time.Time, topBorrowers int,
+) (*loan.ProductPortfolio, error) {
+	loans := make([]*models.Loan, 0)
+	err := LoanProductAPI.SQLDB.Find(&loans, "product_id = ? AND created_at < ?", productDB.ID, asOf).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loans", err)
+	}
+
+	portfolio := &loan.ProductPortfolio{
+		ChamaId:      productDB.ChamaID,
+		ProductId:    fmt.Sprint(productDB.ID),
+		ProductName:  productDB.Name,
+		TotalLoans:   int32(len(loans)),
+		TopBorrowers: make([]*loan.TopBorrower, 0, topBorrowers),
+	}
+
+	if len(loans) == 0 {
+		return portfolio, nil
+	}
+
+	loanIDs := make([]uint, 0, len(loans))
+	for _, loanDB := range loans {
+		loanIDs = append(loanIDs, loanDB.ID)
+	}
+
+	installments := make([]*models.LoanInstallment, 0)
+	err = LoanProductAPI.SQLDB.Find(&installments, "loan_id IN (?)", loanIDs).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan installments", err)
+	}
+
+	schedules := make(map[uint][]*models.LoanInstallment, len(loans))
+	for _, installment := range installments {
+		schedules[installment.LoanID] = append(schedules[installment.LoanID], installment)
+	}
+
+	var dueToDate, paidToDate float64
+	borrowers := make(map[string]*loan.TopBorrower)
+
+	for _, loanDB := range loans {
+		schedule, ok := schedules[loanDB.ID]
+		if !ok {
+			// Not yet disbursed
+			continue
+		}
+
+		portfolio.DisbursedAmount += loanDB.LoanAmount - loanDB.CarriedOver
+		portfolio.SettledAmount += loanDB.SettledAmount
+
+		var principalOutstanding, interestOutstanding float64
+		var oldestOverdue *time.Time
+
+		for _, installment := range schedule {
+			interestPaid := math.Min(installment.AmountPaid, installment.InterestDue)
+			principalPaid := math.Min(math.Max(installment.AmountPaid-installment.InterestDue, 0), installment.PrincipalDue)
+
+			portfolio.InterestEarned += interestPaid
+			principalOutstanding += installment.PrincipalDue - principalPaid
+			interestOutstanding += installment.InterestDue - interestPaid
+
+			if installment.DueDate.Before(asOf) {
+				dueToDate += installment.AmountDue
+				paidToDate += math.Min(installment.AmountPaid, installment.AmountDue)
+				if installment.AmountPaid < installment.AmountDue && (oldestOverdue == nil || installment.DueDate.Before(*oldestOverdue)) {
+					oldestOverdue = &installment.DueDate
+				}
+			}
+		}
+
+		if closedLoan(loanDB) {
+			continue
+		}
+
+		if principalOutstanding+interestOutstanding <= 0 {
+			portfolio.SettledLoans++
+			continue
+		}
+
+		portfolio.ActiveLoans++
+		portfolio.OutstandingPrincipal += principalOutstanding
+		portfolio.OutstandingInterest += interestOutstanding
+
+		if oldestOverdue != nil {
+			daysPastDue := asOf.Sub(*oldestOverdue).Hours() / 24
+			if daysPastDue > 30 {
+				portfolio.Par30Amount += principalOutstanding
+			}
+			if daysPastDue > 90 {
+				portfolio.Par90Amount += principalOutstanding
+			}
+		}
+
+		borrower, ok := borrowers[loanDB.MemberID]
+		if !ok {
+			borrower = &loan.TopBorrower{
+				MemberId:    loanDB.MemberID,
+				LoaneeNames: loanDB.LoaneeNames,
+			}
+			borrowers[loanDB.MemberID] = borrower
+		}
+		borrower.Loans++
+		borrower.OutstandingPrincipal = roundAmount(borrower.OutstandingPrincipal + principalOutstanding)
+	}
+
+	portfolio.DisbursedAmount = roundAmount(portfolio.DisbursedAmount)
+	portfolio.SettledAmount = roundAmount(portfolio.SettledAmount)
+	portfolio.InterestEarned = roundAmount(portfolio.InterestEarned)
+	portfolio.OutstandingPrincipal = roundAmount(portfolio.OutstandingPrincipal)
+	portfolio.OutstandingInterest = roundAmount(portfolio.OutstandingInterest)
+	portfolio.Par30Amount = roundAmount(portfolio.Par30Amount)
+	portfolio.Par90Amount = roundAmount(portfolio.Par90Amount)
+	portfolio.Par30Percentage = percentage(portfolio.Par30Amount, portfolio.OutstandingPrincipal)
+	portfolio.Par90Percentage = percentage(portfolio.Par90Amount, portfolio.OutstandingPrincipal)
+	portfolio.RepaymentRate = percentage(paidToDate, dueToDate)
+
+	for _, borrower := range borrowers {
+		portfolio.TopBorrowers = append(portfolio.TopBorrowers, borrower)
+	}
+	sort.Slice(portfolio.TopBorrowers, func(i, j int) bool {
+		return portfolio.TopBorrowers[i].OutstandingPrincipal > portfolio.TopBorrowers[j].OutstandingPrincipal
+	})
+	if len(portfolio.TopBorrowers) > topBorrowers {
+		portfolio.TopBorrowers = portfolio.TopBorrowers[:topBorrowers]
+	}
+
+	return portfolio, nil
+}
+
+// setCounters fills the loan counters of a product from its current portfolio. Counters are computed for responses
+// only so that reading a product doesn't write to it.
+func setCounters(productDB *models.LoanProduct, portfolio *loan.ProductPortfolio) {
+	productDB.LoanAccountBalance = portfolio.OutstandingPrincipal
+	productDB.LoanInterestBalance = portfolio.OutstandingInterest
+	productDB.LoanSettledBalance = portfolio.SettledAmount
+	productDB.SettledLoans = portfolio.SettledLoans
+	productDB.ActiveLoans = portfolio.ActiveLoans
+	productDB.TotalLoans = portfolio.TotalLoans
+}
+
+func (LoanProductAPI *loanProductAPIServer) GetPortfolioReport(
+	ctx context.Context, req *loan.GetPortfolioReportRequest,
+) (*loan.PortfolioReport, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("report request")
+	case req.TopBorrowers < 0:
+		return nil, errs.IncorrectVal("top borrowers")
+	}
+
+	// Authorization
+	_, chamaIDs, err := LoanProductAPI.authorizer.ScopeChamas(ctx, req.ChamaIds, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	topBorrowers := int(req.TopBorrowers)
+	if topBorrowers == 0 {
+		topBorrowers = defaultTopBorrowers
+	}
+
+	asOf := time.Now()
+	reportDate := asOf.Format(reportDateLayout)
+	if req.AsOfDate != "" {
+		date, err := time.Parse(reportDateLayout, req.AsOfDate)
+		if err != nil {
+			return nil, errs.IncorrectVal("as of date")
+		}
+		// The report covers the whole of the day
+		asOf = date.AddDate(0, 0, 1)
+		reportDate = req.AsOfDate
+	}
+
+	db := LoanProductAPI.SQLDB.Order("id ASC")
+	if chamaIDs != nil {
+		db = db.Where("chama_id IN (?)", chamaIDs)
+	}
+	if len(req.ProductIds) != 0 {
+		db = db.Where("id IN (?)", req.ProductIds)
+	}
+
+	products := make([]*models.LoanProduct, 0)
+	err = db.Find(&products).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan products", err)
+	}
+
+	report := &loan.PortfolioReport{
+		AsOfDate: reportDate,
+		Products: make([]*loan.ProductPortfolio, 0, len(products)),
+	}
+
+	for _, productDB := range products {
+		portfolio, err := LoanProductAPI.productPortfolio(productDB, asOf, topBorrowers)
+		if err != nil {
+			return nil, err
+		}
+
+		report.Products = append(report.Products, portfolio)
+	}
+
+	return report, nil
+}
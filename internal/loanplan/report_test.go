@@ -0,0 +1,132 @@
+package loanproduct
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("GetPortfolioReport", func() {
+	var (
+		reportReq *loan.GetPortfolioReportRequest
+		ctx       context.Context
+		productDB *models.LoanProduct
+	)
+
+	BeforeEach(func() {
+		reportReq = &loan.GetPortfolioReportRequest{}
+		if productDB != nil {
+			reportReq.ProductIds = []string{fmt.Sprint(productDB.ID)}
+		}
+		ctx = context.TODO()
+	})
+
+	// createLoan saves a disbursed loan of 1000 with 100 interest due in a single installment
+	createLoan := func(dueDate time.Time, amountPaid float64) {
+		loanDB := &models.Loan{
+			ChamaID:       productDB.ChamaID,
+			ProductID:     fmt.Sprint(productDB.ID),
+			MemberID:      randomID(),
+			LoaneeNames:   randomdata.SillyName(),
+			LoaneePhone:   "254712345678",
+			NationalID:    "12345678",
+			Approved:      true,
+			Status:        loan.LoanStatus_FUNDS_TRANSFERED.String(),
+			DurationDays:  30,
+			InterestRate:  10,
+			LoanAmount:    1000,
+			SettledAmount: amountPaid,
+		}
+		Expect(LoanProductAPIServer.SQLDB.Create(loanDB).Error).ShouldNot(HaveOccurred())
+
+		err := LoanProductAPIServer.SQLDB.Create(&models.LoanInstallment{
+			LoanID:            loanDB.ID,
+			InstallmentNumber: 1,
+			PrincipalDue:      1000,
+			InterestDue:       100,
+			AmountDue:         1100,
+			AmountPaid:        amountPaid,
+			DueDate:           dueDate,
+		}).Error
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	Describe("GetPortfolioReport with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			reportRes, err := LoanProductAPI.GetPortfolioReport(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(reportRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the as of date is malformed", func() {
+			reportReq.AsOfDate = "today"
+			reportRes, err := LoanProductAPI.GetPortfolioReport(ctx, reportReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(reportRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("GetPortfolioReport with well formed request", func() {
+		Context("Lets create a product with current, overdue and settled loans first", func() {
+			It("should succeed", func() {
+				var err error
+				productDB, err = models.LoanProductModel(mockLoanProduct())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(LoanProductAPIServer.SQLDB.Create(productDB).Error).ShouldNot(HaveOccurred())
+
+				createLoan(time.Now().AddDate(0, 0, 10), 0)
+				createLoan(time.Now().AddDate(0, 0, -100), 100)
+				createLoan(time.Now().AddDate(0, 0, -5), 1100)
+			})
+		})
+
+		It("should compute the loan book of the product", func() {
+			reportRes, err := LoanProductAPI.GetPortfolioReport(ctx, reportReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(reportRes.Products).Should(HaveLen(1))
+
+			portfolio := reportRes.Products[0]
+			Expect(portfolio.TotalLoans).Should(BeEquivalentTo(3))
+			Expect(portfolio.ActiveLoans).Should(BeEquivalentTo(2))
+			Expect(portfolio.SettledLoans).Should(BeEquivalentTo(1))
+			Expect(portfolio.DisbursedAmount).Should(BeNumerically("~", 3000, 0.01))
+			Expect(portfolio.OutstandingPrincipal).Should(BeNumerically("~", 2000, 0.01))
+			Expect(portfolio.InterestEarned).Should(BeNumerically("~", 200, 0.01))
+			Expect(portfolio.Par30Amount).Should(BeNumerically("~", 1000, 0.01))
+			Expect(portfolio.Par90Amount).Should(BeNumerically("~", 1000, 0.01))
+			Expect(portfolio.Par30Percentage).Should(BeNumerically("~", 50, 0.01))
+			Expect(portfolio.TopBorrowers).ShouldNot(BeEmpty())
+		})
+
+		It("should compute the product counters without saving them", func() {
+			getRes, err := LoanProductAPI.GetLoanProduct(ctx, &loan.GetLoanProductRequest{
+				ProductId: fmt.Sprint(productDB.ID),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.TotalLoans).Should(BeEquivalentTo(3))
+			Expect(getRes.ActiveLoans).Should(BeEquivalentTo(2))
+			Expect(getRes.LoanAccountBalance).Should(BeNumerically("~", 2000, 0.01))
+
+			db := &models.LoanProduct{}
+			Expect(LoanProductAPIServer.SQLDB.First(db, "id = ?", productDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(db.TotalLoans).Should(BeZero())
+			Expect(db.LoanAccountBalance).Should(BeZero())
+		})
+
+		It("should exclude loans created after the as of date", func() {
+			reportReq.AsOfDate = time.Now().AddDate(0, 0, -1).Format(reportDateLayout)
+			reportRes, err := LoanProductAPI.GetPortfolioReport(ctx, reportReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(reportRes.Products[0].TotalLoans).Should(BeEquivalentTo(0))
+		})
+	})
+})
@@ -74,6 +74,7 @@ var _ = Describe("UpdateLoanProduct", func() {
 				newProduct.Name = randomdata.SillyName()
 				newProduct.Description = randomDescription()
 				newProduct.InterestRate = float32(randomdata.Decimal(3, 20))
+				newProduct.ChamaId = "moved-" + initialProduct.ChamaId
 
 				updateRes, err := LoanProductAPI.UpdateLoanProduct(ctx, &loan.UpdateLoanProductRequest{
 					LoanProduct: newProduct,
@@ -102,6 +103,9 @@ var _ = Describe("UpdateLoanProduct", func() {
 
 				Expect(productPB.InterestRate).Should(Equal(newProduct.InterestRate))
 				Expect(productPB.InterestRate).ShouldNot(Equal(initialProduct.InterestRate))
+
+				// Products can't be moved to another chama
+				Expect(productPB.ChamaId).Should(Equal(initialProduct.ChamaId))
 			})
 		})
 	})
@@ -0,0 +1,122 @@
+package loanproduct
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Loan product versions", func() {
+	var (
+		ctx       context.Context
+		productPB *loan.LoanProduct
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+	})
+
+	Context("Lets create loan product", func() {
+		It("should succeed", func() {
+			productPB = mockLoanProduct()
+			productPB.ChamaId = fmt.Sprintf("v%d", randomdata.Number(100000, 999999))
+
+			createRes, err := LoanProductAPI.CreateLoanProduct(ctx, &loan.CreateLoanProductRequest{
+				LoanProduct: productPB,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(createRes).ShouldNot(BeNil())
+
+			productDB := &models.LoanProduct{}
+			err = LoanProductAPIServer.SQLDB.First(productDB, "chama_id = ?", productPB.ChamaId).Error
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(productDB.Version).Should(BeEquivalentTo(1))
+
+			productPB.ProductId = fmt.Sprint(productDB.ID)
+		})
+	})
+
+	Describe("Updating product terms", func() {
+		It("should keep the earlier terms as a version", func() {
+			updateRes, err := LoanProductAPI.UpdateLoanProduct(ctx, &loan.UpdateLoanProductRequest{
+				LoanProduct: &loan.LoanProduct{
+					ProductId:    productPB.ProductId,
+					InterestRate: productPB.InterestRate + 5,
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(updateRes).ShouldNot(BeNil())
+
+			getRes, err := LoanProductAPI.GetLoanProduct(ctx, &loan.GetLoanProductRequest{
+				ProductId: productPB.ProductId,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.Version).Should(BeEquivalentTo(2))
+			Expect(getRes.Versions).Should(HaveLen(2))
+			Expect(getRes.Versions[0].Terms.InterestRate).Should(Equal(productPB.InterestRate + 5))
+			Expect(getRes.Versions[1].Terms.InterestRate).Should(Equal(productPB.InterestRate))
+		})
+	})
+
+	Describe("Deleting a product with active loans", func() {
+		Context("Lets create an active loan first", func() {
+			It("should succeed", func() {
+				err := LoanProductAPIServer.SQLDB.Create(&models.Loan{
+					ChamaID:        productPB.ChamaId,
+					ProductID:      productPB.ProductId,
+					ProductVersion: 1,
+					MemberID:       randomID(),
+					LoaneeNames:    randomdata.SillyName(),
+					LoaneePhone:    "254712345678",
+					NationalID:     "12345678",
+					Status:         loan.LoanStatus_FUNDS_TRANSFERED.String(),
+					LoanAmount:     1000,
+				}).Error
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+
+		It("should fail and offer archive instead", func() {
+			deleteRes, err := LoanProductAPI.DeleteLoanProduct(ctx, &loan.DeleteLoanProductRequest{
+				ProductId: productPB.ProductId,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(deleteRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should archive the product", func() {
+			archiveRes, err := LoanProductAPI.ArchiveLoanProduct(ctx, &loan.ArchiveLoanProductRequest{
+				ProductId: productPB.ProductId,
+				Archived:  true,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(archiveRes).ShouldNot(BeNil())
+
+			getRes, err := LoanProductAPI.GetLoanProduct(ctx, &loan.GetLoanProductRequest{
+				ProductId: productPB.ProductId,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.Archived).Should(BeTrue())
+		})
+	})
+
+	Describe("ArchiveLoanProduct with malformed request", func() {
+		It("should fail when product does not exist", func() {
+			archiveRes, err := LoanProductAPI.ArchiveLoanProduct(ctx, &loan.ArchiveLoanProductRequest{
+				ProductId: "0",
+				Archived:  true,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(archiveRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+	})
+})
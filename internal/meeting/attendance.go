@@ -0,0 +1,293 @@
+package meeting
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gidyon/machama-app/internal/fine"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	transaction_app "github.com/gidyon/machama-app/internal/transaction"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/pkg/utils/mdutil"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (meetingAPI *meetingAPIServer) RecordAttendance(
+	ctx context.Context, req *chama.RecordAttendanceRequest,
+) (*chama.Meeting, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.MeetingId == "":
+		return nil, errs.MissingField("meeting id")
+	case len(req.Attendance) == 0:
+		return nil, errs.MissingField("attendance")
+	}
+
+	memberIDs := make([]string, 0, len(req.Attendance))
+	for _, attendancePB := range req.Attendance {
+		switch {
+		case attendancePB == nil:
+			return nil, errs.MissingField("attendance")
+		case attendancePB.MemberId == "":
+			return nil, errs.MissingField("member id")
+		case chama.AttendanceStatus_name[int32(attendancePB.Status)] == "":
+			return nil, errs.IncorrectVal("attendance status")
+		case attendancePB.Status == chama.AttendanceStatus_ATTENDANCE_UNSPECIFIED:
+			return nil, errs.MissingField("attendance status")
+		}
+		memberIDs = append(memberIDs, attendancePB.MemberId)
+	}
+
+	// Authorization. Attendance is final once the meeting is closed and fines are charged
+	_, meetingDB, err := meetingAPI.scheduledMeeting(ctx, req.MeetingId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*models.ChamaMember, 0, len(memberIDs))
+	err = meetingAPI.SQLDB.Find(&members, "chama_id = ? AND id IN (?)", meetingDB.ChamaID, memberIDs).Error
+	if err != nil {
+		return nil, errs.FailedToFind("chama members", err)
+	}
+
+	names := make(map[string]string, len(members))
+	for _, memberDB := range members {
+		names[fmt.Sprint(memberDB.ID)] = memberDB.FirstName + " " + memberDB.LastName
+	}
+
+	attendance := make([]*models.MeetingAttendance, 0, len(req.Attendance))
+	for _, attendancePB := range req.Attendance {
+		memberNames, ok := names[attendancePB.MemberId]
+		if !ok {
+			return nil, errs.WrapMessagef(codes.InvalidArgument, "member %s is not in the chama", attendancePB.MemberId)
+		}
+		attendance = append(attendance, &models.MeetingAttendance{
+			MeetingID:   meetingDB.ID,
+			MemberID:    attendancePB.MemberId,
+			MemberNames: memberNames,
+			Status:      attendancePB.Status.String(),
+			Apology:     attendancePB.Apology,
+		})
+	}
+
+	err = meetingAPI.SQLDB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "meeting_id"}, {Name: "member_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "apology", "updated_at"}),
+	}).Create(attendance).Error
+	if err != nil {
+		return nil, errs.FailedToSave("meeting attendance", err)
+	}
+
+	return meetingAPI.meetingProto(meetingDB)
+}
+
+func (meetingAPI *meetingAPIServer) CloseMeeting(
+	ctx context.Context, req *chama.CloseMeetingRequest,
+) (*chama.Meeting, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.MeetingId == "":
+		return nil, errs.MissingField("meeting id")
+	}
+
+	// Authorization
+	actor, meetingDB, err := meetingAPI.scheduledMeeting(ctx, req.MeetingId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	fined := make([]*models.MeetingAttendance, 0)
+
+	err = meetingAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		// Claim the meeting so that fines are charged once
+		db := tx.Model(&models.Meeting{}).
+			Where("id = ? AND status = ?", meetingDB.ID, chama.MeetingStatus_MEETING_SCHEDULED.String()).
+			Update("status", chama.MeetingStatus_MEETING_HELD.String())
+		if db.Error != nil {
+			return errs.FailedToUpdate("meeting", db.Error)
+		}
+		if db.RowsAffected == 0 {
+			return errs.WrapMessage(codes.FailedPrecondition, "meeting has already been closed")
+		}
+
+		// Active members whose attendance wasn't recorded were absent
+		err := tx.Exec(
+			`INSERT INTO meeting_attendance (meeting_id, member_id, member_names, status, created_at, updated_at)
+			SELECT ?, CAST(id AS CHAR), CONCAT(first_name, ' ', last_name), ?, NOW(), NOW() FROM chama_members
+			WHERE chama_id = ? AND active = ? AND CAST(id AS CHAR) NOT IN (SELECT member_id FROM meeting_attendance WHERE meeting_id = ?)`,
+			meetingDB.ID, chama.AttendanceStatus_ABSENT.String(), meetingDB.ChamaID, true, meetingDB.ID,
+		).Error
+		if err != nil {
+			return errs.FailedToSave("meeting attendance", err)
+		}
+
+		for status, amount := range map[chama.AttendanceStatus]float64{
+			chama.AttendanceStatus_ABSENT: meetingDB.AbsenteeFine,
+			chama.AttendanceStatus_LATE:   meetingDB.LateFine,
+		} {
+			if amount <= 0 {
+				continue
+			}
+			err = tx.Model(&models.MeetingAttendance{}).
+				Where("meeting_id = ? AND status = ?", meetingDB.ID, status.String()).
+				Update("fine_amount", amount).Error
+			if err != nil {
+				return errs.FailedToUpdate("meeting attendance", err)
+			}
+		}
+
+		err = tx.Order("id ASC").Find(&fined, "meeting_id = ? AND fine_amount > 0", meetingDB.ID).Error
+		if err != nil {
+			return errs.FailedToFind("meeting attendance", err)
+		}
+
+		// Meeting fines are issued to members like any other fine, so that fines they can't pay stay outstanding
+		for _, attendanceDB := range fined {
+			fineName := fine.MeetingAbsenceFine
+			if attendanceDB.Status == chama.AttendanceStatus_LATE.String() {
+				fineName = fine.MeetingLatenessFine
+			}
+
+			fineDB := &models.Fine{
+				ChamaID:     meetingDB.ChamaID,
+				MemberID:    attendanceDB.MemberID,
+				MemberNames: attendanceDB.MemberNames,
+				FineName:    fineName,
+				AccountName: meetingDB.FineAccountName,
+				Amount:      attendanceDB.FineAmount,
+				Reason:      fmt.Sprintf("%s for meeting %s", attendanceDB.Status, meetingDB.Title),
+				IssuedBy:    actor.ID,
+			}
+
+			err = fine.Charge(tx, fineDB)
+			if err != nil {
+				return err
+			}
+
+			attendanceDB.FineID = fineDB.ID
+
+			err = tx.Model(attendanceDB).Update("fine_id", fineDB.ID).Error
+			if err != nil {
+				return errs.FailedToUpdate("meeting attendance", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	meetingDB.Status = chama.MeetingStatus_MEETING_HELD.String()
+
+	for _, attendanceDB := range fined {
+		err = meetingAPI.collectFine(ctx, actor.ID, meetingDB, attendanceDB)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return meetingAPI.meetingProto(meetingDB)
+}
+
+// collectFine pays a meeting fine from the member account into the chama fines account. Fines the member can't afford
+// stay outstanding.
+func (meetingAPI *meetingAPIServer) collectFine(
+	ctx context.Context, actorID string, meetingDB *models.Meeting, attendanceDB *models.MeetingAttendance,
+) error {
+	ctxExt := mdutil.AddFromCtx(ctx)
+
+	memberAccount, err := meetingAPI.MoneyAccountAPI.GetChamaAccount(ctxExt, &transaction.GetChamaAccountRequest{
+		OwnerId:     attendanceDB.MemberID,
+		AccountName: meetingDB.MemberAccountName,
+	})
+	if err != nil {
+		meetingAPI.Logger.Warningf("meeting fine %d for member %s left outstanding: %v", attendanceDB.FineID, attendanceDB.MemberID, err)
+		return nil
+	}
+
+	fineAccount, err := meetingAPI.MoneyAccountAPI.GetChamaAccount(ctxExt, &transaction.GetChamaAccountRequest{
+		OwnerId:     meetingDB.ChamaID,
+		AccountName: meetingDB.FineAccountName,
+	})
+	if err != nil {
+		return err
+	}
+
+	fineDB := &models.Fine{ID: attendanceDB.FineID, Amount: attendanceDB.FineAmount}
+
+	err = fine.ClaimPayment(meetingAPI.SQLDB, fineDB, attendanceDB.FineAmount)
+	if err != nil {
+		meetingAPI.Logger.Warningf("meeting fine %d for member %s left outstanding: %v", attendanceDB.FineID, attendanceDB.MemberID, err)
+		return nil
+	}
+
+	description := fmt.Sprintf("%s fine for meeting %s", attendanceDB.Status, meetingDB.Title)
+
+	_, err = meetingAPI.TransactionAPI.Withdraw(transaction_app.WithTransfer(ctxExt), &transaction.WithdrawRequest{
+		ActorId:     actorID,
+		AccountId:   memberAccount.AccountId,
+		Description: description,
+		Amount:      attendanceDB.FineAmount,
+	})
+	if err != nil {
+		meetingAPI.Logger.Warningf("meeting fine %d for member %s left outstanding: %v", attendanceDB.FineID, attendanceDB.MemberID, err)
+		meetingAPI.releaseFine(attendanceDB)
+		return nil
+	}
+
+	_, err = meetingAPI.TransactionAPI.Deposit(ctxExt, &transaction.DepositRequest{
+		ActorId:     actorID,
+		AccountId:   fineAccount.AccountId,
+		Description: description,
+		Amount:      attendanceDB.FineAmount,
+	})
+	if err != nil {
+		// Refund the member account before releasing the claim
+		_, err2 := meetingAPI.TransactionAPI.Deposit(ctxExt, &transaction.DepositRequest{
+			ActorId:     actorID,
+			AccountId:   memberAccount.AccountId,
+			Description: "Refund of " + description,
+			Amount:      attendanceDB.FineAmount,
+		})
+		if err2 != nil {
+			meetingAPI.Logger.Errorf(
+				"failed to refund meeting fine of %.2f to account %s: %v", attendanceDB.FineAmount, memberAccount.AccountId, err2,
+			)
+			return err
+		}
+		meetingAPI.releaseFine(attendanceDB)
+		return err
+	}
+
+	return meetingAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		_, err := fine.SettleFine(tx, attendanceDB.FineID)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(attendanceDB).Update("fine_paid", true).Error
+		if err != nil {
+			return errs.FailedToUpdate("meeting attendance", err)
+		}
+
+		return nil
+	})
+}
+
+// releaseFine gives back the claimed payment of a meeting fine whose money didn't move
+func (meetingAPI *meetingAPIServer) releaseFine(attendanceDB *models.MeetingAttendance) {
+	err := fine.ReleasePayment(meetingAPI.SQLDB, attendanceDB.FineID, attendanceDB.FineAmount)
+	if err != nil {
+		meetingAPI.Logger.Errorf("failed to release payment of meeting fine %d: %v", attendanceDB.FineID, err)
+	}
+}
@@ -0,0 +1,197 @@
+package meeting
+
+import (
+	"context"
+
+	"github.com/gidyon/machama-app/internal/fine"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Meeting attendance", func() {
+	var (
+		ctx       context.Context
+		chamaID   string
+		memberIDs []string
+		meetingID string
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		var err error
+		chamaID, memberIDs, err = createChama(1000, 1000, 1000, 1000, 100)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		meetingRes, err := MeetingAPI.ScheduleMeeting(ctx, &chama.ScheduleMeetingRequest{Meeting: mockMeeting(chamaID)})
+		Expect(err).ShouldNot(HaveOccurred())
+		meetingID = meetingRes.MeetingId
+	})
+
+	balance := func(ownerID, accountName string) float64 {
+		accountDB := &models.ChamaAccount{}
+		err := MeetingAPIServer.SQLDB.First(accountDB, "owner_id = ? AND account_name = ?", ownerID, accountName).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		return accountDB.AvailableAmount
+	}
+
+	attendanceOf := func(meetingPB *chama.Meeting) map[string]*chama.MeetingAttendance {
+		attendance := make(map[string]*chama.MeetingAttendance, len(meetingPB.Attendance))
+		for _, attendancePB := range meetingPB.Attendance {
+			attendance[attendancePB.MemberId] = attendancePB
+		}
+		return attendance
+	}
+
+	Describe("Recording attendance", func() {
+		It("should fail when the status is missing", func() {
+			meetingRes, err := MeetingAPI.RecordAttendance(ctx, &chama.RecordAttendanceRequest{
+				MeetingId:  meetingID,
+				Attendance: []*chama.MeetingAttendance{{MemberId: memberIDs[0]}},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(meetingRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("should fail for members of another chama", func() {
+			meetingRes, err := MeetingAPI.RecordAttendance(ctx, &chama.RecordAttendanceRequest{
+				MeetingId: meetingID,
+				Attendance: []*chama.MeetingAttendance{
+					{MemberId: "0", Status: chama.AttendanceStatus_PRESENT},
+				},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(meetingRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("should correct attendance recorded earlier", func() {
+			_, err := MeetingAPI.RecordAttendance(ctx, &chama.RecordAttendanceRequest{
+				MeetingId: meetingID,
+				Attendance: []*chama.MeetingAttendance{
+					{MemberId: memberIDs[0], Status: chama.AttendanceStatus_ABSENT},
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meetingRes, err := MeetingAPI.RecordAttendance(ctx, &chama.RecordAttendanceRequest{
+				MeetingId: meetingID,
+				Attendance: []*chama.MeetingAttendance{
+					{MemberId: memberIDs[0], Status: chama.AttendanceStatus_LATE},
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(meetingRes.Attendance).Should(HaveLen(1))
+			Expect(meetingRes.Attendance[0].Status).Should(Equal(chama.AttendanceStatus_LATE))
+			Expect(meetingRes.Attendance[0].MemberNames).ShouldNot(BeEmpty())
+		})
+	})
+
+	Describe("Closing the meeting", func() {
+		It("should mark unrecorded members absent and collect fines", func() {
+			_, err := MeetingAPI.RecordAttendance(ctx, &chama.RecordAttendanceRequest{
+				MeetingId: meetingID,
+				Attendance: []*chama.MeetingAttendance{
+					{MemberId: memberIDs[0], Status: chama.AttendanceStatus_PRESENT},
+					{MemberId: memberIDs[1], Status: chama.AttendanceStatus_LATE},
+					{MemberId: memberIDs[2], Status: chama.AttendanceStatus_ABSENT_WITH_APOLOGY, Apology: "Hospital visit"},
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meetingRes, err := MeetingAPI.CloseMeeting(ctx, &chama.CloseMeetingRequest{MeetingId: meetingID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(meetingRes.Status).Should(Equal(chama.MeetingStatus_MEETING_HELD))
+			Expect(meetingRes.Attendance).Should(HaveLen(5))
+
+			attendance := attendanceOf(meetingRes)
+
+			Expect(attendance[memberIDs[0]].FineAmount).Should(BeZero())
+			Expect(attendance[memberIDs[2]].FineAmount).Should(BeZero())
+
+			Expect(attendance[memberIDs[1]].FineAmount).Should(BeNumerically("~", 50, 0.001))
+			Expect(attendance[memberIDs[1]].FinePaid).Should(BeTrue())
+			Expect(balance(memberIDs[1], memberAccount)).Should(BeNumerically("~", 950, 0.001))
+
+			Expect(attendance[memberIDs[3]].Status).Should(Equal(chama.AttendanceStatus_ABSENT))
+			Expect(attendance[memberIDs[3]].FineAmount).Should(BeNumerically("~", 200, 0.001))
+			Expect(attendance[memberIDs[3]].FinePaid).Should(BeTrue())
+			Expect(balance(memberIDs[3], memberAccount)).Should(BeNumerically("~", 800, 0.001))
+
+			// Fines the member can't afford stay unpaid
+			Expect(attendance[memberIDs[4]].FineAmount).Should(BeNumerically("~", 200, 0.001))
+			Expect(attendance[memberIDs[4]].FinePaid).Should(BeFalse())
+			Expect(balance(memberIDs[4], memberAccount)).Should(BeNumerically("~", 100, 0.001))
+
+			Expect(balance(chamaID, fineAccount)).Should(BeNumerically("~", 250, 0.001))
+		})
+
+		It("should record fines the member can't afford as outstanding", func() {
+			_, err := MeetingAPI.RecordAttendance(ctx, &chama.RecordAttendanceRequest{
+				MeetingId: meetingID,
+				Attendance: []*chama.MeetingAttendance{
+					{MemberId: memberIDs[0], Status: chama.AttendanceStatus_PRESENT},
+					{MemberId: memberIDs[1], Status: chama.AttendanceStatus_PRESENT},
+					{MemberId: memberIDs[2], Status: chama.AttendanceStatus_PRESENT},
+					{MemberId: memberIDs[3], Status: chama.AttendanceStatus_LATE},
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = MeetingAPI.CloseMeeting(ctx, &chama.CloseMeetingRequest{MeetingId: meetingID})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fines := make([]*models.Fine, 0)
+			err = MeetingAPIServer.SQLDB.Find(&fines, "chama_id = ?", chamaID).Error
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fines).Should(HaveLen(2))
+
+			finesOf := make(map[string]*models.Fine, len(fines))
+			for _, fineDB := range fines {
+				finesOf[fineDB.MemberID] = fineDB
+			}
+
+			Expect(finesOf[memberIDs[3]].FineName).Should(Equal(fine.MeetingLatenessFine))
+			Expect(finesOf[memberIDs[3]].Status).Should(Equal(chama.FineStatus_FINE_PAID.String()))
+			Expect(finesOf[memberIDs[3]].PaidAmount).Should(BeNumerically("~", 50, 0.001))
+
+			// The member only has 100 towards the absence fine of 200
+			Expect(finesOf[memberIDs[4]].FineName).Should(Equal(fine.MeetingAbsenceFine))
+			Expect(finesOf[memberIDs[4]].AccountName).Should(Equal(fineAccount))
+			Expect(finesOf[memberIDs[4]].Amount).Should(BeNumerically("~", 200, 0.001))
+			Expect(finesOf[memberIDs[4]].PaidAmount).Should(BeZero())
+			Expect(finesOf[memberIDs[4]].Status).Should(Equal(chama.FineStatus_FINE_OUTSTANDING.String()))
+			Expect(balance(memberIDs[4], memberAccount)).Should(BeNumerically("~", 100, 0.001))
+
+			attendanceDB := &models.MeetingAttendance{}
+			err = MeetingAPIServer.SQLDB.First(attendanceDB, "member_id = ?", memberIDs[4]).Error
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(attendanceDB.FineID).Should(Equal(finesOf[memberIDs[4]].ID))
+			Expect(attendanceDB.FinePaid).Should(BeFalse())
+		})
+
+		It("should not close a meeting twice", func() {
+			_, err := MeetingAPI.CloseMeeting(ctx, &chama.CloseMeetingRequest{MeetingId: meetingID})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meetingRes, err := MeetingAPI.CloseMeeting(ctx, &chama.CloseMeetingRequest{MeetingId: meetingID})
+			Expect(err).Should(HaveOccurred())
+			Expect(meetingRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			meetingRes, err = MeetingAPI.RecordAttendance(ctx, &chama.RecordAttendanceRequest{
+				MeetingId: meetingID,
+				Attendance: []*chama.MeetingAttendance{
+					{MemberId: memberIDs[0], Status: chama.AttendanceStatus_PRESENT},
+				},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(meetingRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+})
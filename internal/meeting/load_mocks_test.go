@@ -0,0 +1,83 @@
+package meeting
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+)
+
+const (
+	memberAccount = "savings"
+	fineAccount   = "fines"
+)
+
+func randomPhone() string {
+	return fmt.Sprintf("2547%d", randomdata.Number(10000000, 99999999))
+}
+
+// createChama saves active members of a new chama, each with the given savings, and the account fines are paid into
+func createChama(savings ...float64) (chamaID string, memberIDs []string, err error) {
+	chamaID = fmt.Sprint(randomdata.Number(1000, 9999999))
+
+	err = MeetingAPIServer.SQLDB.Create(&models.ChamaAccount{
+		OwnerID:     chamaID,
+		AccountName: fineAccount,
+		AccountType: transaction.AccountType_SAVINGS_ACCOUNT.String(),
+		Active:      true,
+	}).Error
+	if err != nil {
+		return "", nil, err
+	}
+
+	for _, amount := range savings {
+		memberDB := &models.ChamaMember{
+			ChamaID:   chamaID,
+			FirstName: randomdata.FirstName(randomdata.Female),
+			LastName:  randomdata.LastName(),
+			Phone:     randomPhone(),
+			IDNumber:  fmt.Sprint(randomdata.Number(22222222, 44444444)),
+			Active:    true,
+		}
+		err = MeetingAPIServer.SQLDB.Create(memberDB).Error
+		if err != nil {
+			return "", nil, err
+		}
+
+		memberID := fmt.Sprint(memberDB.ID)
+
+		err = MeetingAPIServer.SQLDB.Create(&models.ChamaAccount{
+			OwnerID:              memberID,
+			AccountName:          memberAccount,
+			AccountType:          transaction.AccountType_SAVINGS_ACCOUNT.String(),
+			Withdrawable:         true,
+			TotalDepositedAmount: amount,
+			AvailableAmount:      amount,
+			Active:               true,
+		}).Error
+		if err != nil {
+			return "", nil, err
+		}
+
+		memberIDs = append(memberIDs, memberID)
+	}
+
+	return chamaID, memberIDs, nil
+}
+
+func mockMeeting(chamaID string) *chama.Meeting {
+	return &chama.Meeting{
+		ChamaId:           chamaID,
+		Title:             randomdata.SillyName() + " monthly meeting",
+		Venue:             randomdata.City(),
+		Agenda:            randomdata.Paragraph(),
+		ScheduledDate:     time.Now().AddDate(0, 0, 7).Format(models.MeetingTimeLayout),
+		AbsenteeFine:      200,
+		LateFine:          50,
+		MemberAccountName: memberAccount,
+		FineAccountName:   fineAccount,
+	}
+}
@@ -0,0 +1,535 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/pkg/utils/mdutil"
+
+	"github.com/speps/go-hashids"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"gorm.io/gorm"
+)
+
+type Options struct {
+	MoneyAccountAPI transaction.ChamaAccountAPIServer
+	TransactionAPI  transaction.TransactionAPIServer
+	SQLDB           *gorm.DB
+	PageHasher      *hashids.HashID
+	Logger          grpclog.LoggerV2
+	Auth            auth.API
+	AllowedGroups   []string
+}
+
+type meetingAPIServer struct {
+	chama.UnimplementedMeetingAPIServer
+	*Options
+	authorizer *roles.Authorizer
+}
+
+// NewMeetingAPI creates the API for chama meetings, their attendance, minutes and resolutions
+func NewMeetingAPI(ctx context.Context, opt *Options) (chama.MeetingAPIServer, error) {
+	// Validation
+	switch {
+	case ctx == nil:
+		return nil, errors.New("missing context")
+	case opt == nil:
+		return nil, errors.New("missing options")
+	case opt.TransactionAPI == nil:
+		return nil, errors.New("missing transaction API")
+	case opt.MoneyAccountAPI == nil:
+		return nil, errors.New("missing chama account API")
+	case opt.SQLDB == nil:
+		return nil, errors.New("missing sql db")
+	case opt.Logger == nil:
+		return nil, errors.New("missing logger")
+	case opt.Auth == nil:
+		return nil, errors.New("missing auth API")
+	case opt.PageHasher == nil:
+		return nil, errors.New("missing pagination hasher")
+	default:
+		if len(opt.AllowedGroups) == 0 {
+			opt.AllowedGroups = opt.Auth.AdminGroups()
+		}
+	}
+
+	meetingAPI := &meetingAPIServer{
+		Options:    opt,
+		authorizer: roles.NewAuthorizer(opt.Auth, opt.SQLDB, opt.AllowedGroups),
+	}
+
+	return meetingAPI, nil
+}
+
+func ValidateMeeting(pb *chama.Meeting) error {
+	switch {
+	case pb == nil:
+		return errs.MissingField("meeting")
+	case pb.ChamaId == "":
+		return errs.MissingField("chama id")
+	case pb.Title == "":
+		return errs.MissingField("meeting title")
+	case pb.ScheduledDate == "":
+		return errs.MissingField("scheduled date")
+	}
+	return validateFines(pb)
+}
+
+// validateFines checks that fined meetings say which accounts fines move between
+func validateFines(pb *chama.Meeting) error {
+	switch {
+	case pb.AbsenteeFine < 0:
+		return errs.IncorrectVal("absentee fine")
+	case pb.LateFine < 0:
+		return errs.IncorrectVal("late fine")
+	case pb.AbsenteeFine == 0 && pb.LateFine == 0:
+	case pb.MemberAccountName == "":
+		return errs.MissingField("member account name")
+	case pb.FineAccountName == "":
+		return errs.MissingField("fine account name")
+	}
+	return nil
+}
+
+func (meetingAPI *meetingAPIServer) ScheduleMeeting(
+	ctx context.Context, req *chama.ScheduleMeetingRequest,
+) (*chama.Meeting, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	default:
+		err := ValidateMeeting(req.Meeting)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Authorization
+	_, err := meetingAPI.authorizer.AuthorizeChama(ctx, req.Meeting.ChamaId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	meetingDB, err := models.MeetingModel(req.Meeting)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fines are collected into a chama account
+	if meetingDB.FineAccountName != "" {
+		_, err = meetingAPI.MoneyAccountAPI.GetChamaAccount(mdutil.AddFromCtx(ctx), &transaction.GetChamaAccountRequest{
+			OwnerId:     meetingDB.ChamaID,
+			AccountName: meetingDB.FineAccountName,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	meetingDB.Status = chama.MeetingStatus_MEETING_SCHEDULED.String()
+
+	err = meetingAPI.SQLDB.Create(meetingDB).Error
+	if err != nil {
+		return nil, errs.FailedToSave("meeting", err)
+	}
+
+	return meetingAPI.meetingProto(meetingDB)
+}
+
+func (meetingAPI *meetingAPIServer) UpdateMeeting(
+	ctx context.Context, req *chama.UpdateMeetingRequest,
+) (*chama.Meeting, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.Meeting == nil:
+		return nil, errs.MissingField("meeting")
+	case req.Meeting.MeetingId == "":
+		return nil, errs.MissingField("meeting id")
+	}
+
+	// Authorization
+	_, meetingDB, err := meetingAPI.scheduledMeeting(ctx, req.Meeting.MeetingId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	updateDB, err := models.MeetingModel(req.Meeting)
+	if err != nil {
+		return nil, err
+	}
+
+	// The chama and status of a meeting don't change through updates
+	updateDB.ChamaID = ""
+	updateDB.Status = ""
+
+	// Fines settings are validated as they will be after the update
+	finesPB := &chama.Meeting{
+		AbsenteeFine:      meetingDB.AbsenteeFine,
+		LateFine:          meetingDB.LateFine,
+		MemberAccountName: meetingDB.MemberAccountName,
+		FineAccountName:   meetingDB.FineAccountName,
+	}
+	if req.Meeting.AbsenteeFine != 0 {
+		finesPB.AbsenteeFine = req.Meeting.AbsenteeFine
+	}
+	if req.Meeting.LateFine != 0 {
+		finesPB.LateFine = req.Meeting.LateFine
+	}
+	if req.Meeting.MemberAccountName != "" {
+		finesPB.MemberAccountName = req.Meeting.MemberAccountName
+	}
+	if req.Meeting.FineAccountName != "" {
+		finesPB.FineAccountName = req.Meeting.FineAccountName
+		_, err = meetingAPI.MoneyAccountAPI.GetChamaAccount(mdutil.AddFromCtx(ctx), &transaction.GetChamaAccountRequest{
+			OwnerId:     meetingDB.ChamaID,
+			AccountName: req.Meeting.FineAccountName,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = validateFines(finesPB)
+	if err != nil {
+		return nil, err
+	}
+
+	err = meetingAPI.SQLDB.Model(meetingDB).Updates(updateDB).Error
+	if err != nil {
+		return nil, errs.FailedToUpdate("meeting", err)
+	}
+
+	return meetingAPI.GetMeeting(ctx, &chama.GetMeetingRequest{MeetingId: req.Meeting.MeetingId})
+}
+
+func (meetingAPI *meetingAPIServer) CancelMeeting(
+	ctx context.Context, req *chama.CancelMeetingRequest,
+) (*chama.Meeting, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.MeetingId == "":
+		return nil, errs.MissingField("meeting id")
+	}
+
+	// Authorization
+	_, meetingDB, err := meetingAPI.scheduledMeeting(ctx, req.MeetingId, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
+	meetingDB.Status = chama.MeetingStatus_MEETING_CANCELLED.String()
+
+	err = meetingAPI.SQLDB.Model(meetingDB).Update("status", meetingDB.Status).Error
+	if err != nil {
+		return nil, errs.FailedToUpdate("meeting", err)
+	}
+
+	return meetingAPI.meetingProto(meetingDB)
+}
+
+func (meetingAPI *meetingAPIServer) getMeeting(meetingID string) (*models.Meeting, error) {
+	meetingDB := &models.Meeting{}
+	err := meetingAPI.SQLDB.First(meetingDB, "id = ?", meetingID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("meeting", meetingID)
+	default:
+		return nil, errs.FailedToFind("meeting", err)
+	}
+	return meetingDB, nil
+}
+
+// authorizeMeeting gets a meeting and authorizes an actor holding one of the roles in its chama
+func (meetingAPI *meetingAPIServer) authorizeMeeting(
+	ctx context.Context, meetingID string, roles ...chama.ChamaRole,
+) (*auth.Payload, *models.Meeting, error) {
+	meetingDB, err := meetingAPI.getMeeting(meetingID)
+	if err != nil {
+		return nil, nil, err
+	}
+	actor, err := meetingAPI.authorizer.AuthorizeChama(ctx, meetingDB.ChamaID, roles...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return actor, meetingDB, nil
+}
+
+// scheduledMeeting authorizes the actor on a meeting that has neither been held nor cancelled
+func (meetingAPI *meetingAPIServer) scheduledMeeting(
+	ctx context.Context, meetingID string, roles ...chama.ChamaRole,
+) (*auth.Payload, *models.Meeting, error) {
+	actor, meetingDB, err := meetingAPI.authorizeMeeting(ctx, meetingID, roles...)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch meetingDB.Status {
+	case chama.MeetingStatus_MEETING_HELD.String():
+		return nil, nil, errs.WrapMessage(codes.FailedPrecondition, "meeting has already been held")
+	case chama.MeetingStatus_MEETING_CANCELLED.String():
+		return nil, nil, errs.WrapMessage(codes.FailedPrecondition, "meeting has been cancelled")
+	}
+	return actor, meetingDB, nil
+}
+
+func (meetingAPI *meetingAPIServer) meetingProto(meetingDB *models.Meeting) (*chama.Meeting, error) {
+	meetingPB, err := models.MeetingProto(meetingDB)
+	if err != nil {
+		return nil, err
+	}
+
+	attendance := make([]*models.MeetingAttendance, 0)
+	err = meetingAPI.SQLDB.Order("id ASC").Find(&attendance, "meeting_id = ?", meetingDB.ID).Error
+	if err != nil {
+		return nil, errs.FailedToFind("meeting attendance", err)
+	}
+
+	for _, attendanceDB := range attendance {
+		attendancePB, err := models.MeetingAttendanceProto(attendanceDB)
+		if err != nil {
+			return nil, err
+		}
+		meetingPB.Attendance = append(meetingPB.Attendance, attendancePB)
+	}
+
+	resolutions := make([]*models.MeetingResolution, 0)
+	err = meetingAPI.SQLDB.Order("id ASC").Find(&resolutions, "meeting_id = ?", meetingDB.ID).Error
+	if err != nil {
+		return nil, errs.FailedToFind("meeting resolutions", err)
+	}
+
+	for _, resolutionDB := range resolutions {
+		resolutionPB, err := models.MeetingResolutionProto(resolutionDB)
+		if err != nil {
+			return nil, err
+		}
+		meetingPB.Resolutions = append(meetingPB.Resolutions, resolutionPB)
+	}
+
+	return meetingPB, nil
+}
+
+func (meetingAPI *meetingAPIServer) GetMeeting(
+	ctx context.Context, req *chama.GetMeetingRequest,
+) (*chama.Meeting, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.MeetingId == "":
+		return nil, errs.MissingField("meeting id")
+	}
+
+	// Authorization
+	_, meetingDB, err := meetingAPI.authorizeMeeting(ctx, req.MeetingId, roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
+	return meetingAPI.meetingProto(meetingDB)
+}
+
+const defaultPageSize = 50
+
+func (meetingAPI *meetingAPIServer) ListMeetings(
+	ctx context.Context, req *chama.ListMeetingsRequest,
+) (*chama.ListMeetingsResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("list request")
+	}
+
+	// Authorization
+	chamaIDs := []string{}
+	if req.ChamaId != "" {
+		chamaIDs = append(chamaIDs, req.ChamaId)
+	}
+	actor, chamaIDs, err := meetingAPI.authorizer.ScopeChamas(ctx, chamaIDs, roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.GetPageSize()
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultPageSize
+	case pageSize > defaultPageSize:
+		if !meetingAPI.Auth.IsAdmin(actor.Group) {
+			pageSize = defaultPageSize
+		}
+	}
+
+	var ID uint
+	pageToken := req.GetPageToken()
+	if pageToken != "" {
+		ids, err := meetingAPI.PageHasher.DecodeInt64WithError(req.GetPageToken())
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		ID = uint(ids[0])
+	}
+
+	db := meetingAPI.SQLDB.Limit(int(pageSize + 1)).Order("id DESC")
+	if ID != 0 {
+		db = db.Where("id<?", ID)
+	}
+
+	if chamaIDs != nil {
+		db = db.Where("chama_id IN (?)", chamaIDs)
+	}
+
+	if len(req.Statuses) != 0 {
+		statuses := make([]string, 0, len(req.Statuses))
+		for _, status := range req.Statuses {
+			statuses = append(statuses, status.String())
+		}
+		db = db.Where("status IN (?)", statuses)
+	}
+
+	dbs := make([]*models.Meeting, 0, pageSize+1)
+	err = db.Find(&dbs).Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "LIST")
+	}
+
+	pbs := make([]*chama.Meeting, 0, len(dbs))
+	for i, db := range dbs {
+		if i == int(pageSize) {
+			break
+		}
+
+		pb, err := models.MeetingProto(db)
+		if err != nil {
+			return nil, err
+		}
+
+		pbs = append(pbs, pb)
+
+		ID = db.ID
+	}
+
+	var token string
+	if len(dbs) > int(pageSize) {
+		// Next page token
+		token, err = meetingAPI.PageHasher.EncodeInt64([]int64{int64(ID)})
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to generate next page token")
+		}
+	}
+
+	return &chama.ListMeetingsResponse{
+		Meetings:      pbs,
+		NextPageToken: token,
+	}, nil
+}
+
+func (meetingAPI *meetingAPIServer) RecordMinutes(
+	ctx context.Context, req *chama.RecordMinutesRequest,
+) (*chama.Meeting, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.MeetingId == "":
+		return nil, errs.MissingField("meeting id")
+	case req.Minutes == "":
+		return nil, errs.MissingField("minutes")
+	}
+
+	// Authorization
+	_, meetingDB, err := meetingAPI.authorizeMeeting(ctx, req.MeetingId, roles.Secretary...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Minutes are often written up after the meeting has been closed
+	if meetingDB.Status == chama.MeetingStatus_MEETING_CANCELLED.String() {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "meeting has been cancelled")
+	}
+
+	meetingDB.Minutes = req.Minutes
+
+	err = meetingAPI.SQLDB.Model(meetingDB).Update("minutes", meetingDB.Minutes).Error
+	if err != nil {
+		return nil, errs.FailedToUpdate("meeting minutes", err)
+	}
+
+	return meetingAPI.meetingProto(meetingDB)
+}
+
+func (meetingAPI *meetingAPIServer) AddResolution(
+	ctx context.Context, req *chama.AddResolutionRequest,
+) (*chama.MeetingResolution, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.Resolution == nil:
+		return nil, errs.MissingField("resolution")
+	case req.Resolution.MeetingId == "":
+		return nil, errs.MissingField("meeting id")
+	case req.Resolution.Description == "":
+		return nil, errs.MissingField("resolution description")
+	case req.Resolution.VotesFor < 0:
+		return nil, errs.IncorrectVal("votes for")
+	case req.Resolution.VotesAgainst < 0:
+		return nil, errs.IncorrectVal("votes against")
+	case req.Resolution.Abstentions < 0:
+		return nil, errs.IncorrectVal("abstentions")
+	case req.Resolution.ProposedBy != "" && req.Resolution.ProposedBy == req.Resolution.SecondedBy:
+		return nil, errs.WrapMessage(codes.InvalidArgument, "resolution cannot be seconded by its proposer")
+	}
+
+	// Authorization
+	_, meetingDB, err := meetingAPI.authorizeMeeting(ctx, req.Resolution.MeetingId, roles.Secretary...)
+	if err != nil {
+		return nil, err
+	}
+
+	if meetingDB.Status == chama.MeetingStatus_MEETING_CANCELLED.String() {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "meeting has been cancelled")
+	}
+
+	// Proposers and seconders are members of the chama
+	for _, memberID := range []string{req.Resolution.ProposedBy, req.Resolution.SecondedBy} {
+		if memberID == "" {
+			continue
+		}
+		err = meetingAPI.SQLDB.First(&models.ChamaMember{}, "id = ? AND chama_id = ?", memberID, meetingDB.ChamaID).Error
+		switch {
+		case err == nil:
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, errs.WrapMessagef(codes.InvalidArgument, "member %s is not in the chama", memberID)
+		default:
+			return nil, errs.FailedToFind("chama member", err)
+		}
+	}
+
+	resolutionDB, err := models.MeetingResolutionModel(req.Resolution)
+	if err != nil {
+		return nil, err
+	}
+
+	resolutionDB.MeetingID = meetingDB.ID
+	resolutionDB.Passed = resolutionDB.VotesFor > resolutionDB.VotesAgainst
+
+	err = meetingAPI.SQLDB.Create(resolutionDB).Error
+	if err != nil {
+		return nil, errs.FailedToSave("meeting resolution", err)
+	}
+
+	return models.MeetingResolutionProto(resolutionDB)
+}
@@ -0,0 +1,156 @@
+package meeting
+
+import (
+	"context"
+
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Scheduling meetings", func() {
+	var (
+		ctx       context.Context
+		chamaID   string
+		memberIDs []string
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		var err error
+		chamaID, memberIDs, err = createChama(1000, 1000)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Describe("ScheduleMeeting with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			meetingRes, err := MeetingAPI.ScheduleMeeting(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(meetingRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the title is missing", func() {
+			meetingPB := mockMeeting(chamaID)
+			meetingPB.Title = ""
+			meetingRes, err := MeetingAPI.ScheduleMeeting(ctx, &chama.ScheduleMeetingRequest{Meeting: meetingPB})
+			Expect(err).Should(HaveOccurred())
+			Expect(meetingRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the scheduled date is malformed", func() {
+			meetingPB := mockMeeting(chamaID)
+			meetingPB.ScheduledDate = "next friday"
+			meetingRes, err := MeetingAPI.ScheduleMeeting(ctx, &chama.ScheduleMeetingRequest{Meeting: meetingPB})
+			Expect(err).Should(HaveOccurred())
+			Expect(meetingRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when fines have no account to be paid into", func() {
+			meetingPB := mockMeeting(chamaID)
+			meetingPB.FineAccountName = ""
+			meetingRes, err := MeetingAPI.ScheduleMeeting(ctx, &chama.ScheduleMeetingRequest{Meeting: meetingPB})
+			Expect(err).Should(HaveOccurred())
+			Expect(meetingRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the fines account doesn't exist", func() {
+			meetingPB := mockMeeting(chamaID)
+			meetingPB.FineAccountName = "welfare"
+			meetingRes, err := MeetingAPI.ScheduleMeeting(ctx, &chama.ScheduleMeetingRequest{Meeting: meetingPB})
+			Expect(err).Should(HaveOccurred())
+			Expect(meetingRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("Managing a scheduled meeting", func() {
+		var meetingID string
+
+		BeforeEach(func() {
+			meetingRes, err := MeetingAPI.ScheduleMeeting(ctx, &chama.ScheduleMeetingRequest{Meeting: mockMeeting(chamaID)})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(meetingRes.Status).Should(Equal(chama.MeetingStatus_MEETING_SCHEDULED))
+			meetingID = meetingRes.MeetingId
+		})
+
+		It("should update the venue and agenda", func() {
+			meetingRes, err := MeetingAPI.UpdateMeeting(ctx, &chama.UpdateMeetingRequest{
+				Meeting: &chama.Meeting{
+					MeetingId: meetingID,
+					Venue:     "Community hall",
+					Agenda:    "1. Loans\n2. AOB",
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(meetingRes.Venue).Should(Equal("Community hall"))
+			Expect(meetingRes.Agenda).Should(Equal("1. Loans\n2. AOB"))
+			Expect(meetingRes.AbsenteeFine).Should(BeNumerically("~", 200, 0.001))
+		})
+
+		It("should record minutes and resolutions", func() {
+			meetingRes, err := MeetingAPI.RecordMinutes(ctx, &chama.RecordMinutesRequest{
+				MeetingId: meetingID,
+				Minutes:   "Meeting opened with a prayer",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(meetingRes.Minutes).Should(Equal("Meeting opened with a prayer"))
+
+			resolutionRes, err := MeetingAPI.AddResolution(ctx, &chama.AddResolutionRequest{
+				Resolution: &chama.MeetingResolution{
+					MeetingId:    meetingID,
+					Description:  "Raise monthly contributions to 1500",
+					ProposedBy:   memberIDs[0],
+					SecondedBy:   memberIDs[1],
+					VotesFor:     2,
+					VotesAgainst: 1,
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resolutionRes.Passed).Should(BeTrue())
+
+			getRes, err := MeetingAPI.GetMeeting(ctx, &chama.GetMeetingRequest{MeetingId: meetingID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.Resolutions).Should(HaveLen(1))
+		})
+
+		It("should fail to add a resolution proposed by a non member", func() {
+			resolutionRes, err := MeetingAPI.AddResolution(ctx, &chama.AddResolutionRequest{
+				Resolution: &chama.MeetingResolution{
+					MeetingId:   meetingID,
+					Description: "Dissolve the chama",
+					ProposedBy:  "0",
+				},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(resolutionRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("should cancel the meeting and keep it from being closed", func() {
+			meetingRes, err := MeetingAPI.CancelMeeting(ctx, &chama.CancelMeetingRequest{MeetingId: meetingID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(meetingRes.Status).Should(Equal(chama.MeetingStatus_MEETING_CANCELLED))
+
+			meetingRes, err = MeetingAPI.CloseMeeting(ctx, &chama.CloseMeetingRequest{MeetingId: meetingID})
+			Expect(err).Should(HaveOccurred())
+			Expect(meetingRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			listRes, err := MeetingAPI.ListMeetings(ctx, &chama.ListMeetingsRequest{
+				ChamaId:  chamaID,
+				Statuses: []chama.MeetingStatus{chama.MeetingStatus_MEETING_SCHEDULED},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Meetings).Should(BeEmpty())
+		})
+
+		It("should list meetings of the chama", func() {
+			listRes, err := MeetingAPI.ListMeetings(ctx, &chama.ListMeetingsRequest{ChamaId: chamaID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Meetings).Should(HaveLen(1))
+			Expect(listRes.Meetings[0].MeetingId).Should(Equal(meetingID))
+		})
+	})
+})
@@ -0,0 +1,343 @@
+package memberexit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type Options struct {
+	MoneyAccountAPI transaction.ChamaAccountAPIServer
+	TransactionAPI  transaction.TransactionAPIServer
+	LoanAPI         loan.LoanAPIServer
+	SQLDB           *gorm.DB
+	Logger          grpclog.LoggerV2
+	Auth            auth.API
+	AllowedGroups   []string
+}
+
+type memberExitAPIServer struct {
+	chama.UnimplementedMemberExitAPIServer
+	*Options
+	authorizer *roles.Authorizer
+}
+
+// NewMemberExitAPI creates the API for settling and exiting members of a chama
+func NewMemberExitAPI(ctx context.Context, opt *Options) (chama.MemberExitAPIServer, error) {
+	// Validation
+	switch {
+	case ctx == nil:
+		return nil, errors.New("missing context")
+	case opt == nil:
+		return nil, errors.New("missing options")
+	case opt.TransactionAPI == nil:
+		return nil, errors.New("missing transaction API")
+	case opt.MoneyAccountAPI == nil:
+		return nil, errors.New("missing chama account API")
+	case opt.LoanAPI == nil:
+		return nil, errors.New("missing loan API")
+	case opt.SQLDB == nil:
+		return nil, errors.New("missing sql db")
+	case opt.Logger == nil:
+		return nil, errors.New("missing logger")
+	case opt.Auth == nil:
+		return nil, errors.New("missing auth API")
+	default:
+		if len(opt.AllowedGroups) == 0 {
+			opt.AllowedGroups = opt.Auth.AdminGroups()
+		}
+	}
+
+	exitAPI := &memberExitAPIServer{
+		Options:    opt,
+		authorizer: roles.NewAuthorizer(opt.Auth, opt.SQLDB, opt.AllowedGroups),
+	}
+
+	return exitAPI, nil
+}
+
+const amountTolerance = 0.005
+
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// Loans that are closed or whose disbursement is still being decided are not netted off
+var (
+	closedLoanStatuses = []string{
+		loan.LoanStatus_REFINANCED.String(),
+		loan.LoanStatus_WRITTEN_OFF.String(),
+		loan.LoanStatus_CANCELLED.String(),
+	}
+	pendingLoanStatuses = []string{
+		loan.LoanStatus_WAITING_APPROVAL.String(),
+		loan.LoanStatus_APPROVED.String(),
+		loan.LoanStatus_FUNDS_WITHDRAWN_ACCOUNT.String(),
+		loan.LoanStatus_WAITING_FUNDS_TRANSFER.String(),
+	}
+)
+
+// loanBalance is what is still owed on a running loan
+type loanBalance struct {
+	ID          uint
+	MemberID    string
+	Outstanding float64
+}
+
+// loanBalances gets what is owed on the disbursed loans of members. As with repayments, loans without a repayment schedule
+// haven't been disbursed and owe nothing yet.
+func (exitAPI *memberExitAPIServer) loanBalances(memberIDs []string) ([]*loanBalance, error) {
+	balances := make([]*loanBalance, 0)
+	err := exitAPI.SQLDB.Table("loans").
+		Select(
+			"loans.id, loans.member_id, "+
+				"GREATEST(SUM(loan_installments.amount_due) + loans.penalty_amount - loans.settled_amount, 0) AS outstanding",
+		).
+		Joins("JOIN loan_installments ON loan_installments.loan_id = loans.id").
+		Where("loans.member_id IN (?) AND loans.status NOT IN (?) AND loans.status NOT IN (?)",
+			memberIDs, closedLoanStatuses, pendingLoanStatuses).
+		Group("loans.id, loans.member_id, loans.penalty_amount, loans.settled_amount").
+		Order("loans.id").
+		Scan(&balances).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loan balances", err)
+	}
+	return balances, nil
+}
+
+// statement works out the lines of the settlement of a member. Savings and share capital are paid to the member
+// while loans, fines and the share of loans they guarantee are recovered from them.
+func (exitAPI *memberExitAPIServer) statement(memberDB *models.ChamaMember) (*models.MemberExit, error) {
+	memberID := fmt.Sprint(memberDB.ID)
+
+	exitDB := &models.MemberExit{
+		ChamaID:     memberDB.ChamaID,
+		MemberID:    memberID,
+		MemberNames: fmt.Sprintf("%s %s", memberDB.FirstName, memberDB.LastName),
+		Status:      chama.ExitStatus_EXIT_PREVIEW.String(),
+		Lines:       make([]*models.MemberExitLine, 0),
+	}
+
+	// Savings and share capital
+	accounts := make([]*models.ChamaAccount, 0)
+	err := exitAPI.SQLDB.Order("id").Find(&accounts, "owner_id = ? AND chama_id = ? AND account_type IN (?) AND available_amount > ?",
+		memberID, memberDB.ChamaID, []string{
+			transaction.AccountType_SAVINGS_ACCOUNT.String(), transaction.AccountType_SHARE_CAPITAL_ACCOUNT.String(),
+		}, amountTolerance).Error
+	if err != nil {
+		return nil, errs.FailedToFind("member accounts", err)
+	}
+
+	for _, accountDB := range accounts {
+		lineType, description := chama.SettlementLineType_SETTLEMENT_SAVINGS, "Savings in %s"
+		if accountDB.AccountType == transaction.AccountType_SHARE_CAPITAL_ACCOUNT.String() {
+			lineType, description = chama.SettlementLineType_SETTLEMENT_SHARE_CAPITAL, "Share capital in %s"
+		}
+		exitDB.Lines = append(exitDB.Lines, &models.MemberExitLine{
+			LineType:    lineType.String(),
+			ReferenceID: fmt.Sprint(accountDB.ID),
+			Description: fmt.Sprintf(description, accountDB.AccountName),
+			Amount:      roundAmount(accountDB.AvailableAmount),
+		})
+	}
+
+	// Loans. Loans awaiting approval or disbursement have to be cancelled or disbursed first
+	var pending int64
+	err = exitAPI.SQLDB.Model(&models.Loan{}).Where("member_id = ? AND status NOT IN (?)", memberID, closedLoanStatuses).
+		Where("(status IN (?) OR NOT EXISTS (SELECT 1 FROM loan_installments WHERE loan_installments.loan_id = loans.id))",
+			pendingLoanStatuses).
+		Count(&pending).Error
+	if err != nil {
+		return nil, errs.FailedToFind("loans", err)
+	}
+	if pending != 0 {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "member has loans awaiting approval or disbursement")
+	}
+
+	balances, err := exitAPI.loanBalances([]string{memberID})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, balance := range balances {
+		if balance.Outstanding <= amountTolerance {
+			continue
+		}
+		exitDB.Lines = append(exitDB.Lines, &models.MemberExitLine{
+			LineType:    chama.SettlementLineType_SETTLEMENT_LOAN.String(),
+			ReferenceID: fmt.Sprint(balance.ID),
+			Description: fmt.Sprintf("Balance of loan %d", balance.ID),
+			Amount:      roundAmount(balance.Outstanding),
+		})
+	}
+
+	// Fines, including those charged for late contributions and missed meetings
+	fines := make([]*models.Fine, 0)
+	err = exitAPI.SQLDB.Order("id").Find(&fines, "member_id = ? AND status IN (?)", memberID, []string{
+		chama.FineStatus_FINE_OUTSTANDING.String(), chama.FineStatus_FINE_WAIVER_PENDING.String(),
+	}).Error
+	if err != nil {
+		return nil, errs.FailedToFind("fines", err)
+	}
+
+	for _, fineDB := range fines {
+		if fineDB.Status == chama.FineStatus_FINE_WAIVER_PENDING.String() {
+			return nil, errs.WrapMessagef(codes.FailedPrecondition, "fine %d has a pending waiver", fineDB.ID)
+		}
+		exitDB.Lines = append(exitDB.Lines, &models.MemberExitLine{
+			LineType:    chama.SettlementLineType_SETTLEMENT_FINE.String(),
+			ReferenceID: fmt.Sprint(fineDB.ID),
+			Description: fmt.Sprintf("%s fine", fineDB.FineName),
+			Amount:      roundAmount(fineDB.Amount - fineDB.PaidAmount),
+		})
+	}
+
+	// Guarantees are shared equally among the guarantors of a member
+	guaranteed := make([]*models.ChamaMember, 0)
+	err = exitAPI.SQLDB.Select("id, first_name, last_name, guarantees").
+		Where("chama_id = ? AND id != ?", memberDB.ChamaID, memberDB.ID).
+		Where("JSON_CONTAINS(guarantees, JSON_OBJECT('phone', ?))", memberDB.Phone).
+		Order("id").Find(&guaranteed).Error
+	if err != nil {
+		return nil, errs.FailedToFind("guaranteed members", err)
+	}
+
+	for _, guaranteedDB := range guaranteed {
+		guarantors := make([]*chama.TrustPerson, 0)
+		err = json.Unmarshal(guaranteedDB.Guarantees, &guarantors)
+		if err != nil {
+			return nil, errs.FromJSONUnMarshal(err, "guarantees")
+		}
+
+		balances, err := exitAPI.loanBalances([]string{fmt.Sprint(guaranteedDB.ID)})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(guarantors) == 0 {
+			continue
+		}
+
+		// The share of each loan is repaid on that loan
+		for _, balance := range balances {
+			amount := roundAmount(balance.Outstanding / float64(len(guarantors)))
+			if amount <= amountTolerance {
+				continue
+			}
+			exitDB.Lines = append(exitDB.Lines, &models.MemberExitLine{
+				LineType:    chama.SettlementLineType_SETTLEMENT_GUARANTEE.String(),
+				ReferenceID: fmt.Sprint(balance.ID),
+				Description: fmt.Sprintf("Guarantee of loan %d to %s %s", balance.ID, guaranteedDB.FirstName, guaranteedDB.LastName),
+				Amount:      amount,
+			})
+		}
+	}
+
+	return exitDB, nil
+}
+
+// authorizeStatement lets members see their own settlement and officers see the settlement of any member
+func (exitAPI *memberExitAPIServer) authorizeStatement(ctx context.Context, memberID string) (*models.ChamaMember, error) {
+	actor, memberDB, err := exitAPI.authorizer.AuthorizeMember(ctx, memberID, roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
+	if memberDB.UserID == "" || memberDB.UserID != actor.ID {
+		_, err = exitAPI.authorizer.AuthorizeChama(ctx, memberDB.ChamaID, roles.Officers...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return memberDB, nil
+}
+
+func (exitAPI *memberExitAPIServer) GetExitStatement(
+	ctx context.Context, req *chama.GetExitStatementRequest,
+) (*chama.ExitSettlement, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.MemberId == "":
+		return nil, errs.MissingField("member id")
+	}
+
+	// Authorization
+	memberDB, err := exitAPI.authorizeStatement(ctx, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	// A member that has started exiting has their statement fixed
+	exitDB, err := exitAPI.getExit(req.MemberId)
+	switch {
+	case err == nil:
+		return models.MemberExitProto(exitDB)
+	case status.Code(err) != codes.NotFound:
+		return nil, err
+	}
+
+	exitDB, err = exitAPI.statement(memberDB)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.MemberExitProto(exitDB)
+}
+
+func (exitAPI *memberExitAPIServer) getExit(memberID string) (*models.MemberExit, error) {
+	exitDB := &models.MemberExit{}
+	err := exitAPI.SQLDB.Preload("Lines", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id")
+	}).First(exitDB, "member_id = ?", memberID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.WrapMessagef(codes.NotFound, "member %s has no exit settlement", memberID)
+	default:
+		return nil, errs.FailedToFind("member exit", err)
+	}
+	return exitDB, nil
+}
+
+func (exitAPI *memberExitAPIServer) GetExitSettlement(
+	ctx context.Context, req *chama.GetExitSettlementRequest,
+) (*chama.ExitSettlement, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.MemberId == "":
+		return nil, errs.MissingField("member id")
+	}
+
+	// Authorization
+	_, err := exitAPI.authorizeStatement(ctx, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	exitDB, err := exitAPI.getExit(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.MemberExitProto(exitDB)
+}
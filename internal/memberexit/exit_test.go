@@ -0,0 +1,260 @@
+package memberexit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gidyon/machama-app/internal/fine"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Member exit", func() {
+	var (
+		ctx      context.Context
+		chamaID  string
+		memberDB *models.ChamaMember
+		memberID string
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		chamaID = createChama()
+		memberDB = createMember(chamaID)
+		memberID = fmt.Sprint(memberDB.ID)
+		verifyKYC(memberDB)
+	})
+
+	// withDebts gives the member savings, share capital, a loan, a fine and half of the guarantee of another member's loan.
+	// The guaranteed loan is returned.
+	withDebts := func() *models.Loan {
+		createAccount(memberID, chamaID, "savings", transaction.AccountType_SAVINGS_ACCOUNT, 5000)
+		createAccount(memberID, chamaID, "shares", transaction.AccountType_SHARE_CAPITAL_ACCOUNT, 2000)
+		createLoan(memberDB, 1500, loan.LoanStatus_FUNDS_TRANSFERED)
+		createFine(memberDB, 300)
+
+		guaranteedDB := createMember(chamaID,
+			&chama.TrustPerson{Name: memberDB.FirstName, Phone: memberDB.Phone},
+			&chama.TrustPerson{Name: "Other", Phone: randomPhone()},
+		)
+		return createLoan(guaranteedDB, 1000, loan.LoanStatus_FUNDS_TRANSFERED)
+	}
+
+	Describe("Calling exit APIs with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			exitRes, err := MemberExitAPI.ExitChamaMember(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(exitRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+			statementRes, err := MemberExitAPI.GetExitStatement(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(statementRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the settlement account is missing", func() {
+			exitRes, err := MemberExitAPI.ExitChamaMember(ctx, &chama.ExitChamaMemberRequest{MemberId: memberID})
+			Expect(err).Should(HaveOccurred())
+			Expect(exitRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when the member has no settlement", func() {
+			settlementRes, err := MemberExitAPI.GetExitSettlement(ctx, &chama.GetExitSettlementRequest{MemberId: memberID})
+			Expect(err).Should(HaveOccurred())
+			Expect(settlementRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("GetExitStatement", func() {
+		It("should net loans, fines and guarantees off savings and share capital", func() {
+			withDebts()
+
+			statementRes, err := MemberExitAPI.GetExitStatement(ctx, &chama.GetExitStatementRequest{MemberId: memberID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(statementRes.Status).Should(Equal(chama.ExitStatus_EXIT_PREVIEW))
+			Expect(statementRes.SavingsAmount).Should(BeEquivalentTo(5000))
+			Expect(statementRes.ShareCapitalAmount).Should(BeEquivalentTo(2000))
+			Expect(statementRes.LoanAmount).Should(BeEquivalentTo(1500))
+			Expect(statementRes.FineAmount).Should(BeEquivalentTo(300))
+			Expect(statementRes.GuaranteeAmount).Should(BeEquivalentTo(500))
+			Expect(statementRes.NetAmount).Should(BeEquivalentTo(4700))
+			Expect(statementRes.Lines).Should(HaveLen(5))
+		})
+		It("should leave out guaranteed loans that haven't been disbursed", func() {
+			createAccount(memberID, chamaID, "savings", transaction.AccountType_SAVINGS_ACCOUNT, 5000)
+			guaranteedDB := createMember(chamaID, &chama.TrustPerson{Name: memberDB.FirstName, Phone: memberDB.Phone})
+			createLoan(guaranteedDB, 1000, loan.LoanStatus_WAITING_APPROVAL)
+			createLoan(guaranteedDB, 1000, loan.LoanStatus_FUNDS_WITHDRAWN_ACCOUNT)
+
+			statementRes, err := MemberExitAPI.GetExitStatement(ctx, &chama.GetExitStatementRequest{MemberId: memberID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(statementRes.GuaranteeAmount).Should(BeZero())
+			Expect(statementRes.NetAmount).Should(BeEquivalentTo(5000))
+			Expect(statementRes.Lines).Should(HaveLen(1))
+		})
+		It("should fail when the member has a loan awaiting approval", func() {
+			createLoan(memberDB, 1000, loan.LoanStatus_WAITING_APPROVAL)
+
+			statementRes, err := MemberExitAPI.GetExitStatement(ctx, &chama.GetExitStatementRequest{MemberId: memberID})
+			Expect(err).Should(HaveOccurred())
+			Expect(statementRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+
+	Describe("ExitChamaMember", func() {
+		It("should refuse to exit a member who owes more than they have", func() {
+			createAccount(memberID, chamaID, "savings", transaction.AccountType_SAVINGS_ACCOUNT, 100)
+			createLoan(memberDB, 1000, loan.LoanStatus_FUNDS_TRANSFERED)
+
+			exitRes, err := MemberExitAPI.ExitChamaMember(ctx, &chama.ExitChamaMemberRequest{
+				MemberId:              memberID,
+				SettlementAccountName: settlementAccount,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(exitRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			Expect(MemberExitAPIServer.SQLDB.First(memberDB, memberDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(memberDB.Active).Should(BeTrue())
+		})
+
+		It("should refuse to pay out savings to a member whose KYC is not verified", func() {
+			otherDB := createMember(chamaID)
+			otherID := fmt.Sprint(otherDB.ID)
+			createAccount(otherID, chamaID, "savings", transaction.AccountType_SAVINGS_ACCOUNT, 100)
+
+			exitRes, err := MemberExitAPI.ExitChamaMember(ctx, &chama.ExitChamaMemberRequest{
+				MemberId:              otherID,
+				SettlementAccountName: settlementAccount,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(exitRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			Expect(MemberExitAPIServer.SQLDB.First(otherDB, otherDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(otherDB.Active).Should(BeTrue())
+		})
+
+		It("should refuse to exit a member with a loan that has no repayment schedule", func() {
+			createAccount(memberID, chamaID, "savings", transaction.AccountType_SAVINGS_ACCOUNT, 5000)
+			loanDB := createLoan(memberDB, 800, loan.LoanStatus_FUNDS_TRANSFERED)
+			Expect(MemberExitAPIServer.SQLDB.Delete(&models.LoanInstallment{}, "loan_id = ?", loanDB.ID).Error).ShouldNot(HaveOccurred())
+
+			exitRes, err := MemberExitAPI.ExitChamaMember(ctx, &chama.ExitChamaMemberRequest{
+				MemberId:              memberID,
+				SettlementAccountName: settlementAccount,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(exitRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			Expect(accountBalance(memberID, "savings")).Should(BeEquivalentTo(5000))
+			Expect(MemberExitAPIServer.SQLDB.First(memberDB, memberDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(memberDB.Active).Should(BeTrue())
+		})
+
+		It("should exit a guarantor without recovering loans that are pending", func() {
+			createAccount(memberID, chamaID, "savings", transaction.AccountType_SAVINGS_ACCOUNT, 5000)
+			guaranteedDB := createMember(chamaID, &chama.TrustPerson{Name: memberDB.FirstName, Phone: memberDB.Phone})
+			guaranteedLoanDB := createLoan(guaranteedDB, 1000, loan.LoanStatus_WAITING_APPROVAL)
+
+			exitRes, err := MemberExitAPI.ExitChamaMember(ctx, &chama.ExitChamaMemberRequest{
+				MemberId:              memberID,
+				SettlementAccountName: settlementAccount,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(exitRes.Status).Should(Equal(chama.ExitStatus_EXIT_COMPLETED))
+			Expect(exitRes.GuaranteeAmount).Should(BeZero())
+			Expect(exitRes.NetAmount).Should(BeEquivalentTo(5000))
+			Expect(exitRes.Lines).Should(HaveLen(1))
+
+			Expect(accountBalance(memberID, "savings")).Should(BeZero())
+			Expect(accountBalance(chamaID, settlementAccount)).Should(BeZero())
+
+			Expect(MemberExitAPIServer.SQLDB.First(guaranteedLoanDB, guaranteedLoanDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(guaranteedLoanDB.SettledAmount).Should(BeZero())
+			Expect(guaranteedLoanDB.Status).Should(Equal(loan.LoanStatus_WAITING_APPROVAL.String()))
+		})
+
+		It("should recover unpaid contribution and meeting fines", func() {
+			createAccount(memberID, chamaID, "savings", transaction.AccountType_SAVINGS_ACCOUNT, 5000)
+			lateFineDB := createNamedFine(memberDB, fine.LateContributionFine, "savings", 10)
+			absenceFineDB := createNamedFine(memberDB, fine.MeetingAbsenceFine, fineAccount, 200)
+
+			exitRes, err := MemberExitAPI.ExitChamaMember(ctx, &chama.ExitChamaMemberRequest{
+				MemberId:              memberID,
+				SettlementAccountName: settlementAccount,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(exitRes.Status).Should(Equal(chama.ExitStatus_EXIT_COMPLETED))
+			Expect(exitRes.FineAmount).Should(BeEquivalentTo(210))
+			Expect(exitRes.NetAmount).Should(BeEquivalentTo(4790))
+
+			// Late contribution fines have no fines account of their own
+			Expect(accountBalance(chamaID, settlementAccount)).Should(BeEquivalentTo(10))
+			Expect(accountBalance(chamaID, fineAccount)).Should(BeEquivalentTo(200))
+
+			for _, fineDB := range []*models.Fine{lateFineDB, absenceFineDB} {
+				Expect(MemberExitAPIServer.SQLDB.First(fineDB, fineDB.ID).Error).ShouldNot(HaveOccurred())
+				Expect(fineDB.Status).Should(Equal(chama.FineStatus_FINE_PAID.String()))
+				Expect(fineDB.PaidAmount).Should(BeEquivalentTo(fineDB.Amount))
+			}
+		})
+
+		It("should settle the member and keep them as exited", func() {
+			guaranteedLoanDB := withDebts()
+
+			exitRes, err := MemberExitAPI.ExitChamaMember(ctx, &chama.ExitChamaMemberRequest{
+				MemberId:              memberID,
+				SettlementAccountName: settlementAccount,
+				Reason:                "Relocating",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(exitRes.Status).Should(Equal(chama.ExitStatus_EXIT_COMPLETED))
+			Expect(exitRes.NetAmount).Should(BeEquivalentTo(4700))
+			for _, line := range exitRes.Lines {
+				Expect(line.Settled).Should(BeTrue())
+			}
+
+			Expect(MemberExitAPIServer.SQLDB.First(memberDB, memberDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(memberDB.Status).Should(Equal(models.MemberStatusExited))
+			Expect(memberDB.Active).Should(BeFalse())
+
+			Expect(accountBalance(memberID, "savings")).Should(BeZero())
+			Expect(accountBalance(memberID, "shares")).Should(BeZero())
+			Expect(accountBalance(chamaID, settlementAccount)).Should(BeEquivalentTo(2000))
+			Expect(accountBalance(chamaID, fineAccount)).Should(BeEquivalentTo(300))
+
+			loanDB := &models.Loan{}
+			Expect(MemberExitAPIServer.SQLDB.First(loanDB, "member_id = ?", memberID).Error).ShouldNot(HaveOccurred())
+			Expect(loanDB.SettledAmount).Should(BeEquivalentTo(1500))
+
+			Expect(MemberExitAPIServer.SQLDB.First(guaranteedLoanDB, guaranteedLoanDB.ID).Error).ShouldNot(HaveOccurred())
+			Expect(guaranteedLoanDB.SettledAmount).Should(BeEquivalentTo(500))
+
+			fineDB := &models.Fine{}
+			Expect(MemberExitAPIServer.SQLDB.First(fineDB, "member_id = ?", memberID).Error).ShouldNot(HaveOccurred())
+			Expect(fineDB.Status).Should(Equal(chama.FineStatus_FINE_PAID.String()))
+
+			settlementRes, err := MemberExitAPI.GetExitSettlement(ctx, &chama.GetExitSettlementRequest{MemberId: memberID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(settlementRes.Reason).Should(Equal("Relocating"))
+
+			exitRes, err = MemberExitAPI.ExitChamaMember(ctx, &chama.ExitChamaMemberRequest{
+				MemberId:              memberID,
+				SettlementAccountName: settlementAccount,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(exitRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+})
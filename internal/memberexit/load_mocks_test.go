@@ -0,0 +1,130 @@
+package memberexit
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	settlementAccount = "settlements"
+	fineAccount       = "fines"
+)
+
+func randomPhone() string {
+	return fmt.Sprintf("2547%d", randomdata.Number(10000000, 99999999))
+}
+
+// createChama saves the accounts of a new chama that exits are settled into
+func createChama() string {
+	chamaID := fmt.Sprint(randomdata.Number(1000, 9999999))
+	for _, name := range []string{settlementAccount, fineAccount} {
+		createAccount(chamaID, chamaID, name, transaction.AccountType_SAVINGS_ACCOUNT, 0)
+	}
+	return chamaID
+}
+
+func createMember(chamaID string, guarantors ...*chama.TrustPerson) *models.ChamaMember {
+	memberDB := &models.ChamaMember{
+		ChamaID:   chamaID,
+		FirstName: randomdata.FirstName(randomdata.Female),
+		LastName:  randomdata.LastName(),
+		Phone:     randomPhone(),
+		IDNumber:  fmt.Sprint(randomdata.Number(22222222, 44444444)),
+		Active:    true,
+	}
+	if len(guarantors) != 0 {
+		bs, err := json.Marshal(guarantors)
+		Expect(err).ShouldNot(HaveOccurred())
+		memberDB.Guarantees = bs
+	}
+	Expect(MemberExitAPIServer.SQLDB.Create(memberDB).Error).ShouldNot(HaveOccurred())
+	return memberDB
+}
+
+func verifyKYC(memberDB *models.ChamaMember) {
+	Expect(MemberExitAPIServer.SQLDB.Create(&models.MemberKYC{
+		MemberID: fmt.Sprint(memberDB.ID),
+		ChamaID:  memberDB.ChamaID,
+		Status:   chama.KycStatus_KYC_VERIFIED.String(),
+	}).Error).ShouldNot(HaveOccurred())
+}
+
+func createAccount(ownerID, chamaID, name string, accountType transaction.AccountType, amount float64) *models.ChamaAccount {
+	accountDB := &models.ChamaAccount{
+		OwnerID:              ownerID,
+		ChamaID:              chamaID,
+		AccountName:          name,
+		AccountType:          accountType.String(),
+		Withdrawable:         true,
+		TotalDepositedAmount: amount,
+		AvailableAmount:      amount,
+		Active:               true,
+	}
+	Expect(MemberExitAPIServer.SQLDB.Create(accountDB).Error).ShouldNot(HaveOccurred())
+	return accountDB
+}
+
+// createLoan saves a loan in the given status. Disbursed loans get a single installment of the given amount.
+func createLoan(memberDB *models.ChamaMember, amount float64, status loan.LoanStatus) *models.Loan {
+	loanDB := &models.Loan{
+		ChamaID:      memberDB.ChamaID,
+		ProductID:    "1",
+		MemberID:     fmt.Sprint(memberDB.ID),
+		LoaneeNames:  memberDB.FirstName,
+		LoaneePhone:  memberDB.Phone,
+		NationalID:   memberDB.IDNumber,
+		Approved:     true,
+		Status:       status.String(),
+		DurationDays: 30,
+		LoanAmount:   amount,
+	}
+	Expect(MemberExitAPIServer.SQLDB.Create(loanDB).Error).ShouldNot(HaveOccurred())
+
+	if status != loan.LoanStatus_FUNDS_TRANSFERED {
+		return loanDB
+	}
+
+	Expect(MemberExitAPIServer.SQLDB.Create(&models.LoanInstallment{
+		LoanID:            loanDB.ID,
+		InstallmentNumber: 1,
+		PrincipalDue:      amount,
+		AmountDue:         amount,
+		DueDate:           time.Now().AddDate(0, 0, 30),
+	}).Error).ShouldNot(HaveOccurred())
+
+	return loanDB
+}
+
+func createFine(memberDB *models.ChamaMember, amount float64) *models.Fine {
+	return createNamedFine(memberDB, "Lateness", fineAccount, amount)
+}
+
+// createNamedFine saves an outstanding fine of the given name paid into the named account
+func createNamedFine(memberDB *models.ChamaMember, name, accountName string, amount float64) *models.Fine {
+	fineDB := &models.Fine{
+		ChamaID:     memberDB.ChamaID,
+		MemberID:    fmt.Sprint(memberDB.ID),
+		MemberNames: memberDB.FirstName,
+		FineTypeID:  1,
+		FineName:    name,
+		AccountName: accountName,
+		Amount:      amount,
+		Status:      chama.FineStatus_FINE_OUTSTANDING.String(),
+	}
+	Expect(MemberExitAPIServer.SQLDB.Create(fineDB).Error).ShouldNot(HaveOccurred())
+	return fineDB
+}
+
+func accountBalance(ownerID, name string) float64 {
+	accountDB := &models.ChamaAccount{}
+	Expect(MemberExitAPIServer.SQLDB.First(accountDB, "owner_id = ? AND account_name = ?", ownerID, name).Error).ShouldNot(HaveOccurred())
+	return accountDB.AvailableAmount
+}
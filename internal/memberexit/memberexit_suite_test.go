@@ -0,0 +1,148 @@
+package memberexit
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/Pallinder/go-randomdata"
+	loan_app "github.com/gidyon/machama-app/internal/loan"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/moneyaccount"
+	transaction_app "github.com/gidyon/machama-app/internal/transaction"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2"
+	"github.com/gidyon/micro/v2/pkg/conn"
+	"github.com/gidyon/micro/v2/pkg/mocks"
+	"github.com/gidyon/micro/v2/utils/encryption"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+)
+
+func TestMemberExit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Member Exit Suite")
+}
+
+var (
+	MemberExitAPIServer *memberExitAPIServer
+	MemberExitAPI       chama.MemberExitAPIServer
+	modelsStructs       = []interface{}{
+		&models.ChamaMember{},
+		&models.ChamaAccount{},
+		&models.Transaction{},
+		&models.Fine{},
+		&models.Loan{},
+		&models.LoanInstallment{},
+		&models.LoanCharge{},
+		&models.LoanCollateral{},
+		&models.LoanHoliday{},
+		&models.LoanRepayment{},
+		&models.LoanPayoffQuote{},
+		&models.MemberExit{},
+		&models.MemberExitLine{},
+		&models.MemberKYC{},
+	}
+	schema = "machama"
+)
+
+func startDB() (*gorm.DB, error) {
+	return conn.OpenGormConn(&conn.DBOptions{
+		Dialect:  "mysql",
+		Address:  "localhost:3306",
+		User:     "root",
+		Password: "hakty11",
+		Schema:   schema,
+	})
+}
+
+var _ = BeforeSuite(func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rand.Seed(time.Now().UnixNano())
+
+	// Start real database
+	db, err := startDB()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	db = db.Debug()
+
+	err = db.Migrator().DropTable(modelsStructs...)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	err = db.Migrator().AutoMigrate(modelsStructs...)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	hasher, err := encryption.NewHasher(string([]byte(randomdata.RandStringRunes(32))))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	logger := micro.NewLogger("memberexit", 0)
+
+	authAPI := mocks.AuthAPI
+
+	moneyAccountAPI, err := moneyaccount.NewChamaAccountAPI(ctx, &moneyaccount.Options{
+		SQLDB:      db,
+		PageHasher: hasher,
+		Logger:     logger,
+		Auth:       authAPI,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	transactionAPI, err := transaction_app.NewTransactionAPI(ctx, &transaction_app.Options{
+		SQLDB:      db,
+		PageHasher: hasher,
+		Logger:     logger,
+		Auth:       authAPI,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	loanAPI, err := loan_app.NewLoanAPI(ctx, &loan_app.Options{
+		MoneyAccountAPI: moneyAccountAPI,
+		TransactionAPI:  transactionAPI,
+		SQLDB:           db,
+		PageHasher:      hasher,
+		Logger:          logger,
+		Auth:            authAPI,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	opt := &Options{
+		MoneyAccountAPI: moneyAccountAPI,
+		TransactionAPI:  transactionAPI,
+		LoanAPI:         loanAPI,
+		SQLDB:           db,
+		Logger:          logger,
+		Auth:            authAPI,
+	}
+
+	MemberExitAPI, err = NewMemberExitAPI(ctx, opt)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	var ok bool
+	MemberExitAPIServer, ok = MemberExitAPI.(*memberExitAPIServer)
+	Expect(ok).Should(BeTrue())
+
+	_, err = NewMemberExitAPI(ctx, nil)
+	Expect(err).Should(HaveOccurred())
+
+	opt.LoanAPI = nil
+	_, err = NewMemberExitAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.LoanAPI = loanAPI
+	opt.TransactionAPI = nil
+	_, err = NewMemberExitAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.TransactionAPI = transactionAPI
+	opt.SQLDB = nil
+	_, err = NewMemberExitAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.SQLDB = db
+	_, err = NewMemberExitAPI(ctx, opt)
+	Expect(err).ShouldNot(HaveOccurred())
+})
@@ -0,0 +1,349 @@
+package memberexit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/fine"
+	"github.com/gidyon/machama-app/internal/kyc"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/pkg/utils/mdutil"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (exitAPI *memberExitAPIServer) ExitChamaMember(
+	ctx context.Context, req *chama.ExitChamaMemberRequest,
+) (*chama.ExitSettlement, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.MemberId == "":
+		return nil, errs.MissingField("member id")
+	case req.SettlementAccountName == "":
+		return nil, errs.MissingField("settlement account name")
+	}
+
+	// Authorization
+	actor, memberDB, err := exitAPI.authorizer.AuthorizeMember(ctx, req.MemberId, roles.Chairman...)
+	if err != nil {
+		return nil, err
+	}
+
+	exitDB, err := exitAPI.getExit(req.MemberId)
+	switch {
+	case err == nil:
+		// An interrupted settlement is resumed as it was started
+		if exitDB.Status == chama.ExitStatus_EXIT_COMPLETED.String() {
+			return nil, errs.WrapMessage(codes.FailedPrecondition, "member has already exited")
+		}
+	case status.Code(err) == codes.NotFound:
+		exitDB, err = exitAPI.startExit(ctx, actor, memberDB, req)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	ctxExt := mdutil.AddFromCtx(ctx)
+
+	// Balances are paid out before anything is recovered into chama accounts
+	for _, line := range exitDB.Lines {
+		err = exitAPI.settleLine(ctxExt, actor, exitDB, line)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = exitAPI.completeExit(exitDB)
+	if err != nil {
+		return nil, err
+	}
+
+	exitDB, err = exitAPI.getExit(req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.MemberExitProto(exitDB)
+}
+
+// startExit fixes the settlement statement of the member and takes them out of the chama's active members
+func (exitAPI *memberExitAPIServer) startExit(
+	ctx context.Context, actor *auth.Payload, memberDB *models.ChamaMember, req *chama.ExitChamaMemberRequest,
+) (*models.MemberExit, error) {
+	if memberDB.Status == models.MemberStatusExited {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "member has already exited")
+	}
+
+	exitDB, err := exitAPI.statement(memberDB)
+	if err != nil {
+		return nil, err
+	}
+
+	exitPB, err := models.MemberExitProto(exitDB)
+	if err != nil {
+		return nil, err
+	}
+
+	if exitPB.NetAmount < -amountTolerance {
+		return nil, errs.WrapMessagef(
+			codes.FailedPrecondition, "member owes %.2f more than their savings and share capital", -exitPB.NetAmount,
+		)
+	}
+
+	// Savings and share capital are paid out of member accounts
+	if exitPB.SavingsAmount > 0 || exitPB.ShareCapitalAmount > 0 {
+		err = kyc.RequireVerified(exitAPI.SQLDB, fmt.Sprint(memberDB.ID))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if exitPB.LoanAmount > 0 || exitPB.GuaranteeAmount > 0 || exitPB.FineAmount > 0 {
+		_, err = exitAPI.MoneyAccountAPI.GetChamaAccount(mdutil.AddFromCtx(ctx), &transaction.GetChamaAccountRequest{
+			OwnerId:     memberDB.ChamaID,
+			AccountName: req.SettlementAccountName,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	exitDB.Status = chama.ExitStatus_EXIT_SETTLING.String()
+	exitDB.SettlementAccountName = req.SettlementAccountName
+	exitDB.Reason = req.Reason
+	exitDB.ExitedBy = actor.ID
+
+	err = exitAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		db := tx.Model(&models.ChamaMember{}).Where("id = ? AND status NOT IN (?)", memberDB.ID, []string{
+			models.MemberStatusExiting, models.MemberStatusExited,
+		}).Updates(map[string]interface{}{
+			"status": models.MemberStatusExiting,
+			"active": false,
+		})
+		if db.Error != nil {
+			return errs.FailedToUpdate("chama member", db.Error)
+		}
+		if db.RowsAffected == 0 {
+			return errs.WrapMessage(codes.FailedPrecondition, "member is already exiting")
+		}
+
+		err := tx.Create(exitDB).Error
+		if err != nil {
+			return errs.FailedToSave("member exit", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return exitDB, nil
+}
+
+// settleLine moves the money of a line of the settlement. The line is claimed first so that it is settled once.
+func (exitAPI *memberExitAPIServer) settleLine(
+	ctx context.Context, actor *auth.Payload, exitDB *models.MemberExit, line *models.MemberExitLine,
+) error {
+	if line.Settled {
+		return nil
+	}
+
+	db := exitAPI.SQLDB.Model(&models.MemberExitLine{}).Where("id = ? AND settled = ?", line.ID, false).Update("settled", true)
+	if db.Error != nil {
+		return errs.FailedToUpdate("settlement line", db.Error)
+	}
+	if db.RowsAffected == 0 {
+		return errs.WrapMessage(codes.Aborted, "member settlement is being processed")
+	}
+
+	description := fmt.Sprintf("Exit settlement for %s: %s", exitDB.MemberNames, line.Description)
+
+	switch line.LineType {
+	case chama.SettlementLineType_SETTLEMENT_SAVINGS.String(), chama.SettlementLineType_SETTLEMENT_SHARE_CAPITAL.String():
+		_, err := exitAPI.TransactionAPI.Withdraw(ctx, &transaction.WithdrawRequest{
+			ActorId:     actor.ID,
+			AccountId:   line.ReferenceID,
+			Description: description,
+			Amount:      line.Amount,
+		})
+		if err != nil {
+			exitAPI.releaseLine(line.ID)
+			return err
+		}
+	case chama.SettlementLineType_SETTLEMENT_LOAN.String(), chama.SettlementLineType_SETTLEMENT_GUARANTEE.String():
+		err := exitAPI.settleLoan(ctx, actor, exitDB, line, description)
+		if err != nil {
+			exitAPI.releaseLine(line.ID)
+			return err
+		}
+	case chama.SettlementLineType_SETTLEMENT_FINE.String():
+		err := exitAPI.settleFine(ctx, actor, exitDB, line, description)
+		if err != nil {
+			exitAPI.releaseLine(line.ID)
+			return err
+		}
+	}
+
+	line.Settled = true
+
+	return nil
+}
+
+// releaseLine gives back the claim on a line whose money didn't move
+func (exitAPI *memberExitAPIServer) releaseLine(lineID uint) {
+	err := exitAPI.SQLDB.Model(&models.MemberExitLine{}).Where("id = ?", lineID).Update("settled", false).Error
+	if err != nil {
+		exitAPI.Logger.Errorf("failed to release settlement line %d: %v", lineID, err)
+	}
+}
+
+func (exitAPI *memberExitAPIServer) deposit(
+	ctx context.Context, actor *auth.Payload, ownerID, accountName, description string, amount float64,
+) (*transaction.ChamaAccount, error) {
+	accountPB, err := exitAPI.MoneyAccountAPI.GetChamaAccount(ctx, &transaction.GetChamaAccountRequest{
+		OwnerId:     ownerID,
+		AccountName: accountName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = exitAPI.TransactionAPI.Deposit(ctx, &transaction.DepositRequest{
+		ActorId:     actor.ID,
+		AccountId:   accountPB.AccountId,
+		Description: description,
+		Amount:      amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return accountPB, nil
+}
+
+// settleLoan pays a loan balance or the share of a guaranteed loan into the settlement account and repays the loan with it
+func (exitAPI *memberExitAPIServer) settleLoan(
+	ctx context.Context, actor *auth.Payload, exitDB *models.MemberExit, line *models.MemberExitLine, description string,
+) error {
+	// Loans without a repayment schedule haven't been disbursed and can't be repaid
+	var installments int64
+	err := exitAPI.SQLDB.Model(&models.LoanInstallment{}).Where("loan_id = ?", line.ReferenceID).Count(&installments).Error
+	if err != nil {
+		return errs.FailedToFind("loan installments", err)
+	}
+	if installments == 0 {
+		return errs.WrapMessagef(codes.FailedPrecondition, "loan %s has not been disbursed", line.ReferenceID)
+	}
+
+	accountPB, err := exitAPI.deposit(ctx, actor, exitDB.ChamaID, exitDB.SettlementAccountName, description, line.Amount)
+	if err != nil {
+		return err
+	}
+
+	_, err = exitAPI.LoanAPI.RepayLoan(ctx, &loan.RepayLoanRequest{
+		LoanId: line.ReferenceID,
+		Amount: line.Amount,
+	})
+	if err != nil {
+		_, err2 := exitAPI.TransactionAPI.Withdraw(ctx, &transaction.WithdrawRequest{
+			ActorId:     actor.ID,
+			AccountId:   accountPB.AccountId,
+			Description: "Reversal of " + description,
+			Amount:      line.Amount,
+		})
+		if err2 != nil {
+			exitAPI.Logger.Errorf("failed to reverse loan settlement of %.2f from account %s: %v", line.Amount, accountPB.AccountId, err2)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// settleFine pays the fine balance into the account of the fine. Late contribution fines are paid into the settlement
+// account, since contributions are kept in member accounts.
+func (exitAPI *memberExitAPIServer) settleFine(
+	ctx context.Context, actor *auth.Payload, exitDB *models.MemberExit, line *models.MemberExitLine, description string,
+) error {
+	fineDB := &models.Fine{}
+	err := exitAPI.SQLDB.First(fineDB, "id = ?", line.ReferenceID).Error
+	if err != nil {
+		return errs.FailedToFind("fine", err)
+	}
+
+	err = fine.ClaimPayment(exitAPI.SQLDB, fineDB, line.Amount)
+	switch {
+	case err == nil:
+	case status.Code(err) == codes.FailedPrecondition:
+		return errs.WrapMessagef(codes.FailedPrecondition, "fine %d has changed since the settlement was started", fineDB.ID)
+	default:
+		return err
+	}
+
+	accountName := fineDB.AccountName
+	if fineDB.FineName == fine.LateContributionFine {
+		accountName = exitDB.SettlementAccountName
+	}
+
+	_, err = exitAPI.deposit(ctx, actor, fineDB.ChamaID, accountName, description, line.Amount)
+	if err != nil {
+		err2 := fine.ReleasePayment(exitAPI.SQLDB, fineDB.ID, line.Amount)
+		if err2 != nil {
+			exitAPI.Logger.Errorf("failed to release payment of %.2f on fine %d: %v", line.Amount, fineDB.ID, err2)
+		}
+		return err
+	}
+
+	// The money has moved, so a fine that can't be marked paid is only logged
+	_, err = fine.SettleFine(exitAPI.SQLDB, fineDB.ID)
+	if err != nil {
+		exitAPI.Logger.Errorf("failed to mark fine %d paid: %v", fineDB.ID, err)
+	}
+
+	return nil
+}
+
+// completeExit marks the member as exited once every line is settled. Their records are kept.
+func (exitAPI *memberExitAPIServer) completeExit(exitDB *models.MemberExit) error {
+	return exitAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.ChamaMember{}).Where("id = ?", exitDB.MemberID).Updates(map[string]interface{}{
+			"status": models.MemberStatusExited,
+			"active": false,
+			"role":   chama.ChamaRole_ROLE_MEMBER.String(),
+		}).Error
+		if err != nil {
+			return errs.FailedToUpdate("chama member", err)
+		}
+
+		err = tx.Model(&models.ChamaAccount{}).Where("owner_id = ? AND chama_id = ?", exitDB.MemberID, exitDB.ChamaID).
+			Update("active", false).Error
+		if err != nil {
+			return errs.FailedToUpdate("member accounts", err)
+		}
+
+		completedAt := time.Now()
+		err = tx.Model(exitDB).Updates(map[string]interface{}{
+			"status":       chama.ExitStatus_EXIT_COMPLETED.String(),
+			"completed_at": &completedAt,
+		}).Error
+		if err != nil {
+			return errs.FailedToUpdate("member exit", err)
+		}
+
+		return nil
+	})
+}
@@ -24,6 +24,7 @@ import (
 type ChamaAccount struct {
 	ID                   uint      `gorm:"primaryKey;autoIncrement"`
 	OwnerID              string    `gorm:"type:varchar(50);not null"`
+	ChamaID              string    `gorm:"index;type:varchar(15)"`
 	AccountName          string    `gorm:"type:varchar(50);not null"`
 	AccountType          string    `gorm:"type:varchar(30);not null"`
 	Withdrawable         bool      `gorm:"type:tinyint(1)"`
@@ -47,6 +48,7 @@ func ChamaAccountModel(pb *transaction.ChamaAccount) (*ChamaAccount, error) {
 	}
 	db := &ChamaAccount{
 		OwnerID:              pb.OwnerId,
+		ChamaID:              pb.ChamaId,
 		AccountName:          pb.AccountName,
 		AccountType:          pb.AccountType.String(),
 		Withdrawable:         pb.Withdrawable,
@@ -67,6 +69,7 @@ func ChamaAccountProto(db *ChamaAccount) (*transaction.ChamaAccount, error) {
 	pb := &transaction.ChamaAccount{
 		AccountId:            fmt.Sprint(db.ID),
 		OwnerId:              db.OwnerID,
+		ChamaId:              db.ChamaID,
 		AccountName:          db.AccountName,
 		AccountType:          transaction.AccountType(transaction.AccountType_value[db.AccountType]),
 		Withdrawable:         db.Withdrawable,
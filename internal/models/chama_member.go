@@ -9,6 +9,13 @@ import (
 	"github.com/gidyon/micro/v2/utils/errs"
 )
 
+// Statuses of members leaving a chama. Exited members and duplicates merged into another member are kept for their history.
+const (
+	MemberStatusExiting = "EXITING"
+	MemberStatusExited  = "EXITED"
+	MemberStatusMerged  = "MERGED"
+)
+
 // MemberId      string            `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
 // ChamaId       string            `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
 // FirstName     string            `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
@@ -25,13 +32,15 @@ import (
 // Status        string            `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
 // RegisterDate  string            `protobuf:"bytes,15,opt,name=register_date,json=registerDate,proto3" json:"register_date,omitempty"`
 
+// ChamaMember is a member of a chama. The id number and phone of members that haven't exited or been merged are unique
+// in a chama, which the database enforces through the generated open identity columns.
 type ChamaMember struct {
 	ID            uint      `gorm:"primaryKey;autoIncrement"`
-	ChamaID       string    `gorm:"type:varchar(15);not null"`
+	ChamaID       string    `gorm:"uniqueIndex:idx_chama_open_id_number,priority:1;uniqueIndex:idx_chama_open_phone,priority:1;type:varchar(15);not null"`
 	FirstName     string    `gorm:"type:varchar(30);not null"`
 	LastName      string    `gorm:"type:varchar(30);not null"`
-	Phone         string    `gorm:"type:varchar(15);not null"`
-	IDNumber      string    `gorm:"type:varchar(10);not null"`
+	Phone         string    `gorm:"index;type:varchar(15);not null"`
+	IDNumber      string    `gorm:"index;type:varchar(10);not null"`
 	Email         string    `gorm:"type:varchar(50)"`
 	Residence     string    `gorm:"type:varchar(100)"`
 	Status        string    `gorm:"type:varchar(100)"`
@@ -40,6 +49,10 @@ type ChamaMember struct {
 	JobDetails    []byte    `gorm:"type:json"`
 	KYC           []byte    `gorm:"type:json"`
 	Active        bool      `gorm:"type:tinyint(1)"`
+	UserID        string    `gorm:"index;type:varchar(50)"`
+	Role          string    `gorm:"type:varchar(20);default:ROLE_MEMBER"`
+	OpenIDNumber  *string   `gorm:"->;uniqueIndex:idx_chama_open_id_number,priority:2;type:varchar(10) GENERATED ALWAYS AS (IF(id_number != '' AND COALESCE(status, '') NOT IN ('EXITED', 'MERGED'), id_number, NULL)) STORED"`
+	OpenPhone     *string   `gorm:"->;uniqueIndex:idx_chama_open_phone,priority:2;type:varchar(15) GENERATED ALWAYS AS (IF(phone != '' AND COALESCE(status, '') NOT IN ('EXITED', 'MERGED'), phone, NULL)) STORED"`
 	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
 	CreatedAt     time.Time `gorm:"autoCreateTime"`
 }
@@ -63,6 +76,7 @@ func ChamaMemberModel(pb *chama.ChamaMember) (*ChamaMember, error) {
 		Residence: pb.Residence,
 		Status:    pb.Status,
 		Active:    pb.Active,
+		UserID:    pb.UserId,
 	}
 
 	if len(pb.JobDetails) != 0 {
@@ -116,6 +130,8 @@ func ChamaMemberProto(db *ChamaMember) (*chama.ChamaMember, error) {
 		Residence:    db.Residence,
 		Status:       db.Status,
 		Active:       db.Active,
+		UserId:       db.UserID,
+		Role:         chama.ChamaRole(chama.ChamaRole_value[db.Role]),
 		UpdatedDate:  db.UpdatedAt.String(),
 		RegisterDate: db.CreatedAt.String(),
 	}
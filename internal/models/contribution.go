@@ -0,0 +1,95 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+const contributionDateLayout = "2006-01-02"
+
+type ContributionPlan struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	ChamaID     string    `gorm:"uniqueIndex;type:varchar(15);not null"`
+	AccountName string    `gorm:"type:varchar(50)"`
+	Amount      float64   `gorm:"type:float(15);not null"`
+	Frequency   string    `gorm:"type:varchar(20);not null"`
+	DueDay      int32     `gorm:"type:int(2);not null"`
+	LateFine    float64   `gorm:"type:float(15)"`
+	StartDate   time.Time `gorm:"not null"`
+	Active      bool      `gorm:"type:tinyint(1)"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+func (*ContributionPlan) TableName() string {
+	return "contribution_plans"
+}
+
+func ContributionPlanModel(pb *chama.ContributionPlan) (*ContributionPlan, error) {
+	if pb == nil {
+		return nil, errs.NilObject("contribution plan")
+	}
+
+	db := &ContributionPlan{
+		ChamaID:     pb.ChamaId,
+		AccountName: pb.AccountName,
+		Amount:      pb.Amount,
+		Frequency:   pb.Frequency.String(),
+		DueDay:      pb.DueDay,
+		LateFine:    pb.LateFine,
+		Active:      pb.Active,
+	}
+
+	if pb.StartDate != "" {
+		startDate, err := time.ParseInLocation(contributionDateLayout, pb.StartDate, time.Local)
+		if err != nil {
+			return nil, errs.IncorrectVal("contribution start date")
+		}
+		db.StartDate = startDate
+	}
+
+	return db, nil
+}
+
+func ContributionPlanProto(db *ContributionPlan) (*chama.ContributionPlan, error) {
+	if db == nil {
+		return nil, errs.NilObject("contribution plan")
+	}
+	return &chama.ContributionPlan{
+		PlanId:      fmt.Sprint(db.ID),
+		ChamaId:     db.ChamaID,
+		AccountName: db.AccountName,
+		Amount:      db.Amount,
+		Frequency:   chama.ContributionFrequency(chama.ContributionFrequency_value[db.Frequency]),
+		DueDay:      db.DueDay,
+		LateFine:    db.LateFine,
+		StartDate:   db.StartDate.Format(contributionDateLayout),
+		Active:      db.Active,
+		UpdatedDate: db.UpdatedAt.String(),
+		CreatedDate: db.CreatedAt.String(),
+	}, nil
+}
+
+// MemberContribution is a contribution expected from a member on a due date of the chama contribution plan
+type MemberContribution struct {
+	ID         uint       `gorm:"primaryKey;autoIncrement"`
+	PlanID     uint       `gorm:"uniqueIndex:idx_member_contribution;not null"`
+	ChamaID    string     `gorm:"index;type:varchar(15);not null"`
+	MemberID   string     `gorm:"uniqueIndex:idx_member_contribution;type:varchar(15);not null"`
+	DueDate    time.Time  `gorm:"uniqueIndex:idx_member_contribution;not null"`
+	AmountDue  float64    `gorm:"type:float(15)"`
+	AmountPaid float64    `gorm:"type:float(15)"`
+	FineAmount float64    `gorm:"type:float(15)"`
+	FinePaid   float64    `gorm:"type:float(15)"`
+	FineID     uint       `gorm:"index"`
+	PaidAt     *time.Time `gorm:"type:datetime"`
+	UpdatedAt  time.Time  `gorm:"autoUpdateTime"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime"`
+}
+
+func (*MemberContribution) TableName() string {
+	return "member_contributions"
+}
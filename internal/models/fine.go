@@ -0,0 +1,109 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+type FineType struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	ChamaID     string    `gorm:"uniqueIndex:idx_chama_fine_type;type:varchar(15);not null"`
+	Name        string    `gorm:"uniqueIndex:idx_chama_fine_type;type:varchar(50);not null"`
+	Description string    `gorm:"type:varchar(200)"`
+	Amount      float64   `gorm:"type:float(15);not null"`
+	AccountName string    `gorm:"type:varchar(50);not null"`
+	Active      bool      `gorm:"type:tinyint(1)"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+func (*FineType) TableName() string {
+	return "fine_types"
+}
+
+func FineTypeModel(pb *chama.FineType) (*FineType, error) {
+	if pb == nil {
+		return nil, errs.NilObject("fine type")
+	}
+	return &FineType{
+		ChamaID:     pb.ChamaId,
+		Name:        pb.Name,
+		Description: pb.Description,
+		Amount:      pb.Amount,
+		AccountName: pb.AccountName,
+		Active:      pb.Active,
+	}, nil
+}
+
+func FineTypeProto(db *FineType) (*chama.FineType, error) {
+	if db == nil {
+		return nil, errs.NilObject("fine type")
+	}
+	return &chama.FineType{
+		FineTypeId:  fmt.Sprint(db.ID),
+		ChamaId:     db.ChamaID,
+		Name:        db.Name,
+		Description: db.Description,
+		Amount:      db.Amount,
+		AccountName: db.AccountName,
+		Active:      db.Active,
+		CreatedDate: db.CreatedAt.String(),
+	}, nil
+}
+
+// Fine is issued to a member from the catalog. The name, amount and account of the fine type are kept as they were when issued.
+type Fine struct {
+	ID                uint       `gorm:"primaryKey;autoIncrement"`
+	ChamaID           string     `gorm:"index;type:varchar(15);not null"`
+	MemberID          string     `gorm:"index;type:varchar(15);not null"`
+	MemberNames       string     `gorm:"type:varchar(60)"`
+	FineTypeID        uint       `gorm:"not null"`
+	FineName          string     `gorm:"type:varchar(50);not null"`
+	AccountName       string     `gorm:"type:varchar(50);not null"`
+	Amount            float64    `gorm:"type:float(15);not null"`
+	PaidAmount        float64    `gorm:"type:float(15)"`
+	Reason            string     `gorm:"type:varchar(200)"`
+	Status            string     `gorm:"index;type:varchar(30);not null"`
+	IssuedBy          string     `gorm:"type:varchar(15)"`
+	WaiverReason      string     `gorm:"type:varchar(200)"`
+	WaiverRequestedBy string     `gorm:"type:varchar(15)"`
+	WaivedBy          string     `gorm:"type:varchar(15)"`
+	PaidAt            *time.Time `gorm:"type:datetime"`
+	UpdatedAt         time.Time  `gorm:"autoUpdateTime"`
+	CreatedAt         time.Time  `gorm:"autoCreateTime"`
+}
+
+func (*Fine) TableName() string {
+	return "fines"
+}
+
+func FineProto(db *Fine) (*chama.Fine, error) {
+	if db == nil {
+		return nil, errs.NilObject("fine")
+	}
+	pb := &chama.Fine{
+		FineId:            fmt.Sprint(db.ID),
+		ChamaId:           db.ChamaID,
+		MemberId:          db.MemberID,
+		MemberNames:       db.MemberNames,
+		FineTypeId:        fmt.Sprint(db.FineTypeID),
+		FineName:          db.FineName,
+		AccountName:       db.AccountName,
+		Amount:            db.Amount,
+		PaidAmount:        db.PaidAmount,
+		Reason:            db.Reason,
+		Status:            chama.FineStatus(chama.FineStatus_value[db.Status]),
+		IssuedBy:          db.IssuedBy,
+		WaiverReason:      db.WaiverReason,
+		WaiverRequestedBy: db.WaiverRequestedBy,
+		WaivedBy:          db.WaivedBy,
+		CreatedDate:       db.CreatedAt.String(),
+	}
+	if db.PaidAt != nil {
+		pb.PaidDate = db.PaidAt.String()
+	}
+	return pb, nil
+}
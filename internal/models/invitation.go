@@ -0,0 +1,66 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+// MemberInvitation invites a person into a chama. The one-time code is kept hashed and the profile submitted by the invitee
+// becomes a member once an officer confirms it.
+type MemberInvitation struct {
+	ID             uint       `gorm:"primaryKey;autoIncrement"`
+	ChamaID        string     `gorm:"index;type:varchar(15);not null"`
+	Phone          string     `gorm:"index;type:varchar(15)"`
+	Email          string     `gorm:"index;type:varchar(50)"`
+	CodeHash       string     `gorm:"type:varchar(64);not null"`
+	FailedAttempts int        `gorm:"type:int"`
+	Status         string     `gorm:"index;type:varchar(30);not null"`
+	InvitedBy      string     `gorm:"type:varchar(50)"`
+	SendCount      int        `gorm:"type:int"`
+	Profile        []byte     `gorm:"type:json"`
+	UserID         string     `gorm:"type:varchar(50)"`
+	MemberID       string     `gorm:"type:varchar(15)"`
+	ConfirmedBy    string     `gorm:"type:varchar(50)"`
+	ExpiresAt      time.Time  `gorm:"type:datetime;not null"`
+	SubmittedAt    *time.Time `gorm:"type:datetime"`
+	UpdatedAt      time.Time  `gorm:"autoUpdateTime"`
+	CreatedAt      time.Time  `gorm:"autoCreateTime"`
+}
+
+func (*MemberInvitation) TableName() string {
+	return "member_invitations"
+}
+
+func MemberInvitationProto(db *MemberInvitation) (*chama.MemberInvitation, error) {
+	if db == nil {
+		return nil, errs.NilObject("member invitation")
+	}
+
+	pb := &chama.MemberInvitation{
+		InvitationId: fmt.Sprint(db.ID),
+		ChamaId:      db.ChamaID,
+		Phone:        db.Phone,
+		Email:        db.Email,
+		Status:       chama.InvitationStatus(chama.InvitationStatus_value[db.Status]),
+		InvitedBy:    db.InvitedBy,
+		SendCount:    int32(db.SendCount),
+		MemberId:     db.MemberID,
+		ConfirmedBy:  db.ConfirmedBy,
+		ExpiresDate:  db.ExpiresAt.String(),
+		CreatedDate:  db.CreatedAt.String(),
+	}
+
+	if len(db.Profile) != 0 {
+		pb.Profile = &chama.ChamaMember{}
+		err := json.Unmarshal(db.Profile, pb.Profile)
+		if err != nil {
+			return nil, errs.FromJSONUnMarshal(err, "invitation profile")
+		}
+	}
+
+	return pb, nil
+}
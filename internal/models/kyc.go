@@ -0,0 +1,96 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+// MemberKYC is the verification of a member's identity documents. A member has at most one.
+type MemberKYC struct {
+	ID            uint       `gorm:"primaryKey;autoIncrement"`
+	MemberID      string     `gorm:"uniqueIndex;type:varchar(15);not null"`
+	ChamaID       string     `gorm:"index;type:varchar(15);not null"`
+	MemberNames   string     `gorm:"type:varchar(60)"`
+	Status        string     `gorm:"index;type:varchar(30);not null"`
+	ReviewerID    string     `gorm:"type:varchar(50)"`
+	ReviewerNotes string     `gorm:"type:varchar(300)"`
+	SubmittedAt   *time.Time `gorm:"type:datetime"`
+	ReviewedAt    *time.Time `gorm:"type:datetime"`
+	UpdatedAt     time.Time  `gorm:"autoUpdateTime"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime"`
+}
+
+func (*MemberKYC) TableName() string {
+	return "member_kyc"
+}
+
+// KYCDocument is an uploaded identity document. The file is kept in blob storage under the storage key and a new
+// upload of the same type replaces it.
+type KYCDocument struct {
+	ID           uint      `gorm:"primaryKey;autoIncrement"`
+	MemberID     string    `gorm:"uniqueIndex:idx_member_document;type:varchar(15);not null"`
+	DocumentType string    `gorm:"uniqueIndex:idx_member_document;type:varchar(20);not null"`
+	StorageKey   string    `gorm:"type:varchar(100);not null"`
+	ContentType  string    `gorm:"type:varchar(50);not null"`
+	SizeBytes    int64     `gorm:"type:int"`
+	Checksum     string    `gorm:"type:varchar(64)"`
+	UploadedBy   string    `gorm:"type:varchar(50)"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+}
+
+func (*KYCDocument) TableName() string {
+	return "kyc_documents"
+}
+
+func KYCDocumentProto(db *KYCDocument) (*chama.KycDocument, error) {
+	if db == nil {
+		return nil, errs.NilObject("kyc document")
+	}
+
+	return &chama.KycDocument{
+		DocumentId:   fmt.Sprint(db.ID),
+		MemberId:     db.MemberID,
+		DocumentType: chama.KycDocumentType(chama.KycDocumentType_value[db.DocumentType]),
+		ContentType:  db.ContentType,
+		SizeBytes:    db.SizeBytes,
+		UploadedBy:   db.UploadedBy,
+		CreatedDate:  db.UpdatedAt.String(),
+	}, nil
+}
+
+func MemberKYCProto(db *MemberKYC, docs []*KYCDocument) (*chama.MemberKyc, error) {
+	if db == nil {
+		return nil, errs.NilObject("member kyc")
+	}
+
+	pb := &chama.MemberKyc{
+		MemberId:      db.MemberID,
+		ChamaId:       db.ChamaID,
+		MemberNames:   db.MemberNames,
+		Status:        chama.KycStatus(chama.KycStatus_value[db.Status]),
+		Documents:     make([]*chama.KycDocument, 0, len(docs)),
+		ReviewerId:    db.ReviewerID,
+		ReviewerNotes: db.ReviewerNotes,
+	}
+
+	if db.SubmittedAt != nil {
+		pb.SubmittedDate = db.SubmittedAt.String()
+	}
+	if db.ReviewedAt != nil {
+		pb.ReviewedDate = db.ReviewedAt.String()
+	}
+
+	for _, doc := range docs {
+		docPB, err := KYCDocumentProto(doc)
+		if err != nil {
+			return nil, err
+		}
+		pb.Documents = append(pb.Documents, docPB)
+	}
+
+	return pb, nil
+}
@@ -25,22 +25,29 @@ import (
 // BorrowedDate  string  `protobuf:"bytes,15,opt,name=borrowed_date,json=borrowedDate,proto3" json:"borrowed_date,omitempty"`
 
 type Loan struct {
-	ID            uint      `gorm:"primaryKey;autoIncrement"`
-	ChamaID       string    `gorm:"type:varchar(15);not null"`
-	ProductID     string    `gorm:"type:varchar(15);not null"`
-	MemberID      string    `gorm:"type:varchar(15);not null"`
-	LoaneeNames   string    `gorm:"type:varchar(30);not null"`
-	LoaneePhone   string    `gorm:"type:varchar(15);not null"`
-	NationalID    string    `gorm:"type:varchar(10);not null"`
-	LoaneeEmail   string    `gorm:"type:varchar(50)"`
-	Approved      bool      `gorm:"type:tinyint(1)"`
-	DurationDays  int32     `gorm:"type:int(10)"`
-	InterestRate  float32   `gorm:"type:float(3)"`
-	LoanAmount    float64   `gorm:"type:float(15)"`
-	SettledAmount float64   `gorm:"type:float(15)"`
-	PenaltyAmount float64   `gorm:"type:float(15)"`
-	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
-	CreatedAt     time.Time `gorm:"autoCreateTime"`
+	ID             uint      `gorm:"primaryKey;autoIncrement"`
+	ChamaID        string    `gorm:"type:varchar(15);not null"`
+	ProductID      string    `gorm:"type:varchar(15);not null"`
+	ProductVersion int32     `gorm:"type:int(10)"`
+	MemberID       string    `gorm:"type:varchar(15);not null"`
+	LoaneeNames    string    `gorm:"type:varchar(30);not null"`
+	LoaneePhone    string    `gorm:"type:varchar(15);not null"`
+	NationalID     string    `gorm:"type:varchar(10);not null"`
+	LoaneeEmail    string    `gorm:"type:varchar(50)"`
+	Approved       bool      `gorm:"type:tinyint(1)"`
+	Status         string    `gorm:"type:varchar(30)"`
+	DurationDays   int32     `gorm:"type:int(10)"`
+	InterestRate   float32   `gorm:"type:float(3)"`
+	LoanAmount     float64   `gorm:"type:float(15)"`
+	SettledAmount  float64   `gorm:"type:float(15)"`
+	PenaltyAmount  float64   `gorm:"type:float(15)"`
+	ParentLoanID   string    `gorm:"index;type:varchar(15)"`
+	CarriedOver    float64   `gorm:"type:float(15)"`
+	WrittenOff     float64   `gorm:"type:float(15)"`
+	FeeAmount      float64   `gorm:"type:float(15)"`
+	NetAmount      float64   `gorm:"type:float(15)"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
 }
 
 func LoanModel(pb *loan.Loan) (*Loan, error) {
@@ -48,19 +55,26 @@ func LoanModel(pb *loan.Loan) (*Loan, error) {
 		return nil, errs.NilObject("loan")
 	}
 	db := &Loan{
-		ChamaID:       pb.ChamaId,
-		ProductID:     pb.ProductId,
-		MemberID:      pb.MemberId,
-		LoaneeNames:   pb.LoaneeNames,
-		LoaneePhone:   pb.LoaneePhone,
-		LoaneeEmail:   pb.LoaneeEmail,
-		NationalID:    pb.NationalId,
-		Approved:      pb.Approved,
-		DurationDays:  pb.DurationDays,
-		LoanAmount:    pb.LoanAmount,
-		InterestRate:  pb.InterestRate,
-		SettledAmount: pb.SettledAmount,
-		PenaltyAmount: pb.PenaltyAmount,
+		ChamaID:        pb.ChamaId,
+		ProductID:      pb.ProductId,
+		ProductVersion: pb.ProductVersion,
+		MemberID:       pb.MemberId,
+		LoaneeNames:    pb.LoaneeNames,
+		LoaneePhone:    pb.LoaneePhone,
+		LoaneeEmail:    pb.LoaneeEmail,
+		NationalID:     pb.NationalId,
+		Approved:       pb.Approved,
+		Status:         pb.Status.String(),
+		DurationDays:   pb.DurationDays,
+		LoanAmount:     pb.LoanAmount,
+		InterestRate:   pb.InterestRate,
+		SettledAmount:  pb.SettledAmount,
+		PenaltyAmount:  pb.PenaltyAmount,
+		ParentLoanID:   pb.ParentLoanId,
+		CarriedOver:    pb.CarriedOverAmount,
+		WrittenOff:     pb.WrittenOffAmount,
+		FeeAmount:      pb.FeeAmount,
+		NetAmount:      pb.NetAmount,
 	}
 	return db, nil
 }
@@ -70,22 +84,29 @@ func LoanProto(db *Loan) (*loan.Loan, error) {
 		return nil, errs.NilObject("loan")
 	}
 	pb := &loan.Loan{
-		LoanId:        fmt.Sprint(db.ID),
-		ChamaId:       db.ChamaID,
-		ProductId:     db.ProductID,
-		MemberId:      db.MemberID,
-		LoaneeNames:   db.LoaneeNames,
-		LoaneePhone:   db.LoaneePhone,
-		LoaneeEmail:   db.LoaneeEmail,
-		NationalId:    db.NationalID,
-		Approved:      db.Approved,
-		DurationDays:  db.DurationDays,
-		LoanAmount:    db.LoanAmount,
-		InterestRate:  db.InterestRate,
-		SettledAmount: db.SettledAmount,
-		PenaltyAmount: db.PenaltyAmount,
-		UpdatedDate:   db.UpdatedAt.String(),
-		BorrowedDate:  db.CreatedAt.String(),
+		LoanId:            fmt.Sprint(db.ID),
+		ChamaId:           db.ChamaID,
+		ProductId:         db.ProductID,
+		ProductVersion:    db.ProductVersion,
+		MemberId:          db.MemberID,
+		LoaneeNames:       db.LoaneeNames,
+		LoaneePhone:       db.LoaneePhone,
+		LoaneeEmail:       db.LoaneeEmail,
+		NationalId:        db.NationalID,
+		Approved:          db.Approved,
+		Status:            loan.LoanStatus(loan.LoanStatus_value[db.Status]),
+		DurationDays:      db.DurationDays,
+		LoanAmount:        db.LoanAmount,
+		InterestRate:      db.InterestRate,
+		SettledAmount:     db.SettledAmount,
+		PenaltyAmount:     db.PenaltyAmount,
+		ParentLoanId:      db.ParentLoanID,
+		CarriedOverAmount: db.CarriedOver,
+		WrittenOffAmount:  db.WrittenOff,
+		FeeAmount:         db.FeeAmount,
+		NetAmount:         db.NetAmount,
+		UpdatedDate:       db.UpdatedAt.String(),
+		BorrowedDate:      db.CreatedAt.String(),
 	}
 	return pb, nil
 }
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+type LoanApproval struct {
+	ID            uint      `gorm:"primaryKey;autoIncrement"`
+	LoanID        uint      `gorm:"uniqueIndex:idx_loan_approver;not null"`
+	ApproverID    string    `gorm:"uniqueIndex:idx_loan_approver;type:varchar(50);not null"`
+	ApproverNames string    `gorm:"type:varchar(50)"`
+	AccountName   string    `gorm:"type:varchar(50);not null"`
+	Comment       string    `gorm:"type:varchar(200)"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (*LoanApproval) TableName() string {
+	return "loan_approvals"
+}
+
+func LoanApprovalProto(db *LoanApproval) (*loan.LoanApproval, error) {
+	if db == nil {
+		return nil, errs.NilObject("loan approval")
+	}
+	return &loan.LoanApproval{
+		ApprovalId:    fmt.Sprint(db.ID),
+		LoanId:        fmt.Sprint(db.LoanID),
+		ApproverId:    db.ApproverID,
+		ApproverNames: db.ApproverNames,
+		AccountName:   db.AccountName,
+		Comment:       db.Comment,
+		ApprovedDate:  db.CreatedAt.String(),
+	}, nil
+}
+
+type LoanVote struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	LoanID    uint      `gorm:"uniqueIndex:idx_loan_voter;not null"`
+	MemberID  string    `gorm:"uniqueIndex:idx_loan_voter;type:varchar(15);not null"`
+	InFavour  bool      `gorm:"type:tinyint(1)"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (*LoanVote) TableName() string {
+	return "loan_votes"
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	LoanChargePenalty            = "PENALTY"
+	LoanChargeEarlySettlementFee = "EARLY_SETTLEMENT_FEE"
+)
+
+type LoanCharge struct {
+	ID            uint      `gorm:"primaryKey;autoIncrement"`
+	LoanID        uint      `gorm:"uniqueIndex:idx_loan_charge_day;not null"`
+	InstallmentID uint      `gorm:"uniqueIndex:idx_loan_charge_day"`
+	ChargeType    string    `gorm:"uniqueIndex:idx_loan_charge_day;type:varchar(30);not null"`
+	ChargeDate    string    `gorm:"uniqueIndex:idx_loan_charge_day;type:varchar(10);not null"`
+	Description   string    `gorm:"type:varchar(200)"`
+	Amount        float64   `gorm:"type:float(15)"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (*LoanCharge) TableName() string {
+	return "loan_charges"
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+type LoanCollateral struct {
+	ID                 uint       `gorm:"primaryKey;autoIncrement"`
+	LoanID             uint       `gorm:"index;not null"`
+	CollateralType     string     `gorm:"type:varchar(30);not null"`
+	Description        string     `gorm:"type:varchar(200);not null"`
+	EstimatedValue     float64    `gorm:"type:float(15)"`
+	DocumentReferences []byte     `gorm:"type:json"`
+	LienStatus         string     `gorm:"index;type:varchar(30)"`
+	ReleasedAt         *time.Time `gorm:"type:datetime"`
+	UpdatedAt          time.Time  `gorm:"autoUpdateTime"`
+	CreatedAt          time.Time  `gorm:"autoCreateTime"`
+}
+
+func (*LoanCollateral) TableName() string {
+	return "loan_collaterals"
+}
+
+func LoanCollateralModel(pb *loan.Collateral) (*LoanCollateral, error) {
+	if pb == nil {
+		return nil, errs.NilObject("collateral")
+	}
+
+	db := &LoanCollateral{
+		CollateralType: pb.CollateralType.String(),
+		Description:    pb.Description,
+		EstimatedValue: pb.EstimatedValue,
+		LienStatus:     pb.LienStatus.String(),
+	}
+
+	if len(pb.DocumentReferences) != 0 {
+		bs, err := json.Marshal(pb.DocumentReferences)
+		if err != nil {
+			return nil, errs.FromJSONMarshal(err, "document references")
+		}
+		db.DocumentReferences = bs
+	}
+
+	return db, nil
+}
+
+func LoanCollateralProto(db *LoanCollateral) (*loan.Collateral, error) {
+	if db == nil {
+		return nil, errs.NilObject("collateral")
+	}
+
+	pb := &loan.Collateral{
+		CollateralId:   fmt.Sprint(db.ID),
+		LoanId:         fmt.Sprint(db.LoanID),
+		CollateralType: loan.CollateralType(loan.CollateralType_value[db.CollateralType]),
+		Description:    db.Description,
+		EstimatedValue: db.EstimatedValue,
+		LienStatus:     loan.LienStatus(loan.LienStatus_value[db.LienStatus]),
+		CreatedDate:    db.CreatedAt.String(),
+	}
+
+	if db.ReleasedAt != nil {
+		pb.ReleasedDate = db.ReleasedAt.String()
+	}
+
+	if len(db.DocumentReferences) != 0 {
+		err := json.Unmarshal(db.DocumentReferences, &pb.DocumentReferences)
+		if err != nil {
+			return nil, errs.FromJSONUnMarshal(err, "document references")
+		}
+	}
+
+	return pb, nil
+}
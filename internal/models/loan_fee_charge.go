@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+)
+
+// LoanFeeCharge is a product fee charged on a loan. Charges are recorded before funds are released and are posted to the
+// income account of the fee once.
+type LoanFeeCharge struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	LoanID      uint      `gorm:"index;not null"`
+	FeeName     string    `gorm:"type:varchar(50);not null"`
+	AccountName string    `gorm:"type:varchar(50);not null"`
+	AccountID   string    `gorm:"type:varchar(50)"`
+	Amount      float64   `gorm:"type:float(15)"`
+	Posted      bool      `gorm:"type:tinyint(1)"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+func (*LoanFeeCharge) TableName() string {
+	return "loan_fee_charges"
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+type LoanHoliday struct {
+	ID             uint      `gorm:"primaryKey;autoIncrement"`
+	LoanID         uint      `gorm:"index;not null"`
+	ActorID        string    `gorm:"type:varchar(50);not null"`
+	Periods        int32     `gorm:"type:int(5);not null"`
+	ShiftDays      int32     `gorm:"type:int(10);not null"`
+	InterestAmount float64   `gorm:"type:float(15)"`
+	Reason         string    `gorm:"type:varchar(200);not null"`
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+}
+
+func (*LoanHoliday) TableName() string {
+	return "loan_holidays"
+}
+
+func LoanHolidayProto(db *LoanHoliday) (*loan.RepaymentHoliday, error) {
+	if db == nil {
+		return nil, errs.NilObject("repayment holiday")
+	}
+	return &loan.RepaymentHoliday{
+		HolidayId:      fmt.Sprint(db.ID),
+		LoanId:         fmt.Sprint(db.LoanID),
+		ActorId:        db.ActorID,
+		Periods:        db.Periods,
+		ShiftDays:      db.ShiftDays,
+		InterestAmount: db.InterestAmount,
+		Reason:         db.Reason,
+		CreatedDate:    db.CreatedAt.String(),
+	}, nil
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+type LoanInstallment struct {
+	ID                uint      `gorm:"primaryKey;autoIncrement"`
+	LoanID            uint      `gorm:"index;not null"`
+	InstallmentNumber int32     `gorm:"type:int(10);not null"`
+	PrincipalDue      float64   `gorm:"type:float(15)"`
+	InterestDue       float64   `gorm:"type:float(15)"`
+	AmountDue         float64   `gorm:"type:float(15)"`
+	AmountPaid        float64   `gorm:"type:float(15)"`
+	DueDate           time.Time `gorm:"index;not null"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime"`
+	CreatedAt         time.Time `gorm:"autoCreateTime"`
+}
+
+func (*LoanInstallment) TableName() string {
+	return "loan_installments"
+}
+
+func LoanInstallmentProto(db *LoanInstallment) (*loan.LoanInstallment, error) {
+	if db == nil {
+		return nil, errs.NilObject("loan installment")
+	}
+	pb := &loan.LoanInstallment{
+		InstallmentId:     fmt.Sprint(db.ID),
+		LoanId:            fmt.Sprint(db.LoanID),
+		InstallmentNumber: db.InstallmentNumber,
+		PrincipalDue:      db.PrincipalDue,
+		InterestDue:       db.InterestDue,
+		AmountDue:         db.AmountDue,
+		AmountPaid:        db.AmountPaid,
+		DueDate:           db.DueDate.Format("2006-01-02"),
+	}
+	return pb, nil
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+type LoanPayoffQuote struct {
+	ID               uint      `gorm:"primaryKey;autoIncrement"`
+	LoanID           uint      `gorm:"index;not null"`
+	SettlementType   string    `gorm:"type:varchar(30)"`
+	AsOfDate         string    `gorm:"type:varchar(10)"`
+	ExpiresAt        time.Time `gorm:"not null"`
+	PrincipalAmount  float64   `gorm:"type:float(15)"`
+	InterestAmount   float64   `gorm:"type:float(15)"`
+	PenaltyAmount    float64   `gorm:"type:float(15)"`
+	RebateAmount     float64   `gorm:"type:float(15)"`
+	FeeAmount        float64   `gorm:"type:float(15)"`
+	SettlementAmount float64   `gorm:"type:float(15)"`
+	SettledAmount    float64   `gorm:"type:float(15)"`
+	Used             bool      `gorm:"type:tinyint(1)"`
+	CreatedAt        time.Time `gorm:"autoCreateTime"`
+}
+
+func (*LoanPayoffQuote) TableName() string {
+	return "loan_payoff_quotes"
+}
+
+func LoanPayoffQuoteProto(db *LoanPayoffQuote) (*loan.PayoffQuote, error) {
+	if db == nil {
+		return nil, errs.NilObject("payoff quote")
+	}
+	return &loan.PayoffQuote{
+		QuoteId:          fmt.Sprint(db.ID),
+		LoanId:           fmt.Sprint(db.LoanID),
+		SettlementType:   loan.EarlySettlementType(loan.EarlySettlementType_value[db.SettlementType]),
+		AsOfDate:         db.AsOfDate,
+		ExpiryDate:       db.ExpiresAt.String(),
+		PrincipalAmount:  db.PrincipalAmount,
+		InterestAmount:   db.InterestAmount,
+		PenaltyAmount:    db.PenaltyAmount,
+		RebateAmount:     db.RebateAmount,
+		FeeAmount:        db.FeeAmount,
+		SettlementAmount: db.SettlementAmount,
+	}, nil
+}
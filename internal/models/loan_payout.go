@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	PayoutPending   = "PENDING"
+	PayoutSucceeded = "SUCCEEDED"
+	PayoutCompleted = "COMPLETED"
+	PayoutReversed  = "REVERSED"
+)
+
+type LoanPayout struct {
+	ID                uint      `gorm:"primaryKey;autoIncrement"`
+	LoanID            uint      `gorm:"index;not null"`
+	ActorID           string    `gorm:"type:varchar(50);not null"`
+	AccountID         string    `gorm:"type:varchar(50);not null"`
+	Phone             string    `gorm:"type:varchar(15);not null"`
+	Amount            float64   `gorm:"type:float(15)"`
+	ProviderReference string    `gorm:"index;type:varchar(100)"`
+	Status            string    `gorm:"type:varchar(30);not null"`
+	FailureReason     string    `gorm:"type:varchar(200)"`
+	ReconcileReason   string    `gorm:"type:varchar(200)"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime"`
+	CreatedAt         time.Time `gorm:"autoCreateTime"`
+}
+
+func (*LoanPayout) TableName() string {
+	return "loan_payouts"
+}
@@ -1,7 +1,9 @@
 package models
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/gidyon/machama-app/pkg/api/loan"
@@ -39,6 +41,16 @@ type LoanProduct struct {
 	SettledLoans        int32     `gorm:"type:int(10)"`
 	ActiveLoans         int32     `gorm:"type:int(10)"`
 	TotalLoans          int32     `gorm:"type:int(10)"`
+	RepaymentPeriodDays int32     `gorm:"type:int(10)"`
+	EligibilityRules    []byte    `gorm:"type:json"`
+	PenaltyPolicy       []byte    `gorm:"type:json"`
+	ApprovalPolicy      []byte    `gorm:"type:json"`
+	SettlementPolicy    []byte    `gorm:"type:json"`
+	Version             int32     `gorm:"type:int(10)"`
+	Archived            bool      `gorm:"type:tinyint(1)"`
+	CollateralCoverage  float32   `gorm:"type:float(6)"`
+	Fees                []byte    `gorm:"type:json"`
+	GracePolicy         []byte    `gorm:"type:json"`
 	UpdatedAt           time.Time `gorm:"autoUpdateTime"`
 	CreatedAt           time.Time `gorm:"autoCreateTime"`
 }
@@ -65,7 +77,58 @@ func LoanProductModel(pb *loan.LoanProduct) (*LoanProduct, error) {
 		SettledLoans:        pb.SettledLoans,
 		ActiveLoans:         pb.ActiveLoans,
 		TotalLoans:          pb.TotalLoans,
+		RepaymentPeriodDays: pb.RepaymentPeriodDays,
+		CollateralCoverage:  pb.CollateralCoverage,
 	}
+
+	if pb.PenaltyPolicy != nil {
+		bs, err := json.Marshal(pb.PenaltyPolicy)
+		if err != nil {
+			return nil, errs.FromJSONMarshal(err, "penalty policy")
+		}
+		db.PenaltyPolicy = bs
+	}
+
+	if pb.ApprovalPolicy != nil {
+		bs, err := json.Marshal(pb.ApprovalPolicy)
+		if err != nil {
+			return nil, errs.FromJSONMarshal(err, "approval policy")
+		}
+		db.ApprovalPolicy = bs
+	}
+
+	if pb.EarlySettlementPolicy != nil {
+		bs, err := json.Marshal(pb.EarlySettlementPolicy)
+		if err != nil {
+			return nil, errs.FromJSONMarshal(err, "early settlement policy")
+		}
+		db.SettlementPolicy = bs
+	}
+
+	if pb.EligibilityRules != nil {
+		bs, err := json.Marshal(pb.EligibilityRules)
+		if err != nil {
+			return nil, errs.FromJSONMarshal(err, "eligibility rules")
+		}
+		db.EligibilityRules = bs
+	}
+
+	if len(pb.Fees) != 0 {
+		bs, err := json.Marshal(pb.Fees)
+		if err != nil {
+			return nil, errs.FromJSONMarshal(err, "loan fees")
+		}
+		db.Fees = bs
+	}
+
+	if pb.GracePolicy != nil {
+		bs, err := json.Marshal(pb.GracePolicy)
+		if err != nil {
+			return nil, errs.FromJSONMarshal(err, "grace policy")
+		}
+		db.GracePolicy = bs
+	}
+
 	return db, nil
 }
 
@@ -88,8 +151,132 @@ func LoanProductProto(db *LoanProduct) (*loan.LoanProduct, error) {
 		SettledLoans:        db.SettledLoans,
 		ActiveLoans:         db.ActiveLoans,
 		TotalLoans:          db.TotalLoans,
+		RepaymentPeriodDays: db.RepaymentPeriodDays,
+		Version:             db.Version,
+		Archived:            db.Archived,
+		CollateralCoverage:  db.CollateralCoverage,
 		UpdatedDate:         db.UpdatedAt.String(),
 		CreatedDate:         db.CreatedAt.String(),
 	}
+
+	if len(db.EligibilityRules) != 0 {
+		pb.EligibilityRules = &loan.EligibilityRules{}
+		err := json.Unmarshal(db.EligibilityRules, pb.EligibilityRules)
+		if err != nil {
+			return nil, errs.FromJSONUnMarshal(err, "eligibility rules")
+		}
+	}
+
+	if len(db.PenaltyPolicy) != 0 {
+		pb.PenaltyPolicy = &loan.PenaltyPolicy{}
+		err := json.Unmarshal(db.PenaltyPolicy, pb.PenaltyPolicy)
+		if err != nil {
+			return nil, errs.FromJSONUnMarshal(err, "penalty policy")
+		}
+	}
+
+	if len(db.ApprovalPolicy) != 0 {
+		pb.ApprovalPolicy = &loan.ApprovalPolicy{}
+		err := json.Unmarshal(db.ApprovalPolicy, pb.ApprovalPolicy)
+		if err != nil {
+			return nil, errs.FromJSONUnMarshal(err, "approval policy")
+		}
+	}
+
+	if len(db.SettlementPolicy) != 0 {
+		pb.EarlySettlementPolicy = &loan.EarlySettlementPolicy{}
+		err := json.Unmarshal(db.SettlementPolicy, pb.EarlySettlementPolicy)
+		if err != nil {
+			return nil, errs.FromJSONUnMarshal(err, "early settlement policy")
+		}
+	}
+
+	if len(db.Fees) != 0 {
+		err := json.Unmarshal(db.Fees, &pb.Fees)
+		if err != nil {
+			return nil, errs.FromJSONUnMarshal(err, "loan fees")
+		}
+	}
+
+	if len(db.GracePolicy) != 0 {
+		pb.GracePolicy = &loan.GracePolicy{}
+		err := json.Unmarshal(db.GracePolicy, pb.GracePolicy)
+		if err != nil {
+			return nil, errs.FromJSONUnMarshal(err, "grace policy")
+		}
+	}
+
+	return pb, nil
+}
+
+type LoanProductVersion struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	ProductID uint      `gorm:"uniqueIndex:idx_product_version;not null"`
+	Version   int32     `gorm:"uniqueIndex:idx_product_version;type:int(10);not null"`
+	Terms     []byte    `gorm:"type:json;not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (*LoanProductVersion) TableName() string {
+	return "loan_product_versions"
+}
+
+// LoanProductVersionModel snapshots the terms of a loan product at its current version
+func LoanProductVersionModel(pb *loan.LoanProduct) (*LoanProductVersion, error) {
+	if pb == nil {
+		return nil, errs.NilObject("loan plan")
+	}
+
+	productID, err := strconv.ParseUint(pb.ProductId, 10, 64)
+	if err != nil {
+		return nil, errs.IncorrectVal("product id")
+	}
+
+	bs, err := json.Marshal(&loan.LoanProduct{
+		ProductId:             pb.ProductId,
+		ChamaId:               pb.ChamaId,
+		Name:                  pb.Name,
+		Description:           pb.Description,
+		LoanDurationDays:      pb.LoanDurationDays,
+		InterestRate:          pb.InterestRate,
+		LoanMinimumAmount:     pb.LoanMinimumAmount,
+		LoanMaximumAmount:     pb.LoanMaximumAmount,
+		RepaymentPeriodDays:   pb.RepaymentPeriodDays,
+		EligibilityRules:      pb.EligibilityRules,
+		PenaltyPolicy:         pb.PenaltyPolicy,
+		ApprovalPolicy:        pb.ApprovalPolicy,
+		EarlySettlementPolicy: pb.EarlySettlementPolicy,
+		CollateralCoverage:    pb.CollateralCoverage,
+		Fees:                  pb.Fees,
+		GracePolicy:           pb.GracePolicy,
+		Version:               pb.Version,
+	})
+	if err != nil {
+		return nil, errs.FromJSONMarshal(err, "loan product terms")
+	}
+
+	return &LoanProductVersion{
+		ProductID: uint(productID),
+		Version:   pb.Version,
+		Terms:     bs,
+	}, nil
+}
+
+func LoanProductVersionProto(db *LoanProductVersion) (*loan.LoanProductVersion, error) {
+	if db == nil {
+		return nil, errs.NilObject("loan product version")
+	}
+
+	pb := &loan.LoanProductVersion{
+		Version:     db.Version,
+		Terms:       &loan.LoanProduct{},
+		CreatedDate: db.CreatedAt.String(),
+	}
+
+	err := json.Unmarshal(db.Terms, pb.Terms)
+	if err != nil {
+		return nil, errs.FromJSONUnMarshal(err, "loan product terms")
+	}
+
 	return pb, nil
 }
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+)
+
+type LoanRepayment struct {
+	ID              uint      `gorm:"primaryKey;autoIncrement"`
+	LoanID          uint      `gorm:"index;not null"`
+	ActorID         string    `gorm:"type:varchar(50);not null"`
+	QuoteID         uint      `gorm:"type:int(10)"`
+	Amount          float64   `gorm:"type:float(15)"`
+	PrincipalAmount float64   `gorm:"type:float(15)"`
+	InterestAmount  float64   `gorm:"type:float(15)"`
+	PenaltyAmount   float64   `gorm:"type:float(15)"`
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+}
+
+func (*LoanRepayment) TableName() string {
+	return "loan_repayments"
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+type LoanWriteOff struct {
+	ID            uint      `gorm:"primaryKey;autoIncrement"`
+	LoanID        uint      `gorm:"uniqueIndex:idx_loan_write_off_approver;not null"`
+	ApproverID    string    `gorm:"uniqueIndex:idx_loan_write_off_approver;type:varchar(50);not null"`
+	ApproverNames string    `gorm:"type:varchar(50)"`
+	AccountName   string    `gorm:"type:varchar(50);not null"`
+	Reason        string    `gorm:"type:varchar(200);not null"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (*LoanWriteOff) TableName() string {
+	return "loan_write_offs"
+}
+
+func LoanWriteOffProto(db *LoanWriteOff) (*loan.LoanWriteOff, error) {
+	if db == nil {
+		return nil, errs.NilObject("loan write off")
+	}
+	return &loan.LoanWriteOff{
+		WriteOffId:    fmt.Sprint(db.ID),
+		LoanId:        fmt.Sprint(db.LoanID),
+		ApproverId:    db.ApproverID,
+		ApproverNames: db.ApproverNames,
+		AccountName:   db.AccountName,
+		Reason:        db.Reason,
+		ApprovedDate:  db.CreatedAt.String(),
+	}, nil
+}
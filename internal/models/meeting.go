@@ -0,0 +1,164 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+// MeetingTimeLayout is the layout of meeting scheduled dates
+const MeetingTimeLayout = "2006-01-02 15:04"
+
+type Meeting struct {
+	ID                uint      `gorm:"primaryKey;autoIncrement"`
+	ChamaID           string    `gorm:"index;type:varchar(15);not null"`
+	Title             string    `gorm:"type:varchar(100);not null"`
+	Venue             string    `gorm:"type:varchar(100)"`
+	Agenda            string    `gorm:"type:text"`
+	Minutes           string    `gorm:"type:text"`
+	ScheduledAt       time.Time `gorm:"not null"`
+	Status            string    `gorm:"type:varchar(20);not null"`
+	AbsenteeFine      float64   `gorm:"type:float(15)"`
+	LateFine          float64   `gorm:"type:float(15)"`
+	MemberAccountName string    `gorm:"type:varchar(50)"`
+	FineAccountName   string    `gorm:"type:varchar(50)"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime"`
+	CreatedAt         time.Time `gorm:"autoCreateTime"`
+}
+
+func (*Meeting) TableName() string {
+	return "meetings"
+}
+
+func MeetingModel(pb *chama.Meeting) (*Meeting, error) {
+	if pb == nil {
+		return nil, errs.NilObject("meeting")
+	}
+
+	db := &Meeting{
+		ChamaID:           pb.ChamaId,
+		Title:             pb.Title,
+		Venue:             pb.Venue,
+		Agenda:            pb.Agenda,
+		Minutes:           pb.Minutes,
+		Status:            pb.Status.String(),
+		AbsenteeFine:      pb.AbsenteeFine,
+		LateFine:          pb.LateFine,
+		MemberAccountName: pb.MemberAccountName,
+		FineAccountName:   pb.FineAccountName,
+	}
+
+	if pb.ScheduledDate != "" {
+		scheduledAt, err := time.ParseInLocation(MeetingTimeLayout, pb.ScheduledDate, time.Local)
+		if err != nil {
+			return nil, errs.IncorrectVal("meeting scheduled date")
+		}
+		db.ScheduledAt = scheduledAt
+	}
+
+	return db, nil
+}
+
+func MeetingProto(db *Meeting) (*chama.Meeting, error) {
+	if db == nil {
+		return nil, errs.NilObject("meeting")
+	}
+	return &chama.Meeting{
+		MeetingId:         fmt.Sprint(db.ID),
+		ChamaId:           db.ChamaID,
+		Title:             db.Title,
+		Venue:             db.Venue,
+		Agenda:            db.Agenda,
+		Minutes:           db.Minutes,
+		ScheduledDate:     db.ScheduledAt.Format(MeetingTimeLayout),
+		Status:            chama.MeetingStatus(chama.MeetingStatus_value[db.Status]),
+		AbsenteeFine:      db.AbsenteeFine,
+		LateFine:          db.LateFine,
+		MemberAccountName: db.MemberAccountName,
+		FineAccountName:   db.FineAccountName,
+		CreatedDate:       db.CreatedAt.String(),
+	}, nil
+}
+
+type MeetingAttendance struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	MeetingID   uint      `gorm:"uniqueIndex:idx_meeting_member;not null"`
+	MemberID    string    `gorm:"uniqueIndex:idx_meeting_member;type:varchar(15);not null"`
+	MemberNames string    `gorm:"type:varchar(60)"`
+	Status      string    `gorm:"type:varchar(30);not null"`
+	Apology     string    `gorm:"type:varchar(200)"`
+	FineAmount  float64   `gorm:"type:float(15)"`
+	FinePaid    bool      `gorm:"type:tinyint(1)"`
+	FineID      uint      `gorm:"index"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+func (*MeetingAttendance) TableName() string {
+	return "meeting_attendance"
+}
+
+func MeetingAttendanceProto(db *MeetingAttendance) (*chama.MeetingAttendance, error) {
+	if db == nil {
+		return nil, errs.NilObject("meeting attendance")
+	}
+	return &chama.MeetingAttendance{
+		MemberId:    db.MemberID,
+		MemberNames: db.MemberNames,
+		Status:      chama.AttendanceStatus(chama.AttendanceStatus_value[db.Status]),
+		Apology:     db.Apology,
+		FineAmount:  db.FineAmount,
+		FinePaid:    db.FinePaid,
+	}, nil
+}
+
+type MeetingResolution struct {
+	ID           uint      `gorm:"primaryKey;autoIncrement"`
+	MeetingID    uint      `gorm:"index;not null"`
+	Description  string    `gorm:"type:text;not null"`
+	ProposedBy   string    `gorm:"type:varchar(15)"`
+	SecondedBy   string    `gorm:"type:varchar(15)"`
+	VotesFor     int32     `gorm:"type:int(5)"`
+	VotesAgainst int32     `gorm:"type:int(5)"`
+	Abstentions  int32     `gorm:"type:int(5)"`
+	Passed       bool      `gorm:"type:tinyint(1)"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+}
+
+func (*MeetingResolution) TableName() string {
+	return "meeting_resolutions"
+}
+
+func MeetingResolutionModel(pb *chama.MeetingResolution) (*MeetingResolution, error) {
+	if pb == nil {
+		return nil, errs.NilObject("meeting resolution")
+	}
+	return &MeetingResolution{
+		Description:  pb.Description,
+		ProposedBy:   pb.ProposedBy,
+		SecondedBy:   pb.SecondedBy,
+		VotesFor:     pb.VotesFor,
+		VotesAgainst: pb.VotesAgainst,
+		Abstentions:  pb.Abstentions,
+	}, nil
+}
+
+func MeetingResolutionProto(db *MeetingResolution) (*chama.MeetingResolution, error) {
+	if db == nil {
+		return nil, errs.NilObject("meeting resolution")
+	}
+	return &chama.MeetingResolution{
+		ResolutionId: fmt.Sprint(db.ID),
+		MeetingId:    fmt.Sprint(db.MeetingID),
+		Description:  db.Description,
+		ProposedBy:   db.ProposedBy,
+		SecondedBy:   db.SecondedBy,
+		VotesFor:     db.VotesFor,
+		VotesAgainst: db.VotesAgainst,
+		Abstentions:  db.Abstentions,
+		Passed:       db.Passed,
+		CreatedDate:  db.CreatedAt.String(),
+	}, nil
+}
@@ -0,0 +1,111 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+// MemberExit is the final settlement of a member leaving a chama. A member has at most one.
+type MemberExit struct {
+	ID                    uint              `gorm:"primaryKey;autoIncrement"`
+	ChamaID               string            `gorm:"index;type:varchar(15);not null"`
+	MemberID              string            `gorm:"uniqueIndex;type:varchar(15);not null"`
+	MemberNames           string            `gorm:"type:varchar(60)"`
+	Status                string            `gorm:"index;type:varchar(20);not null"`
+	SettlementAccountName string            `gorm:"type:varchar(50);not null"`
+	Reason                string            `gorm:"type:varchar(200)"`
+	ExitedBy              string            `gorm:"type:varchar(50)"`
+	CompletedAt           *time.Time        `gorm:"type:datetime"`
+	Lines                 []*MemberExitLine `gorm:"foreignKey:ExitID"`
+	UpdatedAt             time.Time         `gorm:"autoUpdateTime"`
+	CreatedAt             time.Time         `gorm:"autoCreateTime"`
+}
+
+func (*MemberExit) TableName() string {
+	return "member_exits"
+}
+
+// MemberExitLine is an amount paid to or recovered from the exiting member. Settled lines are not settled again
+// when an interrupted settlement is resumed.
+type MemberExitLine struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	ExitID      uint      `gorm:"index;not null"`
+	LineType    string    `gorm:"type:varchar(30);not null"`
+	ReferenceID string    `gorm:"type:varchar(15);not null"`
+	Description string    `gorm:"type:varchar(200)"`
+	Amount      float64   `gorm:"type:float(15);not null"`
+	Settled     bool      `gorm:"type:tinyint(1)"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+func (*MemberExitLine) TableName() string {
+	return "member_exit_lines"
+}
+
+func MemberExitProto(db *MemberExit) (*chama.ExitSettlement, error) {
+	if db == nil {
+		return nil, errs.NilObject("member exit")
+	}
+
+	pb := &chama.ExitSettlement{
+		MemberId:              db.MemberID,
+		ChamaId:               db.ChamaID,
+		MemberNames:           db.MemberNames,
+		Status:                chama.ExitStatus(chama.ExitStatus_value[db.Status]),
+		Lines:                 make([]*chama.SettlementLine, 0, len(db.Lines)),
+		SettlementAccountName: db.SettlementAccountName,
+		Reason:                db.Reason,
+		ExitedBy:              db.ExitedBy,
+	}
+
+	if db.ID != 0 {
+		pb.SettlementId = fmt.Sprint(db.ID)
+		pb.CreatedDate = db.CreatedAt.String()
+	}
+	if db.CompletedAt != nil {
+		pb.CompletedDate = db.CompletedAt.String()
+	}
+
+	for _, line := range db.Lines {
+		linePB := &chama.SettlementLine{
+			LineType:    chama.SettlementLineType(chama.SettlementLineType_value[line.LineType]),
+			ReferenceId: line.ReferenceID,
+			Description: line.Description,
+			Amount:      line.Amount,
+			Settled:     line.Settled,
+		}
+		if line.ID != 0 {
+			linePB.LineId = fmt.Sprint(line.ID)
+		}
+		pb.Lines = append(pb.Lines, linePB)
+
+		switch linePB.LineType {
+		case chama.SettlementLineType_SETTLEMENT_SAVINGS:
+			pb.SavingsAmount += line.Amount
+		case chama.SettlementLineType_SETTLEMENT_SHARE_CAPITAL:
+			pb.ShareCapitalAmount += line.Amount
+		case chama.SettlementLineType_SETTLEMENT_LOAN:
+			pb.LoanAmount += line.Amount
+		case chama.SettlementLineType_SETTLEMENT_FINE:
+			pb.FineAmount += line.Amount
+		case chama.SettlementLineType_SETTLEMENT_GUARANTEE:
+			pb.GuaranteeAmount += line.Amount
+		}
+	}
+
+	round := func(amount float64) float64 { return math.Round(amount*100) / 100 }
+
+	pb.SavingsAmount = round(pb.SavingsAmount)
+	pb.ShareCapitalAmount = round(pb.ShareCapitalAmount)
+	pb.LoanAmount = round(pb.LoanAmount)
+	pb.FineAmount = round(pb.FineAmount)
+	pb.GuaranteeAmount = round(pb.GuaranteeAmount)
+	pb.NetAmount = round(pb.SavingsAmount + pb.ShareCapitalAmount - pb.LoanAmount - pb.FineAmount - pb.GuaranteeAmount)
+
+	return pb, nil
+}
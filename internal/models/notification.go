@@ -0,0 +1,94 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/pkg/api/loan"
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+type NotificationTemplate struct {
+	ID         uint      `gorm:"primaryKey;autoIncrement"`
+	ChamaID    string    `gorm:"uniqueIndex:idx_chama_template;type:varchar(15);not null"`
+	Event      string    `gorm:"uniqueIndex:idx_chama_template;type:varchar(30);not null"`
+	Channel    string    `gorm:"uniqueIndex:idx_chama_template;type:varchar(15);not null"`
+	Body       string    `gorm:"type:text;not null"`
+	DaysBefore int32     `gorm:"type:int(5)"`
+	Disabled   bool      `gorm:"type:tinyint(1)"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+func (*NotificationTemplate) TableName() string {
+	return "notification_templates"
+}
+
+func NotificationTemplateModel(pb *loan.NotificationTemplate) (*NotificationTemplate, error) {
+	if pb == nil {
+		return nil, errs.NilObject("notification template")
+	}
+	return &NotificationTemplate{
+		ChamaID:    pb.ChamaId,
+		Event:      pb.Event.String(),
+		Channel:    pb.Channel.String(),
+		Body:       pb.Body,
+		DaysBefore: pb.DaysBefore,
+		Disabled:   pb.Disabled,
+	}, nil
+}
+
+func NotificationTemplateProto(db *NotificationTemplate) (*loan.NotificationTemplate, error) {
+	if db == nil {
+		return nil, errs.NilObject("notification template")
+	}
+	return &loan.NotificationTemplate{
+		TemplateId:  fmt.Sprint(db.ID),
+		ChamaId:     db.ChamaID,
+		Event:       loan.NotificationEvent(loan.NotificationEvent_value[db.Event]),
+		Channel:     loan.NotificationChannel(loan.NotificationChannel_value[db.Channel]),
+		Body:        db.Body,
+		DaysBefore:  db.DaysBefore,
+		Disabled:    db.Disabled,
+		UpdatedDate: db.UpdatedAt.String(),
+	}, nil
+}
+
+type NotificationDelivery struct {
+	ID            uint      `gorm:"primaryKey;autoIncrement"`
+	ChamaID       string    `gorm:"index;type:varchar(15);not null"`
+	LoanID        uint      `gorm:"index;not null"`
+	InstallmentID uint      `gorm:"uniqueIndex:idx_installment_notification;not null"`
+	Installment   int32     `gorm:"type:int(5)"`
+	Event         string    `gorm:"uniqueIndex:idx_installment_notification;type:varchar(30);not null"`
+	Channel       string    `gorm:"uniqueIndex:idx_installment_notification;type:varchar(15);not null"`
+	Recipient     string    `gorm:"type:varchar(100)"`
+	Message       string    `gorm:"type:text"`
+	Status        string    `gorm:"index;type:varchar(30)"`
+	FailureReason string    `gorm:"type:varchar(200)"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (*NotificationDelivery) TableName() string {
+	return "notification_deliveries"
+}
+
+func NotificationDeliveryProto(db *NotificationDelivery) (*loan.NotificationDelivery, error) {
+	if db == nil {
+		return nil, errs.NilObject("notification delivery")
+	}
+	return &loan.NotificationDelivery{
+		DeliveryId:        fmt.Sprint(db.ID),
+		ChamaId:           db.ChamaID,
+		LoanId:            fmt.Sprint(db.LoanID),
+		InstallmentNumber: db.Installment,
+		Event:             loan.NotificationEvent(loan.NotificationEvent_value[db.Event]),
+		Channel:           loan.NotificationChannel(loan.NotificationChannel_value[db.Channel]),
+		Recipient:         db.Recipient,
+		Message:           db.Message,
+		Status:            loan.DeliveryStatus(loan.DeliveryStatus_value[db.Status]),
+		FailureReason:     db.FailureReason,
+		CreatedDate:       db.CreatedAt.String(),
+	}, nil
+}
@@ -0,0 +1,170 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+type Rotation struct {
+	ID                 uint      `gorm:"primaryKey;autoIncrement"`
+	ChamaID            string    `gorm:"index;type:varchar(15);not null"`
+	Name               string    `gorm:"type:varchar(50);not null"`
+	RotationOrder      string    `gorm:"type:varchar(20);not null"`
+	ContributionAmount float64   `gorm:"type:float(15);not null"`
+	AccountName        string    `gorm:"type:varchar(50);not null"`
+	CycleDays          int32     `gorm:"type:int(5);not null"`
+	StartDate          time.Time `gorm:"not null"`
+	Status             string    `gorm:"type:varchar(20);not null"`
+	CurrentCycle       int32     `gorm:"type:int(5)"`
+	UpdatedAt          time.Time `gorm:"autoUpdateTime"`
+	CreatedAt          time.Time `gorm:"autoCreateTime"`
+}
+
+func (*Rotation) TableName() string {
+	return "rotations"
+}
+
+func RotationModel(pb *chama.Rotation) (*Rotation, error) {
+	if pb == nil {
+		return nil, errs.NilObject("rotation")
+	}
+
+	db := &Rotation{
+		ChamaID:            pb.ChamaId,
+		Name:               pb.Name,
+		RotationOrder:      pb.Order.String(),
+		ContributionAmount: pb.ContributionAmount,
+		AccountName:        pb.AccountName,
+		CycleDays:          pb.CycleDays,
+		Status:             pb.Status.String(),
+		CurrentCycle:       pb.CurrentCycle,
+	}
+
+	if pb.StartDate != "" {
+		startDate, err := time.ParseInLocation(contributionDateLayout, pb.StartDate, time.Local)
+		if err != nil {
+			return nil, errs.IncorrectVal("rotation start date")
+		}
+		db.StartDate = startDate
+	}
+
+	return db, nil
+}
+
+func RotationProto(db *Rotation) (*chama.Rotation, error) {
+	if db == nil {
+		return nil, errs.NilObject("rotation")
+	}
+	return &chama.Rotation{
+		RotationId:         fmt.Sprint(db.ID),
+		ChamaId:            db.ChamaID,
+		Name:               db.Name,
+		Order:              chama.RotationOrder(chama.RotationOrder_value[db.RotationOrder]),
+		ContributionAmount: db.ContributionAmount,
+		AccountName:        db.AccountName,
+		CycleDays:          db.CycleDays,
+		StartDate:          db.StartDate.Format(contributionDateLayout),
+		Status:             chama.RotationStatus(chama.RotationStatus_value[db.Status]),
+		CurrentCycle:       db.CurrentCycle,
+		CreatedDate:        db.CreatedAt.String(),
+	}, nil
+}
+
+// RotationSlot is the turn of a member in a rotation. Members that have received the pot keep the cycle they received it in.
+type RotationSlot struct {
+	ID            uint      `gorm:"primaryKey;autoIncrement"`
+	RotationID    uint      `gorm:"uniqueIndex:idx_rotation_member;not null"`
+	MemberID      string    `gorm:"uniqueIndex:idx_rotation_member;type:varchar(15);not null"`
+	MemberNames   string    `gorm:"type:varchar(60)"`
+	Position      int32     `gorm:"type:int(5)"`
+	ReceivedCycle int32     `gorm:"type:int(5)"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (*RotationSlot) TableName() string {
+	return "rotation_slots"
+}
+
+func RotationSlotProto(db *RotationSlot) (*chama.RotationSlot, error) {
+	if db == nil {
+		return nil, errs.NilObject("rotation slot")
+	}
+	return &chama.RotationSlot{
+		MemberId:      db.MemberID,
+		MemberNames:   db.MemberNames,
+		Position:      db.Position,
+		ReceivedCycle: db.ReceivedCycle,
+	}, nil
+}
+
+type RotationCycle struct {
+	ID              uint       `gorm:"primaryKey;autoIncrement"`
+	RotationID      uint       `gorm:"uniqueIndex:idx_rotation_cycle;not null"`
+	CycleNumber     int32      `gorm:"uniqueIndex:idx_rotation_cycle;type:int(5);not null"`
+	RecipientID     string     `gorm:"type:varchar(15)"`
+	PotAmount       float64    `gorm:"type:float(15)"`
+	CollectedAmount float64    `gorm:"type:float(15)"`
+	BidAmount       float64    `gorm:"type:float(15)"`
+	PayoutAmount    float64    `gorm:"type:float(15)"`
+	PaidOut         bool       `gorm:"type:tinyint(1)"`
+	DueDate         time.Time  `gorm:"not null"`
+	PaidOutAt       *time.Time `gorm:"type:datetime"`
+	UpdatedAt       time.Time  `gorm:"autoUpdateTime"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime"`
+}
+
+func (*RotationCycle) TableName() string {
+	return "rotation_cycles"
+}
+
+func RotationCycleProto(db *RotationCycle) (*chama.RotationCycle, error) {
+	if db == nil {
+		return nil, errs.NilObject("rotation cycle")
+	}
+	pb := &chama.RotationCycle{
+		CycleId:         fmt.Sprint(db.ID),
+		RotationId:      fmt.Sprint(db.RotationID),
+		CycleNumber:     db.CycleNumber,
+		RecipientId:     db.RecipientID,
+		PotAmount:       db.PotAmount,
+		CollectedAmount: db.CollectedAmount,
+		BidAmount:       db.BidAmount,
+		PayoutAmount:    db.PayoutAmount,
+		PaidOut:         db.PaidOut,
+		DueDate:         db.DueDate.Format(contributionDateLayout),
+	}
+	if db.PaidOutAt != nil {
+		pb.PaidOutDate = db.PaidOutAt.String()
+	}
+	return pb, nil
+}
+
+type RotationContribution struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	CycleID   uint      `gorm:"uniqueIndex:idx_cycle_member_contribution;not null"`
+	MemberID  string    `gorm:"uniqueIndex:idx_cycle_member_contribution;type:varchar(15);not null"`
+	Amount    float64   `gorm:"type:float(15)"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (*RotationContribution) TableName() string {
+	return "rotation_contributions"
+}
+
+type RotationBid struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	CycleID   uint      `gorm:"uniqueIndex:idx_cycle_member_bid;not null"`
+	MemberID  string    `gorm:"uniqueIndex:idx_cycle_member_bid;type:varchar(15);not null"`
+	Amount    float64   `gorm:"type:float(15)"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (*RotationBid) TableName() string {
+	return "rotation_bids"
+}
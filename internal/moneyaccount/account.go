@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
 	"github.com/gidyon/machama-app/pkg/api/transaction"
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
 	"github.com/gidyon/micro/v2/utils/errs"
@@ -27,6 +28,7 @@ type Options struct {
 type moneyAccountAPIServer struct {
 	transaction.UnimplementedChamaAccountAPIServer
 	*Options
+	authorizer *roles.Authorizer
 }
 
 func NewChamaAccountAPI(ctx context.Context, opt *Options) (transaction.ChamaAccountAPIServer, error) {
@@ -51,7 +53,8 @@ func NewChamaAccountAPI(ctx context.Context, opt *Options) (transaction.ChamaAcc
 	}
 
 	moneyAccountAPI := &moneyAccountAPIServer{
-		Options: opt,
+		Options:    opt,
+		authorizer: roles.NewAuthorizer(opt.Auth, opt.SQLDB, opt.AllowedGroups),
 	}
 
 	return moneyAccountAPI, nil
@@ -74,18 +77,12 @@ func ValidateChamaAccount(pb *transaction.ChamaAccount) error {
 func (moneyAccountAPI *moneyAccountAPIServer) CreateChamaAccount(
 	ctx context.Context, req *transaction.CreateChamaAccountRequest,
 ) (*emptypb.Empty, error) {
-	// Authorization
-	_, err := moneyAccountAPI.Auth.AuthorizeGroup(ctx, moneyAccountAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validate
 	switch {
 	case req == nil:
 		return nil, errs.NilObject("request body")
 	default:
-		err = ValidateChamaAccount(req.ChamaAccount)
+		err := ValidateChamaAccount(req.ChamaAccount)
 		if err != nil {
 			return nil, err
 		}
@@ -96,6 +93,29 @@ func (moneyAccountAPI *moneyAccountAPIServer) CreateChamaAccount(
 		return nil, err
 	}
 
+	// Accounts without a chama are owned by the chama itself
+	if db.ChamaID == "" {
+		db.ChamaID = db.OwnerID
+	}
+
+	// Authorization
+	_, err = moneyAccountAPI.authorizer.AuthorizeChama(ctx, db.ChamaID, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Member accounts must belong to a member of the chama
+	if db.OwnerID != db.ChamaID {
+		err = moneyAccountAPI.SQLDB.First(&models.ChamaMember{}, "id = ? AND chama_id = ?", db.OwnerID, db.ChamaID).Error
+		switch {
+		case err == nil:
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, errs.WrapMessagef(codes.InvalidArgument, "owner %s is not a member of chama %s", db.OwnerID, db.ChamaID)
+		default:
+			return nil, errs.FailedToFind("chama member", err)
+		}
+	}
+
 	err = moneyAccountAPI.SQLDB.Create(db).Error
 	if err != nil {
 		return nil, errs.FailedToSave("moneyAccount", err)
@@ -109,18 +129,18 @@ const defaultPageSize = 50
 func (moneyAccountAPI *moneyAccountAPIServer) ListChamaAccounts(
 	ctx context.Context, req *transaction.ListChamaAccountsRequest,
 ) (*transaction.ListChamaAccountsResponse, error) {
-	// Authorization
-	actor, err := moneyAccountAPI.Auth.AuthorizeGroup(ctx, moneyAccountAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validation
 	switch {
 	case req == nil:
 		return nil, errs.NilObject("list request")
 	}
 
+	// Authorization
+	actor, chamaIDs, err := moneyAccountAPI.authorizer.ScopeChamas(ctx, req.GetFilter().GetChamaIds(), roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
 	pageSize := req.GetPageSize()
 	switch {
 	case pageSize <= 0:
@@ -147,6 +167,10 @@ func (moneyAccountAPI *moneyAccountAPIServer) ListChamaAccounts(
 	}
 
 	// Apply tv filters
+	if chamaIDs != nil {
+		// Accounts that don't record their chama are owned by it
+		db = db.Where("(chama_id IN (?) OR (chama_id = '' AND owner_id IN (?)))", chamaIDs, chamaIDs)
+	}
 	if req.Filter != nil {
 		if len(req.Filter.AccountIds) != 0 {
 			db = db.Where("id IN (?)", req.Filter.AccountIds)
@@ -209,12 +233,6 @@ func (moneyAccountAPI *moneyAccountAPIServer) ListChamaAccounts(
 func (moneyAccountAPI *moneyAccountAPIServer) GetChamaAccount(
 	ctx context.Context, req *transaction.GetChamaAccountRequest,
 ) (*transaction.ChamaAccount, error) {
-	// Authorization
-	_, err := moneyAccountAPI.Auth.AuthorizeGroup(ctx, moneyAccountAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validation
 	switch {
 	case req == nil:
@@ -225,6 +243,7 @@ func (moneyAccountAPI *moneyAccountAPIServer) GetChamaAccount(
 
 	db := &models.ChamaAccount{}
 
+	var err error
 	if req.AccountId != "" {
 		err = moneyAccountAPI.SQLDB.First(db, "id = ?", req.AccountId).Error
 	} else {
@@ -238,5 +257,11 @@ func (moneyAccountAPI *moneyAccountAPIServer) GetChamaAccount(
 		return nil, errs.FailedToFind("moneyAccount", err)
 	}
 
+	// Authorization
+	_, err = moneyAccountAPI.authorizer.AuthorizeChama(ctx, roles.AccountChama(db), roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
 	return models.ChamaAccountProto(db)
 }
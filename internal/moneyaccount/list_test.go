@@ -2,6 +2,10 @@ package moneyaccount
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/machama-app/internal/models"
 
 	"github.com/gidyon/machama-app/pkg/api/transaction"
 	. "github.com/onsi/ginkgo"
@@ -200,5 +204,30 @@ var _ = Describe("ListChamaAccounts", func() {
 				}
 			})
 		})
+
+		Describe("ListChamaAccounts of a chama", func() {
+			It("should include accounts that don't record their chama", func() {
+				chamaID := fmt.Sprintf("c%d", randomdata.Number(100000, 999999))
+
+				for _, accountDB := range []*models.ChamaAccount{
+					{OwnerID: chamaID, AccountName: "legacy"},
+					{OwnerID: randomID(), ChamaID: chamaID, AccountName: "member"},
+					{OwnerID: randomID(), ChamaID: "other", AccountName: "other"},
+				} {
+					accountDB.AccountType = transaction.AccountType_SAVINGS_ACCOUNT.String()
+					Expect(ChamaAccountAPIServer.SQLDB.Create(accountDB).Error).ShouldNot(HaveOccurred())
+				}
+
+				listRes, err := ChamaAccountAPI.ListChamaAccounts(ctx, &transaction.ListChamaAccountsRequest{
+					PageSize: defaultPageSize,
+					Filter:   &transaction.ChamaAccountFilter{ChamaIds: []string{chamaID}},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(listRes.ChamaAccounts).Should(HaveLen(2))
+				for _, accountPB := range listRes.ChamaAccounts {
+					Expect(accountPB.AccountName).Should(BeElementOf("legacy", "member"))
+				}
+			})
+		})
 	})
 })
@@ -0,0 +1,53 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Fake is an in-process sender that records every message it is given
+type Fake struct {
+	mu       sync.Mutex
+	fail     bool
+	messages []*Message
+}
+
+// NewFake creates a fake sender
+func NewFake() *Fake {
+	return &Fake{
+		messages: make([]*Message, 0),
+	}
+}
+
+// FailSends makes subsequent sends fail when set to true
+func (fake *Fake) FailSends(fail bool) {
+	fake.mu.Lock()
+	fake.fail = fail
+	fake.mu.Unlock()
+}
+
+// Messages returns the messages sent so far
+func (fake *Fake) Messages() []*Message {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	return append([]*Message{}, fake.messages...)
+}
+
+func (fake *Fake) Send(ctx context.Context, msg *Message) error {
+	err := ValidateMessage(msg)
+	if err != nil {
+		return err
+	}
+
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+
+	if fake.fail {
+		return errors.New("message not delivered")
+	}
+
+	fake.messages = append(fake.messages, msg)
+
+	return nil
+}
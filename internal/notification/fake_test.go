@@ -0,0 +1,44 @@
+package notification
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Fake sender", func() {
+	var (
+		fake *Fake
+		msg  *Message
+		ctx  context.Context
+	)
+
+	BeforeEach(func() {
+		fake = NewFake()
+		msg = &Message{
+			Reference: "1",
+			Recipient: "254700000000",
+			Body:      "Your installment is due",
+		}
+		ctx = context.TODO()
+	})
+
+	It("should fail when the message is malformed", func() {
+		msg.Recipient = ""
+		Expect(fake.Send(ctx, msg)).Should(HaveOccurred())
+		Expect(fake.Messages()).Should(BeEmpty())
+	})
+
+	It("should record sent messages", func() {
+		Expect(fake.Send(ctx, msg)).ShouldNot(HaveOccurred())
+		Expect(fake.Messages()).Should(HaveLen(1))
+		Expect(fake.Messages()[0].Body).Should(Equal(msg.Body))
+	})
+
+	It("should fail when sends fail", func() {
+		fake.FailSends(true)
+		Expect(fake.Send(ctx, msg)).Should(HaveOccurred())
+		Expect(fake.Messages()).Should(BeEmpty())
+	})
+})
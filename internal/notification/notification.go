@@ -0,0 +1,33 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+// Message is a notification addressed to a single recipient
+type Message struct {
+	Reference string
+	Recipient string
+	Subject   string
+	Body      string
+}
+
+// Sender delivers messages through a single channel such as SMS or email
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// ValidateMessage validates a notification message
+func ValidateMessage(msg *Message) error {
+	switch {
+	case msg == nil:
+		return errs.NilObject("notification message")
+	case msg.Recipient == "":
+		return errs.MissingField("notification recipient")
+	case msg.Body == "":
+		return errs.MissingField("notification body")
+	}
+	return nil
+}
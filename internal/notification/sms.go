@@ -0,0 +1,39 @@
+package notification
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gidyon/services/pkg/api/messaging/sms"
+)
+
+// SMSSender sends messages through the SMS messaging service
+type SMSSender struct {
+	client  sms.SMSAPIClient
+	keyword string
+}
+
+// NewSMSSender creates a sender backed by the SMS messaging service
+func NewSMSSender(client sms.SMSAPIClient, keyword string) (*SMSSender, error) {
+	if client == nil {
+		return nil, errors.New("missing sms client")
+	}
+	return &SMSSender{
+		client:  client,
+		keyword: keyword,
+	}, nil
+}
+
+func (sender *SMSSender) Send(ctx context.Context, msg *Message) error {
+	err := ValidateMessage(msg)
+	if err != nil {
+		return err
+	}
+
+	_, err = sender.client.SendSMS(ctx, &sms.SMS{
+		DestinationPhones: []string{msg.Recipient},
+		Keyword:           sender.keyword,
+		Message:           msg.Body,
+	})
+	return err
+}
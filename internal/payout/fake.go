@@ -0,0 +1,67 @@
+package payout
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Fake is an in-process payout provider that settles every payout immediately
+type Fake struct {
+	mu       sync.Mutex
+	fail     bool
+	requests []*Request
+	results  chan *Result
+}
+
+// NewFake creates a fake payout provider
+func NewFake() *Fake {
+	return &Fake{
+		requests: make([]*Request, 0),
+		results:  make(chan *Result, 100),
+	}
+}
+
+// FailPayouts makes subsequent payouts fail when set to true
+func (fake *Fake) FailPayouts(fail bool) {
+	fake.mu.Lock()
+	fake.fail = fail
+	fake.mu.Unlock()
+}
+
+// Requests returns the payouts received so far
+func (fake *Fake) Requests() []*Request {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	return append([]*Request{}, fake.requests...)
+}
+
+func (fake *Fake) Disburse(ctx context.Context, req *Request) (string, error) {
+	err := ValidateRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	fake.mu.Lock()
+	fake.requests = append(fake.requests, req)
+	providerRef := fmt.Sprintf("FAKE-%d", len(fake.requests))
+	result := &Result{
+		Reference:         req.Reference,
+		ProviderReference: providerRef,
+		Succeeded:         !fake.fail,
+	}
+	if fake.fail {
+		result.Reason = "payout failed"
+	}
+	fake.mu.Unlock()
+
+	go func() {
+		fake.results <- result
+	}()
+
+	return providerRef, nil
+}
+
+func (fake *Fake) Results() <-chan *Result {
+	return fake.results
+}
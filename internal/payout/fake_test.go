@@ -0,0 +1,56 @@
+package payout
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Fake provider", func() {
+	var (
+		fake *Fake
+		req  *Request
+		ctx  context.Context
+	)
+
+	BeforeEach(func() {
+		fake = NewFake()
+		req = &Request{
+			Reference: "1",
+			Phone:     "254700000000",
+			Amount:    100,
+			Remarks:   "test",
+		}
+		ctx = context.TODO()
+	})
+
+	It("should fail when the request is malformed", func() {
+		req.Phone = ""
+		_, err := fake.Disburse(ctx, req)
+		Expect(err).Should(HaveOccurred())
+		Expect(fake.Requests()).Should(BeEmpty())
+	})
+
+	It("should deliver a successful result", func() {
+		ref, err := fake.Disburse(ctx, req)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ref).ShouldNot(BeEmpty())
+
+		result := <-fake.Results()
+		Expect(result.Reference).Should(Equal(req.Reference))
+		Expect(result.ProviderReference).Should(Equal(ref))
+		Expect(result.Succeeded).Should(BeTrue())
+		Expect(fake.Requests()).Should(HaveLen(1))
+	})
+
+	It("should deliver a failed result when payouts fail", func() {
+		fake.FailPayouts(true)
+		_, err := fake.Disburse(ctx, req)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		result := <-fake.Results()
+		Expect(result.Succeeded).Should(BeFalse())
+		Expect(result.Reason).ShouldNot(BeEmpty())
+	})
+})
@@ -0,0 +1,308 @@
+package payout
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MpesaOptions contains credentials and endpoints for the M-Pesa B2C API
+type MpesaOptions struct {
+	APIURL             string
+	ConsumerKey        string
+	ConsumerSecret     string
+	InitiatorName      string
+	SecurityCredential string
+	ShortCode          string
+	CommandID          string
+	ResultURL          string
+	QueueTimeoutURL    string
+	CallbackToken      string
+	HTTPClient         *http.Client
+}
+
+// MpesaB2C sends payouts through M-Pesa business to customer API. Results are posted by M-Pesa to the result url, which should be served by MpesaB2C.
+// The callback token is added to the result and queue timeout urls and callbacks without it are rejected.
+type MpesaB2C struct {
+	*MpesaOptions
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+	results     chan *Result
+}
+
+// NewMpesaB2C creates an M-Pesa B2C payout provider
+func NewMpesaB2C(opt *MpesaOptions) (*MpesaB2C, error) {
+	switch {
+	case opt == nil:
+		return nil, errors.New("missing options")
+	case opt.APIURL == "":
+		return nil, errors.New("missing api url")
+	case opt.ConsumerKey == "":
+		return nil, errors.New("missing consumer key")
+	case opt.ConsumerSecret == "":
+		return nil, errors.New("missing consumer secret")
+	case opt.InitiatorName == "":
+		return nil, errors.New("missing initiator name")
+	case opt.SecurityCredential == "":
+		return nil, errors.New("missing security credential")
+	case opt.ShortCode == "":
+		return nil, errors.New("missing short code")
+	case opt.ResultURL == "":
+		return nil, errors.New("missing result url")
+	case opt.CallbackToken == "":
+		return nil, errors.New("missing callback token")
+	default:
+		if opt.CommandID == "" {
+			opt.CommandID = "BusinessPayment"
+		}
+		if opt.QueueTimeoutURL == "" {
+			opt.QueueTimeoutURL = opt.ResultURL
+		}
+		if opt.HTTPClient == nil {
+			opt.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+		}
+	}
+
+	var err error
+	opt.ResultURL, err = withCallbackToken(opt.ResultURL, opt.CallbackToken)
+	if err != nil {
+		return nil, fmt.Errorf("incorrect result url: %w", err)
+	}
+	opt.QueueTimeoutURL, err = withCallbackToken(opt.QueueTimeoutURL, opt.CallbackToken)
+	if err != nil {
+		return nil, fmt.Errorf("incorrect queue timeout url: %w", err)
+	}
+
+	return &MpesaB2C{
+		MpesaOptions: opt,
+		results:      make(chan *Result, 100),
+	}, nil
+}
+
+const callbackTokenParam = "token"
+
+func withCallbackToken(callbackURL, token string) (string, error) {
+	u, err := url.Parse(callbackURL)
+	if err != nil {
+		return "", err
+	}
+	query := u.Query()
+	query.Set(callbackTokenParam, token)
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+func (mpesa *MpesaB2C) Results() <-chan *Result {
+	return mpesa.results
+}
+
+func (mpesa *MpesaB2C) token(ctx context.Context) (string, error) {
+	mpesa.mu.Lock()
+	defer mpesa.mu.Unlock()
+
+	if mpesa.accessToken != "" && time.Now().Before(mpesa.expiresAt) {
+		return mpesa.accessToken, nil
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, strings.TrimSuffix(mpesa.APIURL, "/")+"/oauth/v1/generate?grant_type=client_credentials", nil,
+	)
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(mpesa.ConsumerKey, mpesa.ConsumerSecret)
+
+	res, err := mpesa.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get access token: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get access token: status %s", res.Status)
+	}
+
+	resBody := struct {
+		AccessToken string      `json:"access_token"`
+		ExpiresIn   json.Number `json:"expires_in"`
+	}{}
+	err = json.NewDecoder(res.Body).Decode(&resBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode access token: %w", err)
+	}
+
+	expiresIn, err := resBody.ExpiresIn.Int64()
+	if err != nil || expiresIn <= 0 {
+		return "", fmt.Errorf("incorrect access token expiry %q", resBody.ExpiresIn)
+	}
+
+	lifetime := time.Duration(expiresIn) * time.Second
+	// Refresh a minute before the token expires
+	if lifetime > 2*time.Minute {
+		lifetime -= time.Minute
+	}
+
+	mpesa.accessToken = resBody.AccessToken
+	mpesa.expiresAt = time.Now().Add(lifetime)
+
+	return mpesa.accessToken, nil
+}
+
+func (mpesa *MpesaB2C) Disburse(ctx context.Context, req *Request) (string, error) {
+	err := ValidateRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := mpesa.token(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	bs, err := json.Marshal(map[string]interface{}{
+		"InitiatorName":      mpesa.InitiatorName,
+		"SecurityCredential": mpesa.SecurityCredential,
+		"CommandID":          mpesa.CommandID,
+		"Amount":             fmt.Sprintf("%.0f", req.Amount),
+		"PartyA":             mpesa.ShortCode,
+		"PartyB":             req.Phone,
+		"Remarks":            req.Remarks,
+		"QueueTimeOutURL":    mpesa.QueueTimeoutURL,
+		"ResultURL":          mpesa.ResultURL,
+		"Occasion":           req.Reference,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	httpReq, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, strings.TrimSuffix(mpesa.APIURL, "/")+"/mpesa/b2c/v1/paymentrequest", bytes.NewReader(bs),
+	)
+	if err != nil {
+		return "", err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+token)
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	res, err := mpesa.HTTPClient.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("failed to send b2c request: %w", err)
+	}
+	defer res.Body.Close()
+
+	resBody := struct {
+		ConversationID           string `json:"ConversationID"`
+		OriginatorConversationID string `json:"OriginatorConversationID"`
+		ResponseCode             string `json:"ResponseCode"`
+		ResponseDescription      string `json:"ResponseDescription"`
+		ErrorMessage             string `json:"errorMessage"`
+	}{}
+	err = json.NewDecoder(res.Body).Decode(&resBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode b2c response: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK || resBody.ResponseCode != "0" {
+		return "", fmt.Errorf("b2c request rejected: %s%s", resBody.ResponseDescription, resBody.ErrorMessage)
+	}
+
+	return resBody.ConversationID, nil
+}
+
+type mpesaResult struct {
+	Result struct {
+		ResultType               int    `json:"ResultType"`
+		ResultCode               int    `json:"ResultCode"`
+		ResultDesc               string `json:"ResultDesc"`
+		OriginatorConversationID string `json:"OriginatorConversationID"`
+		ConversationID           string `json:"ConversationID"`
+		TransactionID            string `json:"TransactionID"`
+		ReferenceData            struct {
+			ReferenceItem json.RawMessage `json:"ReferenceItem"`
+		} `json:"ReferenceData"`
+	} `json:"Result"`
+}
+
+type mpesaReferenceItem struct {
+	Key   string      `json:"Key"`
+	Value interface{} `json:"Value"`
+}
+
+// occasion returns the occasion of the payout echoed in the reference data, which holds one item or a list of items
+func (callback *mpesaResult) occasion() string {
+	raw := callback.Result.ReferenceData.ReferenceItem
+	if len(raw) == 0 {
+		return ""
+	}
+
+	items := make([]*mpesaReferenceItem, 0)
+	if json.Unmarshal(raw, &items) != nil {
+		item := &mpesaReferenceItem{}
+		if json.Unmarshal(raw, item) != nil {
+			return ""
+		}
+		items = append(items, item)
+	}
+
+	for _, item := range items {
+		if item != nil && item.Key == "Occasion" && item.Value != nil {
+			return fmt.Sprint(item.Value)
+		}
+	}
+	return ""
+}
+
+// ServeHTTP handles B2C result and queue timeout callbacks from M-Pesa
+func (mpesa *MpesaB2C) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := r.URL.Query().Get(callbackTokenParam)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(mpesa.CallbackToken)) != 1 {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	callback := &mpesaResult{}
+	err := json.NewDecoder(r.Body).Decode(callback)
+	if err != nil {
+		http.Error(w, "failed to decode result", http.StatusBadRequest)
+		return
+	}
+
+	if callback.Result.ConversationID == "" {
+		http.Error(w, "missing conversation id", http.StatusBadRequest)
+		return
+	}
+
+	// Payouts are found by the occasion they were sent with, since results may arrive before the conversation id is saved
+	result := &Result{
+		Reference:         callback.occasion(),
+		ProviderReference: callback.Result.ConversationID,
+		Succeeded:         callback.Result.ResultCode == 0,
+	}
+	if !result.Succeeded {
+		result.Reason = callback.Result.ResultDesc
+	}
+
+	select {
+	case mpesa.results <- result:
+	case <-r.Context().Done():
+		http.Error(w, "request cancelled", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(`{"ResultCode":0,"ResultDesc":"Accepted"}`))
+}
@@ -0,0 +1,166 @@
+package payout
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Mpesa B2C provider", func() {
+	var (
+		server       *httptest.Server
+		mpesa        *MpesaB2C
+		opt          *MpesaOptions
+		b2cBody      map[string]interface{}
+		tokenExpiry  string
+		tokenFetches int
+		ctx          context.Context
+	)
+
+	callback := func(target, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		mpesa.ServeHTTP(w, httptest.NewRequest(http.MethodPost, target, strings.NewReader(body)))
+		return w
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		tokenExpiry = `"3599"`
+		tokenFetches = 0
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/oauth/v1/generate", func(w http.ResponseWriter, r *http.Request) {
+			tokenFetches++
+			w.Write([]byte(`{"access_token":"token","expires_in":` + tokenExpiry + `}`))
+		})
+		mux.HandleFunc("/mpesa/b2c/v1/paymentrequest", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Header.Get("Authorization")).Should(Equal("Bearer token"))
+			Expect(json.NewDecoder(r.Body).Decode(&b2cBody)).ShouldNot(HaveOccurred())
+			w.Write([]byte(`{"ConversationID":"AG_1","OriginatorConversationID":"1","ResponseCode":"0"}`))
+		})
+		server = httptest.NewServer(mux)
+
+		opt = &MpesaOptions{
+			APIURL:             server.URL,
+			ConsumerKey:        "key",
+			ConsumerSecret:     "secret",
+			InitiatorName:      "initiator",
+			SecurityCredential: "credential",
+			ShortCode:          "600000",
+			ResultURL:          "https://example.com/result",
+			CallbackToken:      "callback-secret",
+		}
+
+		var err error
+		mpesa, err = NewMpesaB2C(opt)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("Creating provider with malformed options", func() {
+		It("should fail when options is nil", func() {
+			_, err := NewMpesaB2C(nil)
+			Expect(err).Should(HaveOccurred())
+		})
+		It("should fail when result url is missing", func() {
+			opt.ResultURL = ""
+			_, err := NewMpesaB2C(opt)
+			Expect(err).Should(HaveOccurred())
+		})
+		It("should fail when callback token is missing", func() {
+			opt.CallbackToken = ""
+			_, err := NewMpesaB2C(opt)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Describe("Disbursing", func() {
+		It("should send b2c payment request", func() {
+			ref, err := mpesa.Disburse(ctx, &Request{
+				Reference: "7",
+				Phone:     "254700000000",
+				Amount:    1500,
+				Remarks:   "loan",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ref).Should(Equal("AG_1"))
+			Expect(b2cBody["PartyB"]).Should(Equal("254700000000"))
+			Expect(b2cBody["Amount"]).Should(Equal("1500"))
+			Expect(b2cBody["Occasion"]).Should(Equal("7"))
+			Expect(b2cBody["ResultURL"]).Should(Equal("https://example.com/result?token=callback-secret"))
+			Expect(b2cBody["QueueTimeOutURL"]).Should(Equal("https://example.com/result?token=callback-secret"))
+		})
+		It("should reuse the access token until it expires", func() {
+			for i := 0; i < 2; i++ {
+				_, err := mpesa.Disburse(ctx, &Request{Reference: "7", Phone: "254700000000", Amount: 10})
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+			Expect(tokenFetches).Should(Equal(1))
+		})
+		It("should renew the access token after the lifetime given by M-Pesa", func() {
+			tokenExpiry = `1`
+			for i := 0; i < 2; i++ {
+				_, err := mpesa.Disburse(ctx, &Request{Reference: "7", Phone: "254700000000", Amount: 10})
+				Expect(err).ShouldNot(HaveOccurred())
+				time.Sleep(1100 * time.Millisecond)
+			}
+			Expect(tokenFetches).Should(Equal(2))
+		})
+	})
+
+	Describe("Handling result callbacks", func() {
+		It("should reject callbacks without the callback token", func() {
+			w := callback("/", `{"Result":{"ResultCode":2001,"ConversationID":"AG_1"}}`)
+			Expect(w.Code).Should(Equal(http.StatusUnauthorized))
+		})
+		It("should reject callbacks with a wrong callback token", func() {
+			w := callback("/?token=guess", `{"Result":{"ResultCode":2001,"ConversationID":"AG_1"}}`)
+			Expect(w.Code).Should(Equal(http.StatusUnauthorized))
+		})
+		It("should reject malformed callbacks", func() {
+			w := callback("/?token=callback-secret", `{"Result":{}}`)
+			Expect(w.Code).Should(Equal(http.StatusBadRequest))
+		})
+		It("should deliver successful results", func() {
+			w := callback("/?token=callback-secret",
+				`{"Result":{"ResultType":0,"ResultCode":0,"ResultDesc":"ok","ConversationID":"AG_1",`+
+					`"ReferenceData":{"ReferenceItem":{"Key":"Occasion","Value":"7"}}}}`,
+			)
+			Expect(w.Code).Should(Equal(http.StatusOK))
+
+			result := <-mpesa.Results()
+			Expect(result.Reference).Should(Equal("7"))
+			Expect(result.ProviderReference).Should(Equal("AG_1"))
+			Expect(result.Succeeded).Should(BeTrue())
+		})
+		It("should read the occasion from a list of reference items", func() {
+			w := callback("/?token=callback-secret",
+				`{"Result":{"ResultType":0,"ResultCode":0,"ResultDesc":"ok","ConversationID":"AG_1",`+
+					`"ReferenceData":{"ReferenceItem":[{"Key":"QueueTimeoutURL","Value":"x"},{"Key":"Occasion","Value":"8"}]}}}`,
+			)
+			Expect(w.Code).Should(Equal(http.StatusOK))
+
+			result := <-mpesa.Results()
+			Expect(result.Reference).Should(Equal("8"))
+		})
+		It("should deliver failed results", func() {
+			w := callback("/?token=callback-secret",
+				`{"Result":{"ResultType":0,"ResultCode":2001,"ResultDesc":"The initiator information is invalid.","ConversationID":"AG_2"}}`,
+			)
+			Expect(w.Code).Should(Equal(http.StatusOK))
+
+			result := <-mpesa.Results()
+			Expect(result.Succeeded).Should(BeFalse())
+			Expect(result.Reason).Should(Equal("The initiator information is invalid."))
+		})
+	})
+})
@@ -0,0 +1,44 @@
+package payout
+
+import (
+	"context"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+)
+
+// Request is an instruction to send money to a recipient
+type Request struct {
+	Reference string
+	Phone     string
+	Amount    float64
+	Remarks   string
+}
+
+// Result is the outcome of a payout as reported by the provider
+type Result struct {
+	Reference         string
+	ProviderReference string
+	Succeeded         bool
+	Reason            string
+}
+
+// Provider sends money to recipients. Payouts complete asynchronously and their outcome is delivered on the Results channel.
+type Provider interface {
+	Disburse(ctx context.Context, req *Request) (providerReference string, err error)
+	Results() <-chan *Result
+}
+
+// ValidateRequest validates a payout request
+func ValidateRequest(req *Request) error {
+	switch {
+	case req == nil:
+		return errs.NilObject("payout request")
+	case req.Reference == "":
+		return errs.MissingField("payout reference")
+	case req.Phone == "":
+		return errs.MissingField("payout phone")
+	case req.Amount <= 0:
+		return errs.IncorrectVal("payout amount")
+	}
+	return nil
+}
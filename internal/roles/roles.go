@@ -0,0 +1,202 @@
+// Package roles authorizes actors by the role they hold in a chama.
+package roles
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+// Sets of roles allowed to act within a chama
+var (
+	Members   = []chama.ChamaRole{chama.ChamaRole_ROLE_MEMBER, chama.ChamaRole_CHAIRMAN, chama.ChamaRole_TREASURER, chama.ChamaRole_SECRETARY}
+	Officers  = []chama.ChamaRole{chama.ChamaRole_CHAIRMAN, chama.ChamaRole_TREASURER, chama.ChamaRole_SECRETARY}
+	Treasury  = []chama.ChamaRole{chama.ChamaRole_CHAIRMAN, chama.ChamaRole_TREASURER}
+	Chairman  = []chama.ChamaRole{chama.ChamaRole_CHAIRMAN}
+	Secretary = []chama.ChamaRole{chama.ChamaRole_CHAIRMAN, chama.ChamaRole_SECRETARY}
+)
+
+// Authorizer checks the role of the actor in the chama a request targets. Actors in the global groups act on every chama.
+type Authorizer struct {
+	Auth          auth.API
+	SQLDB         *gorm.DB
+	GlobalGroups  []string
+	globalGroupsM map[string]struct{}
+}
+
+// NewAuthorizer creates an authorizer where actors of the global groups are not limited to chamas they hold roles in
+func NewAuthorizer(authAPI auth.API, sqlDB *gorm.DB, globalGroups []string) *Authorizer {
+	authorizer := &Authorizer{
+		Auth:          authAPI,
+		SQLDB:         sqlDB,
+		GlobalGroups:  globalGroups,
+		globalGroupsM: make(map[string]struct{}, len(globalGroups)),
+	}
+	for _, group := range globalGroups {
+		authorizer.globalGroupsM[group] = struct{}{}
+	}
+	return authorizer
+}
+
+// IsGlobal checks whether the actor acts on every chama
+func (authorizer *Authorizer) IsGlobal(actor *auth.Payload) bool {
+	if authorizer.Auth.IsAdmin(actor.Group) {
+		return true
+	}
+	_, ok := authorizer.globalGroupsM[actor.Group]
+	return ok
+}
+
+func roleNames(roles []chama.ChamaRole) []string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.String())
+	}
+	return names
+}
+
+// AuthorizeChama authorizes an actor holding one of the roles in the chama
+func (authorizer *Authorizer) AuthorizeChama(
+	ctx context.Context, chamaID string, roles ...chama.ChamaRole,
+) (*auth.Payload, error) {
+	actor, err := authorizer.Auth.AuthenticateRequestV2(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorizer.IsGlobal(actor) {
+		return actor, nil
+	}
+
+	var count int64
+	err = authorizer.SQLDB.Model(&models.ChamaMember{}).
+		Where("chama_id = ? AND user_id = ? AND active = ? AND role IN (?)", chamaID, actor.ID, true, roleNames(roles)).
+		Count(&count).Error
+	if err != nil {
+		return nil, errs.FailedToFind("chama roles", err)
+	}
+
+	if count == 0 {
+		return nil, errs.WrapMessagef(codes.PermissionDenied, "not permitted to perform this action in chama %s", chamaID)
+	}
+
+	return actor, nil
+}
+
+// AuthorizeMember authorizes an actor holding one of the roles in the chama of the member
+func (authorizer *Authorizer) AuthorizeMember(
+	ctx context.Context, memberID string, roles ...chama.ChamaRole,
+) (*auth.Payload, *models.ChamaMember, error) {
+	memberDB := &models.ChamaMember{}
+	err := authorizer.SQLDB.First(memberDB, "id = ?", memberID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, nil, errs.DoesNotExist("chama member", memberID)
+	default:
+		return nil, nil, errs.FailedToFind("chama member", err)
+	}
+
+	actor, err := authorizer.AuthorizeChama(ctx, memberDB.ChamaID, roles...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return actor, memberDB, nil
+}
+
+// AuthorizeAccount authorizes an actor holding one of the roles in the chama the account belongs to
+func (authorizer *Authorizer) AuthorizeAccount(
+	ctx context.Context, accountID string, roles ...chama.ChamaRole,
+) (*auth.Payload, *models.ChamaAccount, error) {
+	accountDB := &models.ChamaAccount{}
+	err := authorizer.SQLDB.First(accountDB, "id = ?", accountID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, nil, errs.DoesNotExist("chama account", accountID)
+	default:
+		return nil, nil, errs.FailedToFind("chama account", err)
+	}
+
+	actor, err := authorizer.AuthorizeChama(ctx, AccountChama(accountDB), roles...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return actor, accountDB, nil
+}
+
+// AccountChama is the chama an account belongs to. Accounts created before accounts recorded their chama are owned by the chama.
+func AccountChama(accountDB *models.ChamaAccount) string {
+	if accountDB.ChamaID != "" {
+		return accountDB.ChamaID
+	}
+	return accountDB.OwnerID
+}
+
+// AuthorizeLoan authorizes an actor holding one of the roles in the chama of the loan
+func (authorizer *Authorizer) AuthorizeLoan(
+	ctx context.Context, loanID string, roles ...chama.ChamaRole,
+) (*auth.Payload, error) {
+	loanDB := &models.Loan{}
+	err := authorizer.SQLDB.Select("id, chama_id").First(loanDB, "id = ?", loanID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("loan", loanID)
+	default:
+		return nil, errs.FailedToFind("loan", err)
+	}
+
+	return authorizer.AuthorizeChama(ctx, loanDB.ChamaID, roles...)
+}
+
+// ScopeChamas limits a listing to the chamas where the actor holds one of the roles. It returns nil when the actor may list
+// across all chamas and no chamas were requested, and fails when a requested chama is outside those of the actor.
+func (authorizer *Authorizer) ScopeChamas(
+	ctx context.Context, chamaIDs []string, roles ...chama.ChamaRole,
+) (*auth.Payload, []string, error) {
+	actor, err := authorizer.Auth.AuthenticateRequestV2(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if authorizer.IsGlobal(actor) {
+		if len(chamaIDs) == 0 {
+			return actor, nil, nil
+		}
+		return actor, chamaIDs, nil
+	}
+
+	actorChamas := make([]string, 0)
+	err = authorizer.SQLDB.Model(&models.ChamaMember{}).
+		Where("user_id = ? AND active = ? AND role IN (?)", actor.ID, true, roleNames(roles)).
+		Distinct().Pluck("chama_id", &actorChamas).Error
+	if err != nil {
+		return nil, nil, errs.FailedToFind("chama roles", err)
+	}
+
+	if len(chamaIDs) == 0 {
+		return actor, actorChamas, nil
+	}
+
+	allowed := make(map[string]struct{}, len(actorChamas))
+	for _, chamaID := range actorChamas {
+		allowed[chamaID] = struct{}{}
+	}
+
+	for _, chamaID := range chamaIDs {
+		if _, ok := allowed[chamaID]; !ok {
+			return nil, nil, errs.WrapMessagef(codes.PermissionDenied, "not permitted to perform this action in chama %s", chamaID)
+		}
+	}
+
+	return actor, chamaIDs, nil
+}
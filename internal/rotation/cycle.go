@@ -0,0 +1,499 @@
+package rotation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/kyc"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/pkg/utils/mdutil"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const amountTolerance = 0.005
+
+// currentCycle gets the latest cycle of the rotation, which is the paid out last cycle of a completed rotation
+func (rotationAPI *rotationAPIServer) currentCycle(rotationDB *models.Rotation) (*models.RotationCycle, error) {
+	cycleDB := &models.RotationCycle{}
+	err := rotationAPI.SQLDB.First(cycleDB, "rotation_id = ? AND cycle_number = ?", rotationDB.ID, rotationDB.CurrentCycle).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("rotation cycle", fmt.Sprint(rotationDB.CurrentCycle))
+	default:
+		return nil, errs.FailedToFind("rotation cycle", err)
+	}
+	return cycleDB, nil
+}
+
+// cycleProto includes the members yet to contribute to the cycle and, for open bidding cycles, the highest bid so far
+func (rotationAPI *rotationAPIServer) cycleProto(
+	cycleDB *models.RotationCycle, rotationDB *models.Rotation, slots []*models.RotationSlot,
+) (*chama.RotationCycle, error) {
+	cyclePB, err := models.RotationCycleProto(cycleDB)
+	if err != nil {
+		return nil, err
+	}
+
+	contributions := make([]*models.RotationContribution, 0)
+	err = rotationAPI.SQLDB.Find(&contributions, "cycle_id = ?", cycleDB.ID).Error
+	if err != nil {
+		return nil, errs.FailedToFind("rotation contributions", err)
+	}
+
+	paid := make(map[string]float64, len(contributions))
+	for _, contribution := range contributions {
+		paid[contribution.MemberID] = contribution.Amount
+	}
+
+	cyclePB.PendingMemberIds = make([]string, 0)
+	for _, slotDB := range slots {
+		if rotationDB.ContributionAmount-paid[slotDB.MemberID] > amountTolerance {
+			cyclePB.PendingMemberIds = append(cyclePB.PendingMemberIds, slotDB.MemberID)
+		}
+	}
+
+	if !cycleDB.PaidOut && rotationDB.RotationOrder == chama.RotationOrder_BIDDING.String() {
+		bidDB, err := rotationAPI.highestBid(cycleDB.ID)
+		if err != nil {
+			return nil, err
+		}
+		if bidDB != nil {
+			cyclePB.BidAmount = bidDB.Amount
+		}
+	}
+
+	return cyclePB, nil
+}
+
+// highestBid is the earliest of the highest bids for the cycle, or nil when there are no bids
+func (rotationAPI *rotationAPIServer) highestBid(cycleID uint) (*models.RotationBid, error) {
+	bids := make([]*models.RotationBid, 0, 1)
+	err := rotationAPI.SQLDB.Order("amount DESC, updated_at ASC").Limit(1).Find(&bids, "cycle_id = ?", cycleID).Error
+	if err != nil {
+		return nil, errs.FailedToFind("rotation bids", err)
+	}
+	if len(bids) == 0 {
+		return nil, nil
+	}
+	return bids[0], nil
+}
+
+// openCycle gets the current cycle of an active rotation, which must not have been paid out
+func (rotationAPI *rotationAPIServer) openCycle(rotationDB *models.Rotation) (*models.RotationCycle, error) {
+	cycleDB, err := rotationAPI.currentCycle(rotationDB)
+	if err != nil {
+		return nil, err
+	}
+	if cycleDB.PaidOut {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "rotation cycle has been paid out")
+	}
+	return cycleDB, nil
+}
+
+func (rotationAPI *rotationAPIServer) RecordRotationContribution(
+	ctx context.Context, req *chama.RecordRotationContributionRequest,
+) (*chama.RotationCycle, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.RotationId == "":
+		return nil, errs.MissingField("rotation id")
+	case req.MemberId == "":
+		return nil, errs.MissingField("member id")
+	case req.Amount <= 0:
+		return nil, errs.IncorrectVal("amount")
+	}
+
+	// Authorization
+	actor, rotationDB, err := rotationAPI.activeRotation(ctx, req.RotationId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = rotationAPI.rotationSlot(rotationDB.ID, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	cycleDB, err := rotationAPI.openCycle(rotationDB)
+	if err != nil {
+		return nil, err
+	}
+
+	ctxExt := mdutil.AddFromCtx(ctx)
+
+	accountPB, err := rotationAPI.MoneyAccountAPI.GetChamaAccount(ctxExt, &transaction.GetChamaAccountRequest{
+		OwnerId:     rotationDB.ChamaID,
+		AccountName: rotationDB.AccountName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the contribution before moving any money so that concurrent contributions can't exceed what is due
+	err = rotationAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(cycleDB, "id = ?", cycleDB.ID).Error
+		if err != nil {
+			return errs.FailedToFind("rotation cycle", err)
+		}
+		if cycleDB.PaidOut {
+			return errs.WrapMessage(codes.FailedPrecondition, "rotation cycle has been paid out")
+		}
+
+		contributionDB := &models.RotationContribution{}
+		err = tx.First(contributionDB, "cycle_id = ? AND member_id = ?", cycleDB.ID, req.MemberId).Error
+		switch {
+		case err == nil:
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			contributionDB = &models.RotationContribution{CycleID: cycleDB.ID, MemberID: req.MemberId}
+		default:
+			return errs.FailedToFind("rotation contribution", err)
+		}
+
+		remaining := rotationDB.ContributionAmount - contributionDB.Amount
+		switch {
+		case remaining <= amountTolerance:
+			return errs.WrapMessage(codes.FailedPrecondition, "member has already contributed to this cycle")
+		case req.Amount > remaining+amountTolerance:
+			return errs.WrapMessagef(codes.FailedPrecondition, "amount exceeds the %.2f due from the member this cycle", remaining)
+		}
+
+		contributionDB.Amount += req.Amount
+		err = tx.Save(contributionDB).Error
+		if err != nil {
+			return errs.FailedToSave("rotation contribution", err)
+		}
+
+		err = tx.Model(cycleDB).Update("collected_amount", gorm.Expr("collected_amount + ?", req.Amount)).Error
+		if err != nil {
+			return errs.FailedToUpdate("rotation cycle", err)
+		}
+
+		return tx.First(cycleDB, "id = ?", cycleDB.ID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = rotationAPI.TransactionAPI.Deposit(ctxExt, &transaction.DepositRequest{
+		ActorId:     actor.ID,
+		AccountId:   accountPB.AccountId,
+		Description: fmt.Sprintf("%s cycle %d contribution by member %s", rotationDB.Name, cycleDB.CycleNumber, req.MemberId),
+		Amount:      req.Amount,
+	})
+	if err != nil {
+		rotationAPI.releaseContribution(cycleDB.ID, req.MemberId, req.Amount)
+		return nil, err
+	}
+
+	// The pot is paid out once every member has contributed. A contribution stands when the payout fails and the pot
+	// is paid out later with PayoutRotationCycle.
+	if cycleDB.PotAmount-cycleDB.CollectedAmount <= amountTolerance {
+		err = rotationAPI.payout(ctx, actor.ID, accountPB.AccountId, rotationDB, cycleDB)
+		if err != nil {
+			rotationAPI.Logger.Errorf("failed to pay out rotation cycle %d: %v", cycleDB.ID, err)
+		}
+
+		err = rotationAPI.SQLDB.First(cycleDB, "id = ?", cycleDB.ID).Error
+		if err != nil {
+			return nil, errs.FailedToFind("rotation cycle", err)
+		}
+	}
+
+	slots, err := rotationAPI.rotationSlots(rotationDB.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rotationAPI.cycleProto(cycleDB, rotationDB, slots)
+}
+
+// releaseContribution takes back a recorded contribution whose money didn't move
+func (rotationAPI *rotationAPIServer) releaseContribution(cycleID uint, memberID string, amount float64) {
+	err := rotationAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.RotationContribution{}).Where("cycle_id = ? AND member_id = ?", cycleID, memberID).
+			Update("amount", gorm.Expr("amount - ?", amount)).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&models.RotationCycle{}).Where("id = ?", cycleID).
+			Update("collected_amount", gorm.Expr("collected_amount - ?", amount)).Error
+	})
+	if err != nil {
+		rotationAPI.Logger.Errorf("failed to release contribution of %.2f by member %s to rotation cycle %d: %v", amount, memberID, cycleID, err)
+	}
+}
+
+func (rotationAPI *rotationAPIServer) PayoutRotationCycle(
+	ctx context.Context, req *chama.PayoutRotationCycleRequest,
+) (*chama.RotationCycle, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.RotationId == "":
+		return nil, errs.MissingField("rotation id")
+	}
+
+	// Authorization
+	actor, rotationDB, err := rotationAPI.activeRotation(ctx, req.RotationId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	cycleDB, err := rotationAPI.currentCycle(rotationDB)
+	if err != nil {
+		return nil, err
+	}
+
+	// A cycle claimed for its recipient whose pot was withdrawn is finished by the payout
+	switch {
+	case cycleDB.PaidOutAt != nil:
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "rotation cycle has been paid out")
+	case cycleDB.PotAmount-cycleDB.CollectedAmount > amountTolerance:
+		return nil, errs.WrapMessagef(
+			codes.FailedPrecondition, "%.2f of the pot is yet to be collected", cycleDB.PotAmount-cycleDB.CollectedAmount,
+		)
+	}
+
+	accountPB, err := rotationAPI.MoneyAccountAPI.GetChamaAccount(mdutil.AddFromCtx(ctx), &transaction.GetChamaAccountRequest{
+		OwnerId:     rotationDB.ChamaID,
+		AccountName: rotationDB.AccountName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = rotationAPI.payout(ctx, actor.ID, accountPB.AccountId, rotationDB, cycleDB)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rotationAPI.SQLDB.First(cycleDB, "id = ?", cycleDB.ID).Error
+	if err != nil {
+		return nil, errs.FailedToFind("rotation cycle", err)
+	}
+
+	slots, err := rotationAPI.rotationSlots(rotationDB.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rotationAPI.cycleProto(cycleDB, rotationDB, slots)
+}
+
+// payout withdraws the pot less the winning bid for the recipient of the cycle and opens the next cycle.
+// The recipient is the highest bidder in bidding rotations, otherwise the next member in turn. The recipient is saved
+// with the claim on the cycle, so a cycle whose pot was withdrawn but wasn't advanced is finished by a retry.
+func (rotationAPI *rotationAPIServer) payout(
+	ctx context.Context, actorID, accountID string, rotationDB *models.Rotation, cycleDB *models.RotationCycle,
+) error {
+	if cycleDB.PaidOut {
+		return rotationAPI.finishPayout(rotationDB, cycleDB.ID)
+	}
+
+	slots, err := rotationAPI.rotationSlots(rotationDB.ID)
+	if err != nil {
+		return err
+	}
+
+	waiting := make(map[string]*models.RotationSlot, len(slots))
+	var recipient *models.RotationSlot
+	for _, slotDB := range slots {
+		if slotDB.ReceivedCycle != 0 {
+			continue
+		}
+		waiting[slotDB.MemberID] = slotDB
+		if recipient == nil {
+			recipient = slotDB
+		}
+	}
+
+	if recipient == nil {
+		return errs.WrapMessage(codes.FailedPrecondition, "all members have received the pot")
+	}
+
+	var bidAmount float64
+	if rotationDB.RotationOrder == chama.RotationOrder_BIDDING.String() {
+		bidDB, err := rotationAPI.highestBid(cycleDB.ID)
+		if err != nil {
+			return err
+		}
+		if bidDB != nil && waiting[bidDB.MemberID] != nil {
+			recipient = waiting[bidDB.MemberID]
+			bidAmount = bidDB.Amount
+		}
+	}
+
+	// The pot is paid out only to members whose KYC is verified
+	err = kyc.RequireVerified(rotationAPI.SQLDB, recipient.MemberID)
+	if err != nil {
+		return err
+	}
+
+	payoutAmount := cycleDB.CollectedAmount - bidAmount
+
+	// Claim the cycle for the recipient so that it is paid out once
+	res := rotationAPI.SQLDB.Model(&models.RotationCycle{}).Where("id = ? AND paid_out = ?", cycleDB.ID, false).
+		Updates(map[string]interface{}{
+			"paid_out":      true,
+			"recipient_id":  recipient.MemberID,
+			"bid_amount":    bidAmount,
+			"payout_amount": payoutAmount,
+		})
+	switch {
+	case res.Error != nil:
+		return errs.FailedToUpdate("rotation cycle", res.Error)
+	case res.RowsAffected == 0:
+		return nil
+	}
+
+	_, err = rotationAPI.TransactionAPI.Withdraw(mdutil.AddFromCtx(ctx), &transaction.WithdrawRequest{
+		ActorId:     actorID,
+		AccountId:   accountID,
+		Description: fmt.Sprintf("%s cycle %d payout to %s", rotationDB.Name, cycleDB.CycleNumber, recipient.MemberNames),
+		Amount:      payoutAmount,
+	})
+	if err != nil {
+		errRelease := rotationAPI.SQLDB.Model(&models.RotationCycle{}).Where("id = ? AND paid_out_at IS NULL", cycleDB.ID).
+			Updates(map[string]interface{}{
+				"paid_out":      false,
+				"recipient_id":  "",
+				"bid_amount":    0,
+				"payout_amount": 0,
+			}).Error
+		if errRelease != nil {
+			rotationAPI.Logger.Errorf("failed to release rotation cycle %d: %v", cycleDB.ID, errRelease)
+		}
+		return err
+	}
+
+	return rotationAPI.finishPayout(rotationDB, cycleDB.ID)
+}
+
+// finishPayout marks the recipient of a withdrawn pot and opens the next cycle of the rotation
+func (rotationAPI *rotationAPIServer) finishPayout(rotationDB *models.Rotation, cycleID uint) error {
+	return rotationAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		cycleDB := &models.RotationCycle{}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(cycleDB, "id = ?", cycleID).Error
+		if err != nil {
+			return errs.FailedToFind("rotation cycle", err)
+		}
+		if !cycleDB.PaidOut || cycleDB.PaidOutAt != nil {
+			return nil
+		}
+
+		now := time.Now()
+		err = tx.Model(cycleDB).Update("paid_out_at", &now).Error
+		if err != nil {
+			return errs.FailedToUpdate("rotation cycle", err)
+		}
+
+		err = tx.Model(&models.RotationSlot{}).Where("rotation_id = ? AND member_id = ?", rotationDB.ID, cycleDB.RecipientID).
+			Update("received_cycle", cycleDB.CycleNumber).Error
+		if err != nil {
+			return errs.FailedToUpdate("rotation slot", err)
+		}
+
+		slots := make([]*models.RotationSlot, 0)
+		err = tx.Find(&slots, "rotation_id = ?", rotationDB.ID).Error
+		if err != nil {
+			return errs.FailedToFind("rotation slots", err)
+		}
+
+		waiting := 0
+		for _, slotDB := range slots {
+			if slotDB.ReceivedCycle == 0 {
+				waiting++
+			}
+		}
+
+		if waiting == 0 {
+			err = tx.Model(rotationDB).Update("status", chama.RotationStatus_ROTATION_COMPLETED.String()).Error
+			if err != nil {
+				return errs.FailedToUpdate("rotation", err)
+			}
+			return nil
+		}
+
+		rotationDB.CurrentCycle = cycleDB.CycleNumber + 1
+		err = tx.Model(rotationDB).Update("current_cycle", rotationDB.CurrentCycle).Error
+		if err != nil {
+			return errs.FailedToUpdate("rotation", err)
+		}
+
+		return createCycle(tx, rotationDB, len(slots))
+	})
+}
+
+func (rotationAPI *rotationAPIServer) PlaceRotationBid(
+	ctx context.Context, req *chama.PlaceRotationBidRequest,
+) (*chama.RotationCycle, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.RotationId == "":
+		return nil, errs.MissingField("rotation id")
+	case req.MemberId == "":
+		return nil, errs.MissingField("member id")
+	case req.Amount <= 0:
+		return nil, errs.IncorrectVal("bid amount")
+	}
+
+	// Authorization
+	_, rotationDB, err := rotationAPI.activeRotation(ctx, req.RotationId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	if rotationDB.RotationOrder != chama.RotationOrder_BIDDING.String() {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "rotation does not take bids")
+	}
+
+	slotDB, err := rotationAPI.rotationSlot(rotationDB.ID, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	if slotDB.ReceivedCycle != 0 {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "member has already received the pot")
+	}
+
+	cycleDB, err := rotationAPI.openCycle(rotationDB)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Amount >= cycleDB.PotAmount {
+		return nil, errs.WrapMessagef(codes.InvalidArgument, "bid must be less than the pot of %.2f", cycleDB.PotAmount)
+	}
+
+	err = rotationAPI.SQLDB.Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"amount", "updated_at"}),
+	}).Create(&models.RotationBid{
+		CycleID:  cycleDB.ID,
+		MemberID: req.MemberId,
+		Amount:   req.Amount,
+	}).Error
+	if err != nil {
+		return nil, errs.FailedToSave("rotation bid", err)
+	}
+
+	slots, err := rotationAPI.rotationSlots(rotationDB.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rotationAPI.cycleProto(cycleDB, rotationDB, slots)
+}
@@ -0,0 +1,275 @@
+package rotation
+
+import (
+	"context"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Rotation cycles", func() {
+	var (
+		ctx       context.Context
+		chamaID   string
+		memberIDs []string
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		var err error
+		chamaID, memberIDs, err = createChama(3)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// Only verified members are paid out
+		for _, memberID := range memberIDs {
+			err = RotationAPIServer.SQLDB.Create(&models.MemberKYC{
+				MemberID: memberID,
+				ChamaID:  chamaID,
+				Status:   chama.KycStatus_KYC_VERIFIED.String(),
+			}).Error
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+	})
+
+	createRotation := func(order chama.RotationOrder) string {
+		rotationRes, err := RotationAPI.CreateRotation(ctx, &chama.CreateRotationRequest{
+			Rotation:  mockRotation(chamaID, order),
+			MemberIds: memberIDs,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		return rotationRes.RotationId
+	}
+
+	// contributeAll records the contribution of every member to the current cycle
+	contributeAll := func(rotationID string) *chama.RotationCycle {
+		var cyclePB *chama.RotationCycle
+		for _, memberID := range memberIDs {
+			var err error
+			cyclePB, err = RotationAPI.RecordRotationContribution(ctx, &chama.RecordRotationContributionRequest{
+				RotationId: rotationID,
+				MemberId:   memberID,
+				Amount:     1000,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		return cyclePB
+	}
+
+	potBalance := func() float64 {
+		accountDB := &models.ChamaAccount{}
+		err := RotationAPIServer.SQLDB.First(accountDB, "owner_id = ? AND account_name = ?", chamaID, potAccount).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		return accountDB.AvailableAmount
+	}
+
+	Describe("Recording contributions", func() {
+		var rotationID string
+
+		BeforeEach(func() {
+			rotationID = createRotation(chama.RotationOrder_FIXED_ORDER)
+		})
+
+		It("should fail when the request is nil", func() {
+			cycleRes, err := RotationAPI.RecordRotationContribution(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(cycleRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("should fail when the member is not in the rotation", func() {
+			cycleRes, err := RotationAPI.RecordRotationContribution(ctx, &chama.RecordRotationContributionRequest{
+				RotationId: rotationID,
+				MemberId:   "0",
+				Amount:     1000,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(cycleRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should fail when the amount exceeds the contribution", func() {
+			cycleRes, err := RotationAPI.RecordRotationContribution(ctx, &chama.RecordRotationContributionRequest{
+				RotationId: rotationID,
+				MemberId:   memberIDs[0],
+				Amount:     1500,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(cycleRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should track members yet to contribute", func() {
+			cycleRes, err := RotationAPI.RecordRotationContribution(ctx, &chama.RecordRotationContributionRequest{
+				RotationId: rotationID,
+				MemberId:   memberIDs[0],
+				Amount:     400,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cycleRes.CollectedAmount).Should(BeNumerically("~", 400, 0.001))
+			Expect(cycleRes.PendingMemberIds).Should(ConsistOf(memberIDs))
+			Expect(cycleRes.PaidOut).Should(BeFalse())
+			Expect(potBalance()).Should(BeNumerically("~", 400, 0.001))
+		})
+	})
+
+	Describe("Paying out the pot", func() {
+		It("should pay the members in turn once all contributions are in and complete the rotation", func() {
+			rotationID := createRotation(chama.RotationOrder_FIXED_ORDER)
+
+			for cycle, memberID := range memberIDs {
+				cyclePB := contributeAll(rotationID)
+				Expect(cyclePB.CycleNumber).Should(BeEquivalentTo(cycle + 1))
+				Expect(cyclePB.PaidOut).Should(BeTrue())
+				Expect(cyclePB.RecipientId).Should(Equal(memberID))
+				Expect(cyclePB.PayoutAmount).Should(BeNumerically("~", 3000, 0.001))
+				Expect(potBalance()).Should(BeNumerically("~", 0, 0.001))
+			}
+
+			rotationRes, err := RotationAPI.GetRotation(ctx, &chama.GetRotationRequest{RotationId: rotationID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rotationRes.Status).Should(Equal(chama.RotationStatus_ROTATION_COMPLETED))
+			for _, slot := range rotationRes.Slots {
+				Expect(slot.ReceivedCycle).ShouldNot(BeZero())
+			}
+
+			cycleRes, err := RotationAPI.RecordRotationContribution(ctx, &chama.RecordRotationContributionRequest{
+				RotationId: rotationID,
+				MemberId:   memberIDs[0],
+				Amount:     1000,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(cycleRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should pay the highest bidder the pot less their bid", func() {
+			rotationID := createRotation(chama.RotationOrder_BIDDING)
+
+			_, err := RotationAPI.PlaceRotationBid(ctx, &chama.PlaceRotationBidRequest{
+				RotationId: rotationID,
+				MemberId:   memberIDs[1],
+				Amount:     100,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			bidRes, err := RotationAPI.PlaceRotationBid(ctx, &chama.PlaceRotationBidRequest{
+				RotationId: rotationID,
+				MemberId:   memberIDs[2],
+				Amount:     250,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(bidRes.BidAmount).Should(BeNumerically("~", 250, 0.001))
+
+			cyclePB := contributeAll(rotationID)
+			Expect(cyclePB.RecipientId).Should(Equal(memberIDs[2]))
+			Expect(cyclePB.PayoutAmount).Should(BeNumerically("~", 2750, 0.001))
+			Expect(potBalance()).Should(BeNumerically("~", 250, 0.001))
+
+			// The winner cannot bid again
+			bidRes, err = RotationAPI.PlaceRotationBid(ctx, &chama.PlaceRotationBidRequest{
+				RotationId: rotationID,
+				MemberId:   memberIDs[2],
+				Amount:     100,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(bidRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should keep a collected pot that failed to pay out for a retry", func() {
+			rotationID := createRotation(chama.RotationOrder_FIXED_ORDER)
+
+			cycleRes, err := RotationAPI.PayoutRotationCycle(ctx, &chama.PayoutRotationCycleRequest{RotationId: rotationID})
+			Expect(err).Should(HaveOccurred())
+			Expect(cycleRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			setWithdrawable := func(withdrawable bool) {
+				err := RotationAPIServer.SQLDB.Model(&models.ChamaAccount{}).
+					Where("owner_id = ? AND account_name = ?", chamaID, potAccount).Update("withdrawable", withdrawable).Error
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			setWithdrawable(false)
+			cyclePB := contributeAll(rotationID)
+			Expect(cyclePB.PaidOut).Should(BeFalse())
+			Expect(cyclePB.PendingMemberIds).Should(BeEmpty())
+			Expect(potBalance()).Should(BeNumerically("~", 3000, 0.001))
+
+			setWithdrawable(true)
+			cyclePB, err = RotationAPI.PayoutRotationCycle(ctx, &chama.PayoutRotationCycleRequest{RotationId: rotationID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cyclePB.PaidOut).Should(BeTrue())
+			Expect(cyclePB.RecipientId).Should(Equal(memberIDs[0]))
+			Expect(potBalance()).Should(BeNumerically("~", 0, 0.001))
+		})
+
+		It("should not pay out the pot to a member whose KYC is not verified", func() {
+			rotationID := createRotation(chama.RotationOrder_FIXED_ORDER)
+
+			err := RotationAPIServer.SQLDB.Model(&models.MemberKYC{}).Where("member_id = ?", memberIDs[0]).
+				Update("status", chama.KycStatus_KYC_PENDING.String()).Error
+			Expect(err).ShouldNot(HaveOccurred())
+
+			cyclePB := contributeAll(rotationID)
+			Expect(cyclePB.PaidOut).Should(BeFalse())
+			Expect(potBalance()).Should(BeNumerically("~", 3000, 0.001))
+
+			cycleRes, err := RotationAPI.PayoutRotationCycle(ctx, &chama.PayoutRotationCycleRequest{RotationId: rotationID})
+			Expect(err).Should(HaveOccurred())
+			Expect(cycleRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+			Expect(potBalance()).Should(BeNumerically("~", 3000, 0.001))
+		})
+
+		It("should finish a cycle whose pot was withdrawn without withdrawing it again", func() {
+			rotationID := createRotation(chama.RotationOrder_FIXED_ORDER)
+
+			err := RotationAPIServer.SQLDB.Model(&models.ChamaAccount{}).
+				Where("owner_id = ? AND account_name = ?", chamaID, potAccount).Update("withdrawable", false).Error
+			Expect(err).ShouldNot(HaveOccurred())
+
+			cyclePB := contributeAll(rotationID)
+			Expect(cyclePB.PaidOut).Should(BeFalse())
+
+			// The cycle was claimed and its pot withdrawn, but the rotation wasn't advanced
+			err = RotationAPIServer.SQLDB.Model(&models.RotationCycle{}).Where("id = ?", cyclePB.CycleId).
+				Updates(map[string]interface{}{
+					"paid_out":      true,
+					"recipient_id":  memberIDs[0],
+					"payout_amount": 3000,
+				}).Error
+			Expect(err).ShouldNot(HaveOccurred())
+			err = RotationAPIServer.SQLDB.Model(&models.ChamaAccount{}).
+				Where("owner_id = ? AND account_name = ?", chamaID, potAccount).Update("available_amount", 0).Error
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// The pot can't be withdrawn, so the cycle is only finished if it isn't paid out again
+			cyclePB, err = RotationAPI.PayoutRotationCycle(ctx, &chama.PayoutRotationCycleRequest{RotationId: rotationID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cyclePB.PaidOut).Should(BeTrue())
+			Expect(cyclePB.RecipientId).Should(Equal(memberIDs[0]))
+			Expect(potBalance()).Should(BeNumerically("~", 0, 0.001))
+
+			rotationRes, err := RotationAPI.GetRotation(ctx, &chama.GetRotationRequest{RotationId: rotationID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rotationRes.CurrentCycle).Should(BeEquivalentTo(2))
+		})
+
+		It("should not take bids on a fixed rotation", func() {
+			rotationID := createRotation(chama.RotationOrder_FIXED_ORDER)
+			bidRes, err := RotationAPI.PlaceRotationBid(ctx, &chama.PlaceRotationBidRequest{
+				RotationId: rotationID,
+				MemberId:   memberIDs[0],
+				Amount:     100,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(bidRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+})
@@ -0,0 +1,60 @@
+package rotation
+
+import (
+	"fmt"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+)
+
+const potAccount = "merry-go-round"
+
+func randomPhone() string {
+	return fmt.Sprintf("2547%d", randomdata.Number(10000000, 99999999))
+}
+
+// createChama saves active members of a new chama and the account its pot is collected into
+func createChama(members int) (chamaID string, memberIDs []string, err error) {
+	chamaID = fmt.Sprint(randomdata.Number(1000, 9999999))
+
+	err = RotationAPIServer.SQLDB.Create(&models.ChamaAccount{
+		OwnerID:     chamaID,
+		AccountName: potAccount,
+		AccountType: transaction.AccountType_SAVINGS_ACCOUNT.String(),
+		Active:      true,
+	}).Error
+	if err != nil {
+		return "", nil, err
+	}
+
+	for i := 0; i < members; i++ {
+		memberDB := &models.ChamaMember{
+			ChamaID:   chamaID,
+			FirstName: randomdata.FirstName(randomdata.Female),
+			LastName:  randomdata.LastName(),
+			Phone:     randomPhone(),
+			IDNumber:  fmt.Sprint(randomdata.Number(22222222, 44444444)),
+			Active:    true,
+		}
+		err = RotationAPIServer.SQLDB.Create(memberDB).Error
+		if err != nil {
+			return "", nil, err
+		}
+		memberIDs = append(memberIDs, fmt.Sprint(memberDB.ID))
+	}
+
+	return chamaID, memberIDs, nil
+}
+
+func mockRotation(chamaID string, order chama.RotationOrder) *chama.Rotation {
+	return &chama.Rotation{
+		ChamaId:            chamaID,
+		Name:               randomdata.SillyName() + " merry-go-round",
+		Order:              order,
+		ContributionAmount: 1000,
+		AccountName:        potAccount,
+		CycleDays:          30,
+	}
+}
@@ -0,0 +1,540 @@
+package rotation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	"github.com/gidyon/machama-app/pkg/api/transaction"
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/pkg/utils/mdutil"
+
+	"github.com/speps/go-hashids"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"gorm.io/gorm"
+)
+
+const rotationDateLayout = "2006-01-02"
+
+type Options struct {
+	MoneyAccountAPI transaction.ChamaAccountAPIServer
+	TransactionAPI  transaction.TransactionAPIServer
+	SQLDB           *gorm.DB
+	PageHasher      *hashids.HashID
+	Logger          grpclog.LoggerV2
+	Auth            auth.API
+	AllowedGroups   []string
+}
+
+type rotationAPIServer struct {
+	chama.UnimplementedRotationAPIServer
+	*Options
+	authorizer *roles.Authorizer
+}
+
+// NewRotationAPI creates the merry-go-round API where members of a chama take turns receiving the pot of each cycle
+func NewRotationAPI(ctx context.Context, opt *Options) (chama.RotationAPIServer, error) {
+	// Validation
+	switch {
+	case ctx == nil:
+		return nil, errors.New("missing context")
+	case opt == nil:
+		return nil, errors.New("missing options")
+	case opt.TransactionAPI == nil:
+		return nil, errors.New("missing transaction API")
+	case opt.MoneyAccountAPI == nil:
+		return nil, errors.New("missing chama account API")
+	case opt.SQLDB == nil:
+		return nil, errors.New("missing sql db")
+	case opt.Logger == nil:
+		return nil, errors.New("missing logger")
+	case opt.Auth == nil:
+		return nil, errors.New("missing auth API")
+	case opt.PageHasher == nil:
+		return nil, errors.New("missing pagination hasher")
+	default:
+		if len(opt.AllowedGroups) == 0 {
+			opt.AllowedGroups = opt.Auth.AdminGroups()
+		}
+	}
+
+	rotationAPI := &rotationAPIServer{
+		Options:    opt,
+		authorizer: roles.NewAuthorizer(opt.Auth, opt.SQLDB, opt.AllowedGroups),
+	}
+
+	return rotationAPI, nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+func ValidateRotation(pb *chama.Rotation) error {
+	switch {
+	case pb == nil:
+		return errs.MissingField("rotation")
+	case pb.ChamaId == "":
+		return errs.MissingField("chama id")
+	case pb.Name == "":
+		return errs.MissingField("rotation name")
+	case pb.ContributionAmount <= 0:
+		return errs.IncorrectVal("contribution amount")
+	case pb.AccountName == "":
+		return errs.MissingField("account name")
+	case pb.CycleDays <= 0:
+		return errs.IncorrectVal("cycle days")
+	case chama.RotationOrder_name[int32(pb.Order)] == "":
+		return errs.IncorrectVal("rotation order")
+	}
+	return nil
+}
+
+func (rotationAPI *rotationAPIServer) CreateRotation(
+	ctx context.Context, req *chama.CreateRotationRequest,
+) (*chama.Rotation, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	default:
+		err := ValidateRotation(req.Rotation)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Authorization
+	_, err := rotationAPI.authorizer.AuthorizeChama(ctx, req.Rotation.ChamaId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	// The pot is collected into and paid out from a chama account
+	_, err = rotationAPI.MoneyAccountAPI.GetChamaAccount(mdutil.AddFromCtx(ctx), &transaction.GetChamaAccountRequest{
+		OwnerId:     req.Rotation.ChamaId,
+		AccountName: req.Rotation.AccountName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*models.ChamaMember, 0)
+	db := rotationAPI.SQLDB.Where("chama_id = ? AND active = ?", req.Rotation.ChamaId, true)
+	if len(req.MemberIds) != 0 {
+		db = db.Where("id IN (?)", req.MemberIds)
+	}
+	err = db.Order("id ASC").Find(&members).Error
+	if err != nil {
+		return nil, errs.FailedToFind("chama members", err)
+	}
+
+	if len(req.MemberIds) != 0 {
+		if len(members) != len(req.MemberIds) {
+			return nil, errs.WrapMessage(codes.InvalidArgument, "rotation members must be distinct active members of the chama")
+		}
+
+		// Keep the order in which the members were given
+		positions := make(map[string]int, len(req.MemberIds))
+		for i, memberID := range req.MemberIds {
+			positions[memberID] = i
+		}
+		sort.Slice(members, func(i, j int) bool {
+			return positions[fmt.Sprint(members[i].ID)] < positions[fmt.Sprint(members[j].ID)]
+		})
+	}
+
+	if len(members) < 2 {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "rotation needs at least two members")
+	}
+
+	if req.Rotation.Order == chama.RotationOrder_RANDOM_DRAW {
+		rand.Shuffle(len(members), func(i, j int) {
+			members[i], members[j] = members[j], members[i]
+		})
+	}
+
+	rotationDB, err := models.RotationModel(req.Rotation)
+	if err != nil {
+		return nil, err
+	}
+
+	if rotationDB.StartDate.IsZero() {
+		rotationDB.StartDate = startOfDay(time.Now())
+	}
+	rotationDB.Status = chama.RotationStatus_ROTATION_ACTIVE.String()
+	rotationDB.CurrentCycle = 1
+
+	err = rotationAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(rotationDB).Error
+		if err != nil {
+			return errs.FailedToSave("rotation", err)
+		}
+
+		slots := make([]*models.RotationSlot, 0, len(members))
+		for i, memberDB := range members {
+			slots = append(slots, &models.RotationSlot{
+				RotationID:  rotationDB.ID,
+				MemberID:    fmt.Sprint(memberDB.ID),
+				MemberNames: memberDB.FirstName + " " + memberDB.LastName,
+				Position:    int32(i + 1),
+			})
+		}
+
+		err = tx.Create(slots).Error
+		if err != nil {
+			return errs.FailedToSave("rotation slots", err)
+		}
+
+		return createCycle(tx, rotationDB, len(slots))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rotationAPI.rotationProto(rotationDB)
+}
+
+// createCycle opens the current cycle of the rotation. Every member contributes to the pot of each cycle.
+func createCycle(tx *gorm.DB, rotationDB *models.Rotation, members int) error {
+	err := tx.Create(&models.RotationCycle{
+		RotationID:  rotationDB.ID,
+		CycleNumber: rotationDB.CurrentCycle,
+		PotAmount:   rotationDB.ContributionAmount * float64(members),
+		DueDate:     rotationDB.StartDate.AddDate(0, 0, int(rotationDB.CycleDays*(rotationDB.CurrentCycle-1))),
+	}).Error
+	if err != nil {
+		return errs.FailedToSave("rotation cycle", err)
+	}
+	return nil
+}
+
+func (rotationAPI *rotationAPIServer) getRotation(rotationID string) (*models.Rotation, error) {
+	rotationDB := &models.Rotation{}
+	err := rotationAPI.SQLDB.First(rotationDB, "id = ?", rotationID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("rotation", rotationID)
+	default:
+		return nil, errs.FailedToFind("rotation", err)
+	}
+	return rotationDB, nil
+}
+
+// authorizeRotation gets a rotation and authorizes an actor holding one of the roles in its chama
+func (rotationAPI *rotationAPIServer) authorizeRotation(
+	ctx context.Context, rotationID string, roles ...chama.ChamaRole,
+) (*auth.Payload, *models.Rotation, error) {
+	rotationDB, err := rotationAPI.getRotation(rotationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	actor, err := rotationAPI.authorizer.AuthorizeChama(ctx, rotationDB.ChamaID, roles...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return actor, rotationDB, nil
+}
+
+// activeRotation authorizes the actor on a rotation that is still running
+func (rotationAPI *rotationAPIServer) activeRotation(
+	ctx context.Context, rotationID string, roles ...chama.ChamaRole,
+) (*auth.Payload, *models.Rotation, error) {
+	actor, rotationDB, err := rotationAPI.authorizeRotation(ctx, rotationID, roles...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rotationDB.Status != chama.RotationStatus_ROTATION_ACTIVE.String() {
+		return nil, nil, errs.WrapMessage(codes.FailedPrecondition, "rotation has been completed")
+	}
+	return actor, rotationDB, nil
+}
+
+// rotationSlots are the turns of members in the rotation in order
+func (rotationAPI *rotationAPIServer) rotationSlots(rotationID uint) ([]*models.RotationSlot, error) {
+	slots := make([]*models.RotationSlot, 0)
+	err := rotationAPI.SQLDB.Order("position ASC").Find(&slots, "rotation_id = ?", rotationID).Error
+	if err != nil {
+		return nil, errs.FailedToFind("rotation slots", err)
+	}
+	return slots, nil
+}
+
+// rotationSlot gets the turn of a member in the rotation
+func (rotationAPI *rotationAPIServer) rotationSlot(rotationID uint, memberID string) (*models.RotationSlot, error) {
+	slotDB := &models.RotationSlot{}
+	err := rotationAPI.SQLDB.First(slotDB, "rotation_id = ? AND member_id = ?", rotationID, memberID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.WrapMessagef(codes.FailedPrecondition, "member %s is not in the rotation", memberID)
+	default:
+		return nil, errs.FailedToFind("rotation slot", err)
+	}
+	return slotDB, nil
+}
+
+func (rotationAPI *rotationAPIServer) rotationProto(rotationDB *models.Rotation) (*chama.Rotation, error) {
+	rotationPB, err := models.RotationProto(rotationDB)
+	if err != nil {
+		return nil, err
+	}
+
+	slots, err := rotationAPI.rotationSlots(rotationDB.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, slotDB := range slots {
+		slotPB, err := models.RotationSlotProto(slotDB)
+		if err != nil {
+			return nil, err
+		}
+		rotationPB.Slots = append(rotationPB.Slots, slotPB)
+	}
+
+	cycleDB, err := rotationAPI.currentCycle(rotationDB)
+	if err != nil {
+		return nil, err
+	}
+
+	rotationPB.Cycle, err = rotationAPI.cycleProto(cycleDB, rotationDB, slots)
+	if err != nil {
+		return nil, err
+	}
+
+	return rotationPB, nil
+}
+
+func (rotationAPI *rotationAPIServer) GetRotation(
+	ctx context.Context, req *chama.GetRotationRequest,
+) (*chama.Rotation, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.RotationId == "":
+		return nil, errs.MissingField("rotation id")
+	}
+
+	// Authorization
+	_, rotationDB, err := rotationAPI.authorizeRotation(ctx, req.RotationId, roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
+	return rotationAPI.rotationProto(rotationDB)
+}
+
+const defaultPageSize = 50
+
+func (rotationAPI *rotationAPIServer) ListRotations(
+	ctx context.Context, req *chama.ListRotationsRequest,
+) (*chama.ListRotationsResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("list request")
+	}
+
+	// Authorization
+	chamaIDs := []string{}
+	if req.ChamaId != "" {
+		chamaIDs = append(chamaIDs, req.ChamaId)
+	}
+	actor, chamaIDs, err := rotationAPI.authorizer.ScopeChamas(ctx, chamaIDs, roles.Members...)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.GetPageSize()
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultPageSize
+	case pageSize > defaultPageSize:
+		if !rotationAPI.Auth.IsAdmin(actor.Group) {
+			pageSize = defaultPageSize
+		}
+	}
+
+	var ID uint
+	pageToken := req.GetPageToken()
+	if pageToken != "" {
+		ids, err := rotationAPI.PageHasher.DecodeInt64WithError(req.GetPageToken())
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		ID = uint(ids[0])
+	}
+
+	db := rotationAPI.SQLDB.Limit(int(pageSize + 1)).Order("id DESC")
+	if ID != 0 {
+		db = db.Where("id<?", ID)
+	}
+
+	if chamaIDs != nil {
+		db = db.Where("chama_id IN (?)", chamaIDs)
+	}
+
+	dbs := make([]*models.Rotation, 0, pageSize+1)
+	err = db.Find(&dbs).Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "LIST")
+	}
+
+	pbs := make([]*chama.Rotation, 0, len(dbs))
+	for i, db := range dbs {
+		if i == int(pageSize) {
+			break
+		}
+
+		pb, err := models.RotationProto(db)
+		if err != nil {
+			return nil, err
+		}
+
+		pbs = append(pbs, pb)
+
+		ID = db.ID
+	}
+
+	var token string
+	if len(dbs) > int(pageSize) {
+		// Next page token
+		token, err = rotationAPI.PageHasher.EncodeInt64([]int64{int64(ID)})
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to generate next page token")
+		}
+	}
+
+	return &chama.ListRotationsResponse{
+		Rotations:     pbs,
+		NextPageToken: token,
+	}, nil
+}
+
+func (rotationAPI *rotationAPIServer) SkipRotationTurn(
+	ctx context.Context, req *chama.SkipRotationTurnRequest,
+) (*chama.Rotation, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.RotationId == "":
+		return nil, errs.MissingField("rotation id")
+	case req.MemberId == "":
+		return nil, errs.MissingField("member id")
+	}
+
+	// Authorization
+	_, rotationDB, err := rotationAPI.activeRotation(ctx, req.RotationId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	slots, err := rotationAPI.rotationSlots(rotationDB.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		slotDB   *models.RotationSlot
+		waiting  int
+		position int32
+	)
+	for _, slot := range slots {
+		if slot.MemberID == req.MemberId {
+			slotDB = slot
+		}
+		if slot.ReceivedCycle == 0 {
+			waiting++
+		}
+		if slot.Position > position {
+			position = slot.Position
+		}
+	}
+
+	switch {
+	case slotDB == nil:
+		return nil, errs.WrapMessagef(codes.FailedPrecondition, "member %s is not in the rotation", req.MemberId)
+	case slotDB.ReceivedCycle != 0:
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "member has already received the pot")
+	case waiting < 2:
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "member has the last turn of the rotation")
+	}
+
+	// Skipping moves the member to the end of the rotation
+	err = rotationAPI.SQLDB.Model(slotDB).Update("position", position+1).Error
+	if err != nil {
+		return nil, errs.FailedToUpdate("rotation slot", err)
+	}
+
+	return rotationAPI.rotationProto(rotationDB)
+}
+
+func (rotationAPI *rotationAPIServer) SwapRotationTurns(
+	ctx context.Context, req *chama.SwapRotationTurnsRequest,
+) (*chama.Rotation, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request body")
+	case req.RotationId == "":
+		return nil, errs.MissingField("rotation id")
+	case req.MemberId == "":
+		return nil, errs.MissingField("member id")
+	case req.OtherMemberId == "":
+		return nil, errs.MissingField("other member id")
+	case req.MemberId == req.OtherMemberId:
+		return nil, errs.WrapMessage(codes.InvalidArgument, "member cannot swap turns with themselves")
+	}
+
+	// Authorization
+	_, rotationDB, err := rotationAPI.activeRotation(ctx, req.RotationId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	slotDB, err := rotationAPI.rotationSlot(rotationDB.ID, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	otherDB, err := rotationAPI.rotationSlot(rotationDB.ID, req.OtherMemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	if slotDB.ReceivedCycle != 0 || otherDB.ReceivedCycle != 0 {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "members that have received the pot cannot swap turns")
+	}
+
+	position, otherPosition := slotDB.Position, otherDB.Position
+
+	err = rotationAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(slotDB).Update("position", otherPosition).Error
+		if err != nil {
+			return errs.FailedToUpdate("rotation slot", err)
+		}
+		err = tx.Model(otherDB).Update("position", position).Error
+		if err != nil {
+			return errs.FailedToUpdate("rotation slot", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rotationAPI.rotationProto(rotationDB)
+}
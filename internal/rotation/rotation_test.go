@@ -0,0 +1,152 @@
+package rotation
+
+import (
+	"context"
+
+	"github.com/gidyon/machama-app/pkg/api/chama"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Creating and arranging rotations", func() {
+	var (
+		ctx       context.Context
+		chamaID   string
+		memberIDs []string
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		var err error
+		chamaID, memberIDs, err = createChama(4)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	memberOrder := func(rotationPB *chama.Rotation) []string {
+		order := make([]string, 0, len(rotationPB.Slots))
+		for _, slot := range rotationPB.Slots {
+			order = append(order, slot.MemberId)
+		}
+		return order
+	}
+
+	Describe("CreateRotation with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			rotationRes, err := RotationAPI.CreateRotation(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(rotationRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when contribution amount is missing", func() {
+			rotationPB := mockRotation(chamaID, chama.RotationOrder_FIXED_ORDER)
+			rotationPB.ContributionAmount = 0
+			rotationRes, err := RotationAPI.CreateRotation(ctx, &chama.CreateRotationRequest{Rotation: rotationPB})
+			Expect(err).Should(HaveOccurred())
+			Expect(rotationRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when cycle days are missing", func() {
+			rotationPB := mockRotation(chamaID, chama.RotationOrder_FIXED_ORDER)
+			rotationPB.CycleDays = 0
+			rotationRes, err := RotationAPI.CreateRotation(ctx, &chama.CreateRotationRequest{Rotation: rotationPB})
+			Expect(err).Should(HaveOccurred())
+			Expect(rotationRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when a member is not in the chama", func() {
+			rotationRes, err := RotationAPI.CreateRotation(ctx, &chama.CreateRotationRequest{
+				Rotation:  mockRotation(chamaID, chama.RotationOrder_FIXED_ORDER),
+				MemberIds: []string{memberIDs[0], "0"},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(rotationRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when there is a single member", func() {
+			rotationRes, err := RotationAPI.CreateRotation(ctx, &chama.CreateRotationRequest{
+				Rotation:  mockRotation(chamaID, chama.RotationOrder_FIXED_ORDER),
+				MemberIds: memberIDs[:1],
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(rotationRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+
+	Describe("Creating rotations", func() {
+		It("should keep the given order of a fixed rotation", func() {
+			order := []string{memberIDs[2], memberIDs[0], memberIDs[3], memberIDs[1]}
+			rotationRes, err := RotationAPI.CreateRotation(ctx, &chama.CreateRotationRequest{
+				Rotation:  mockRotation(chamaID, chama.RotationOrder_FIXED_ORDER),
+				MemberIds: order,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(memberOrder(rotationRes)).Should(Equal(order))
+			Expect(rotationRes.Status).Should(Equal(chama.RotationStatus_ROTATION_ACTIVE))
+			Expect(rotationRes.CurrentCycle).Should(BeEquivalentTo(1))
+			Expect(rotationRes.Cycle.PotAmount).Should(BeNumerically("~", 4000, 0.001))
+			Expect(rotationRes.Cycle.PendingMemberIds).Should(HaveLen(4))
+		})
+
+		It("should draw every active member of the chama", func() {
+			rotationRes, err := RotationAPI.CreateRotation(ctx, &chama.CreateRotationRequest{
+				Rotation: mockRotation(chamaID, chama.RotationOrder_RANDOM_DRAW),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(memberOrder(rotationRes)).Should(ConsistOf(memberIDs))
+
+			getRes, err := RotationAPI.GetRotation(ctx, &chama.GetRotationRequest{RotationId: rotationRes.RotationId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(memberOrder(getRes)).Should(Equal(memberOrder(rotationRes)))
+
+			listRes, err := RotationAPI.ListRotations(ctx, &chama.ListRotationsRequest{ChamaId: chamaID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Rotations).Should(HaveLen(1))
+		})
+	})
+
+	Describe("Skipping and swapping turns", func() {
+		var rotationID string
+
+		BeforeEach(func() {
+			rotationRes, err := RotationAPI.CreateRotation(ctx, &chama.CreateRotationRequest{
+				Rotation:  mockRotation(chamaID, chama.RotationOrder_FIXED_ORDER),
+				MemberIds: memberIDs,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			rotationID = rotationRes.RotationId
+		})
+
+		It("should move a member that skips to the end of the rotation", func() {
+			rotationRes, err := RotationAPI.SkipRotationTurn(ctx, &chama.SkipRotationTurnRequest{
+				RotationId: rotationID,
+				MemberId:   memberIDs[0],
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(memberOrder(rotationRes)).Should(Equal([]string{memberIDs[1], memberIDs[2], memberIDs[3], memberIDs[0]}))
+		})
+
+		It("should swap the turns of two members", func() {
+			rotationRes, err := RotationAPI.SwapRotationTurns(ctx, &chama.SwapRotationTurnsRequest{
+				RotationId:    rotationID,
+				MemberId:      memberIDs[0],
+				OtherMemberId: memberIDs[2],
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(memberOrder(rotationRes)).Should(Equal([]string{memberIDs[2], memberIDs[1], memberIDs[0], memberIDs[3]}))
+		})
+
+		It("should fail to swap with a member outside the rotation", func() {
+			rotationRes, err := RotationAPI.SwapRotationTurns(ctx, &chama.SwapRotationTurnsRequest{
+				RotationId:    rotationID,
+				MemberId:      memberIDs[0],
+				OtherMemberId: "0",
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(rotationRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+})
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 
+	"github.com/gidyon/machama-app/internal/kyc"
 	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/internal/roles"
 	"github.com/gidyon/machama-app/pkg/api/transaction"
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
 	"github.com/gidyon/micro/v2/utils/errs"
@@ -24,9 +26,24 @@ type Options struct {
 	AllowedGroups []string
 }
 
+type transferKey struct{}
+
+// WithTransfer marks withdrawals made with the context as transfers between accounts of a chama. It is set in process
+// only, by services moving money from member accounts into chama accounts, and can't be set through the API.
+func WithTransfer(ctx context.Context) context.Context {
+	return context.WithValue(ctx, transferKey{}, true)
+}
+
+// IsTransfer checks whether withdrawals made with the context are transfers between accounts of a chama
+func IsTransfer(ctx context.Context) bool {
+	transfer, _ := ctx.Value(transferKey{}).(bool)
+	return transfer
+}
+
 type transactionAPIServer struct {
 	transaction.UnimplementedTransactionAPIServer
 	*Options
+	authorizer *roles.Authorizer
 }
 
 func NewTransactionAPI(ctx context.Context, opt *Options) (transaction.TransactionAPIServer, error) {
@@ -51,19 +68,14 @@ func NewTransactionAPI(ctx context.Context, opt *Options) (transaction.Transacti
 	}
 
 	transactionAPI := &transactionAPIServer{
-		Options: opt,
+		Options:    opt,
+		authorizer: roles.NewAuthorizer(opt.Auth, opt.SQLDB, opt.AllowedGroups),
 	}
 
 	return transactionAPI, nil
 }
 
 func (transactionAPI *transactionAPIServer) Deposit(ctx context.Context, req *transaction.DepositRequest) (*emptypb.Empty, error) {
-	// Authorization
-	_, err := transactionAPI.Auth.AuthorizeGroup(ctx, transactionAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validation
 	switch {
 	case req == nil:
@@ -80,14 +92,10 @@ func (transactionAPI *transactionAPIServer) Deposit(ctx context.Context, req *tr
 		return nil, errs.IncorrectVal("amount")
 	}
 
-	// Confirm account exist
-	err = transactionAPI.SQLDB.First(&models.ChamaAccount{}, "id = ?", req.AccountId).Error
-	switch {
-	case err == nil:
-	case errors.Is(err, gorm.ErrRecordNotFound):
-		return nil, errs.DoesExist("chama account", req.AccountId)
-	default:
-		return nil, errs.FailedToFind("chama account", err)
+	// Authorization
+	_, _, err := transactionAPI.authorizer.AuthorizeAccount(ctx, req.AccountId, roles.Treasury...)
+	if err != nil {
+		return nil, err
 	}
 
 	tx := transactionAPI.SQLDB.Begin()
@@ -136,12 +144,6 @@ func (transactionAPI *transactionAPIServer) Deposit(ctx context.Context, req *tr
 }
 
 func (transactionAPI *transactionAPIServer) Withdraw(ctx context.Context, req *transaction.WithdrawRequest) (*emptypb.Empty, error) {
-	// Authorization
-	_, err := transactionAPI.Auth.AuthorizeGroup(ctx, transactionAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validation
 	switch {
 	case req == nil:
@@ -158,14 +160,18 @@ func (transactionAPI *transactionAPIServer) Withdraw(ctx context.Context, req *t
 		return nil, errs.IncorrectVal("amount")
 	}
 
-	// Confirm account exist
-	err = transactionAPI.SQLDB.First(&models.ChamaAccount{}, "id = ?", req.AccountId).Error
-	switch {
-	case err == nil:
-	case errors.Is(err, gorm.ErrRecordNotFound):
-		return nil, errs.DoesExist("chama account", req.AccountId)
-	default:
-		return nil, errs.FailedToFind("chama account", err)
+	// Authorization
+	_, accountDB, err := transactionAPI.authorizer.AuthorizeAccount(ctx, req.AccountId, roles.Treasury...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Money is paid out of member accounts only to members whose KYC is verified
+	if !IsTransfer(ctx) && accountDB.OwnerID != roles.AccountChama(accountDB) {
+		err = kyc.RequireVerified(transactionAPI.SQLDB, accountDB.OwnerID)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	tx := transactionAPI.SQLDB.Begin()
@@ -192,10 +198,10 @@ func (transactionAPI *transactionAPIServer) Withdraw(ctx context.Context, req *t
 	}
 
 	// Withdraw amount
-	db := transactionAPI.SQLDB.Model(&models.ChamaAccount{}).
-		Where("id = ? AND available_amount >= ? AND withdrawable", req.AccountId, req.Amount, true).
+	db := tx.Model(&models.ChamaAccount{}).
+		Where("id = ? AND available_amount >= ? AND withdrawable = ?", req.AccountId, req.Amount, true).
 		Updates(map[string]interface{}{
-			"total_withdrawn_amount": gorm.Expr("total_deposited_amount + ?", req.Amount),
+			"total_withdrawn_amount": gorm.Expr("total_withdrawn_amount + ?", req.Amount),
 			"available_amount":       gorm.Expr("available_amount - ?", req.Amount),
 			"last_withdrawn_amount":  req.Amount,
 		})
@@ -205,6 +211,7 @@ func (transactionAPI *transactionAPIServer) Withdraw(ctx context.Context, req *t
 	}
 
 	if db.RowsAffected == 0 {
+		tx.Rollback()
 		return nil, errs.WrapMessage(codes.FailedPrecondition, "insufficient amount")
 	}
 
@@ -223,18 +230,18 @@ const defaultPageSize = 50
 func (transactionAPI *transactionAPIServer) ListTransactions(
 	ctx context.Context, req *transaction.ListTransactionsRequest,
 ) (*transaction.ListTransactionsResponse, error) {
-	// Authorization
-	actor, err := transactionAPI.Auth.AuthorizeGroup(ctx, transactionAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validation
 	switch {
 	case req == nil:
 		return nil, errs.NilObject("list request")
 	}
 
+	// Authorization
+	actor, chamaIDs, err := transactionAPI.authorizer.ScopeChamas(ctx, nil, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
 	pageSize := req.GetPageSize()
 	switch {
 	case pageSize <= 0:
@@ -261,6 +268,13 @@ func (transactionAPI *transactionAPIServer) ListTransactions(
 	}
 
 	// Apply filters
+	if chamaIDs != nil {
+		// Accounts that don't record their chama are owned by it
+		db = db.Where(
+			"account_id IN (SELECT CAST(id AS CHAR) FROM chama_accounts WHERE chama_id IN (?) OR (chama_id = '' AND owner_id IN (?)))",
+			chamaIDs, chamaIDs,
+		)
+	}
 	if req.Filter != nil {
 		if len(req.Filter.TransactionIds) != 0 {
 			db = db.Where("id IN (?)", req.Filter.TransactionIds)
@@ -318,12 +332,6 @@ func (transactionAPI *transactionAPIServer) ListTransactions(
 func (transactionAPI *transactionAPIServer) GetTransaction(
 	ctx context.Context, req *transaction.GetTransactionRequest,
 ) (*transaction.Transaction, error) {
-	// Authorization
-	_, err := transactionAPI.Auth.AuthorizeGroup(ctx, transactionAPI.AllowedGroups...)
-	if err != nil {
-		return nil, err
-	}
-
 	// Validation
 	switch {
 	case req == nil:
@@ -334,7 +342,7 @@ func (transactionAPI *transactionAPIServer) GetTransaction(
 
 	db := &models.Transaction{}
 
-	err = transactionAPI.SQLDB.First(db, "id = ?", req.TransactionId).Error
+	err := transactionAPI.SQLDB.First(db, "id = ?", req.TransactionId).Error
 	switch {
 	case err == nil:
 	case errors.Is(err, gorm.ErrRecordNotFound):
@@ -343,5 +351,11 @@ func (transactionAPI *transactionAPIServer) GetTransaction(
 		return nil, errs.FailedToFind("transaction", err)
 	}
 
+	// Authorization
+	_, _, err = transactionAPI.authorizer.AuthorizeAccount(ctx, db.AccountID, roles.Officers...)
+	if err != nil {
+		return nil, err
+	}
+
 	return models.TransactionProto(db)
 }
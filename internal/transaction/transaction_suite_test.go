@@ -28,6 +28,8 @@ var (
 	TransactionAPI       transaction.TransactionAPIServer
 	modelsStructs        = []interface{}{
 		&models.Transaction{},
+		&models.ChamaAccount{},
+		&models.MemberKYC{},
 	}
 	schema = "machama"
 )
@@ -2,8 +2,11 @@ package transaction
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/machama-app/internal/models"
+	"github.com/gidyon/machama-app/pkg/api/chama"
 	"github.com/gidyon/machama-app/pkg/api/transaction"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -80,4 +83,120 @@ var _ = Describe("Withdraw", func() {
 			Expect(status.Code(err)).Should(Equal(codes.OK))
 		})
 	})
+
+	Describe("Withdraw from chama accounts", func() {
+		var accountDB *models.ChamaAccount
+
+		getAccount := func() *models.ChamaAccount {
+			db := &models.ChamaAccount{}
+			Expect(TransactionAPIServer.SQLDB.First(db, "id = ?", accountDB.ID).Error).ShouldNot(HaveOccurred())
+			return db
+		}
+
+		countTransactions := func() int64 {
+			var count int64
+			Expect(TransactionAPIServer.SQLDB.Model(&models.Transaction{}).
+				Where("account_id = ?", fmt.Sprint(accountDB.ID)).Count(&count).Error).ShouldNot(HaveOccurred())
+			return count
+		}
+
+		BeforeEach(func() {
+			chamaID := fmt.Sprint(randomdata.Number(100, 100000))
+			accountDB = &models.ChamaAccount{
+				OwnerID:              chamaID,
+				ChamaID:              chamaID,
+				AccountName:          "loans",
+				AccountType:          transaction.AccountType_SAVINGS_ACCOUNT.String(),
+				TotalDepositedAmount: 5000,
+				TotalWithdrawnAmount: 1000,
+				AvailableAmount:      4000,
+				Withdrawable:         true,
+				Active:               true,
+			}
+			Expect(TransactionAPIServer.SQLDB.Create(accountDB).Error).ShouldNot(HaveOccurred())
+
+			withdrawReq.AccountId = fmt.Sprint(accountDB.ID)
+			withdrawReq.Amount = 500
+		})
+
+		It("should add the amount to the total withdrawn", func() {
+			_, err := TransactionAPI.Withdraw(ctx, withdrawReq)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			db := getAccount()
+			Expect(db.TotalWithdrawnAmount).Should(BeNumerically("~", 1500, 0.001))
+			Expect(db.TotalDepositedAmount).Should(BeNumerically("~", 5000, 0.001))
+			Expect(db.AvailableAmount).Should(BeNumerically("~", 3500, 0.001))
+			Expect(countTransactions()).Should(BeEquivalentTo(1))
+		})
+
+		It("should fail without recording a transaction when the account is not withdrawable", func() {
+			Expect(TransactionAPIServer.SQLDB.Model(accountDB).Update("withdrawable", false).Error).ShouldNot(HaveOccurred())
+
+			withdrawRes, err := TransactionAPI.Withdraw(ctx, withdrawReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(withdrawRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			Expect(getAccount().AvailableAmount).Should(BeNumerically("~", 4000, 0.001))
+			Expect(countTransactions()).Should(BeZero())
+		})
+
+		It("should fail without recording a transaction when the balance is insufficient", func() {
+			withdrawReq.Amount = 4001
+
+			withdrawRes, err := TransactionAPI.Withdraw(ctx, withdrawReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(withdrawRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			db := getAccount()
+			Expect(db.AvailableAmount).Should(BeNumerically("~", 4000, 0.001))
+			Expect(db.TotalWithdrawnAmount).Should(BeNumerically("~", 1000, 0.001))
+			Expect(countTransactions()).Should(BeZero())
+		})
+	})
+
+	Describe("Withdraw from member accounts", func() {
+		var accountDB *models.ChamaAccount
+
+		BeforeEach(func() {
+			accountDB = &models.ChamaAccount{
+				OwnerID:         fmt.Sprint(randomdata.Number(100, 100000)),
+				ChamaID:         randomID(),
+				AccountName:     "savings",
+				AccountType:     transaction.AccountType_SAVINGS_ACCOUNT.String(),
+				AvailableAmount: 1000,
+				Withdrawable:    true,
+				Active:          true,
+			}
+			Expect(TransactionAPIServer.SQLDB.Create(accountDB).Error).ShouldNot(HaveOccurred())
+
+			withdrawReq.AccountId = fmt.Sprint(accountDB.ID)
+			withdrawReq.Amount = 100
+		})
+
+		It("should fail when member KYC is not verified", func() {
+			withdrawRes, err := TransactionAPI.Withdraw(ctx, withdrawReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(withdrawRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should allow transfers to other chama accounts", func() {
+			_, err := TransactionAPI.Withdraw(WithTransfer(ctx), withdrawReq)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should succeed when member KYC is verified", func() {
+			Expect(TransactionAPIServer.SQLDB.Create(&models.MemberKYC{
+				MemberID: accountDB.OwnerID,
+				ChamaID:  accountDB.ChamaID,
+				Status:   chama.KycStatus_KYC_VERIFIED.String(),
+			}).Error).ShouldNot(HaveOccurred())
+
+			_, err := TransactionAPI.Withdraw(ctx, withdrawReq)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
 })
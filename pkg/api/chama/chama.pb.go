@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
-// 	protoc        v3.14.0
+// 	protoc-gen-go v1.26.0
+// 	protoc        (unknown)
 // source: chama.proto
 
 package chama
@@ -22,678 +22,755 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type Chama struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ChamaRole int32
 
-	ChamaId        string  `protobuf:"bytes,1,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
-	CreatorId      string  `protobuf:"bytes,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	Name           string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description    string  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Status         string  `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	AccountBalance float64 `protobuf:"fixed64,6,opt,name=account_balance,json=accountBalance,proto3" json:"account_balance,omitempty"`
-	Active         bool    `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
-	UpdatedDate    string  `protobuf:"bytes,8,opt,name=updated_date,json=updatedDate,proto3" json:"updated_date,omitempty"`
-	CreatedDate    string  `protobuf:"bytes,9,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
-}
+const (
+	ChamaRole_ROLE_MEMBER ChamaRole = 0
+	ChamaRole_CHAIRMAN    ChamaRole = 1
+	ChamaRole_TREASURER   ChamaRole = 2
+	ChamaRole_SECRETARY   ChamaRole = 3
+)
 
-func (x *Chama) Reset() {
-	*x = Chama{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chama_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for ChamaRole.
+var (
+	ChamaRole_name = map[int32]string{
+		0: "ROLE_MEMBER",
+		1: "CHAIRMAN",
+		2: "TREASURER",
+		3: "SECRETARY",
 	}
-}
+	ChamaRole_value = map[string]int32{
+		"ROLE_MEMBER": 0,
+		"CHAIRMAN":    1,
+		"TREASURER":   2,
+		"SECRETARY":   3,
+	}
+)
 
-func (x *Chama) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ChamaRole) Enum() *ChamaRole {
+	p := new(ChamaRole)
+	*p = x
+	return p
 }
 
-func (*Chama) ProtoMessage() {}
-
-func (x *Chama) ProtoReflect() protoreflect.Message {
-	mi := &file_chama_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x ChamaRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// Deprecated: Use Chama.ProtoReflect.Descriptor instead.
-func (*Chama) Descriptor() ([]byte, []int) {
-	return file_chama_proto_rawDescGZIP(), []int{0}
+func (ChamaRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_chama_proto_enumTypes[0].Descriptor()
 }
 
-func (x *Chama) GetChamaId() string {
-	if x != nil {
-		return x.ChamaId
-	}
-	return ""
+func (ChamaRole) Type() protoreflect.EnumType {
+	return &file_chama_proto_enumTypes[0]
 }
 
-func (x *Chama) GetCreatorId() string {
-	if x != nil {
-		return x.CreatorId
-	}
-	return ""
+func (x ChamaRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Chama) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use ChamaRole.Descriptor instead.
+func (ChamaRole) EnumDescriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Chama) GetDescription() string {
-	if x != nil {
-		return x.Description
+type MemberActiveFilter int32
+
+const (
+	MemberActiveFilter_MEMBERS_ACTIVE_ANY    MemberActiveFilter = 0
+	MemberActiveFilter_MEMBERS_ACTIVE_ONLY   MemberActiveFilter = 1
+	MemberActiveFilter_MEMBERS_INACTIVE_ONLY MemberActiveFilter = 2
+)
+
+// Enum value maps for MemberActiveFilter.
+var (
+	MemberActiveFilter_name = map[int32]string{
+		0: "MEMBERS_ACTIVE_ANY",
+		1: "MEMBERS_ACTIVE_ONLY",
+		2: "MEMBERS_INACTIVE_ONLY",
 	}
-	return ""
+	MemberActiveFilter_value = map[string]int32{
+		"MEMBERS_ACTIVE_ANY":    0,
+		"MEMBERS_ACTIVE_ONLY":   1,
+		"MEMBERS_INACTIVE_ONLY": 2,
+	}
+)
+
+func (x MemberActiveFilter) Enum() *MemberActiveFilter {
+	p := new(MemberActiveFilter)
+	*p = x
+	return p
 }
 
-func (x *Chama) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+func (x MemberActiveFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Chama) GetAccountBalance() float64 {
-	if x != nil {
-		return x.AccountBalance
-	}
-	return 0
+func (MemberActiveFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_chama_proto_enumTypes[1].Descriptor()
 }
 
-func (x *Chama) GetActive() bool {
-	if x != nil {
-		return x.Active
-	}
-	return false
+func (MemberActiveFilter) Type() protoreflect.EnumType {
+	return &file_chama_proto_enumTypes[1]
 }
 
-func (x *Chama) GetUpdatedDate() string {
-	if x != nil {
-		return x.UpdatedDate
-	}
-	return ""
+func (x MemberActiveFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Chama) GetCreatedDate() string {
-	if x != nil {
-		return x.CreatedDate
-	}
-	return ""
+// Deprecated: Use MemberActiveFilter.Descriptor instead.
+func (MemberActiveFilter) EnumDescriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{1}
 }
 
-type TrustPerson struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type MemberSortOrder int32
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Phone string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-}
+const (
+	MemberSortOrder_MEMBER_SORT_NEWEST    MemberSortOrder = 0
+	MemberSortOrder_MEMBER_SORT_OLDEST    MemberSortOrder = 1
+	MemberSortOrder_MEMBER_SORT_NAME_ASC  MemberSortOrder = 2
+	MemberSortOrder_MEMBER_SORT_NAME_DESC MemberSortOrder = 3
+)
 
-func (x *TrustPerson) Reset() {
-	*x = TrustPerson{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chama_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for MemberSortOrder.
+var (
+	MemberSortOrder_name = map[int32]string{
+		0: "MEMBER_SORT_NEWEST",
+		1: "MEMBER_SORT_OLDEST",
+		2: "MEMBER_SORT_NAME_ASC",
+		3: "MEMBER_SORT_NAME_DESC",
 	}
+	MemberSortOrder_value = map[string]int32{
+		"MEMBER_SORT_NEWEST":    0,
+		"MEMBER_SORT_OLDEST":    1,
+		"MEMBER_SORT_NAME_ASC":  2,
+		"MEMBER_SORT_NAME_DESC": 3,
+	}
+)
+
+func (x MemberSortOrder) Enum() *MemberSortOrder {
+	p := new(MemberSortOrder)
+	*p = x
+	return p
 }
 
-func (x *TrustPerson) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x MemberSortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*TrustPerson) ProtoMessage() {}
+func (MemberSortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_chama_proto_enumTypes[2].Descriptor()
+}
 
-func (x *TrustPerson) ProtoReflect() protoreflect.Message {
-	mi := &file_chama_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (MemberSortOrder) Type() protoreflect.EnumType {
+	return &file_chama_proto_enumTypes[2]
 }
 
-// Deprecated: Use TrustPerson.ProtoReflect.Descriptor instead.
-func (*TrustPerson) Descriptor() ([]byte, []int) {
-	return file_chama_proto_rawDescGZIP(), []int{1}
+func (x MemberSortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *TrustPerson) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use MemberSortOrder.Descriptor instead.
+func (MemberSortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *TrustPerson) GetEmail() string {
-	if x != nil {
-		return x.Email
+type ContributionFrequency int32
+
+const (
+	ContributionFrequency_FREQUENCY_UNSPECIFIED ContributionFrequency = 0
+	ContributionFrequency_WEEKLY                ContributionFrequency = 1
+	ContributionFrequency_FORTNIGHTLY           ContributionFrequency = 2
+	ContributionFrequency_MONTHLY               ContributionFrequency = 3
+)
+
+// Enum value maps for ContributionFrequency.
+var (
+	ContributionFrequency_name = map[int32]string{
+		0: "FREQUENCY_UNSPECIFIED",
+		1: "WEEKLY",
+		2: "FORTNIGHTLY",
+		3: "MONTHLY",
 	}
-	return ""
+	ContributionFrequency_value = map[string]int32{
+		"FREQUENCY_UNSPECIFIED": 0,
+		"WEEKLY":                1,
+		"FORTNIGHTLY":           2,
+		"MONTHLY":               3,
+	}
+)
+
+func (x ContributionFrequency) Enum() *ContributionFrequency {
+	p := new(ContributionFrequency)
+	*p = x
+	return p
 }
 
-func (x *TrustPerson) GetPhone() string {
-	if x != nil {
-		return x.Phone
-	}
-	return ""
+func (x ContributionFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type ChamaMember struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (ContributionFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_chama_proto_enumTypes[3].Descriptor()
+}
 
-	MemberId      string            `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
-	ChamaId       string            `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
-	FirstName     string            `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName      string            `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Email         string            `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
-	Phone         string            `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
-	IdNumber      string            `protobuf:"bytes,7,opt,name=id_number,json=idNumber,proto3" json:"id_number,omitempty"`
-	Residence     string            `protobuf:"bytes,8,opt,name=residence,proto3" json:"residence,omitempty"`
-	JobDetails    map[string]string `protobuf:"bytes,9,rep,name=job_details,json=jobDetails,proto3" json:"job_details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Kyc           map[string]string `protobuf:"bytes,10,rep,name=kyc,proto3" json:"kyc,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Beneficiaries []*TrustPerson    `protobuf:"bytes,11,rep,name=beneficiaries,proto3" json:"beneficiaries,omitempty"`
-	Guarantees    []*TrustPerson    `protobuf:"bytes,12,rep,name=guarantees,proto3" json:"guarantees,omitempty"`
-	Active        bool              `protobuf:"varint,13,opt,name=active,proto3" json:"active,omitempty"`
-	Status        string            `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
-	UpdatedDate   string            `protobuf:"bytes,15,opt,name=updated_date,json=updatedDate,proto3" json:"updated_date,omitempty"`
-	RegisterDate  string            `protobuf:"bytes,16,opt,name=register_date,json=registerDate,proto3" json:"register_date,omitempty"`
+func (ContributionFrequency) Type() protoreflect.EnumType {
+	return &file_chama_proto_enumTypes[3]
 }
 
-func (x *ChamaMember) Reset() {
-	*x = ChamaMember{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chama_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x ContributionFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *ChamaMember) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use ContributionFrequency.Descriptor instead.
+func (ContributionFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{3}
 }
 
-func (*ChamaMember) ProtoMessage() {}
+type RotationOrder int32
 
-func (x *ChamaMember) ProtoReflect() protoreflect.Message {
-	mi := &file_chama_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	RotationOrder_FIXED_ORDER RotationOrder = 0
+	RotationOrder_RANDOM_DRAW RotationOrder = 1
+	RotationOrder_BIDDING     RotationOrder = 2
+)
+
+// Enum value maps for RotationOrder.
+var (
+	RotationOrder_name = map[int32]string{
+		0: "FIXED_ORDER",
+		1: "RANDOM_DRAW",
+		2: "BIDDING",
 	}
-	return mi.MessageOf(x)
+	RotationOrder_value = map[string]int32{
+		"FIXED_ORDER": 0,
+		"RANDOM_DRAW": 1,
+		"BIDDING":     2,
+	}
+)
+
+func (x RotationOrder) Enum() *RotationOrder {
+	p := new(RotationOrder)
+	*p = x
+	return p
 }
 
-// Deprecated: Use ChamaMember.ProtoReflect.Descriptor instead.
-func (*ChamaMember) Descriptor() ([]byte, []int) {
-	return file_chama_proto_rawDescGZIP(), []int{2}
+func (x RotationOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *ChamaMember) GetMemberId() string {
-	if x != nil {
-		return x.MemberId
-	}
-	return ""
+func (RotationOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_chama_proto_enumTypes[4].Descriptor()
 }
 
-func (x *ChamaMember) GetChamaId() string {
-	if x != nil {
-		return x.ChamaId
-	}
-	return ""
+func (RotationOrder) Type() protoreflect.EnumType {
+	return &file_chama_proto_enumTypes[4]
 }
 
-func (x *ChamaMember) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+func (x RotationOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *ChamaMember) GetLastName() string {
-	if x != nil {
-		return x.LastName
-	}
-	return ""
+// Deprecated: Use RotationOrder.Descriptor instead.
+func (RotationOrder) EnumDescriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ChamaMember) GetEmail() string {
-	if x != nil {
-		return x.Email
+type RotationStatus int32
+
+const (
+	RotationStatus_ROTATION_ACTIVE    RotationStatus = 0
+	RotationStatus_ROTATION_COMPLETED RotationStatus = 1
+)
+
+// Enum value maps for RotationStatus.
+var (
+	RotationStatus_name = map[int32]string{
+		0: "ROTATION_ACTIVE",
+		1: "ROTATION_COMPLETED",
 	}
-	return ""
+	RotationStatus_value = map[string]int32{
+		"ROTATION_ACTIVE":    0,
+		"ROTATION_COMPLETED": 1,
+	}
+)
+
+func (x RotationStatus) Enum() *RotationStatus {
+	p := new(RotationStatus)
+	*p = x
+	return p
 }
 
-func (x *ChamaMember) GetPhone() string {
-	if x != nil {
-		return x.Phone
-	}
-	return ""
+func (x RotationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *ChamaMember) GetIdNumber() string {
-	if x != nil {
-		return x.IdNumber
-	}
-	return ""
+func (RotationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_chama_proto_enumTypes[5].Descriptor()
 }
 
-func (x *ChamaMember) GetResidence() string {
-	if x != nil {
-		return x.Residence
-	}
-	return ""
+func (RotationStatus) Type() protoreflect.EnumType {
+	return &file_chama_proto_enumTypes[5]
 }
 
-func (x *ChamaMember) GetJobDetails() map[string]string {
-	if x != nil {
-		return x.JobDetails
-	}
-	return nil
+func (x RotationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *ChamaMember) GetKyc() map[string]string {
-	if x != nil {
-		return x.Kyc
-	}
-	return nil
+// Deprecated: Use RotationStatus.Descriptor instead.
+func (RotationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ChamaMember) GetBeneficiaries() []*TrustPerson {
-	if x != nil {
-		return x.Beneficiaries
+type MeetingStatus int32
+
+const (
+	MeetingStatus_MEETING_SCHEDULED MeetingStatus = 0
+	MeetingStatus_MEETING_HELD      MeetingStatus = 1
+	MeetingStatus_MEETING_CANCELLED MeetingStatus = 2
+)
+
+// Enum value maps for MeetingStatus.
+var (
+	MeetingStatus_name = map[int32]string{
+		0: "MEETING_SCHEDULED",
+		1: "MEETING_HELD",
+		2: "MEETING_CANCELLED",
 	}
-	return nil
+	MeetingStatus_value = map[string]int32{
+		"MEETING_SCHEDULED": 0,
+		"MEETING_HELD":      1,
+		"MEETING_CANCELLED": 2,
+	}
+)
+
+func (x MeetingStatus) Enum() *MeetingStatus {
+	p := new(MeetingStatus)
+	*p = x
+	return p
 }
 
-func (x *ChamaMember) GetGuarantees() []*TrustPerson {
-	if x != nil {
-		return x.Guarantees
-	}
-	return nil
+func (x MeetingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *ChamaMember) GetActive() bool {
-	if x != nil {
-		return x.Active
-	}
-	return false
+func (MeetingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_chama_proto_enumTypes[6].Descriptor()
 }
 
-func (x *ChamaMember) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+func (MeetingStatus) Type() protoreflect.EnumType {
+	return &file_chama_proto_enumTypes[6]
 }
 
-func (x *ChamaMember) GetUpdatedDate() string {
-	if x != nil {
-		return x.UpdatedDate
-	}
-	return ""
+func (x MeetingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *ChamaMember) GetRegisterDate() string {
-	if x != nil {
-		return x.RegisterDate
-	}
-	return ""
+// Deprecated: Use MeetingStatus.Descriptor instead.
+func (MeetingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{6}
 }
 
-type CreateChamaRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type AttendanceStatus int32
 
-	Chama *Chama `protobuf:"bytes,1,opt,name=chama,proto3" json:"chama,omitempty"`
-}
+const (
+	AttendanceStatus_ATTENDANCE_UNSPECIFIED AttendanceStatus = 0
+	AttendanceStatus_PRESENT                AttendanceStatus = 1
+	AttendanceStatus_LATE                   AttendanceStatus = 2
+	AttendanceStatus_ABSENT                 AttendanceStatus = 3
+	AttendanceStatus_ABSENT_WITH_APOLOGY    AttendanceStatus = 4
+)
 
-func (x *CreateChamaRequest) Reset() {
-	*x = CreateChamaRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chama_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for AttendanceStatus.
+var (
+	AttendanceStatus_name = map[int32]string{
+		0: "ATTENDANCE_UNSPECIFIED",
+		1: "PRESENT",
+		2: "LATE",
+		3: "ABSENT",
+		4: "ABSENT_WITH_APOLOGY",
 	}
+	AttendanceStatus_value = map[string]int32{
+		"ATTENDANCE_UNSPECIFIED": 0,
+		"PRESENT":                1,
+		"LATE":                   2,
+		"ABSENT":                 3,
+		"ABSENT_WITH_APOLOGY":    4,
+	}
+)
+
+func (x AttendanceStatus) Enum() *AttendanceStatus {
+	p := new(AttendanceStatus)
+	*p = x
+	return p
 }
 
-func (x *CreateChamaRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x AttendanceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*CreateChamaRequest) ProtoMessage() {}
+func (AttendanceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_chama_proto_enumTypes[7].Descriptor()
+}
 
-func (x *CreateChamaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chama_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (AttendanceStatus) Type() protoreflect.EnumType {
+	return &file_chama_proto_enumTypes[7]
 }
 
-// Deprecated: Use CreateChamaRequest.ProtoReflect.Descriptor instead.
-func (*CreateChamaRequest) Descriptor() ([]byte, []int) {
-	return file_chama_proto_rawDescGZIP(), []int{3}
+func (x AttendanceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreateChamaRequest) GetChama() *Chama {
-	if x != nil {
-		return x.Chama
-	}
-	return nil
+// Deprecated: Use AttendanceStatus.Descriptor instead.
+func (AttendanceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{7}
 }
 
-type UpdateChamaRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type FineStatus int32
 
-	Chama *Chama `protobuf:"bytes,1,opt,name=chama,proto3" json:"chama,omitempty"`
-}
+const (
+	FineStatus_FINE_OUTSTANDING    FineStatus = 0
+	FineStatus_FINE_WAIVER_PENDING FineStatus = 1
+	FineStatus_FINE_PAID           FineStatus = 2
+	FineStatus_FINE_WAIVED         FineStatus = 3
+)
 
-func (x *UpdateChamaRequest) Reset() {
-	*x = UpdateChamaRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chama_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for FineStatus.
+var (
+	FineStatus_name = map[int32]string{
+		0: "FINE_OUTSTANDING",
+		1: "FINE_WAIVER_PENDING",
+		2: "FINE_PAID",
+		3: "FINE_WAIVED",
+	}
+	FineStatus_value = map[string]int32{
+		"FINE_OUTSTANDING":    0,
+		"FINE_WAIVER_PENDING": 1,
+		"FINE_PAID":           2,
+		"FINE_WAIVED":         3,
 	}
+)
+
+func (x FineStatus) Enum() *FineStatus {
+	p := new(FineStatus)
+	*p = x
+	return p
 }
 
-func (x *UpdateChamaRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x FineStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*UpdateChamaRequest) ProtoMessage() {}
+func (FineStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_chama_proto_enumTypes[8].Descriptor()
+}
 
-func (x *UpdateChamaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chama_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (FineStatus) Type() protoreflect.EnumType {
+	return &file_chama_proto_enumTypes[8]
 }
 
-// Deprecated: Use UpdateChamaRequest.ProtoReflect.Descriptor instead.
-func (*UpdateChamaRequest) Descriptor() ([]byte, []int) {
-	return file_chama_proto_rawDescGZIP(), []int{4}
+func (x FineStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UpdateChamaRequest) GetChama() *Chama {
-	if x != nil {
-		return x.Chama
-	}
-	return nil
+// Deprecated: Use FineStatus.Descriptor instead.
+func (FineStatus) EnumDescriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{8}
 }
 
-type ChamaFilter struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type InvitationStatus int32
 
-	CreatorIds []string `protobuf:"bytes,1,rep,name=creator_ids,json=creatorIds,proto3" json:"creator_ids,omitempty"`
-}
+const (
+	InvitationStatus_INVITATION_PENDING   InvitationStatus = 0
+	InvitationStatus_INVITATION_SUBMITTED InvitationStatus = 1
+	InvitationStatus_INVITATION_CONFIRMED InvitationStatus = 2
+	InvitationStatus_INVITATION_REVOKED   InvitationStatus = 3
+	InvitationStatus_INVITATION_EXPIRED   InvitationStatus = 4
+)
 
-func (x *ChamaFilter) Reset() {
-	*x = ChamaFilter{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chama_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for InvitationStatus.
+var (
+	InvitationStatus_name = map[int32]string{
+		0: "INVITATION_PENDING",
+		1: "INVITATION_SUBMITTED",
+		2: "INVITATION_CONFIRMED",
+		3: "INVITATION_REVOKED",
+		4: "INVITATION_EXPIRED",
 	}
+	InvitationStatus_value = map[string]int32{
+		"INVITATION_PENDING":   0,
+		"INVITATION_SUBMITTED": 1,
+		"INVITATION_CONFIRMED": 2,
+		"INVITATION_REVOKED":   3,
+		"INVITATION_EXPIRED":   4,
+	}
+)
+
+func (x InvitationStatus) Enum() *InvitationStatus {
+	p := new(InvitationStatus)
+	*p = x
+	return p
 }
 
-func (x *ChamaFilter) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x InvitationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*ChamaFilter) ProtoMessage() {}
+func (InvitationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_chama_proto_enumTypes[9].Descriptor()
+}
 
-func (x *ChamaFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_chama_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (InvitationStatus) Type() protoreflect.EnumType {
+	return &file_chama_proto_enumTypes[9]
 }
 
-// Deprecated: Use ChamaFilter.ProtoReflect.Descriptor instead.
-func (*ChamaFilter) Descriptor() ([]byte, []int) {
-	return file_chama_proto_rawDescGZIP(), []int{5}
+func (x InvitationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *ChamaFilter) GetCreatorIds() []string {
-	if x != nil {
-		return x.CreatorIds
-	}
-	return nil
+// Deprecated: Use InvitationStatus.Descriptor instead.
+func (InvitationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{9}
 }
 
-type ListChamasRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type SettlementLineType int32
 
-	Filter    *ChamaFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	PageToken string       `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	PageSize  int32        `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-}
+const (
+	SettlementLineType_SETTLEMENT_SAVINGS       SettlementLineType = 0
+	SettlementLineType_SETTLEMENT_SHARE_CAPITAL SettlementLineType = 1
+	SettlementLineType_SETTLEMENT_LOAN          SettlementLineType = 2
+	SettlementLineType_SETTLEMENT_FINE          SettlementLineType = 3
+	SettlementLineType_SETTLEMENT_GUARANTEE     SettlementLineType = 4
+)
 
-func (x *ListChamasRequest) Reset() {
-	*x = ListChamasRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chama_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for SettlementLineType.
+var (
+	SettlementLineType_name = map[int32]string{
+		0: "SETTLEMENT_SAVINGS",
+		1: "SETTLEMENT_SHARE_CAPITAL",
+		2: "SETTLEMENT_LOAN",
+		3: "SETTLEMENT_FINE",
+		4: "SETTLEMENT_GUARANTEE",
+	}
+	SettlementLineType_value = map[string]int32{
+		"SETTLEMENT_SAVINGS":       0,
+		"SETTLEMENT_SHARE_CAPITAL": 1,
+		"SETTLEMENT_LOAN":          2,
+		"SETTLEMENT_FINE":          3,
+		"SETTLEMENT_GUARANTEE":     4,
 	}
+)
+
+func (x SettlementLineType) Enum() *SettlementLineType {
+	p := new(SettlementLineType)
+	*p = x
+	return p
 }
 
-func (x *ListChamasRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x SettlementLineType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*ListChamasRequest) ProtoMessage() {}
+func (SettlementLineType) Descriptor() protoreflect.EnumDescriptor {
+	return file_chama_proto_enumTypes[10].Descriptor()
+}
 
-func (x *ListChamasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chama_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (SettlementLineType) Type() protoreflect.EnumType {
+	return &file_chama_proto_enumTypes[10]
 }
 
-// Deprecated: Use ListChamasRequest.ProtoReflect.Descriptor instead.
-func (*ListChamasRequest) Descriptor() ([]byte, []int) {
-	return file_chama_proto_rawDescGZIP(), []int{6}
+func (x SettlementLineType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *ListChamasRequest) GetFilter() *ChamaFilter {
-	if x != nil {
-		return x.Filter
-	}
-	return nil
+// Deprecated: Use SettlementLineType.Descriptor instead.
+func (SettlementLineType) EnumDescriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListChamasRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
+type ExitStatus int32
+
+const (
+	ExitStatus_EXIT_PREVIEW   ExitStatus = 0
+	ExitStatus_EXIT_SETTLING  ExitStatus = 1
+	ExitStatus_EXIT_COMPLETED ExitStatus = 2
+)
+
+// Enum value maps for ExitStatus.
+var (
+	ExitStatus_name = map[int32]string{
+		0: "EXIT_PREVIEW",
+		1: "EXIT_SETTLING",
+		2: "EXIT_COMPLETED",
 	}
-	return ""
+	ExitStatus_value = map[string]int32{
+		"EXIT_PREVIEW":   0,
+		"EXIT_SETTLING":  1,
+		"EXIT_COMPLETED": 2,
+	}
+)
+
+func (x ExitStatus) Enum() *ExitStatus {
+	p := new(ExitStatus)
+	*p = x
+	return p
 }
 
-func (x *ListChamasRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+func (x ExitStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type ListChamasResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (ExitStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_chama_proto_enumTypes[11].Descriptor()
+}
 
-	Chamas        []*Chama `protobuf:"bytes,1,rep,name=chamas,proto3" json:"chamas,omitempty"`
-	NextPageToken string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+func (ExitStatus) Type() protoreflect.EnumType {
+	return &file_chama_proto_enumTypes[11]
 }
 
-func (x *ListChamasResponse) Reset() {
-	*x = ListChamasResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chama_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x ExitStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *ListChamasResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use ExitStatus.Descriptor instead.
+func (ExitStatus) EnumDescriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{11}
 }
 
-func (*ListChamasResponse) ProtoMessage() {}
+type KycStatus int32
 
-func (x *ListChamasResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chama_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	KycStatus_KYC_NOT_SUBMITTED KycStatus = 0
+	KycStatus_KYC_PENDING       KycStatus = 1
+	KycStatus_KYC_VERIFIED      KycStatus = 2
+	KycStatus_KYC_REJECTED      KycStatus = 3
+)
+
+// Enum value maps for KycStatus.
+var (
+	KycStatus_name = map[int32]string{
+		0: "KYC_NOT_SUBMITTED",
+		1: "KYC_PENDING",
+		2: "KYC_VERIFIED",
+		3: "KYC_REJECTED",
 	}
-	return mi.MessageOf(x)
+	KycStatus_value = map[string]int32{
+		"KYC_NOT_SUBMITTED": 0,
+		"KYC_PENDING":       1,
+		"KYC_VERIFIED":      2,
+		"KYC_REJECTED":      3,
+	}
+)
+
+func (x KycStatus) Enum() *KycStatus {
+	p := new(KycStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use ListChamasResponse.ProtoReflect.Descriptor instead.
-func (*ListChamasResponse) Descriptor() ([]byte, []int) {
-	return file_chama_proto_rawDescGZIP(), []int{7}
+func (x KycStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *ListChamasResponse) GetChamas() []*Chama {
-	if x != nil {
-		return x.Chamas
-	}
-	return nil
+func (KycStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_chama_proto_enumTypes[12].Descriptor()
 }
 
-func (x *ListChamasResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
+func (KycStatus) Type() protoreflect.EnumType {
+	return &file_chama_proto_enumTypes[12]
 }
 
-type GetChamaRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x KycStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	ChamaId string `protobuf:"bytes,1,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+// Deprecated: Use KycStatus.Descriptor instead.
+func (KycStatus) EnumDescriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetChamaRequest) Reset() {
-	*x = GetChamaRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chama_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type KycDocumentType int32
+
+const (
+	KycDocumentType_KYC_ID_FRONT KycDocumentType = 0
+	KycDocumentType_KYC_ID_BACK  KycDocumentType = 1
+	KycDocumentType_KYC_SELFIE   KycDocumentType = 2
+)
+
+// Enum value maps for KycDocumentType.
+var (
+	KycDocumentType_name = map[int32]string{
+		0: "KYC_ID_FRONT",
+		1: "KYC_ID_BACK",
+		2: "KYC_SELFIE",
+	}
+	KycDocumentType_value = map[string]int32{
+		"KYC_ID_FRONT": 0,
+		"KYC_ID_BACK":  1,
+		"KYC_SELFIE":   2,
 	}
+)
+
+func (x KycDocumentType) Enum() *KycDocumentType {
+	p := new(KycDocumentType)
+	*p = x
+	return p
 }
 
-func (x *GetChamaRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x KycDocumentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetChamaRequest) ProtoMessage() {}
+func (KycDocumentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_chama_proto_enumTypes[13].Descriptor()
+}
 
-func (x *GetChamaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chama_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (KycDocumentType) Type() protoreflect.EnumType {
+	return &file_chama_proto_enumTypes[13]
 }
 
-// Deprecated: Use GetChamaRequest.ProtoReflect.Descriptor instead.
-func (*GetChamaRequest) Descriptor() ([]byte, []int) {
-	return file_chama_proto_rawDescGZIP(), []int{8}
+func (x KycDocumentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetChamaRequest) GetChamaId() string {
-	if x != nil {
-		return x.ChamaId
-	}
-	return ""
+// Deprecated: Use KycDocumentType.Descriptor instead.
+func (KycDocumentType) EnumDescriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{13}
 }
 
-type CreateChamaMemberRequest struct {
+type Chama struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChamaMember *ChamaMember `protobuf:"bytes,1,opt,name=chama_member,json=chamaMember,proto3" json:"chama_member,omitempty"`
+	ChamaId        string  `protobuf:"bytes,1,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	CreatorId      string  `protobuf:"bytes,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Name           string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Status         string  `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	AccountBalance float64 `protobuf:"fixed64,6,opt,name=account_balance,json=accountBalance,proto3" json:"account_balance,omitempty"`
+	Active         bool    `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	UpdatedDate    string  `protobuf:"bytes,8,opt,name=updated_date,json=updatedDate,proto3" json:"updated_date,omitempty"`
+	CreatedDate    string  `protobuf:"bytes,9,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
 }
 
-func (x *CreateChamaMemberRequest) Reset() {
-	*x = CreateChamaMemberRequest{}
+func (x *Chama) Reset() {
+	*x = Chama{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chama_proto_msgTypes[9]
+		mi := &file_chama_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateChamaMemberRequest) String() string {
+func (x *Chama) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChamaMemberRequest) ProtoMessage() {}
+func (*Chama) ProtoMessage() {}
 
-func (x *CreateChamaMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chama_proto_msgTypes[9]
+func (x *Chama) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -704,90 +781,101 @@ func (x *CreateChamaMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChamaMemberRequest.ProtoReflect.Descriptor instead.
-func (*CreateChamaMemberRequest) Descriptor() ([]byte, []int) {
-	return file_chama_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Chama.ProtoReflect.Descriptor instead.
+func (*Chama) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *CreateChamaMemberRequest) GetChamaMember() *ChamaMember {
+func (x *Chama) GetChamaId() string {
 	if x != nil {
-		return x.ChamaMember
+		return x.ChamaId
 	}
-	return nil
+	return ""
 }
 
-type UpdateChamaMemberRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Chama) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
 
-	ChamaMember *ChamaMember `protobuf:"bytes,1,opt,name=chama_member,json=chamaMember,proto3" json:"chama_member,omitempty"`
+func (x *Chama) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *UpdateChamaMemberRequest) Reset() {
-	*x = UpdateChamaMemberRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chama_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Chama) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
+	return ""
 }
 
-func (x *UpdateChamaMemberRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Chama) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (*UpdateChamaMemberRequest) ProtoMessage() {}
+func (x *Chama) GetAccountBalance() float64 {
+	if x != nil {
+		return x.AccountBalance
+	}
+	return 0
+}
 
-func (x *UpdateChamaMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chama_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Chama) GetActive() bool {
+	if x != nil {
+		return x.Active
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use UpdateChamaMemberRequest.ProtoReflect.Descriptor instead.
-func (*UpdateChamaMemberRequest) Descriptor() ([]byte, []int) {
-	return file_chama_proto_rawDescGZIP(), []int{10}
+func (x *Chama) GetUpdatedDate() string {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return ""
 }
 
-func (x *UpdateChamaMemberRequest) GetChamaMember() *ChamaMember {
+func (x *Chama) GetCreatedDate() string {
 	if x != nil {
-		return x.ChamaMember
+		return x.CreatedDate
 	}
-	return nil
+	return ""
 }
 
-type DeleteChamaMemberRequest struct {
+type TrustPerson struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MemberId string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Phone string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
 }
 
-func (x *DeleteChamaMemberRequest) Reset() {
-	*x = DeleteChamaMemberRequest{}
+func (x *TrustPerson) Reset() {
+	*x = TrustPerson{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chama_proto_msgTypes[11]
+		mi := &file_chama_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteChamaMemberRequest) String() string {
+func (x *TrustPerson) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteChamaMemberRequest) ProtoMessage() {}
+func (*TrustPerson) ProtoMessage() {}
 
-func (x *DeleteChamaMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chama_proto_msgTypes[11]
+func (x *TrustPerson) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,43 +886,76 @@ func (x *DeleteChamaMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteChamaMemberRequest.ProtoReflect.Descriptor instead.
-func (*DeleteChamaMemberRequest) Descriptor() ([]byte, []int) {
-	return file_chama_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use TrustPerson.ProtoReflect.Descriptor instead.
+func (*TrustPerson) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *DeleteChamaMemberRequest) GetMemberId() string {
+func (x *TrustPerson) GetName() string {
 	if x != nil {
-		return x.MemberId
+		return x.Name
 	}
 	return ""
 }
 
-type ChamaMemberFilter struct {
+func (x *TrustPerson) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TrustPerson) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type ChamaMember struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChamaIds []string `protobuf:"bytes,1,rep,name=chama_ids,json=chamaIds,proto3" json:"chama_ids,omitempty"`
+	MemberId      string            `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	ChamaId       string            `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	FirstName     string            `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string            `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email         string            `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string            `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
+	IdNumber      string            `protobuf:"bytes,7,opt,name=id_number,json=idNumber,proto3" json:"id_number,omitempty"`
+	Residence     string            `protobuf:"bytes,8,opt,name=residence,proto3" json:"residence,omitempty"`
+	JobDetails    map[string]string `protobuf:"bytes,9,rep,name=job_details,json=jobDetails,proto3" json:"job_details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Kyc           map[string]string `protobuf:"bytes,10,rep,name=kyc,proto3" json:"kyc,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Beneficiaries []*TrustPerson    `protobuf:"bytes,11,rep,name=beneficiaries,proto3" json:"beneficiaries,omitempty"`
+	Guarantees    []*TrustPerson    `protobuf:"bytes,12,rep,name=guarantees,proto3" json:"guarantees,omitempty"`
+	Active        bool              `protobuf:"varint,13,opt,name=active,proto3" json:"active,omitempty"`
+	Status        string            `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	UpdatedDate   string            `protobuf:"bytes,15,opt,name=updated_date,json=updatedDate,proto3" json:"updated_date,omitempty"`
+	RegisterDate  string            `protobuf:"bytes,16,opt,name=register_date,json=registerDate,proto3" json:"register_date,omitempty"`
+	UserId        string            `protobuf:"bytes,17,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          ChamaRole         `protobuf:"varint,18,opt,name=role,proto3,enum=gidyon.chama.ChamaRole" json:"role,omitempty"`
+	OtherChamaIds []string          `protobuf:"bytes,19,rep,name=other_chama_ids,json=otherChamaIds,proto3" json:"other_chama_ids,omitempty"`
+	KycStatus     KycStatus         `protobuf:"varint,20,opt,name=kyc_status,json=kycStatus,proto3,enum=gidyon.chama.KycStatus" json:"kyc_status,omitempty"`
 }
 
-func (x *ChamaMemberFilter) Reset() {
-	*x = ChamaMemberFilter{}
+func (x *ChamaMember) Reset() {
+	*x = ChamaMember{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chama_proto_msgTypes[12]
+		mi := &file_chama_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChamaMemberFilter) String() string {
+func (x *ChamaMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChamaMemberFilter) ProtoMessage() {}
+func (*ChamaMember) ProtoMessage() {}
 
-func (x *ChamaMemberFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_chama_proto_msgTypes[12]
+func (x *ChamaMember) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -845,45 +966,176 @@ func (x *ChamaMemberFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChamaMemberFilter.ProtoReflect.Descriptor instead.
-func (*ChamaMemberFilter) Descriptor() ([]byte, []int) {
-	return file_chama_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ChamaMember.ProtoReflect.Descriptor instead.
+func (*ChamaMember) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ChamaMemberFilter) GetChamaIds() []string {
+func (x *ChamaMember) GetMemberId() string {
 	if x != nil {
-		return x.ChamaIds
+		return x.MemberId
 	}
-	return nil
+	return ""
 }
 
-type ListChamaMembersRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Filter    *ChamaMemberFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	PageToken string             `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	PageSize  int32              `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+func (x *ChamaMember) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
 }
 
-func (x *ListChamaMembersRequest) Reset() {
-	*x = ListChamaMembersRequest{}
+func (x *ChamaMember) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *ChamaMember) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *ChamaMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ChamaMember) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *ChamaMember) GetIdNumber() string {
+	if x != nil {
+		return x.IdNumber
+	}
+	return ""
+}
+
+func (x *ChamaMember) GetResidence() string {
+	if x != nil {
+		return x.Residence
+	}
+	return ""
+}
+
+func (x *ChamaMember) GetJobDetails() map[string]string {
+	if x != nil {
+		return x.JobDetails
+	}
+	return nil
+}
+
+func (x *ChamaMember) GetKyc() map[string]string {
+	if x != nil {
+		return x.Kyc
+	}
+	return nil
+}
+
+func (x *ChamaMember) GetBeneficiaries() []*TrustPerson {
+	if x != nil {
+		return x.Beneficiaries
+	}
+	return nil
+}
+
+func (x *ChamaMember) GetGuarantees() []*TrustPerson {
+	if x != nil {
+		return x.Guarantees
+	}
+	return nil
+}
+
+func (x *ChamaMember) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *ChamaMember) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChamaMember) GetUpdatedDate() string {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return ""
+}
+
+func (x *ChamaMember) GetRegisterDate() string {
+	if x != nil {
+		return x.RegisterDate
+	}
+	return ""
+}
+
+func (x *ChamaMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChamaMember) GetRole() ChamaRole {
+	if x != nil {
+		return x.Role
+	}
+	return ChamaRole_ROLE_MEMBER
+}
+
+func (x *ChamaMember) GetOtherChamaIds() []string {
+	if x != nil {
+		return x.OtherChamaIds
+	}
+	return nil
+}
+
+func (x *ChamaMember) GetKycStatus() KycStatus {
+	if x != nil {
+		return x.KycStatus
+	}
+	return KycStatus_KYC_NOT_SUBMITTED
+}
+
+type CreateChamaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chama *Chama `protobuf:"bytes,1,opt,name=chama,proto3" json:"chama,omitempty"`
+}
+
+func (x *CreateChamaRequest) Reset() {
+	*x = CreateChamaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chama_proto_msgTypes[13]
+		mi := &file_chama_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListChamaMembersRequest) String() string {
+func (x *CreateChamaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChamaMembersRequest) ProtoMessage() {}
+func (*CreateChamaRequest) ProtoMessage() {}
 
-func (x *ListChamaMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chama_proto_msgTypes[13]
+func (x *CreateChamaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -894,446 +1146,8027 @@ func (x *ListChamaMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChamaMembersRequest.ProtoReflect.Descriptor instead.
-func (*ListChamaMembersRequest) Descriptor() ([]byte, []int) {
-	return file_chama_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CreateChamaRequest.ProtoReflect.Descriptor instead.
+func (*CreateChamaRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ListChamaMembersRequest) GetFilter() *ChamaMemberFilter {
+func (x *CreateChamaRequest) GetChama() *Chama {
 	if x != nil {
-		return x.Filter
+		return x.Chama
 	}
 	return nil
 }
 
-func (x *ListChamaMembersRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
+type UpdateChamaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chama *Chama `protobuf:"bytes,1,opt,name=chama,proto3" json:"chama,omitempty"`
+}
+
+func (x *UpdateChamaRequest) Reset() {
+	*x = UpdateChamaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ListChamaMembersRequest) GetPageSize() int32 {
+func (x *UpdateChamaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChamaRequest) ProtoMessage() {}
+
+func (x *UpdateChamaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChamaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChamaRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateChamaRequest) GetChama() *Chama {
 	if x != nil {
-		return x.PageSize
+		return x.Chama
 	}
-	return 0
+	return nil
 }
 
-type ListChamaMembersResponse struct {
+type ChamaFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChamaMembers  []*ChamaMember `protobuf:"bytes,1,rep,name=chama_members,json=chamaMembers,proto3" json:"chama_members,omitempty"`
-	NextPageToken string         `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	CreatorIds []string `protobuf:"bytes,1,rep,name=creator_ids,json=creatorIds,proto3" json:"creator_ids,omitempty"`
 }
 
-func (x *ListChamaMembersResponse) Reset() {
-	*x = ListChamaMembersResponse{}
+func (x *ChamaFilter) Reset() {
+	*x = ChamaFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chama_proto_msgTypes[14]
+		mi := &file_chama_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListChamaMembersResponse) String() string {
+func (x *ChamaFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChamaMembersResponse) ProtoMessage() {}
+func (*ChamaFilter) ProtoMessage() {}
 
-func (x *ListChamaMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chama_proto_msgTypes[14]
+func (x *ChamaFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListChamaMembersResponse.ProtoReflect.Descriptor instead.
-func (*ListChamaMembersResponse) Descriptor() ([]byte, []int) {
-	return file_chama_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *ListChamaMembersResponse) GetChamaMembers() []*ChamaMember {
-	if x != nil {
-		return x.ChamaMembers
-	}
-	return nil
-}
-
-func (x *ListChamaMembersResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-type GetChamaMemberRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	MemberId string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
-}
-
-func (x *GetChamaMemberRequest) Reset() {
-	*x = GetChamaMemberRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chama_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetChamaMemberRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetChamaMemberRequest) ProtoMessage() {}
-
-func (x *GetChamaMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chama_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChamaFilter.ProtoReflect.Descriptor instead.
+func (*ChamaFilter) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChamaFilter) GetCreatorIds() []string {
+	if x != nil {
+		return x.CreatorIds
+	}
+	return nil
+}
+
+type ListChamasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter    *ChamaFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageToken string       `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32        `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListChamasRequest) Reset() {
+	*x = ListChamasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChamasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChamasRequest) ProtoMessage() {}
+
+func (x *ListChamasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChamasRequest.ProtoReflect.Descriptor instead.
+func (*ListChamasRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListChamasRequest) GetFilter() *ChamaFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListChamasRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListChamasRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListChamasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chamas        []*Chama `protobuf:"bytes,1,rep,name=chamas,proto3" json:"chamas,omitempty"`
+	NextPageToken string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListChamasResponse) Reset() {
+	*x = ListChamasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChamasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChamasResponse) ProtoMessage() {}
+
+func (x *ListChamasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChamasResponse.ProtoReflect.Descriptor instead.
+func (*ListChamasResponse) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListChamasResponse) GetChamas() []*Chama {
+	if x != nil {
+		return x.Chamas
+	}
+	return nil
+}
+
+func (x *ListChamasResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetChamaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaId string `protobuf:"bytes,1,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+}
+
+func (x *GetChamaRequest) Reset() {
+	*x = GetChamaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChamaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChamaRequest) ProtoMessage() {}
+
+func (x *GetChamaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChamaRequest.ProtoReflect.Descriptor instead.
+func (*GetChamaRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetChamaRequest) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+type AssignChamaRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId string    `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Role     ChamaRole `protobuf:"varint,2,opt,name=role,proto3,enum=gidyon.chama.ChamaRole" json:"role,omitempty"`
+}
+
+func (x *AssignChamaRoleRequest) Reset() {
+	*x = AssignChamaRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignChamaRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignChamaRoleRequest) ProtoMessage() {}
+
+func (x *AssignChamaRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignChamaRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignChamaRoleRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AssignChamaRoleRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *AssignChamaRoleRequest) GetRole() ChamaRole {
+	if x != nil {
+		return x.Role
+	}
+	return ChamaRole_ROLE_MEMBER
+}
+
+type FindMemberByIdentityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdNumber string `protobuf:"bytes,1,opt,name=id_number,json=idNumber,proto3" json:"id_number,omitempty"`
+	Phone    string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+}
+
+func (x *FindMemberByIdentityRequest) Reset() {
+	*x = FindMemberByIdentityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindMemberByIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindMemberByIdentityRequest) ProtoMessage() {}
+
+func (x *FindMemberByIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindMemberByIdentityRequest.ProtoReflect.Descriptor instead.
+func (*FindMemberByIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FindMemberByIdentityRequest) GetIdNumber() string {
+	if x != nil {
+		return x.IdNumber
+	}
+	return ""
+}
+
+func (x *FindMemberByIdentityRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type FindMemberByIdentityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaMembers []*ChamaMember `protobuf:"bytes,1,rep,name=chama_members,json=chamaMembers,proto3" json:"chama_members,omitempty"`
+}
+
+func (x *FindMemberByIdentityResponse) Reset() {
+	*x = FindMemberByIdentityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindMemberByIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindMemberByIdentityResponse) ProtoMessage() {}
+
+func (x *FindMemberByIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindMemberByIdentityResponse.ProtoReflect.Descriptor instead.
+func (*FindMemberByIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FindMemberByIdentityResponse) GetChamaMembers() []*ChamaMember {
+	if x != nil {
+		return x.ChamaMembers
+	}
+	return nil
+}
+
+type MergeChamaMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrimaryMemberId    string   `protobuf:"bytes,1,opt,name=primary_member_id,json=primaryMemberId,proto3" json:"primary_member_id,omitempty"`
+	DuplicateMemberIds []string `protobuf:"bytes,2,rep,name=duplicate_member_ids,json=duplicateMemberIds,proto3" json:"duplicate_member_ids,omitempty"`
+}
+
+func (x *MergeChamaMembersRequest) Reset() {
+	*x = MergeChamaMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeChamaMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeChamaMembersRequest) ProtoMessage() {}
+
+func (x *MergeChamaMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeChamaMembersRequest.ProtoReflect.Descriptor instead.
+func (*MergeChamaMembersRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MergeChamaMembersRequest) GetPrimaryMemberId() string {
+	if x != nil {
+		return x.PrimaryMemberId
+	}
+	return ""
+}
+
+func (x *MergeChamaMembersRequest) GetDuplicateMemberIds() []string {
+	if x != nil {
+		return x.DuplicateMemberIds
+	}
+	return nil
+}
+
+type CreateChamaMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaMember *ChamaMember `protobuf:"bytes,1,opt,name=chama_member,json=chamaMember,proto3" json:"chama_member,omitempty"`
+}
+
+func (x *CreateChamaMemberRequest) Reset() {
+	*x = CreateChamaMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateChamaMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChamaMemberRequest) ProtoMessage() {}
+
+func (x *CreateChamaMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChamaMemberRequest.ProtoReflect.Descriptor instead.
+func (*CreateChamaMemberRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateChamaMemberRequest) GetChamaMember() *ChamaMember {
+	if x != nil {
+		return x.ChamaMember
+	}
+	return nil
+}
+
+type UpdateChamaMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaMember *ChamaMember `protobuf:"bytes,1,opt,name=chama_member,json=chamaMember,proto3" json:"chama_member,omitempty"`
+}
+
+func (x *UpdateChamaMemberRequest) Reset() {
+	*x = UpdateChamaMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateChamaMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChamaMemberRequest) ProtoMessage() {}
+
+func (x *UpdateChamaMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChamaMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChamaMemberRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateChamaMemberRequest) GetChamaMember() *ChamaMember {
+	if x != nil {
+		return x.ChamaMember
+	}
+	return nil
+}
+
+type ChamaMemberFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaIds       []string           `protobuf:"bytes,1,rep,name=chama_ids,json=chamaIds,proto3" json:"chama_ids,omitempty"`
+	Search         string             `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"`
+	Statuses       []string           `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Active         MemberActiveFilter `protobuf:"varint,4,opt,name=active,proto3,enum=gidyon.chama.MemberActiveFilter" json:"active,omitempty"`
+	JoinedFromDate string             `protobuf:"bytes,5,opt,name=joined_from_date,json=joinedFromDate,proto3" json:"joined_from_date,omitempty"`
+	JoinedToDate   string             `protobuf:"bytes,6,opt,name=joined_to_date,json=joinedToDate,proto3" json:"joined_to_date,omitempty"`
+	WithActiveLoan bool               `protobuf:"varint,7,opt,name=with_active_loan,json=withActiveLoan,proto3" json:"with_active_loan,omitempty"`
+	InArrears      bool               `protobuf:"varint,8,opt,name=in_arrears,json=inArrears,proto3" json:"in_arrears,omitempty"`
+}
+
+func (x *ChamaMemberFilter) Reset() {
+	*x = ChamaMemberFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChamaMemberFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChamaMemberFilter) ProtoMessage() {}
+
+func (x *ChamaMemberFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChamaMemberFilter.ProtoReflect.Descriptor instead.
+func (*ChamaMemberFilter) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChamaMemberFilter) GetChamaIds() []string {
+	if x != nil {
+		return x.ChamaIds
+	}
+	return nil
+}
+
+func (x *ChamaMemberFilter) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ChamaMemberFilter) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ChamaMemberFilter) GetActive() MemberActiveFilter {
+	if x != nil {
+		return x.Active
+	}
+	return MemberActiveFilter_MEMBERS_ACTIVE_ANY
+}
+
+func (x *ChamaMemberFilter) GetJoinedFromDate() string {
+	if x != nil {
+		return x.JoinedFromDate
+	}
+	return ""
+}
+
+func (x *ChamaMemberFilter) GetJoinedToDate() string {
+	if x != nil {
+		return x.JoinedToDate
+	}
+	return ""
+}
+
+func (x *ChamaMemberFilter) GetWithActiveLoan() bool {
+	if x != nil {
+		return x.WithActiveLoan
+	}
+	return false
+}
+
+func (x *ChamaMemberFilter) GetInArrears() bool {
+	if x != nil {
+		return x.InArrears
+	}
+	return false
+}
+
+type ListChamaMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter    *ChamaMemberFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageToken string             `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32              `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	SortOrder MemberSortOrder    `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3,enum=gidyon.chama.MemberSortOrder" json:"sort_order,omitempty"`
+}
+
+func (x *ListChamaMembersRequest) Reset() {
+	*x = ListChamaMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChamaMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChamaMembersRequest) ProtoMessage() {}
+
+func (x *ListChamaMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChamaMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListChamaMembersRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListChamaMembersRequest) GetFilter() *ChamaMemberFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListChamaMembersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListChamaMembersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListChamaMembersRequest) GetSortOrder() MemberSortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return MemberSortOrder_MEMBER_SORT_NEWEST
+}
+
+type ListChamaMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaMembers  []*ChamaMember `protobuf:"bytes,1,rep,name=chama_members,json=chamaMembers,proto3" json:"chama_members,omitempty"`
+	NextPageToken string         `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListChamaMembersResponse) Reset() {
+	*x = ListChamaMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChamaMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChamaMembersResponse) ProtoMessage() {}
+
+func (x *ListChamaMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChamaMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListChamaMembersResponse) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListChamaMembersResponse) GetChamaMembers() []*ChamaMember {
+	if x != nil {
+		return x.ChamaMembers
+	}
+	return nil
+}
+
+func (x *ListChamaMembersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetChamaMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+}
+
+func (x *GetChamaMemberRequest) Reset() {
+	*x = GetChamaMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChamaMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChamaMemberRequest) ProtoMessage() {}
+
+func (x *GetChamaMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChamaMemberRequest.ProtoReflect.Descriptor instead.
+func (*GetChamaMemberRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetChamaMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type ContributionPlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlanId      string                `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	ChamaId     string                `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	AccountName string                `protobuf:"bytes,3,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Amount      float64               `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Frequency   ContributionFrequency `protobuf:"varint,5,opt,name=frequency,proto3,enum=gidyon.chama.ContributionFrequency" json:"frequency,omitempty"`
+	DueDay      int32                 `protobuf:"varint,6,opt,name=due_day,json=dueDay,proto3" json:"due_day,omitempty"`
+	LateFine    float64               `protobuf:"fixed64,7,opt,name=late_fine,json=lateFine,proto3" json:"late_fine,omitempty"`
+	StartDate   string                `protobuf:"bytes,8,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	Active      bool                  `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"`
+	UpdatedDate string                `protobuf:"bytes,10,opt,name=updated_date,json=updatedDate,proto3" json:"updated_date,omitempty"`
+	CreatedDate string                `protobuf:"bytes,11,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
+}
+
+func (x *ContributionPlan) Reset() {
+	*x = ContributionPlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContributionPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContributionPlan) ProtoMessage() {}
+
+func (x *ContributionPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContributionPlan.ProtoReflect.Descriptor instead.
+func (*ContributionPlan) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ContributionPlan) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *ContributionPlan) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *ContributionPlan) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ContributionPlan) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ContributionPlan) GetFrequency() ContributionFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return ContributionFrequency_FREQUENCY_UNSPECIFIED
+}
+
+func (x *ContributionPlan) GetDueDay() int32 {
+	if x != nil {
+		return x.DueDay
+	}
+	return 0
+}
+
+func (x *ContributionPlan) GetLateFine() float64 {
+	if x != nil {
+		return x.LateFine
+	}
+	return 0
+}
+
+func (x *ContributionPlan) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ContributionPlan) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *ContributionPlan) GetUpdatedDate() string {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return ""
+}
+
+func (x *ContributionPlan) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+type SetContributionPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plan *ContributionPlan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+}
+
+func (x *SetContributionPlanRequest) Reset() {
+	*x = SetContributionPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetContributionPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContributionPlanRequest) ProtoMessage() {}
+
+func (x *SetContributionPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContributionPlanRequest.ProtoReflect.Descriptor instead.
+func (*SetContributionPlanRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetContributionPlanRequest) GetPlan() *ContributionPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+type GetContributionPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaId string `protobuf:"bytes,1,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+}
+
+func (x *GetContributionPlanRequest) Reset() {
+	*x = GetContributionPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContributionPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContributionPlanRequest) ProtoMessage() {}
+
+func (x *GetContributionPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContributionPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetContributionPlanRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetContributionPlanRequest) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+type MemberArrears struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId             string  `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	MemberNames          string  `protobuf:"bytes,2,opt,name=member_names,json=memberNames,proto3" json:"member_names,omitempty"`
+	Phone                string  `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	OverdueContributions int32   `protobuf:"varint,4,opt,name=overdue_contributions,json=overdueContributions,proto3" json:"overdue_contributions,omitempty"`
+	ContributionsOwed    float64 `protobuf:"fixed64,5,opt,name=contributions_owed,json=contributionsOwed,proto3" json:"contributions_owed,omitempty"`
+	FinesOwed            float64 `protobuf:"fixed64,6,opt,name=fines_owed,json=finesOwed,proto3" json:"fines_owed,omitempty"`
+	TotalOwed            float64 `protobuf:"fixed64,7,opt,name=total_owed,json=totalOwed,proto3" json:"total_owed,omitempty"`
+	OldestDueDate        string  `protobuf:"bytes,8,opt,name=oldest_due_date,json=oldestDueDate,proto3" json:"oldest_due_date,omitempty"`
+}
+
+func (x *MemberArrears) Reset() {
+	*x = MemberArrears{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemberArrears) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberArrears) ProtoMessage() {}
+
+func (x *MemberArrears) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberArrears.ProtoReflect.Descriptor instead.
+func (*MemberArrears) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MemberArrears) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *MemberArrears) GetMemberNames() string {
+	if x != nil {
+		return x.MemberNames
+	}
+	return ""
+}
+
+func (x *MemberArrears) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *MemberArrears) GetOverdueContributions() int32 {
+	if x != nil {
+		return x.OverdueContributions
+	}
+	return 0
+}
+
+func (x *MemberArrears) GetContributionsOwed() float64 {
+	if x != nil {
+		return x.ContributionsOwed
+	}
+	return 0
+}
+
+func (x *MemberArrears) GetFinesOwed() float64 {
+	if x != nil {
+		return x.FinesOwed
+	}
+	return 0
+}
+
+func (x *MemberArrears) GetTotalOwed() float64 {
+	if x != nil {
+		return x.TotalOwed
+	}
+	return 0
+}
+
+func (x *MemberArrears) GetOldestDueDate() string {
+	if x != nil {
+		return x.OldestDueDate
+	}
+	return ""
+}
+
+type ListContributionArrearsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaId string `protobuf:"bytes,1,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+}
+
+func (x *ListContributionArrearsRequest) Reset() {
+	*x = ListContributionArrearsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContributionArrearsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContributionArrearsRequest) ProtoMessage() {}
+
+func (x *ListContributionArrearsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContributionArrearsRequest.ProtoReflect.Descriptor instead.
+func (*ListContributionArrearsRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListContributionArrearsRequest) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+type ListContributionArrearsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Arrears   []*MemberArrears `protobuf:"bytes,1,rep,name=arrears,proto3" json:"arrears,omitempty"`
+	TotalOwed float64          `protobuf:"fixed64,2,opt,name=total_owed,json=totalOwed,proto3" json:"total_owed,omitempty"`
+}
+
+func (x *ListContributionArrearsResponse) Reset() {
+	*x = ListContributionArrearsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContributionArrearsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContributionArrearsResponse) ProtoMessage() {}
+
+func (x *ListContributionArrearsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContributionArrearsResponse.ProtoReflect.Descriptor instead.
+func (*ListContributionArrearsResponse) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListContributionArrearsResponse) GetArrears() []*MemberArrears {
+	if x != nil {
+		return x.Arrears
+	}
+	return nil
+}
+
+func (x *ListContributionArrearsResponse) GetTotalOwed() float64 {
+	if x != nil {
+		return x.TotalOwed
+	}
+	return 0
+}
+
+type RotationSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId      string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	MemberNames   string `protobuf:"bytes,2,opt,name=member_names,json=memberNames,proto3" json:"member_names,omitempty"`
+	Position      int32  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	ReceivedCycle int32  `protobuf:"varint,4,opt,name=received_cycle,json=receivedCycle,proto3" json:"received_cycle,omitempty"`
+}
+
+func (x *RotationSlot) Reset() {
+	*x = RotationSlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotationSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotationSlot) ProtoMessage() {}
+
+func (x *RotationSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotationSlot.ProtoReflect.Descriptor instead.
+func (*RotationSlot) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RotationSlot) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *RotationSlot) GetMemberNames() string {
+	if x != nil {
+		return x.MemberNames
+	}
+	return ""
+}
+
+func (x *RotationSlot) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *RotationSlot) GetReceivedCycle() int32 {
+	if x != nil {
+		return x.ReceivedCycle
+	}
+	return 0
+}
+
+type RotationCycle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CycleId          string   `protobuf:"bytes,1,opt,name=cycle_id,json=cycleId,proto3" json:"cycle_id,omitempty"`
+	RotationId       string   `protobuf:"bytes,2,opt,name=rotation_id,json=rotationId,proto3" json:"rotation_id,omitempty"`
+	CycleNumber      int32    `protobuf:"varint,3,opt,name=cycle_number,json=cycleNumber,proto3" json:"cycle_number,omitempty"`
+	RecipientId      string   `protobuf:"bytes,4,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	PotAmount        float64  `protobuf:"fixed64,5,opt,name=pot_amount,json=potAmount,proto3" json:"pot_amount,omitempty"`
+	CollectedAmount  float64  `protobuf:"fixed64,6,opt,name=collected_amount,json=collectedAmount,proto3" json:"collected_amount,omitempty"`
+	BidAmount        float64  `protobuf:"fixed64,7,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	PayoutAmount     float64  `protobuf:"fixed64,8,opt,name=payout_amount,json=payoutAmount,proto3" json:"payout_amount,omitempty"`
+	PaidOut          bool     `protobuf:"varint,9,opt,name=paid_out,json=paidOut,proto3" json:"paid_out,omitempty"`
+	DueDate          string   `protobuf:"bytes,10,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	PaidOutDate      string   `protobuf:"bytes,11,opt,name=paid_out_date,json=paidOutDate,proto3" json:"paid_out_date,omitempty"`
+	PendingMemberIds []string `protobuf:"bytes,12,rep,name=pending_member_ids,json=pendingMemberIds,proto3" json:"pending_member_ids,omitempty"`
+}
+
+func (x *RotationCycle) Reset() {
+	*x = RotationCycle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotationCycle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotationCycle) ProtoMessage() {}
+
+func (x *RotationCycle) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotationCycle.ProtoReflect.Descriptor instead.
+func (*RotationCycle) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RotationCycle) GetCycleId() string {
+	if x != nil {
+		return x.CycleId
+	}
+	return ""
+}
+
+func (x *RotationCycle) GetRotationId() string {
+	if x != nil {
+		return x.RotationId
+	}
+	return ""
+}
+
+func (x *RotationCycle) GetCycleNumber() int32 {
+	if x != nil {
+		return x.CycleNumber
+	}
+	return 0
+}
+
+func (x *RotationCycle) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *RotationCycle) GetPotAmount() float64 {
+	if x != nil {
+		return x.PotAmount
+	}
+	return 0
+}
+
+func (x *RotationCycle) GetCollectedAmount() float64 {
+	if x != nil {
+		return x.CollectedAmount
+	}
+	return 0
+}
+
+func (x *RotationCycle) GetBidAmount() float64 {
+	if x != nil {
+		return x.BidAmount
+	}
+	return 0
+}
+
+func (x *RotationCycle) GetPayoutAmount() float64 {
+	if x != nil {
+		return x.PayoutAmount
+	}
+	return 0
+}
+
+func (x *RotationCycle) GetPaidOut() bool {
+	if x != nil {
+		return x.PaidOut
+	}
+	return false
+}
+
+func (x *RotationCycle) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *RotationCycle) GetPaidOutDate() string {
+	if x != nil {
+		return x.PaidOutDate
+	}
+	return ""
+}
+
+func (x *RotationCycle) GetPendingMemberIds() []string {
+	if x != nil {
+		return x.PendingMemberIds
+	}
+	return nil
+}
+
+type Rotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RotationId         string          `protobuf:"bytes,1,opt,name=rotation_id,json=rotationId,proto3" json:"rotation_id,omitempty"`
+	ChamaId            string          `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	Name               string          `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Order              RotationOrder   `protobuf:"varint,4,opt,name=order,proto3,enum=gidyon.chama.RotationOrder" json:"order,omitempty"`
+	ContributionAmount float64         `protobuf:"fixed64,5,opt,name=contribution_amount,json=contributionAmount,proto3" json:"contribution_amount,omitempty"`
+	AccountName        string          `protobuf:"bytes,6,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	CycleDays          int32           `protobuf:"varint,7,opt,name=cycle_days,json=cycleDays,proto3" json:"cycle_days,omitempty"`
+	StartDate          string          `protobuf:"bytes,8,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	Status             RotationStatus  `protobuf:"varint,9,opt,name=status,proto3,enum=gidyon.chama.RotationStatus" json:"status,omitempty"`
+	CurrentCycle       int32           `protobuf:"varint,10,opt,name=current_cycle,json=currentCycle,proto3" json:"current_cycle,omitempty"`
+	Slots              []*RotationSlot `protobuf:"bytes,11,rep,name=slots,proto3" json:"slots,omitempty"`
+	Cycle              *RotationCycle  `protobuf:"bytes,12,opt,name=cycle,proto3" json:"cycle,omitempty"`
+	CreatedDate        string          `protobuf:"bytes,13,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
+}
+
+func (x *Rotation) Reset() {
+	*x = Rotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rotation) ProtoMessage() {}
+
+func (x *Rotation) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rotation.ProtoReflect.Descriptor instead.
+func (*Rotation) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Rotation) GetRotationId() string {
+	if x != nil {
+		return x.RotationId
+	}
+	return ""
+}
+
+func (x *Rotation) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *Rotation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rotation) GetOrder() RotationOrder {
+	if x != nil {
+		return x.Order
+	}
+	return RotationOrder_FIXED_ORDER
+}
+
+func (x *Rotation) GetContributionAmount() float64 {
+	if x != nil {
+		return x.ContributionAmount
+	}
+	return 0
+}
+
+func (x *Rotation) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *Rotation) GetCycleDays() int32 {
+	if x != nil {
+		return x.CycleDays
+	}
+	return 0
+}
+
+func (x *Rotation) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Rotation) GetStatus() RotationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RotationStatus_ROTATION_ACTIVE
+}
+
+func (x *Rotation) GetCurrentCycle() int32 {
+	if x != nil {
+		return x.CurrentCycle
+	}
+	return 0
+}
+
+func (x *Rotation) GetSlots() []*RotationSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Rotation) GetCycle() *RotationCycle {
+	if x != nil {
+		return x.Cycle
+	}
+	return nil
+}
+
+func (x *Rotation) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+type CreateRotationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rotation  *Rotation `protobuf:"bytes,1,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	MemberIds []string  `protobuf:"bytes,2,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+}
+
+func (x *CreateRotationRequest) Reset() {
+	*x = CreateRotationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRotationRequest) ProtoMessage() {}
+
+func (x *CreateRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRotationRequest.ProtoReflect.Descriptor instead.
+func (*CreateRotationRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateRotationRequest) GetRotation() *Rotation {
+	if x != nil {
+		return x.Rotation
+	}
+	return nil
+}
+
+func (x *CreateRotationRequest) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+type GetRotationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RotationId string `protobuf:"bytes,1,opt,name=rotation_id,json=rotationId,proto3" json:"rotation_id,omitempty"`
+}
+
+func (x *GetRotationRequest) Reset() {
+	*x = GetRotationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRotationRequest) ProtoMessage() {}
+
+func (x *GetRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRotationRequest.ProtoReflect.Descriptor instead.
+func (*GetRotationRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetRotationRequest) GetRotationId() string {
+	if x != nil {
+		return x.RotationId
+	}
+	return ""
+}
+
+type ListRotationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaId   string `protobuf:"bytes,1,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListRotationsRequest) Reset() {
+	*x = ListRotationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRotationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRotationsRequest) ProtoMessage() {}
+
+func (x *ListRotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRotationsRequest.ProtoReflect.Descriptor instead.
+func (*ListRotationsRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListRotationsRequest) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *ListRotationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRotationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListRotationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rotations     []*Rotation `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations,omitempty"`
+	NextPageToken string      `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListRotationsResponse) Reset() {
+	*x = ListRotationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRotationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRotationsResponse) ProtoMessage() {}
+
+func (x *ListRotationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRotationsResponse.ProtoReflect.Descriptor instead.
+func (*ListRotationsResponse) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListRotationsResponse) GetRotations() []*Rotation {
+	if x != nil {
+		return x.Rotations
+	}
+	return nil
+}
+
+func (x *ListRotationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RecordRotationContributionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RotationId string  `protobuf:"bytes,1,opt,name=rotation_id,json=rotationId,proto3" json:"rotation_id,omitempty"`
+	MemberId   string  `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Amount     float64 `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *RecordRotationContributionRequest) Reset() {
+	*x = RecordRotationContributionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordRotationContributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordRotationContributionRequest) ProtoMessage() {}
+
+func (x *RecordRotationContributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordRotationContributionRequest.ProtoReflect.Descriptor instead.
+func (*RecordRotationContributionRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RecordRotationContributionRequest) GetRotationId() string {
+	if x != nil {
+		return x.RotationId
+	}
+	return ""
+}
+
+func (x *RecordRotationContributionRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *RecordRotationContributionRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type PayoutRotationCycleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RotationId string `protobuf:"bytes,1,opt,name=rotation_id,json=rotationId,proto3" json:"rotation_id,omitempty"`
+}
+
+func (x *PayoutRotationCycleRequest) Reset() {
+	*x = PayoutRotationCycleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayoutRotationCycleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutRotationCycleRequest) ProtoMessage() {}
+
+func (x *PayoutRotationCycleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutRotationCycleRequest.ProtoReflect.Descriptor instead.
+func (*PayoutRotationCycleRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PayoutRotationCycleRequest) GetRotationId() string {
+	if x != nil {
+		return x.RotationId
+	}
+	return ""
+}
+
+type PlaceRotationBidRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RotationId string  `protobuf:"bytes,1,opt,name=rotation_id,json=rotationId,proto3" json:"rotation_id,omitempty"`
+	MemberId   string  `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Amount     float64 `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *PlaceRotationBidRequest) Reset() {
+	*x = PlaceRotationBidRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceRotationBidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceRotationBidRequest) ProtoMessage() {}
+
+func (x *PlaceRotationBidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceRotationBidRequest.ProtoReflect.Descriptor instead.
+func (*PlaceRotationBidRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PlaceRotationBidRequest) GetRotationId() string {
+	if x != nil {
+		return x.RotationId
+	}
+	return ""
+}
+
+func (x *PlaceRotationBidRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *PlaceRotationBidRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type SkipRotationTurnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RotationId string `protobuf:"bytes,1,opt,name=rotation_id,json=rotationId,proto3" json:"rotation_id,omitempty"`
+	MemberId   string `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+}
+
+func (x *SkipRotationTurnRequest) Reset() {
+	*x = SkipRotationTurnRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SkipRotationTurnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipRotationTurnRequest) ProtoMessage() {}
+
+func (x *SkipRotationTurnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipRotationTurnRequest.ProtoReflect.Descriptor instead.
+func (*SkipRotationTurnRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SkipRotationTurnRequest) GetRotationId() string {
+	if x != nil {
+		return x.RotationId
+	}
+	return ""
+}
+
+func (x *SkipRotationTurnRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type SwapRotationTurnsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RotationId    string `protobuf:"bytes,1,opt,name=rotation_id,json=rotationId,proto3" json:"rotation_id,omitempty"`
+	MemberId      string `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	OtherMemberId string `protobuf:"bytes,3,opt,name=other_member_id,json=otherMemberId,proto3" json:"other_member_id,omitempty"`
+}
+
+func (x *SwapRotationTurnsRequest) Reset() {
+	*x = SwapRotationTurnsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapRotationTurnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapRotationTurnsRequest) ProtoMessage() {}
+
+func (x *SwapRotationTurnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapRotationTurnsRequest.ProtoReflect.Descriptor instead.
+func (*SwapRotationTurnsRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SwapRotationTurnsRequest) GetRotationId() string {
+	if x != nil {
+		return x.RotationId
+	}
+	return ""
+}
+
+func (x *SwapRotationTurnsRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *SwapRotationTurnsRequest) GetOtherMemberId() string {
+	if x != nil {
+		return x.OtherMemberId
+	}
+	return ""
+}
+
+type MeetingAttendance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId    string           `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	MemberNames string           `protobuf:"bytes,2,opt,name=member_names,json=memberNames,proto3" json:"member_names,omitempty"`
+	Status      AttendanceStatus `protobuf:"varint,3,opt,name=status,proto3,enum=gidyon.chama.AttendanceStatus" json:"status,omitempty"`
+	Apology     string           `protobuf:"bytes,4,opt,name=apology,proto3" json:"apology,omitempty"`
+	FineAmount  float64          `protobuf:"fixed64,5,opt,name=fine_amount,json=fineAmount,proto3" json:"fine_amount,omitempty"`
+	FinePaid    bool             `protobuf:"varint,6,opt,name=fine_paid,json=finePaid,proto3" json:"fine_paid,omitempty"`
+}
+
+func (x *MeetingAttendance) Reset() {
+	*x = MeetingAttendance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingAttendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingAttendance) ProtoMessage() {}
+
+func (x *MeetingAttendance) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingAttendance.ProtoReflect.Descriptor instead.
+func (*MeetingAttendance) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MeetingAttendance) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *MeetingAttendance) GetMemberNames() string {
+	if x != nil {
+		return x.MemberNames
+	}
+	return ""
+}
+
+func (x *MeetingAttendance) GetStatus() AttendanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttendanceStatus_ATTENDANCE_UNSPECIFIED
+}
+
+func (x *MeetingAttendance) GetApology() string {
+	if x != nil {
+		return x.Apology
+	}
+	return ""
+}
+
+func (x *MeetingAttendance) GetFineAmount() float64 {
+	if x != nil {
+		return x.FineAmount
+	}
+	return 0
+}
+
+func (x *MeetingAttendance) GetFinePaid() bool {
+	if x != nil {
+		return x.FinePaid
+	}
+	return false
+}
+
+type MeetingResolution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResolutionId string `protobuf:"bytes,1,opt,name=resolution_id,json=resolutionId,proto3" json:"resolution_id,omitempty"`
+	MeetingId    string `protobuf:"bytes,2,opt,name=meeting_id,json=meetingId,proto3" json:"meeting_id,omitempty"`
+	Description  string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ProposedBy   string `protobuf:"bytes,4,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
+	SecondedBy   string `protobuf:"bytes,5,opt,name=seconded_by,json=secondedBy,proto3" json:"seconded_by,omitempty"`
+	VotesFor     int32  `protobuf:"varint,6,opt,name=votes_for,json=votesFor,proto3" json:"votes_for,omitempty"`
+	VotesAgainst int32  `protobuf:"varint,7,opt,name=votes_against,json=votesAgainst,proto3" json:"votes_against,omitempty"`
+	Abstentions  int32  `protobuf:"varint,8,opt,name=abstentions,proto3" json:"abstentions,omitempty"`
+	Passed       bool   `protobuf:"varint,9,opt,name=passed,proto3" json:"passed,omitempty"`
+	CreatedDate  string `protobuf:"bytes,10,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
+}
+
+func (x *MeetingResolution) Reset() {
+	*x = MeetingResolution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeetingResolution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingResolution) ProtoMessage() {}
+
+func (x *MeetingResolution) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingResolution.ProtoReflect.Descriptor instead.
+func (*MeetingResolution) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MeetingResolution) GetResolutionId() string {
+	if x != nil {
+		return x.ResolutionId
+	}
+	return ""
+}
+
+func (x *MeetingResolution) GetMeetingId() string {
+	if x != nil {
+		return x.MeetingId
+	}
+	return ""
+}
+
+func (x *MeetingResolution) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MeetingResolution) GetProposedBy() string {
+	if x != nil {
+		return x.ProposedBy
+	}
+	return ""
+}
+
+func (x *MeetingResolution) GetSecondedBy() string {
+	if x != nil {
+		return x.SecondedBy
+	}
+	return ""
+}
+
+func (x *MeetingResolution) GetVotesFor() int32 {
+	if x != nil {
+		return x.VotesFor
+	}
+	return 0
+}
+
+func (x *MeetingResolution) GetVotesAgainst() int32 {
+	if x != nil {
+		return x.VotesAgainst
+	}
+	return 0
+}
+
+func (x *MeetingResolution) GetAbstentions() int32 {
+	if x != nil {
+		return x.Abstentions
+	}
+	return 0
+}
+
+func (x *MeetingResolution) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *MeetingResolution) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+type Meeting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingId         string               `protobuf:"bytes,1,opt,name=meeting_id,json=meetingId,proto3" json:"meeting_id,omitempty"`
+	ChamaId           string               `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	Title             string               `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Venue             string               `protobuf:"bytes,4,opt,name=venue,proto3" json:"venue,omitempty"`
+	Agenda            string               `protobuf:"bytes,5,opt,name=agenda,proto3" json:"agenda,omitempty"`
+	Minutes           string               `protobuf:"bytes,6,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	ScheduledDate     string               `protobuf:"bytes,7,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"`
+	Status            MeetingStatus        `protobuf:"varint,8,opt,name=status,proto3,enum=gidyon.chama.MeetingStatus" json:"status,omitempty"`
+	AbsenteeFine      float64              `protobuf:"fixed64,9,opt,name=absentee_fine,json=absenteeFine,proto3" json:"absentee_fine,omitempty"`
+	LateFine          float64              `protobuf:"fixed64,10,opt,name=late_fine,json=lateFine,proto3" json:"late_fine,omitempty"`
+	MemberAccountName string               `protobuf:"bytes,11,opt,name=member_account_name,json=memberAccountName,proto3" json:"member_account_name,omitempty"`
+	FineAccountName   string               `protobuf:"bytes,12,opt,name=fine_account_name,json=fineAccountName,proto3" json:"fine_account_name,omitempty"`
+	Attendance        []*MeetingAttendance `protobuf:"bytes,13,rep,name=attendance,proto3" json:"attendance,omitempty"`
+	Resolutions       []*MeetingResolution `protobuf:"bytes,14,rep,name=resolutions,proto3" json:"resolutions,omitempty"`
+	CreatedDate       string               `protobuf:"bytes,15,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
+}
+
+func (x *Meeting) Reset() {
+	*x = Meeting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Meeting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Meeting) ProtoMessage() {}
+
+func (x *Meeting) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Meeting.ProtoReflect.Descriptor instead.
+func (*Meeting) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Meeting) GetMeetingId() string {
+	if x != nil {
+		return x.MeetingId
+	}
+	return ""
+}
+
+func (x *Meeting) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *Meeting) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Meeting) GetVenue() string {
+	if x != nil {
+		return x.Venue
+	}
+	return ""
+}
+
+func (x *Meeting) GetAgenda() string {
+	if x != nil {
+		return x.Agenda
+	}
+	return ""
+}
+
+func (x *Meeting) GetMinutes() string {
+	if x != nil {
+		return x.Minutes
+	}
+	return ""
+}
+
+func (x *Meeting) GetScheduledDate() string {
+	if x != nil {
+		return x.ScheduledDate
+	}
+	return ""
+}
+
+func (x *Meeting) GetStatus() MeetingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MeetingStatus_MEETING_SCHEDULED
+}
+
+func (x *Meeting) GetAbsenteeFine() float64 {
+	if x != nil {
+		return x.AbsenteeFine
+	}
+	return 0
+}
+
+func (x *Meeting) GetLateFine() float64 {
+	if x != nil {
+		return x.LateFine
+	}
+	return 0
+}
+
+func (x *Meeting) GetMemberAccountName() string {
+	if x != nil {
+		return x.MemberAccountName
+	}
+	return ""
+}
+
+func (x *Meeting) GetFineAccountName() string {
+	if x != nil {
+		return x.FineAccountName
+	}
+	return ""
+}
+
+func (x *Meeting) GetAttendance() []*MeetingAttendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+func (x *Meeting) GetResolutions() []*MeetingResolution {
+	if x != nil {
+		return x.Resolutions
+	}
+	return nil
+}
+
+func (x *Meeting) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+type ScheduleMeetingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meeting *Meeting `protobuf:"bytes,1,opt,name=meeting,proto3" json:"meeting,omitempty"`
+}
+
+func (x *ScheduleMeetingRequest) Reset() {
+	*x = ScheduleMeetingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMeetingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMeetingRequest) ProtoMessage() {}
+
+func (x *ScheduleMeetingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMeetingRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMeetingRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ScheduleMeetingRequest) GetMeeting() *Meeting {
+	if x != nil {
+		return x.Meeting
+	}
+	return nil
+}
+
+type UpdateMeetingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meeting *Meeting `protobuf:"bytes,1,opt,name=meeting,proto3" json:"meeting,omitempty"`
+}
+
+func (x *UpdateMeetingRequest) Reset() {
+	*x = UpdateMeetingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMeetingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMeetingRequest) ProtoMessage() {}
+
+func (x *UpdateMeetingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMeetingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMeetingRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateMeetingRequest) GetMeeting() *Meeting {
+	if x != nil {
+		return x.Meeting
+	}
+	return nil
+}
+
+type CancelMeetingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingId string `protobuf:"bytes,1,opt,name=meeting_id,json=meetingId,proto3" json:"meeting_id,omitempty"`
+}
+
+func (x *CancelMeetingRequest) Reset() {
+	*x = CancelMeetingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMeetingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMeetingRequest) ProtoMessage() {}
+
+func (x *CancelMeetingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMeetingRequest.ProtoReflect.Descriptor instead.
+func (*CancelMeetingRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CancelMeetingRequest) GetMeetingId() string {
+	if x != nil {
+		return x.MeetingId
+	}
+	return ""
+}
+
+type GetMeetingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingId string `protobuf:"bytes,1,opt,name=meeting_id,json=meetingId,proto3" json:"meeting_id,omitempty"`
+}
+
+func (x *GetMeetingRequest) Reset() {
+	*x = GetMeetingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeetingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingRequest) ProtoMessage() {}
+
+func (x *GetMeetingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingRequest.ProtoReflect.Descriptor instead.
+func (*GetMeetingRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetMeetingRequest) GetMeetingId() string {
+	if x != nil {
+		return x.MeetingId
+	}
+	return ""
+}
+
+type ListMeetingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaId   string          `protobuf:"bytes,1,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	Statuses  []MeetingStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=gidyon.chama.MeetingStatus" json:"statuses,omitempty"`
+	PageToken string          `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32           `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListMeetingsRequest) Reset() {
+	*x = ListMeetingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMeetingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMeetingsRequest) ProtoMessage() {}
+
+func (x *ListMeetingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMeetingsRequest.ProtoReflect.Descriptor instead.
+func (*ListMeetingsRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListMeetingsRequest) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *ListMeetingsRequest) GetStatuses() []MeetingStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListMeetingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMeetingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListMeetingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meetings      []*Meeting `protobuf:"bytes,1,rep,name=meetings,proto3" json:"meetings,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListMeetingsResponse) Reset() {
+	*x = ListMeetingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMeetingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMeetingsResponse) ProtoMessage() {}
+
+func (x *ListMeetingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMeetingsResponse.ProtoReflect.Descriptor instead.
+func (*ListMeetingsResponse) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListMeetingsResponse) GetMeetings() []*Meeting {
+	if x != nil {
+		return x.Meetings
+	}
+	return nil
+}
+
+func (x *ListMeetingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RecordAttendanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingId  string               `protobuf:"bytes,1,opt,name=meeting_id,json=meetingId,proto3" json:"meeting_id,omitempty"`
+	Attendance []*MeetingAttendance `protobuf:"bytes,2,rep,name=attendance,proto3" json:"attendance,omitempty"`
+}
+
+func (x *RecordAttendanceRequest) Reset() {
+	*x = RecordAttendanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAttendanceRequest) ProtoMessage() {}
+
+func (x *RecordAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*RecordAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RecordAttendanceRequest) GetMeetingId() string {
+	if x != nil {
+		return x.MeetingId
+	}
+	return ""
+}
+
+func (x *RecordAttendanceRequest) GetAttendance() []*MeetingAttendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+type RecordMinutesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingId string `protobuf:"bytes,1,opt,name=meeting_id,json=meetingId,proto3" json:"meeting_id,omitempty"`
+	Minutes   string `protobuf:"bytes,2,opt,name=minutes,proto3" json:"minutes,omitempty"`
+}
+
+func (x *RecordMinutesRequest) Reset() {
+	*x = RecordMinutesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordMinutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordMinutesRequest) ProtoMessage() {}
+
+func (x *RecordMinutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordMinutesRequest.ProtoReflect.Descriptor instead.
+func (*RecordMinutesRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RecordMinutesRequest) GetMeetingId() string {
+	if x != nil {
+		return x.MeetingId
+	}
+	return ""
+}
+
+func (x *RecordMinutesRequest) GetMinutes() string {
+	if x != nil {
+		return x.Minutes
+	}
+	return ""
+}
+
+type AddResolutionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resolution *MeetingResolution `protobuf:"bytes,1,opt,name=resolution,proto3" json:"resolution,omitempty"`
+}
+
+func (x *AddResolutionRequest) Reset() {
+	*x = AddResolutionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddResolutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddResolutionRequest) ProtoMessage() {}
+
+func (x *AddResolutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddResolutionRequest.ProtoReflect.Descriptor instead.
+func (*AddResolutionRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AddResolutionRequest) GetResolution() *MeetingResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return nil
+}
+
+type CloseMeetingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeetingId string `protobuf:"bytes,1,opt,name=meeting_id,json=meetingId,proto3" json:"meeting_id,omitempty"`
+}
+
+func (x *CloseMeetingRequest) Reset() {
+	*x = CloseMeetingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseMeetingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseMeetingRequest) ProtoMessage() {}
+
+func (x *CloseMeetingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseMeetingRequest.ProtoReflect.Descriptor instead.
+func (*CloseMeetingRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CloseMeetingRequest) GetMeetingId() string {
+	if x != nil {
+		return x.MeetingId
+	}
+	return ""
+}
+
+type FineType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FineTypeId  string  `protobuf:"bytes,1,opt,name=fine_type_id,json=fineTypeId,proto3" json:"fine_type_id,omitempty"`
+	ChamaId     string  `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	Name        string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Amount      float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	AccountName string  `protobuf:"bytes,6,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Active      bool    `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedDate string  `protobuf:"bytes,8,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
+}
+
+func (x *FineType) Reset() {
+	*x = FineType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FineType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FineType) ProtoMessage() {}
+
+func (x *FineType) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FineType.ProtoReflect.Descriptor instead.
+func (*FineType) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *FineType) GetFineTypeId() string {
+	if x != nil {
+		return x.FineTypeId
+	}
+	return ""
+}
+
+func (x *FineType) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *FineType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FineType) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FineType) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FineType) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *FineType) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *FineType) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+type Fine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FineId            string     `protobuf:"bytes,1,opt,name=fine_id,json=fineId,proto3" json:"fine_id,omitempty"`
+	ChamaId           string     `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	MemberId          string     `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	MemberNames       string     `protobuf:"bytes,4,opt,name=member_names,json=memberNames,proto3" json:"member_names,omitempty"`
+	FineTypeId        string     `protobuf:"bytes,5,opt,name=fine_type_id,json=fineTypeId,proto3" json:"fine_type_id,omitempty"`
+	FineName          string     `protobuf:"bytes,6,opt,name=fine_name,json=fineName,proto3" json:"fine_name,omitempty"`
+	AccountName       string     `protobuf:"bytes,7,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Amount            float64    `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	PaidAmount        float64    `protobuf:"fixed64,9,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+	Reason            string     `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status            FineStatus `protobuf:"varint,11,opt,name=status,proto3,enum=gidyon.chama.FineStatus" json:"status,omitempty"`
+	IssuedBy          string     `protobuf:"bytes,12,opt,name=issued_by,json=issuedBy,proto3" json:"issued_by,omitempty"`
+	WaiverReason      string     `protobuf:"bytes,13,opt,name=waiver_reason,json=waiverReason,proto3" json:"waiver_reason,omitempty"`
+	WaiverRequestedBy string     `protobuf:"bytes,14,opt,name=waiver_requested_by,json=waiverRequestedBy,proto3" json:"waiver_requested_by,omitempty"`
+	WaivedBy          string     `protobuf:"bytes,15,opt,name=waived_by,json=waivedBy,proto3" json:"waived_by,omitempty"`
+	PaidDate          string     `protobuf:"bytes,16,opt,name=paid_date,json=paidDate,proto3" json:"paid_date,omitempty"`
+	CreatedDate       string     `protobuf:"bytes,17,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
+}
+
+func (x *Fine) Reset() {
+	*x = Fine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fine) ProtoMessage() {}
+
+func (x *Fine) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fine.ProtoReflect.Descriptor instead.
+func (*Fine) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Fine) GetFineId() string {
+	if x != nil {
+		return x.FineId
+	}
+	return ""
+}
+
+func (x *Fine) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *Fine) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *Fine) GetMemberNames() string {
+	if x != nil {
+		return x.MemberNames
+	}
+	return ""
+}
+
+func (x *Fine) GetFineTypeId() string {
+	if x != nil {
+		return x.FineTypeId
+	}
+	return ""
+}
+
+func (x *Fine) GetFineName() string {
+	if x != nil {
+		return x.FineName
+	}
+	return ""
+}
+
+func (x *Fine) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *Fine) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Fine) GetPaidAmount() float64 {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return 0
+}
+
+func (x *Fine) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Fine) GetStatus() FineStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FineStatus_FINE_OUTSTANDING
+}
+
+func (x *Fine) GetIssuedBy() string {
+	if x != nil {
+		return x.IssuedBy
+	}
+	return ""
+}
+
+func (x *Fine) GetWaiverReason() string {
+	if x != nil {
+		return x.WaiverReason
+	}
+	return ""
+}
+
+func (x *Fine) GetWaiverRequestedBy() string {
+	if x != nil {
+		return x.WaiverRequestedBy
+	}
+	return ""
+}
+
+func (x *Fine) GetWaivedBy() string {
+	if x != nil {
+		return x.WaivedBy
+	}
+	return ""
+}
+
+func (x *Fine) GetPaidDate() string {
+	if x != nil {
+		return x.PaidDate
+	}
+	return ""
+}
+
+func (x *Fine) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+type CreateFineTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FineType *FineType `protobuf:"bytes,1,opt,name=fine_type,json=fineType,proto3" json:"fine_type,omitempty"`
+}
+
+func (x *CreateFineTypeRequest) Reset() {
+	*x = CreateFineTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFineTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFineTypeRequest) ProtoMessage() {}
+
+func (x *CreateFineTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFineTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateFineTypeRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateFineTypeRequest) GetFineType() *FineType {
+	if x != nil {
+		return x.FineType
+	}
+	return nil
+}
+
+type UpdateFineTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FineType *FineType `protobuf:"bytes,1,opt,name=fine_type,json=fineType,proto3" json:"fine_type,omitempty"`
+}
+
+func (x *UpdateFineTypeRequest) Reset() {
+	*x = UpdateFineTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFineTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFineTypeRequest) ProtoMessage() {}
+
+func (x *UpdateFineTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFineTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFineTypeRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateFineTypeRequest) GetFineType() *FineType {
+	if x != nil {
+		return x.FineType
+	}
+	return nil
+}
+
+type ListFineTypesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaId    string `protobuf:"bytes,1,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	ActiveOnly bool   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+}
+
+func (x *ListFineTypesRequest) Reset() {
+	*x = ListFineTypesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFineTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFineTypesRequest) ProtoMessage() {}
+
+func (x *ListFineTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFineTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListFineTypesRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListFineTypesRequest) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *ListFineTypesRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListFineTypesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FineTypes []*FineType `protobuf:"bytes,1,rep,name=fine_types,json=fineTypes,proto3" json:"fine_types,omitempty"`
+}
+
+func (x *ListFineTypesResponse) Reset() {
+	*x = ListFineTypesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFineTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFineTypesResponse) ProtoMessage() {}
+
+func (x *ListFineTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFineTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListFineTypesResponse) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListFineTypesResponse) GetFineTypes() []*FineType {
+	if x != nil {
+		return x.FineTypes
+	}
+	return nil
+}
+
+type IssueFineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fine *Fine `protobuf:"bytes,1,opt,name=fine,proto3" json:"fine,omitempty"`
+}
+
+func (x *IssueFineRequest) Reset() {
+	*x = IssueFineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueFineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueFineRequest) ProtoMessage() {}
+
+func (x *IssueFineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueFineRequest.ProtoReflect.Descriptor instead.
+func (*IssueFineRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *IssueFineRequest) GetFine() *Fine {
+	if x != nil {
+		return x.Fine
+	}
+	return nil
+}
+
+type GetFineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FineId string `protobuf:"bytes,1,opt,name=fine_id,json=fineId,proto3" json:"fine_id,omitempty"`
+}
+
+func (x *GetFineRequest) Reset() {
+	*x = GetFineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFineRequest) ProtoMessage() {}
+
+func (x *GetFineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFineRequest.ProtoReflect.Descriptor instead.
+func (*GetFineRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetFineRequest) GetFineId() string {
+	if x != nil {
+		return x.FineId
+	}
+	return ""
+}
+
+type ListFinesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaId   string       `protobuf:"bytes,1,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	MemberId  string       `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Statuses  []FineStatus `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=gidyon.chama.FineStatus" json:"statuses,omitempty"`
+	PageToken string       `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32        `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListFinesRequest) Reset() {
+	*x = ListFinesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFinesRequest) ProtoMessage() {}
+
+func (x *ListFinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFinesRequest.ProtoReflect.Descriptor instead.
+func (*ListFinesRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListFinesRequest) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *ListFinesRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *ListFinesRequest) GetStatuses() []FineStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListFinesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListFinesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListFinesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fines             []*Fine `protobuf:"bytes,1,rep,name=fines,proto3" json:"fines,omitempty"`
+	NextPageToken     string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	OutstandingAmount float64 `protobuf:"fixed64,3,opt,name=outstanding_amount,json=outstandingAmount,proto3" json:"outstanding_amount,omitempty"`
+}
+
+func (x *ListFinesResponse) Reset() {
+	*x = ListFinesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFinesResponse) ProtoMessage() {}
+
+func (x *ListFinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFinesResponse.ProtoReflect.Descriptor instead.
+func (*ListFinesResponse) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListFinesResponse) GetFines() []*Fine {
+	if x != nil {
+		return x.Fines
+	}
+	return nil
+}
+
+func (x *ListFinesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListFinesResponse) GetOutstandingAmount() float64 {
+	if x != nil {
+		return x.OutstandingAmount
+	}
+	return 0
+}
+
+type PayFineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FineId            string  `protobuf:"bytes,1,opt,name=fine_id,json=fineId,proto3" json:"fine_id,omitempty"`
+	Amount            float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	MemberAccountName string  `protobuf:"bytes,3,opt,name=member_account_name,json=memberAccountName,proto3" json:"member_account_name,omitempty"`
+}
+
+func (x *PayFineRequest) Reset() {
+	*x = PayFineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayFineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayFineRequest) ProtoMessage() {}
+
+func (x *PayFineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayFineRequest.ProtoReflect.Descriptor instead.
+func (*PayFineRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *PayFineRequest) GetFineId() string {
+	if x != nil {
+		return x.FineId
+	}
+	return ""
+}
+
+func (x *PayFineRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PayFineRequest) GetMemberAccountName() string {
+	if x != nil {
+		return x.MemberAccountName
+	}
+	return ""
+}
+
+type RequestFineWaiverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FineId string `protobuf:"bytes,1,opt,name=fine_id,json=fineId,proto3" json:"fine_id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RequestFineWaiverRequest) Reset() {
+	*x = RequestFineWaiverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestFineWaiverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestFineWaiverRequest) ProtoMessage() {}
+
+func (x *RequestFineWaiverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestFineWaiverRequest.ProtoReflect.Descriptor instead.
+func (*RequestFineWaiverRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RequestFineWaiverRequest) GetFineId() string {
+	if x != nil {
+		return x.FineId
+	}
+	return ""
+}
+
+func (x *RequestFineWaiverRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ApproveFineWaiverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FineId   string `protobuf:"bytes,1,opt,name=fine_id,json=fineId,proto3" json:"fine_id,omitempty"`
+	Approved bool   `protobuf:"varint,2,opt,name=approved,proto3" json:"approved,omitempty"`
+}
+
+func (x *ApproveFineWaiverRequest) Reset() {
+	*x = ApproveFineWaiverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveFineWaiverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFineWaiverRequest) ProtoMessage() {}
+
+func (x *ApproveFineWaiverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFineWaiverRequest.ProtoReflect.Descriptor instead.
+func (*ApproveFineWaiverRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ApproveFineWaiverRequest) GetFineId() string {
+	if x != nil {
+		return x.FineId
+	}
+	return ""
+}
+
+func (x *ApproveFineWaiverRequest) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+type MemberInvitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationId string           `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	ChamaId      string           `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	Phone        string           `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email        string           `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Status       InvitationStatus `protobuf:"varint,5,opt,name=status,proto3,enum=gidyon.chama.InvitationStatus" json:"status,omitempty"`
+	InvitedBy    string           `protobuf:"bytes,6,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	SendCount    int32            `protobuf:"varint,7,opt,name=send_count,json=sendCount,proto3" json:"send_count,omitempty"`
+	Profile      *ChamaMember     `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"`
+	MemberId     string           `protobuf:"bytes,9,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	ConfirmedBy  string           `protobuf:"bytes,10,opt,name=confirmed_by,json=confirmedBy,proto3" json:"confirmed_by,omitempty"`
+	ExpiresDate  string           `protobuf:"bytes,11,opt,name=expires_date,json=expiresDate,proto3" json:"expires_date,omitempty"`
+	CreatedDate  string           `protobuf:"bytes,12,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
+}
+
+func (x *MemberInvitation) Reset() {
+	*x = MemberInvitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemberInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberInvitation) ProtoMessage() {}
+
+func (x *MemberInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberInvitation.ProtoReflect.Descriptor instead.
+func (*MemberInvitation) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *MemberInvitation) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *MemberInvitation) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *MemberInvitation) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *MemberInvitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *MemberInvitation) GetStatus() InvitationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InvitationStatus_INVITATION_PENDING
+}
+
+func (x *MemberInvitation) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *MemberInvitation) GetSendCount() int32 {
+	if x != nil {
+		return x.SendCount
+	}
+	return 0
+}
+
+func (x *MemberInvitation) GetProfile() *ChamaMember {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *MemberInvitation) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *MemberInvitation) GetConfirmedBy() string {
+	if x != nil {
+		return x.ConfirmedBy
+	}
+	return ""
+}
+
+func (x *MemberInvitation) GetExpiresDate() string {
+	if x != nil {
+		return x.ExpiresDate
+	}
+	return ""
+}
+
+func (x *MemberInvitation) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+type InviteMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaId string `protobuf:"bytes,1,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	Phone   string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email   string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *InviteMemberRequest) Reset() {
+	*x = InviteMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteMemberRequest) ProtoMessage() {}
+
+func (x *InviteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *InviteMemberRequest) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *InviteMemberRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *InviteMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ResendInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationId string `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+}
+
+func (x *ResendInvitationRequest) Reset() {
+	*x = ResendInvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResendInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendInvitationRequest) ProtoMessage() {}
+
+func (x *ResendInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendInvitationRequest.ProtoReflect.Descriptor instead.
+func (*ResendInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ResendInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+type RevokeInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationId string `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RevokeInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+type AcceptInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationId string       `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	Code         string       `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Profile      *ChamaMember `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *AcceptInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *AcceptInvitationRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AcceptInvitationRequest) GetProfile() *ChamaMember {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type ConfirmInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationId string `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+}
+
+func (x *ConfirmInvitationRequest) Reset() {
+	*x = ConfirmInvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmInvitationRequest) ProtoMessage() {}
+
+func (x *ConfirmInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmInvitationRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ConfirmInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+type ListInvitationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaId   string             `protobuf:"bytes,1,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	Statuses  []InvitationStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=gidyon.chama.InvitationStatus" json:"statuses,omitempty"`
+	PageToken string             `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32              `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListInvitationsRequest) Reset() {
+	*x = ListInvitationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsRequest) ProtoMessage() {}
+
+func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListInvitationsRequest) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *ListInvitationsRequest) GetStatuses() []InvitationStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListInvitationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListInvitationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListInvitationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitations   []*MemberInvitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	NextPageToken string              `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResponse) ProtoMessage() {}
+
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListInvitationsResponse) GetInvitations() []*MemberInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+func (x *ListInvitationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SettlementLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LineId      string             `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	LineType    SettlementLineType `protobuf:"varint,2,opt,name=line_type,json=lineType,proto3,enum=gidyon.chama.SettlementLineType" json:"line_type,omitempty"`
+	ReferenceId string             `protobuf:"bytes,3,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Description string             `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Amount      float64            `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Settled     bool               `protobuf:"varint,6,opt,name=settled,proto3" json:"settled,omitempty"`
+}
+
+func (x *SettlementLine) Reset() {
+	*x = SettlementLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettlementLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementLine) ProtoMessage() {}
+
+func (x *SettlementLine) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementLine.ProtoReflect.Descriptor instead.
+func (*SettlementLine) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SettlementLine) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *SettlementLine) GetLineType() SettlementLineType {
+	if x != nil {
+		return x.LineType
+	}
+	return SettlementLineType_SETTLEMENT_SAVINGS
+}
+
+func (x *SettlementLine) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *SettlementLine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SettlementLine) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SettlementLine) GetSettled() bool {
+	if x != nil {
+		return x.Settled
+	}
+	return false
+}
+
+type ExitSettlement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SettlementId          string            `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	MemberId              string            `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	ChamaId               string            `protobuf:"bytes,3,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	MemberNames           string            `protobuf:"bytes,4,opt,name=member_names,json=memberNames,proto3" json:"member_names,omitempty"`
+	Status                ExitStatus        `protobuf:"varint,5,opt,name=status,proto3,enum=gidyon.chama.ExitStatus" json:"status,omitempty"`
+	SavingsAmount         float64           `protobuf:"fixed64,6,opt,name=savings_amount,json=savingsAmount,proto3" json:"savings_amount,omitempty"`
+	ShareCapitalAmount    float64           `protobuf:"fixed64,7,opt,name=share_capital_amount,json=shareCapitalAmount,proto3" json:"share_capital_amount,omitempty"`
+	LoanAmount            float64           `protobuf:"fixed64,8,opt,name=loan_amount,json=loanAmount,proto3" json:"loan_amount,omitempty"`
+	FineAmount            float64           `protobuf:"fixed64,9,opt,name=fine_amount,json=fineAmount,proto3" json:"fine_amount,omitempty"`
+	GuaranteeAmount       float64           `protobuf:"fixed64,10,opt,name=guarantee_amount,json=guaranteeAmount,proto3" json:"guarantee_amount,omitempty"`
+	NetAmount             float64           `protobuf:"fixed64,11,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	Lines                 []*SettlementLine `protobuf:"bytes,12,rep,name=lines,proto3" json:"lines,omitempty"`
+	SettlementAccountName string            `protobuf:"bytes,13,opt,name=settlement_account_name,json=settlementAccountName,proto3" json:"settlement_account_name,omitempty"`
+	Reason                string            `protobuf:"bytes,14,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExitedBy              string            `protobuf:"bytes,15,opt,name=exited_by,json=exitedBy,proto3" json:"exited_by,omitempty"`
+	CompletedDate         string            `protobuf:"bytes,16,opt,name=completed_date,json=completedDate,proto3" json:"completed_date,omitempty"`
+	CreatedDate           string            `protobuf:"bytes,17,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
+}
+
+func (x *ExitSettlement) Reset() {
+	*x = ExitSettlement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExitSettlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExitSettlement) ProtoMessage() {}
+
+func (x *ExitSettlement) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExitSettlement.ProtoReflect.Descriptor instead.
+func (*ExitSettlement) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ExitSettlement) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *ExitSettlement) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *ExitSettlement) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *ExitSettlement) GetMemberNames() string {
+	if x != nil {
+		return x.MemberNames
+	}
+	return ""
+}
+
+func (x *ExitSettlement) GetStatus() ExitStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ExitStatus_EXIT_PREVIEW
+}
+
+func (x *ExitSettlement) GetSavingsAmount() float64 {
+	if x != nil {
+		return x.SavingsAmount
+	}
+	return 0
+}
+
+func (x *ExitSettlement) GetShareCapitalAmount() float64 {
+	if x != nil {
+		return x.ShareCapitalAmount
+	}
+	return 0
+}
+
+func (x *ExitSettlement) GetLoanAmount() float64 {
+	if x != nil {
+		return x.LoanAmount
+	}
+	return 0
+}
+
+func (x *ExitSettlement) GetFineAmount() float64 {
+	if x != nil {
+		return x.FineAmount
+	}
+	return 0
+}
+
+func (x *ExitSettlement) GetGuaranteeAmount() float64 {
+	if x != nil {
+		return x.GuaranteeAmount
+	}
+	return 0
+}
+
+func (x *ExitSettlement) GetNetAmount() float64 {
+	if x != nil {
+		return x.NetAmount
+	}
+	return 0
+}
+
+func (x *ExitSettlement) GetLines() []*SettlementLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *ExitSettlement) GetSettlementAccountName() string {
+	if x != nil {
+		return x.SettlementAccountName
+	}
+	return ""
+}
+
+func (x *ExitSettlement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ExitSettlement) GetExitedBy() string {
+	if x != nil {
+		return x.ExitedBy
+	}
+	return ""
+}
+
+func (x *ExitSettlement) GetCompletedDate() string {
+	if x != nil {
+		return x.CompletedDate
+	}
+	return ""
+}
+
+func (x *ExitSettlement) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+type GetExitStatementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+}
+
+func (x *GetExitStatementRequest) Reset() {
+	*x = GetExitStatementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExitStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExitStatementRequest) ProtoMessage() {}
+
+func (x *GetExitStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExitStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetExitStatementRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetExitStatementRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type ExitChamaMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId              string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	SettlementAccountName string `protobuf:"bytes,2,opt,name=settlement_account_name,json=settlementAccountName,proto3" json:"settlement_account_name,omitempty"`
+	Reason                string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ExitChamaMemberRequest) Reset() {
+	*x = ExitChamaMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExitChamaMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExitChamaMemberRequest) ProtoMessage() {}
+
+func (x *ExitChamaMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExitChamaMemberRequest.ProtoReflect.Descriptor instead.
+func (*ExitChamaMemberRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ExitChamaMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *ExitChamaMemberRequest) GetSettlementAccountName() string {
+	if x != nil {
+		return x.SettlementAccountName
+	}
+	return ""
+}
+
+func (x *ExitChamaMemberRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetExitSettlementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+}
+
+func (x *GetExitSettlementRequest) Reset() {
+	*x = GetExitSettlementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExitSettlementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExitSettlementRequest) ProtoMessage() {}
+
+func (x *GetExitSettlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExitSettlementRequest.ProtoReflect.Descriptor instead.
+func (*GetExitSettlementRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetExitSettlementRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type KycDocument struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocumentId   string          `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	MemberId     string          `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	DocumentType KycDocumentType `protobuf:"varint,3,opt,name=document_type,json=documentType,proto3,enum=gidyon.chama.KycDocumentType" json:"document_type,omitempty"`
+	ContentType  string          `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes    int64           `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	UploadedBy   string          `protobuf:"bytes,6,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
+	CreatedDate  string          `protobuf:"bytes,7,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
+}
+
+func (x *KycDocument) Reset() {
+	*x = KycDocument{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KycDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KycDocument) ProtoMessage() {}
+
+func (x *KycDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KycDocument.ProtoReflect.Descriptor instead.
+func (*KycDocument) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *KycDocument) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *KycDocument) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *KycDocument) GetDocumentType() KycDocumentType {
+	if x != nil {
+		return x.DocumentType
+	}
+	return KycDocumentType_KYC_ID_FRONT
+}
+
+func (x *KycDocument) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *KycDocument) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *KycDocument) GetUploadedBy() string {
+	if x != nil {
+		return x.UploadedBy
+	}
+	return ""
+}
+
+func (x *KycDocument) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+type MemberKyc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId      string         `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	ChamaId       string         `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	MemberNames   string         `protobuf:"bytes,3,opt,name=member_names,json=memberNames,proto3" json:"member_names,omitempty"`
+	Status        KycStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=gidyon.chama.KycStatus" json:"status,omitempty"`
+	Documents     []*KycDocument `protobuf:"bytes,5,rep,name=documents,proto3" json:"documents,omitempty"`
+	ReviewerId    string         `protobuf:"bytes,6,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	ReviewerNotes string         `protobuf:"bytes,7,opt,name=reviewer_notes,json=reviewerNotes,proto3" json:"reviewer_notes,omitempty"`
+	SubmittedDate string         `protobuf:"bytes,8,opt,name=submitted_date,json=submittedDate,proto3" json:"submitted_date,omitempty"`
+	ReviewedDate  string         `protobuf:"bytes,9,opt,name=reviewed_date,json=reviewedDate,proto3" json:"reviewed_date,omitempty"`
+}
+
+func (x *MemberKyc) Reset() {
+	*x = MemberKyc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemberKyc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberKyc) ProtoMessage() {}
+
+func (x *MemberKyc) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberKyc.ProtoReflect.Descriptor instead.
+func (*MemberKyc) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *MemberKyc) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *MemberKyc) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *MemberKyc) GetMemberNames() string {
+	if x != nil {
+		return x.MemberNames
+	}
+	return ""
+}
+
+func (x *MemberKyc) GetStatus() KycStatus {
+	if x != nil {
+		return x.Status
+	}
+	return KycStatus_KYC_NOT_SUBMITTED
+}
+
+func (x *MemberKyc) GetDocuments() []*KycDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *MemberKyc) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *MemberKyc) GetReviewerNotes() string {
+	if x != nil {
+		return x.ReviewerNotes
+	}
+	return ""
+}
+
+func (x *MemberKyc) GetSubmittedDate() string {
+	if x != nil {
+		return x.SubmittedDate
+	}
+	return ""
+}
+
+func (x *MemberKyc) GetReviewedDate() string {
+	if x != nil {
+		return x.ReviewedDate
+	}
+	return ""
+}
+
+type UploadKycDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId     string          `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	DocumentType KycDocumentType `protobuf:"varint,2,opt,name=document_type,json=documentType,proto3,enum=gidyon.chama.KycDocumentType" json:"document_type,omitempty"`
+	ContentType  string          `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content      []byte          `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *UploadKycDocumentRequest) Reset() {
+	*x = UploadKycDocumentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadKycDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadKycDocumentRequest) ProtoMessage() {}
+
+func (x *UploadKycDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadKycDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UploadKycDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UploadKycDocumentRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *UploadKycDocumentRequest) GetDocumentType() KycDocumentType {
+	if x != nil {
+		return x.DocumentType
+	}
+	return KycDocumentType_KYC_ID_FRONT
+}
+
+func (x *UploadKycDocumentRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadKycDocumentRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type DownloadKycDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocumentId string `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+}
+
+func (x *DownloadKycDocumentRequest) Reset() {
+	*x = DownloadKycDocumentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadKycDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadKycDocumentRequest) ProtoMessage() {}
+
+func (x *DownloadKycDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadKycDocumentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadKycDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DownloadKycDocumentRequest) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+type DownloadKycDocumentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Document *KycDocument `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	Content  []byte       `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *DownloadKycDocumentResponse) Reset() {
+	*x = DownloadKycDocumentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadKycDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadKycDocumentResponse) ProtoMessage() {}
+
+func (x *DownloadKycDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadKycDocumentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadKycDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DownloadKycDocumentResponse) GetDocument() *KycDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *DownloadKycDocumentResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type GetMemberKycRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+}
+
+func (x *GetMemberKycRequest) Reset() {
+	*x = GetMemberKycRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMemberKycRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberKycRequest) ProtoMessage() {}
+
+func (x *GetMemberKycRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberKycRequest.ProtoReflect.Descriptor instead.
+func (*GetMemberKycRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetMemberKycRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type ReviewMemberKycRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Verified bool   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	Notes    string `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *ReviewMemberKycRequest) Reset() {
+	*x = ReviewMemberKycRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewMemberKycRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewMemberKycRequest) ProtoMessage() {}
+
+func (x *ReviewMemberKycRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewMemberKycRequest.ProtoReflect.Descriptor instead.
+func (*ReviewMemberKycRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ReviewMemberKycRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *ReviewMemberKycRequest) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *ReviewMemberKycRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type ListMemberKycRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaId   string      `protobuf:"bytes,1,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	Statuses  []KycStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=gidyon.chama.KycStatus" json:"statuses,omitempty"`
+	PageToken string      `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32       `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListMemberKycRequest) Reset() {
+	*x = ListMemberKycRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMemberKycRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberKycRequest) ProtoMessage() {}
+
+func (x *ListMemberKycRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberKycRequest.ProtoReflect.Descriptor instead.
+func (*ListMemberKycRequest) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListMemberKycRequest) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *ListMemberKycRequest) GetStatuses() []KycStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListMemberKycRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMemberKycRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListMemberKycResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberKyc     []*MemberKyc `protobuf:"bytes,1,rep,name=member_kyc,json=memberKyc,proto3" json:"member_kyc,omitempty"`
+	NextPageToken string       `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListMemberKycResponse) Reset() {
+	*x = ListMemberKycResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chama_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMemberKycResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberKycResponse) ProtoMessage() {}
+
+func (x *ListMemberKycResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chama_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberKycResponse.ProtoReflect.Descriptor instead.
+func (*ListMemberKycResponse) Descriptor() ([]byte, []int) {
+	return file_chama_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListMemberKycResponse) GetMemberKyc() []*MemberKyc {
+	if x != nil {
+		return x.MemberKyc
+	}
+	return nil
+}
+
+func (x *ListMemberKycResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_chama_proto protoreflect.FileDescriptor
+
+var file_chama_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
+	0x75, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x96, 0x02, 0x0a, 0x05, 0x43, 0x68, 0x61,
+	0x6d, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x22, 0x4d, 0x0a, 0x0b, 0x54, 0x72, 0x75, 0x73, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x22, 0xfb, 0x06, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x6a,
+	0x6f, 0x62, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e,
+	0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6a, 0x6f, 0x62,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x03, 0x6b, 0x79, 0x63, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x2e,
+	0x4b, 0x79, 0x63, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x6b, 0x79, 0x63, 0x12, 0x3f, 0x0a,
+	0x0d, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52,
+	0x0d, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x39,
+	0x0a, 0x0a, 0x67, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x0a, 0x67,
+	0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x74, 0x68, 0x65, 0x72,
+	0x5f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x73, 0x12,
+	0x36, 0x0a, 0x0a, 0x6b, 0x79, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x2e, 0x4b, 0x79, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x6b, 0x79,
+	0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x4a, 0x6f, 0x62, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x36, 0x0a, 0x08, 0x4b, 0x79, 0x63, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x45,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x22, 0x45, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x22, 0x2e, 0x0a, 0x0b,
+	0x43, 0x68, 0x61, 0x6d, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x73, 0x22, 0x82, 0x01, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0x69, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x52, 0x06, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x32, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64,
+	0x22, 0x68, 0x0a, 0x16, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x50, 0x0a, 0x1b, 0x46, 0x69,
+	0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x64, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x5e, 0x0a, 0x1c,
+	0x46, 0x69, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0c,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x84, 0x01, 0x0a,
+	0x18, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x11, 0x70, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x14, 0x64,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x12, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x22, 0x5e, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x42, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0x5e, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x42, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0xb7, 0x02, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24,
+	0x0a, 0x0e, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x6f,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x77, 0x69, 0x74, 0x68, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x6e, 0x5f, 0x61, 0x72, 0x72, 0x65, 0x61, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x41, 0x72, 0x72, 0x65, 0x61, 0x72, 0x73, 0x22, 0xcc, 0x01,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3c,
+	0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x82, 0x01, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e,
+	0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0c, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x3a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x22, 0xf7, 0x02,
+	0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c,
+	0x61, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x41, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x64, 0x75, 0x65, 0x44, 0x61, 0x79, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x56, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6c, 0x61, 0x6e, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22,
+	0x3d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x22, 0xaf,
+	0x02, 0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x72, 0x72, 0x65, 0x61, 0x72, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x33, 0x0a, 0x15, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x75,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6f, 0x77, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x77, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69,
+	0x6e, 0x65, 0x73, 0x5f, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x66, 0x69, 0x6e, 0x65, 0x73, 0x4f, 0x77, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x77, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6c, 0x64, 0x65,
+	0x73, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x44, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65,
+	0x22, 0x41, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x72, 0x65, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x49, 0x64, 0x22, 0x77, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x72, 0x65, 0x61, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x72, 0x72, 0x65, 0x61, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x72, 0x72,
+	0x65, 0x61, 0x72, 0x73, 0x52, 0x07, 0x61, 0x72, 0x72, 0x65, 0x61, 0x72, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x77, 0x65, 0x64, 0x22, 0x91, 0x01, 0x0a,
+	0x0c, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x43, 0x79, 0x63, 0x6c, 0x65,
+	0x22, 0xa7, 0x03, 0x0a, 0x0d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x79, 0x63,
+	0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70, 0x6f, 0x74, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x62, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x61, 0x69, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x61, 0x69, 0x64,
+	0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x61, 0x69, 0x64, 0x4f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x82, 0x04, 0x0a, 0x08, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x79,
+	0x63, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c,
+	0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x63, 0x79, 0x63,
+	0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x05, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22,
+	0x70, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x22, 0x3b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0b, 0x72, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41,
+	0x01, 0x02, 0x52, 0x0a, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x6d,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x75, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x21, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0b, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0a, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x43, 0x0a, 0x1a, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0b, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0a, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x17, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0b, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0a,
+	0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x09, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x63, 0x0a, 0x17, 0x53,
+	0x6b, 0x69, 0x70, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x72, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0b, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x02, 0x52, 0x0a, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x92, 0x01, 0x0a, 0x18, 0x53, 0x77, 0x61, 0x70, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0b, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0a, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x0f, 0x6f, 0x74, 0x68, 0x65, 0x72,
+	0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x49, 0x64, 0x22, 0xef, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x2e, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x66, 0x69, 0x6e, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69,
+	0x6e, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66,
+	0x69, 0x6e, 0x65, 0x50, 0x61, 0x69, 0x64, 0x22, 0xe6, 0x02, 0x0a, 0x11, 0x4d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0a, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x09, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41,
+	0x01, 0x02, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x79,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x41, 0x67, 0x61, 0x69,
+	0x6e, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x22, 0xc2, 0x04, 0x0a, 0x07, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x65, 0x6e,
+	0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x64, 0x61, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x64, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x65, 0x5f, 0x66, 0x69, 0x6e,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x65,
+	0x65, 0x46, 0x69, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69,
+	0x6e, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x6e, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66,
+	0x69, 0x6e, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3f,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x41, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x4f, 0x0a, 0x16, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x35, 0x0a, 0x07, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e,
+	0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x4d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35,
+	0x0a, 0x07, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x3b, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0a, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x49, 0x64, 0x22, 0x38, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x02, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0xa5, 0x01, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12,
+	0x37, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x71, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x09, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x65,
+	0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0x5b, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x02, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x07,
+	0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x14,
+	0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x13, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x09, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x8b, 0x02, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x27, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xba, 0x04, 0x0a, 0x04, 0x46, 0x69, 0x6e, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x02, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x69, 0x64,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70,
+	0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x2e, 0x46, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x42, 0x79,
+	0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x61, 0x69, 0x76, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x77, 0x61, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x69, 0x76, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x69, 0x76, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x69, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x22, 0x52, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x66,
+	0x69, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x46, 0x69,
+	0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x66, 0x69,
+	0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x52, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x39, 0x0a, 0x09, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02,
+	0x52, 0x08, 0x66, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x58, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e,
+	0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x4e, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x0a, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x2e, 0x46, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x10, 0x49, 0x73, 0x73, 0x75, 0x65, 0x46, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x66, 0x69, 0x6e, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02,
+	0x52, 0x04, 0x66, 0x69, 0x6e, 0x65, 0x22, 0x2f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x66, 0x69, 0x6e, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x06, 0x66, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05,
+	0x66, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x52,
+	0x05, 0x66, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2d,
+	0x0a, 0x12, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x73,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x77, 0x0a,
+	0x0e, 0x50, 0x61, 0x79, 0x46, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x07, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x57, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x46, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x65, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x55, 0x0a, 0x18, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6e, 0x65, 0x57, 0x61,
+	0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x66,
+	0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41,
+	0x01, 0x02, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x22, 0xb5, 0x03, 0x0a, 0x10, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x36, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x62,
+	0x0a, 0x13, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x22, 0x44, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a,
+	0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02,
+	0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x99,
+	0x01, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x0d, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x39, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e,
+	0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x02, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x45, 0x0a, 0x18, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0xb1, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x08,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x3a, 0x0a,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x40, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xdf, 0x01, 0x0a, 0x0e,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x69,
+	0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x22, 0x92, 0x05,
+	0x0a, 0x0e, 0x45, 0x78, 0x69, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e,
+	0x45, 0x78, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x61, 0x76, 0x69,
+	0x6e, 0x67, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x5f, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x73, 0x68, 0x61, 0x72, 0x65, 0x43, 0x61,
+	0x70, 0x69, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x6c, 0x6f, 0x61, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x67, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x67, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6e, 0x65,
+	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x73,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x78, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x65, 0x78, 0x69, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x22, 0x3c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x91, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3c,
+	0x0a, 0x17, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x15, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x74, 0x53,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x95, 0x02, 0x0a, 0x0b, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x42, 0x0a, 0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69,
+	0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xe4, 0x02, 0x0a, 0x09,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4b, 0x79, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x4b, 0x79, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x79, 0x63,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x42, 0x0a, 0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41,
+	0x01, 0x02, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1e, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
+	0x43, 0x0a, 0x1a, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x79, 0x63, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x6e, 0x0a, 0x1b, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x22, 0x38, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x4b, 0x79, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6d,
+	0x0a, 0x16, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4b, 0x79,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0xa8, 0x01,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4b, 0x79, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4b, 0x79, 0x63, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x77, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4b, 0x79, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6b, 0x79, 0x63, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4b, 0x79, 0x63, 0x52, 0x09,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4b, 0x79, 0x63, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x2a, 0x48, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0f,
+	0x0a, 0x0b, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x43, 0x48, 0x41, 0x49, 0x52, 0x4d, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a,
+	0x09, 0x54, 0x52, 0x45, 0x41, 0x53, 0x55, 0x52, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09,
+	0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x41, 0x52, 0x59, 0x10, 0x03, 0x2a, 0x60, 0x0a, 0x12, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x53, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x56, 0x45, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x45, 0x4d,
+	0x42, 0x45, 0x52, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x4f, 0x4e, 0x4c, 0x59,
+	0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x53, 0x5f, 0x49, 0x4e,
+	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x02, 0x2a, 0x76, 0x0a,
+	0x0f, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x12, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f,
+	0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x45, 0x4d, 0x42,
+	0x45, 0x52, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x4c, 0x44, 0x45, 0x53, 0x54, 0x10, 0x01,
+	0x12, 0x18, 0x0a, 0x14, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45,
+	0x4d, 0x42, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x44,
+	0x45, 0x53, 0x43, 0x10, 0x03, 0x2a, 0x5c, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x19,
+	0x0a, 0x15, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x45, 0x45,
+	0x4b, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x54, 0x4e, 0x49, 0x47,
+	0x48, 0x54, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x4c,
+	0x59, 0x10, 0x03, 0x2a, 0x3e, 0x0a, 0x0d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x5f,
+	0x44, 0x52, 0x41, 0x57, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x49, 0x44, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x02, 0x2a, 0x3d, 0x0a, 0x0e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x4f, 0x54, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x4f,
+	0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44,
+	0x10, 0x01, 0x2a, 0x4f, 0x0a, 0x0d, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x45, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53,
+	0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x45,
+	0x45, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x48, 0x45, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
+	0x4d, 0x45, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45,
+	0x44, 0x10, 0x02, 0x2a, 0x6a, 0x0a, 0x10, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x54, 0x54, 0x45, 0x4e,
+	0x44, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x01,
+	0x12, 0x08, 0x0a, 0x04, 0x4c, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x42,
+	0x53, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x42, 0x53, 0x45, 0x4e, 0x54,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x41, 0x50, 0x4f, 0x4c, 0x4f, 0x47, 0x59, 0x10, 0x04, 0x2a,
+	0x5b, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a,
+	0x10, 0x46, 0x49, 0x4e, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x49, 0x4e, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x56,
+	0x45, 0x52, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x46, 0x49, 0x4e, 0x45, 0x5f, 0x50, 0x41, 0x49, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x46,
+	0x49, 0x4e, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x56, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x8e, 0x01, 0x0a,
+	0x10, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x56,
+	0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x02, 0x12, 0x16, 0x0a,
+	0x12, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x56, 0x4f,
+	0x4b, 0x45, 0x44, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x8e, 0x01,
+	0x0a, 0x12, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x53, 0x41, 0x56, 0x49, 0x4e, 0x47, 0x53, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45,
+	0x5f, 0x43, 0x41, 0x50, 0x49, 0x54, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x45,
+	0x54, 0x54, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x41, 0x4e, 0x10, 0x02, 0x12,
+	0x13, 0x0a, 0x0f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x49,
+	0x4e, 0x45, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x47, 0x55, 0x41, 0x52, 0x41, 0x4e, 0x54, 0x45, 0x45, 0x10, 0x04, 0x2a, 0x45,
+	0x0a, 0x0a, 0x45, 0x78, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x0c,
+	0x45, 0x58, 0x49, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x45, 0x58, 0x49, 0x54, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x58, 0x49, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x57, 0x0a, 0x09, 0x4b, 0x79, 0x63, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x15, 0x0a, 0x11, 0x4b, 0x59, 0x43, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55,
+	0x42, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x59, 0x43,
+	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4b, 0x59,
+	0x43, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c,
+	0x4b, 0x59, 0x43, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x44,
+	0x0a, 0x0f, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4b, 0x59, 0x43, 0x5f, 0x49, 0x44, 0x5f, 0x46, 0x52, 0x4f, 0x4e,
+	0x54, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x59, 0x43, 0x5f, 0x49, 0x44, 0x5f, 0x42, 0x41,
+	0x43, 0x4b, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4b, 0x59, 0x43, 0x5f, 0x53, 0x45, 0x4c, 0x46,
+	0x49, 0x45, 0x10, 0x02, 0x32, 0xa7, 0x08, 0x0a, 0x08, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x41, 0x50,
+	0x49, 0x12, 0x67, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61,
+	0x12, 0x20, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x12, 0x78, 0x0a, 0x0b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x12, 0x20, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x32, 0x24,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x73, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0x98, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61,
+	0x6d, 0x61, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x5a, 0x2a,
+	0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61,
+	0x6d, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x12,
+	0x66, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x12, 0x1d, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x22,
+	0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x2f, 0x7b, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x93, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x28, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x53,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2c, 0x3a, 0x01, 0x2a, 0x22, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x3a, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0xbe, 0x01,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x28, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x22,
+	0x5d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x57, 0x5a, 0x2c, 0x3a, 0x01, 0x2a, 0x22, 0x27, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x73, 0x3a, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x3a, 0x67, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0xdd,
+	0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x72, 0x72, 0x65, 0x61, 0x72, 0x73, 0x12, 0x2c, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x72, 0x65, 0x61, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x72, 0x65, 0x61, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x5f, 0x5a,
+	0x30, 0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x72, 0x65, 0x61, 0x72,
+	0x73, 0x12, 0x2b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x72, 0x65, 0x61, 0x72, 0x73, 0x32, 0xb5,
+	0x08, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x50,
+	0x49, 0x12, 0x79, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6d,
+	0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01,
+	0x2a, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x92, 0x01, 0x0a,
+	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x26, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x3a, 0x01, 0x2a, 0x32, 0x32, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x2e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0xb5, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4c, 0x5a, 0x2f, 0x3a,
+	0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x3a, 0x6c, 0x69,
+	0x73, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x19,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x7f, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e,
+	0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x2d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x83, 0x01, 0x0a, 0x0f, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x24,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22,
+	0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x3a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0xce, 0x01, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x42,
+	0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x79,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x5f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x59, 0x5a, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x3a, 0x66, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x28, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x3a, 0x66, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x82, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x26, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6d,
+	0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43,
+	0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x3a, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x32, 0xd4, 0x08, 0x0a, 0x0b, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x50, 0x49, 0x12, 0x70, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a,
+	0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x72,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x75, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0xa3, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x43, 0x5a, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xa0, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x79, 0x63,
+	0x6c, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x8b, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x79, 0x63, 0x6c, 0x65,
+	0x12, 0x28, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e,
+	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x79,
+	0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a,
+	0x01, 0x2a, 0x22, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x70, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x64, 0x12, 0x25, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x22,
+	0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x3a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x69, 0x64, 0x12, 0x7d, 0x0a, 0x10, 0x53,
+	0x6b, 0x69, 0x70, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x72, 0x6e, 0x12,
+	0x25, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x53,
+	0x6b, 0x69, 0x70, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x72, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x3a, 0x73, 0x6b, 0x69, 0x70, 0x54, 0x75, 0x72, 0x6e, 0x12, 0x80, 0x01, 0x0a, 0x11, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x72, 0x6e, 0x73,
+	0x12, 0x26, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x72, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x3a, 0x73, 0x77, 0x61, 0x70, 0x54, 0x75, 0x72, 0x6e, 0x73, 0x32, 0x84, 0x09,
+	0x0a, 0x0a, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x50, 0x49, 0x12, 0x70, 0x0a, 0x0f,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x24, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x20, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x81,
+	0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x35, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x32, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x64, 0x7d, 0x12, 0x73, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x27,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x3a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x70, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x2a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2f, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x9d, 0x01, 0x0a, 0x0c, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x21, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x5a, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6d, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x2f, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x10, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x31, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a, 0x22, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x7a, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x2e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x0d,
+	0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6d, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x3a, 0x61, 0x64, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x70, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x21, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x26, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x32, 0xde, 0x08, 0x0a, 0x07, 0x46, 0x69, 0x6e, 0x65, 0x41, 0x50, 0x49,
+	0x12, 0x71, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x66, 0x69, 0x6e, 0x65, 0x2d, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x12, 0x8a, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x3a, 0x01, 0x2a, 0x32, 0x30,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x66, 0x69, 0x6e,
+	0x65, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x7b, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0xa5, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x45, 0x5a, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d,
+	0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x66, 0x69, 0x6e, 0x65, 0x2d, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x66, 0x69,
+	0x6e, 0x65, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x5e, 0x0a, 0x09, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x46, 0x69, 0x6e, 0x65, 0x12, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x46, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x2f, 0x66, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46,
+	0x69, 0x6e, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x12, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x2e, 0x46, 0x69, 0x6e, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x66, 0x69, 0x6e, 0x65,
+	0x73, 0x2f, 0x7b, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x8b, 0x01, 0x0a, 0x09,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6e,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6e,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x37, 0x5a, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x66, 0x69, 0x6e, 0x65, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74,
+	0x46, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2f, 0x66, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x5e, 0x0a, 0x07, 0x50, 0x61, 0x79,
+	0x46, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x50, 0x61, 0x79, 0x46, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01,
+	0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f,
+	0x66, 0x69, 0x6e, 0x65, 0x73, 0x3a, 0x70, 0x61, 0x79, 0x12, 0x7c, 0x0a, 0x11, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x69, 0x76, 0x65, 0x72, 0x12, 0x26,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x69, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2f, 0x66, 0x69, 0x6e, 0x65, 0x73, 0x3a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x57, 0x61, 0x69, 0x76, 0x65, 0x72, 0x12, 0x7c, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x46, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x69, 0x76, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x46, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2f, 0x66, 0x69, 0x6e, 0x65, 0x73, 0x3a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x57,
+	0x61, 0x69, 0x76, 0x65, 0x72, 0x32, 0xdc, 0x06, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x50, 0x49, 0x12, 0x76, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x85, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x3a, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x12, 0x85, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12,
+	0x85, 0x01, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x3a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x88, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a,
+	0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x12, 0xaf, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x5a, 0x2d, 0x3a, 0x01, 0x2a,
+	0x22, 0x28, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x32, 0x9e, 0x03, 0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x45,
+	0x78, 0x69, 0x74, 0x41, 0x50, 0x49, 0x12, 0x86, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x78,
+	0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78,
+	0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x65, 0x78, 0x69, 0x74, 0x73, 0x3a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x7a, 0x0a, 0x0f, 0x45, 0x78, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x24, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01,
+	0x2a, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x65, 0x78, 0x69, 0x74, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x26, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12,
+	0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x65, 0x78, 0x69, 0x74, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x7d, 0x32, 0xa3, 0x05, 0x0a, 0x06, 0x4b, 0x79, 0x63, 0x41, 0x50, 0x49,
+	0x12, 0x78, 0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x79, 0x63, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x4b, 0x79, 0x63, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01,
+	0x2a, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f,
+	0x6b, 0x79, 0x63, 0x3a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x9c, 0x01, 0x0a, 0x13, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x28, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12,
+	0x28, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6b, 0x79,
+	0x63, 0x2f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x70, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4b, 0x79, 0x63, 0x12, 0x21, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x4b, 0x79, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x4b, 0x79, 0x63, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6b, 0x79, 0x63, 0x2f,
+	0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x74, 0x0a, 0x0f, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4b, 0x79, 0x63, 0x12, 0x24,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4b, 0x79, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4b, 0x79, 0x63, 0x22, 0x22, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d,
+	0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6b, 0x79, 0x63, 0x3a, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x97, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x4b, 0x79, 0x63, 0x12, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4b, 0x79, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x4b, 0x79, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x37, 0x5a, 0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6b, 0x79, 0x63, 0x3a, 0x6c, 0x69, 0x73, 0x74,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4b, 0x79, 0x63, 0x12, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6b, 0x79, 0x63, 0x42, 0x35, 0x5a, 0x33, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_chama_proto_rawDescOnce sync.Once
+	file_chama_proto_rawDescData = file_chama_proto_rawDesc
+)
+
+func file_chama_proto_rawDescGZIP() []byte {
+	file_chama_proto_rawDescOnce.Do(func() {
+		file_chama_proto_rawDescData = protoimpl.X.CompressGZIP(file_chama_proto_rawDescData)
+	})
+	return file_chama_proto_rawDescData
+}
+
+var file_chama_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_chama_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
+var file_chama_proto_goTypes = []interface{}{
+	(ChamaRole)(0),                            // 0: gidyon.chama.ChamaRole
+	(MemberActiveFilter)(0),                   // 1: gidyon.chama.MemberActiveFilter
+	(MemberSortOrder)(0),                      // 2: gidyon.chama.MemberSortOrder
+	(ContributionFrequency)(0),                // 3: gidyon.chama.ContributionFrequency
+	(RotationOrder)(0),                        // 4: gidyon.chama.RotationOrder
+	(RotationStatus)(0),                       // 5: gidyon.chama.RotationStatus
+	(MeetingStatus)(0),                        // 6: gidyon.chama.MeetingStatus
+	(AttendanceStatus)(0),                     // 7: gidyon.chama.AttendanceStatus
+	(FineStatus)(0),                           // 8: gidyon.chama.FineStatus
+	(InvitationStatus)(0),                     // 9: gidyon.chama.InvitationStatus
+	(SettlementLineType)(0),                   // 10: gidyon.chama.SettlementLineType
+	(ExitStatus)(0),                           // 11: gidyon.chama.ExitStatus
+	(KycStatus)(0),                            // 12: gidyon.chama.KycStatus
+	(KycDocumentType)(0),                      // 13: gidyon.chama.KycDocumentType
+	(*Chama)(nil),                             // 14: gidyon.chama.Chama
+	(*TrustPerson)(nil),                       // 15: gidyon.chama.TrustPerson
+	(*ChamaMember)(nil),                       // 16: gidyon.chama.ChamaMember
+	(*CreateChamaRequest)(nil),                // 17: gidyon.chama.CreateChamaRequest
+	(*UpdateChamaRequest)(nil),                // 18: gidyon.chama.UpdateChamaRequest
+	(*ChamaFilter)(nil),                       // 19: gidyon.chama.ChamaFilter
+	(*ListChamasRequest)(nil),                 // 20: gidyon.chama.ListChamasRequest
+	(*ListChamasResponse)(nil),                // 21: gidyon.chama.ListChamasResponse
+	(*GetChamaRequest)(nil),                   // 22: gidyon.chama.GetChamaRequest
+	(*AssignChamaRoleRequest)(nil),            // 23: gidyon.chama.AssignChamaRoleRequest
+	(*FindMemberByIdentityRequest)(nil),       // 24: gidyon.chama.FindMemberByIdentityRequest
+	(*FindMemberByIdentityResponse)(nil),      // 25: gidyon.chama.FindMemberByIdentityResponse
+	(*MergeChamaMembersRequest)(nil),          // 26: gidyon.chama.MergeChamaMembersRequest
+	(*CreateChamaMemberRequest)(nil),          // 27: gidyon.chama.CreateChamaMemberRequest
+	(*UpdateChamaMemberRequest)(nil),          // 28: gidyon.chama.UpdateChamaMemberRequest
+	(*ChamaMemberFilter)(nil),                 // 29: gidyon.chama.ChamaMemberFilter
+	(*ListChamaMembersRequest)(nil),           // 30: gidyon.chama.ListChamaMembersRequest
+	(*ListChamaMembersResponse)(nil),          // 31: gidyon.chama.ListChamaMembersResponse
+	(*GetChamaMemberRequest)(nil),             // 32: gidyon.chama.GetChamaMemberRequest
+	(*ContributionPlan)(nil),                  // 33: gidyon.chama.ContributionPlan
+	(*SetContributionPlanRequest)(nil),        // 34: gidyon.chama.SetContributionPlanRequest
+	(*GetContributionPlanRequest)(nil),        // 35: gidyon.chama.GetContributionPlanRequest
+	(*MemberArrears)(nil),                     // 36: gidyon.chama.MemberArrears
+	(*ListContributionArrearsRequest)(nil),    // 37: gidyon.chama.ListContributionArrearsRequest
+	(*ListContributionArrearsResponse)(nil),   // 38: gidyon.chama.ListContributionArrearsResponse
+	(*RotationSlot)(nil),                      // 39: gidyon.chama.RotationSlot
+	(*RotationCycle)(nil),                     // 40: gidyon.chama.RotationCycle
+	(*Rotation)(nil),                          // 41: gidyon.chama.Rotation
+	(*CreateRotationRequest)(nil),             // 42: gidyon.chama.CreateRotationRequest
+	(*GetRotationRequest)(nil),                // 43: gidyon.chama.GetRotationRequest
+	(*ListRotationsRequest)(nil),              // 44: gidyon.chama.ListRotationsRequest
+	(*ListRotationsResponse)(nil),             // 45: gidyon.chama.ListRotationsResponse
+	(*RecordRotationContributionRequest)(nil), // 46: gidyon.chama.RecordRotationContributionRequest
+	(*PayoutRotationCycleRequest)(nil),        // 47: gidyon.chama.PayoutRotationCycleRequest
+	(*PlaceRotationBidRequest)(nil),           // 48: gidyon.chama.PlaceRotationBidRequest
+	(*SkipRotationTurnRequest)(nil),           // 49: gidyon.chama.SkipRotationTurnRequest
+	(*SwapRotationTurnsRequest)(nil),          // 50: gidyon.chama.SwapRotationTurnsRequest
+	(*MeetingAttendance)(nil),                 // 51: gidyon.chama.MeetingAttendance
+	(*MeetingResolution)(nil),                 // 52: gidyon.chama.MeetingResolution
+	(*Meeting)(nil),                           // 53: gidyon.chama.Meeting
+	(*ScheduleMeetingRequest)(nil),            // 54: gidyon.chama.ScheduleMeetingRequest
+	(*UpdateMeetingRequest)(nil),              // 55: gidyon.chama.UpdateMeetingRequest
+	(*CancelMeetingRequest)(nil),              // 56: gidyon.chama.CancelMeetingRequest
+	(*GetMeetingRequest)(nil),                 // 57: gidyon.chama.GetMeetingRequest
+	(*ListMeetingsRequest)(nil),               // 58: gidyon.chama.ListMeetingsRequest
+	(*ListMeetingsResponse)(nil),              // 59: gidyon.chama.ListMeetingsResponse
+	(*RecordAttendanceRequest)(nil),           // 60: gidyon.chama.RecordAttendanceRequest
+	(*RecordMinutesRequest)(nil),              // 61: gidyon.chama.RecordMinutesRequest
+	(*AddResolutionRequest)(nil),              // 62: gidyon.chama.AddResolutionRequest
+	(*CloseMeetingRequest)(nil),               // 63: gidyon.chama.CloseMeetingRequest
+	(*FineType)(nil),                          // 64: gidyon.chama.FineType
+	(*Fine)(nil),                              // 65: gidyon.chama.Fine
+	(*CreateFineTypeRequest)(nil),             // 66: gidyon.chama.CreateFineTypeRequest
+	(*UpdateFineTypeRequest)(nil),             // 67: gidyon.chama.UpdateFineTypeRequest
+	(*ListFineTypesRequest)(nil),              // 68: gidyon.chama.ListFineTypesRequest
+	(*ListFineTypesResponse)(nil),             // 69: gidyon.chama.ListFineTypesResponse
+	(*IssueFineRequest)(nil),                  // 70: gidyon.chama.IssueFineRequest
+	(*GetFineRequest)(nil),                    // 71: gidyon.chama.GetFineRequest
+	(*ListFinesRequest)(nil),                  // 72: gidyon.chama.ListFinesRequest
+	(*ListFinesResponse)(nil),                 // 73: gidyon.chama.ListFinesResponse
+	(*PayFineRequest)(nil),                    // 74: gidyon.chama.PayFineRequest
+	(*RequestFineWaiverRequest)(nil),          // 75: gidyon.chama.RequestFineWaiverRequest
+	(*ApproveFineWaiverRequest)(nil),          // 76: gidyon.chama.ApproveFineWaiverRequest
+	(*MemberInvitation)(nil),                  // 77: gidyon.chama.MemberInvitation
+	(*InviteMemberRequest)(nil),               // 78: gidyon.chama.InviteMemberRequest
+	(*ResendInvitationRequest)(nil),           // 79: gidyon.chama.ResendInvitationRequest
+	(*RevokeInvitationRequest)(nil),           // 80: gidyon.chama.RevokeInvitationRequest
+	(*AcceptInvitationRequest)(nil),           // 81: gidyon.chama.AcceptInvitationRequest
+	(*ConfirmInvitationRequest)(nil),          // 82: gidyon.chama.ConfirmInvitationRequest
+	(*ListInvitationsRequest)(nil),            // 83: gidyon.chama.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),           // 84: gidyon.chama.ListInvitationsResponse
+	(*SettlementLine)(nil),                    // 85: gidyon.chama.SettlementLine
+	(*ExitSettlement)(nil),                    // 86: gidyon.chama.ExitSettlement
+	(*GetExitStatementRequest)(nil),           // 87: gidyon.chama.GetExitStatementRequest
+	(*ExitChamaMemberRequest)(nil),            // 88: gidyon.chama.ExitChamaMemberRequest
+	(*GetExitSettlementRequest)(nil),          // 89: gidyon.chama.GetExitSettlementRequest
+	(*KycDocument)(nil),                       // 90: gidyon.chama.KycDocument
+	(*MemberKyc)(nil),                         // 91: gidyon.chama.MemberKyc
+	(*UploadKycDocumentRequest)(nil),          // 92: gidyon.chama.UploadKycDocumentRequest
+	(*DownloadKycDocumentRequest)(nil),        // 93: gidyon.chama.DownloadKycDocumentRequest
+	(*DownloadKycDocumentResponse)(nil),       // 94: gidyon.chama.DownloadKycDocumentResponse
+	(*GetMemberKycRequest)(nil),               // 95: gidyon.chama.GetMemberKycRequest
+	(*ReviewMemberKycRequest)(nil),            // 96: gidyon.chama.ReviewMemberKycRequest
+	(*ListMemberKycRequest)(nil),              // 97: gidyon.chama.ListMemberKycRequest
+	(*ListMemberKycResponse)(nil),             // 98: gidyon.chama.ListMemberKycResponse
+	nil,                                       // 99: gidyon.chama.ChamaMember.JobDetailsEntry
+	nil,                                       // 100: gidyon.chama.ChamaMember.KycEntry
+	(*emptypb.Empty)(nil),                     // 101: google.protobuf.Empty
+}
+var file_chama_proto_depIdxs = []int32{
+	99,  // 0: gidyon.chama.ChamaMember.job_details:type_name -> gidyon.chama.ChamaMember.JobDetailsEntry
+	100, // 1: gidyon.chama.ChamaMember.kyc:type_name -> gidyon.chama.ChamaMember.KycEntry
+	15,  // 2: gidyon.chama.ChamaMember.beneficiaries:type_name -> gidyon.chama.TrustPerson
+	15,  // 3: gidyon.chama.ChamaMember.guarantees:type_name -> gidyon.chama.TrustPerson
+	0,   // 4: gidyon.chama.ChamaMember.role:type_name -> gidyon.chama.ChamaRole
+	12,  // 5: gidyon.chama.ChamaMember.kyc_status:type_name -> gidyon.chama.KycStatus
+	14,  // 6: gidyon.chama.CreateChamaRequest.chama:type_name -> gidyon.chama.Chama
+	14,  // 7: gidyon.chama.UpdateChamaRequest.chama:type_name -> gidyon.chama.Chama
+	19,  // 8: gidyon.chama.ListChamasRequest.filter:type_name -> gidyon.chama.ChamaFilter
+	14,  // 9: gidyon.chama.ListChamasResponse.chamas:type_name -> gidyon.chama.Chama
+	0,   // 10: gidyon.chama.AssignChamaRoleRequest.role:type_name -> gidyon.chama.ChamaRole
+	16,  // 11: gidyon.chama.FindMemberByIdentityResponse.chama_members:type_name -> gidyon.chama.ChamaMember
+	16,  // 12: gidyon.chama.CreateChamaMemberRequest.chama_member:type_name -> gidyon.chama.ChamaMember
+	16,  // 13: gidyon.chama.UpdateChamaMemberRequest.chama_member:type_name -> gidyon.chama.ChamaMember
+	1,   // 14: gidyon.chama.ChamaMemberFilter.active:type_name -> gidyon.chama.MemberActiveFilter
+	29,  // 15: gidyon.chama.ListChamaMembersRequest.filter:type_name -> gidyon.chama.ChamaMemberFilter
+	2,   // 16: gidyon.chama.ListChamaMembersRequest.sort_order:type_name -> gidyon.chama.MemberSortOrder
+	16,  // 17: gidyon.chama.ListChamaMembersResponse.chama_members:type_name -> gidyon.chama.ChamaMember
+	3,   // 18: gidyon.chama.ContributionPlan.frequency:type_name -> gidyon.chama.ContributionFrequency
+	33,  // 19: gidyon.chama.SetContributionPlanRequest.plan:type_name -> gidyon.chama.ContributionPlan
+	36,  // 20: gidyon.chama.ListContributionArrearsResponse.arrears:type_name -> gidyon.chama.MemberArrears
+	4,   // 21: gidyon.chama.Rotation.order:type_name -> gidyon.chama.RotationOrder
+	5,   // 22: gidyon.chama.Rotation.status:type_name -> gidyon.chama.RotationStatus
+	39,  // 23: gidyon.chama.Rotation.slots:type_name -> gidyon.chama.RotationSlot
+	40,  // 24: gidyon.chama.Rotation.cycle:type_name -> gidyon.chama.RotationCycle
+	41,  // 25: gidyon.chama.CreateRotationRequest.rotation:type_name -> gidyon.chama.Rotation
+	41,  // 26: gidyon.chama.ListRotationsResponse.rotations:type_name -> gidyon.chama.Rotation
+	7,   // 27: gidyon.chama.MeetingAttendance.status:type_name -> gidyon.chama.AttendanceStatus
+	6,   // 28: gidyon.chama.Meeting.status:type_name -> gidyon.chama.MeetingStatus
+	51,  // 29: gidyon.chama.Meeting.attendance:type_name -> gidyon.chama.MeetingAttendance
+	52,  // 30: gidyon.chama.Meeting.resolutions:type_name -> gidyon.chama.MeetingResolution
+	53,  // 31: gidyon.chama.ScheduleMeetingRequest.meeting:type_name -> gidyon.chama.Meeting
+	53,  // 32: gidyon.chama.UpdateMeetingRequest.meeting:type_name -> gidyon.chama.Meeting
+	6,   // 33: gidyon.chama.ListMeetingsRequest.statuses:type_name -> gidyon.chama.MeetingStatus
+	53,  // 34: gidyon.chama.ListMeetingsResponse.meetings:type_name -> gidyon.chama.Meeting
+	51,  // 35: gidyon.chama.RecordAttendanceRequest.attendance:type_name -> gidyon.chama.MeetingAttendance
+	52,  // 36: gidyon.chama.AddResolutionRequest.resolution:type_name -> gidyon.chama.MeetingResolution
+	8,   // 37: gidyon.chama.Fine.status:type_name -> gidyon.chama.FineStatus
+	64,  // 38: gidyon.chama.CreateFineTypeRequest.fine_type:type_name -> gidyon.chama.FineType
+	64,  // 39: gidyon.chama.UpdateFineTypeRequest.fine_type:type_name -> gidyon.chama.FineType
+	64,  // 40: gidyon.chama.ListFineTypesResponse.fine_types:type_name -> gidyon.chama.FineType
+	65,  // 41: gidyon.chama.IssueFineRequest.fine:type_name -> gidyon.chama.Fine
+	8,   // 42: gidyon.chama.ListFinesRequest.statuses:type_name -> gidyon.chama.FineStatus
+	65,  // 43: gidyon.chama.ListFinesResponse.fines:type_name -> gidyon.chama.Fine
+	9,   // 44: gidyon.chama.MemberInvitation.status:type_name -> gidyon.chama.InvitationStatus
+	16,  // 45: gidyon.chama.MemberInvitation.profile:type_name -> gidyon.chama.ChamaMember
+	16,  // 46: gidyon.chama.AcceptInvitationRequest.profile:type_name -> gidyon.chama.ChamaMember
+	9,   // 47: gidyon.chama.ListInvitationsRequest.statuses:type_name -> gidyon.chama.InvitationStatus
+	77,  // 48: gidyon.chama.ListInvitationsResponse.invitations:type_name -> gidyon.chama.MemberInvitation
+	10,  // 49: gidyon.chama.SettlementLine.line_type:type_name -> gidyon.chama.SettlementLineType
+	11,  // 50: gidyon.chama.ExitSettlement.status:type_name -> gidyon.chama.ExitStatus
+	85,  // 51: gidyon.chama.ExitSettlement.lines:type_name -> gidyon.chama.SettlementLine
+	13,  // 52: gidyon.chama.KycDocument.document_type:type_name -> gidyon.chama.KycDocumentType
+	12,  // 53: gidyon.chama.MemberKyc.status:type_name -> gidyon.chama.KycStatus
+	90,  // 54: gidyon.chama.MemberKyc.documents:type_name -> gidyon.chama.KycDocument
+	13,  // 55: gidyon.chama.UploadKycDocumentRequest.document_type:type_name -> gidyon.chama.KycDocumentType
+	90,  // 56: gidyon.chama.DownloadKycDocumentResponse.document:type_name -> gidyon.chama.KycDocument
+	12,  // 57: gidyon.chama.ListMemberKycRequest.statuses:type_name -> gidyon.chama.KycStatus
+	91,  // 58: gidyon.chama.ListMemberKycResponse.member_kyc:type_name -> gidyon.chama.MemberKyc
+	17,  // 59: gidyon.chama.ChamaAPI.CreateChama:input_type -> gidyon.chama.CreateChamaRequest
+	18,  // 60: gidyon.chama.ChamaAPI.UpdateChama:input_type -> gidyon.chama.UpdateChamaRequest
+	20,  // 61: gidyon.chama.ChamaAPI.ListChamas:input_type -> gidyon.chama.ListChamasRequest
+	22,  // 62: gidyon.chama.ChamaAPI.GetChama:input_type -> gidyon.chama.GetChamaRequest
+	34,  // 63: gidyon.chama.ChamaAPI.SetContributionPlan:input_type -> gidyon.chama.SetContributionPlanRequest
+	35,  // 64: gidyon.chama.ChamaAPI.GetContributionPlan:input_type -> gidyon.chama.GetContributionPlanRequest
+	37,  // 65: gidyon.chama.ChamaAPI.ListContributionArrears:input_type -> gidyon.chama.ListContributionArrearsRequest
+	27,  // 66: gidyon.chama.ChamaMemberAPI.CreateChamaMember:input_type -> gidyon.chama.CreateChamaMemberRequest
+	28,  // 67: gidyon.chama.ChamaMemberAPI.UpdateChamaMember:input_type -> gidyon.chama.UpdateChamaMemberRequest
+	30,  // 68: gidyon.chama.ChamaMemberAPI.ListChamaMembers:input_type -> gidyon.chama.ListChamaMembersRequest
+	32,  // 69: gidyon.chama.ChamaMemberAPI.GetChamaMember:input_type -> gidyon.chama.GetChamaMemberRequest
+	23,  // 70: gidyon.chama.ChamaMemberAPI.AssignChamaRole:input_type -> gidyon.chama.AssignChamaRoleRequest
+	24,  // 71: gidyon.chama.ChamaMemberAPI.FindMemberByIdentity:input_type -> gidyon.chama.FindMemberByIdentityRequest
+	26,  // 72: gidyon.chama.ChamaMemberAPI.MergeChamaMembers:input_type -> gidyon.chama.MergeChamaMembersRequest
+	42,  // 73: gidyon.chama.RotationAPI.CreateRotation:input_type -> gidyon.chama.CreateRotationRequest
+	43,  // 74: gidyon.chama.RotationAPI.GetRotation:input_type -> gidyon.chama.GetRotationRequest
+	44,  // 75: gidyon.chama.RotationAPI.ListRotations:input_type -> gidyon.chama.ListRotationsRequest
+	46,  // 76: gidyon.chama.RotationAPI.RecordRotationContribution:input_type -> gidyon.chama.RecordRotationContributionRequest
+	47,  // 77: gidyon.chama.RotationAPI.PayoutRotationCycle:input_type -> gidyon.chama.PayoutRotationCycleRequest
+	48,  // 78: gidyon.chama.RotationAPI.PlaceRotationBid:input_type -> gidyon.chama.PlaceRotationBidRequest
+	49,  // 79: gidyon.chama.RotationAPI.SkipRotationTurn:input_type -> gidyon.chama.SkipRotationTurnRequest
+	50,  // 80: gidyon.chama.RotationAPI.SwapRotationTurns:input_type -> gidyon.chama.SwapRotationTurnsRequest
+	54,  // 81: gidyon.chama.MeetingAPI.ScheduleMeeting:input_type -> gidyon.chama.ScheduleMeetingRequest
+	55,  // 82: gidyon.chama.MeetingAPI.UpdateMeeting:input_type -> gidyon.chama.UpdateMeetingRequest
+	56,  // 83: gidyon.chama.MeetingAPI.CancelMeeting:input_type -> gidyon.chama.CancelMeetingRequest
+	57,  // 84: gidyon.chama.MeetingAPI.GetMeeting:input_type -> gidyon.chama.GetMeetingRequest
+	58,  // 85: gidyon.chama.MeetingAPI.ListMeetings:input_type -> gidyon.chama.ListMeetingsRequest
+	60,  // 86: gidyon.chama.MeetingAPI.RecordAttendance:input_type -> gidyon.chama.RecordAttendanceRequest
+	61,  // 87: gidyon.chama.MeetingAPI.RecordMinutes:input_type -> gidyon.chama.RecordMinutesRequest
+	62,  // 88: gidyon.chama.MeetingAPI.AddResolution:input_type -> gidyon.chama.AddResolutionRequest
+	63,  // 89: gidyon.chama.MeetingAPI.CloseMeeting:input_type -> gidyon.chama.CloseMeetingRequest
+	66,  // 90: gidyon.chama.FineAPI.CreateFineType:input_type -> gidyon.chama.CreateFineTypeRequest
+	67,  // 91: gidyon.chama.FineAPI.UpdateFineType:input_type -> gidyon.chama.UpdateFineTypeRequest
+	68,  // 92: gidyon.chama.FineAPI.ListFineTypes:input_type -> gidyon.chama.ListFineTypesRequest
+	70,  // 93: gidyon.chama.FineAPI.IssueFine:input_type -> gidyon.chama.IssueFineRequest
+	71,  // 94: gidyon.chama.FineAPI.GetFine:input_type -> gidyon.chama.GetFineRequest
+	72,  // 95: gidyon.chama.FineAPI.ListFines:input_type -> gidyon.chama.ListFinesRequest
+	74,  // 96: gidyon.chama.FineAPI.PayFine:input_type -> gidyon.chama.PayFineRequest
+	75,  // 97: gidyon.chama.FineAPI.RequestFineWaiver:input_type -> gidyon.chama.RequestFineWaiverRequest
+	76,  // 98: gidyon.chama.FineAPI.ApproveFineWaiver:input_type -> gidyon.chama.ApproveFineWaiverRequest
+	78,  // 99: gidyon.chama.InvitationAPI.InviteMember:input_type -> gidyon.chama.InviteMemberRequest
+	79,  // 100: gidyon.chama.InvitationAPI.ResendInvitation:input_type -> gidyon.chama.ResendInvitationRequest
+	80,  // 101: gidyon.chama.InvitationAPI.RevokeInvitation:input_type -> gidyon.chama.RevokeInvitationRequest
+	81,  // 102: gidyon.chama.InvitationAPI.AcceptInvitation:input_type -> gidyon.chama.AcceptInvitationRequest
+	82,  // 103: gidyon.chama.InvitationAPI.ConfirmInvitation:input_type -> gidyon.chama.ConfirmInvitationRequest
+	83,  // 104: gidyon.chama.InvitationAPI.ListInvitations:input_type -> gidyon.chama.ListInvitationsRequest
+	87,  // 105: gidyon.chama.MemberExitAPI.GetExitStatement:input_type -> gidyon.chama.GetExitStatementRequest
+	88,  // 106: gidyon.chama.MemberExitAPI.ExitChamaMember:input_type -> gidyon.chama.ExitChamaMemberRequest
+	89,  // 107: gidyon.chama.MemberExitAPI.GetExitSettlement:input_type -> gidyon.chama.GetExitSettlementRequest
+	92,  // 108: gidyon.chama.KycAPI.UploadKycDocument:input_type -> gidyon.chama.UploadKycDocumentRequest
+	93,  // 109: gidyon.chama.KycAPI.DownloadKycDocument:input_type -> gidyon.chama.DownloadKycDocumentRequest
+	95,  // 110: gidyon.chama.KycAPI.GetMemberKyc:input_type -> gidyon.chama.GetMemberKycRequest
+	96,  // 111: gidyon.chama.KycAPI.ReviewMemberKyc:input_type -> gidyon.chama.ReviewMemberKycRequest
+	97,  // 112: gidyon.chama.KycAPI.ListMemberKyc:input_type -> gidyon.chama.ListMemberKycRequest
+	101, // 113: gidyon.chama.ChamaAPI.CreateChama:output_type -> google.protobuf.Empty
+	101, // 114: gidyon.chama.ChamaAPI.UpdateChama:output_type -> google.protobuf.Empty
+	21,  // 115: gidyon.chama.ChamaAPI.ListChamas:output_type -> gidyon.chama.ListChamasResponse
+	14,  // 116: gidyon.chama.ChamaAPI.GetChama:output_type -> gidyon.chama.Chama
+	33,  // 117: gidyon.chama.ChamaAPI.SetContributionPlan:output_type -> gidyon.chama.ContributionPlan
+	33,  // 118: gidyon.chama.ChamaAPI.GetContributionPlan:output_type -> gidyon.chama.ContributionPlan
+	38,  // 119: gidyon.chama.ChamaAPI.ListContributionArrears:output_type -> gidyon.chama.ListContributionArrearsResponse
+	101, // 120: gidyon.chama.ChamaMemberAPI.CreateChamaMember:output_type -> google.protobuf.Empty
+	101, // 121: gidyon.chama.ChamaMemberAPI.UpdateChamaMember:output_type -> google.protobuf.Empty
+	31,  // 122: gidyon.chama.ChamaMemberAPI.ListChamaMembers:output_type -> gidyon.chama.ListChamaMembersResponse
+	16,  // 123: gidyon.chama.ChamaMemberAPI.GetChamaMember:output_type -> gidyon.chama.ChamaMember
+	16,  // 124: gidyon.chama.ChamaMemberAPI.AssignChamaRole:output_type -> gidyon.chama.ChamaMember
+	25,  // 125: gidyon.chama.ChamaMemberAPI.FindMemberByIdentity:output_type -> gidyon.chama.FindMemberByIdentityResponse
+	16,  // 126: gidyon.chama.ChamaMemberAPI.MergeChamaMembers:output_type -> gidyon.chama.ChamaMember
+	41,  // 127: gidyon.chama.RotationAPI.CreateRotation:output_type -> gidyon.chama.Rotation
+	41,  // 128: gidyon.chama.RotationAPI.GetRotation:output_type -> gidyon.chama.Rotation
+	45,  // 129: gidyon.chama.RotationAPI.ListRotations:output_type -> gidyon.chama.ListRotationsResponse
+	40,  // 130: gidyon.chama.RotationAPI.RecordRotationContribution:output_type -> gidyon.chama.RotationCycle
+	40,  // 131: gidyon.chama.RotationAPI.PayoutRotationCycle:output_type -> gidyon.chama.RotationCycle
+	40,  // 132: gidyon.chama.RotationAPI.PlaceRotationBid:output_type -> gidyon.chama.RotationCycle
+	41,  // 133: gidyon.chama.RotationAPI.SkipRotationTurn:output_type -> gidyon.chama.Rotation
+	41,  // 134: gidyon.chama.RotationAPI.SwapRotationTurns:output_type -> gidyon.chama.Rotation
+	53,  // 135: gidyon.chama.MeetingAPI.ScheduleMeeting:output_type -> gidyon.chama.Meeting
+	53,  // 136: gidyon.chama.MeetingAPI.UpdateMeeting:output_type -> gidyon.chama.Meeting
+	53,  // 137: gidyon.chama.MeetingAPI.CancelMeeting:output_type -> gidyon.chama.Meeting
+	53,  // 138: gidyon.chama.MeetingAPI.GetMeeting:output_type -> gidyon.chama.Meeting
+	59,  // 139: gidyon.chama.MeetingAPI.ListMeetings:output_type -> gidyon.chama.ListMeetingsResponse
+	53,  // 140: gidyon.chama.MeetingAPI.RecordAttendance:output_type -> gidyon.chama.Meeting
+	53,  // 141: gidyon.chama.MeetingAPI.RecordMinutes:output_type -> gidyon.chama.Meeting
+	52,  // 142: gidyon.chama.MeetingAPI.AddResolution:output_type -> gidyon.chama.MeetingResolution
+	53,  // 143: gidyon.chama.MeetingAPI.CloseMeeting:output_type -> gidyon.chama.Meeting
+	64,  // 144: gidyon.chama.FineAPI.CreateFineType:output_type -> gidyon.chama.FineType
+	64,  // 145: gidyon.chama.FineAPI.UpdateFineType:output_type -> gidyon.chama.FineType
+	69,  // 146: gidyon.chama.FineAPI.ListFineTypes:output_type -> gidyon.chama.ListFineTypesResponse
+	65,  // 147: gidyon.chama.FineAPI.IssueFine:output_type -> gidyon.chama.Fine
+	65,  // 148: gidyon.chama.FineAPI.GetFine:output_type -> gidyon.chama.Fine
+	73,  // 149: gidyon.chama.FineAPI.ListFines:output_type -> gidyon.chama.ListFinesResponse
+	65,  // 150: gidyon.chama.FineAPI.PayFine:output_type -> gidyon.chama.Fine
+	65,  // 151: gidyon.chama.FineAPI.RequestFineWaiver:output_type -> gidyon.chama.Fine
+	65,  // 152: gidyon.chama.FineAPI.ApproveFineWaiver:output_type -> gidyon.chama.Fine
+	77,  // 153: gidyon.chama.InvitationAPI.InviteMember:output_type -> gidyon.chama.MemberInvitation
+	77,  // 154: gidyon.chama.InvitationAPI.ResendInvitation:output_type -> gidyon.chama.MemberInvitation
+	77,  // 155: gidyon.chama.InvitationAPI.RevokeInvitation:output_type -> gidyon.chama.MemberInvitation
+	77,  // 156: gidyon.chama.InvitationAPI.AcceptInvitation:output_type -> gidyon.chama.MemberInvitation
+	77,  // 157: gidyon.chama.InvitationAPI.ConfirmInvitation:output_type -> gidyon.chama.MemberInvitation
+	84,  // 158: gidyon.chama.InvitationAPI.ListInvitations:output_type -> gidyon.chama.ListInvitationsResponse
+	86,  // 159: gidyon.chama.MemberExitAPI.GetExitStatement:output_type -> gidyon.chama.ExitSettlement
+	86,  // 160: gidyon.chama.MemberExitAPI.ExitChamaMember:output_type -> gidyon.chama.ExitSettlement
+	86,  // 161: gidyon.chama.MemberExitAPI.GetExitSettlement:output_type -> gidyon.chama.ExitSettlement
+	91,  // 162: gidyon.chama.KycAPI.UploadKycDocument:output_type -> gidyon.chama.MemberKyc
+	94,  // 163: gidyon.chama.KycAPI.DownloadKycDocument:output_type -> gidyon.chama.DownloadKycDocumentResponse
+	91,  // 164: gidyon.chama.KycAPI.GetMemberKyc:output_type -> gidyon.chama.MemberKyc
+	91,  // 165: gidyon.chama.KycAPI.ReviewMemberKyc:output_type -> gidyon.chama.MemberKyc
+	98,  // 166: gidyon.chama.KycAPI.ListMemberKyc:output_type -> gidyon.chama.ListMemberKycResponse
+	113, // [113:167] is the sub-list for method output_type
+	59,  // [59:113] is the sub-list for method input_type
+	59,  // [59:59] is the sub-list for extension type_name
+	59,  // [59:59] is the sub-list for extension extendee
+	0,   // [0:59] is the sub-list for field type_name
+}
+
+func init() { file_chama_proto_init() }
+func file_chama_proto_init() {
+	if File_chama_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_chama_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Chama); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrustPerson); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChamaMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateChamaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateChamaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChamaFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChamasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChamasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChamaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignChamaRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindMemberByIdentityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindMemberByIdentityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeChamaMembersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateChamaMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateChamaMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChamaMemberFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChamaMembersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChamaMembersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChamaMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContributionPlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetContributionPlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContributionPlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberArrears); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContributionArrearsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContributionArrearsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotationSlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotationCycle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rotation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRotationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRotationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRotationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRotationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordRotationContributionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutRotationCycleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceRotationBidRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkipRotationTurnRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapRotationTurnsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingAttendance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeetingResolution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Meeting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMeetingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMeetingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelMeetingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeetingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMeetingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMeetingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordAttendanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordMinutesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddResolutionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseMeetingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FineType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFineTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFineTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFineTypesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFineTypesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueFineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFinesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFinesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayFineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestFineWaiverRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveFineWaiverRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberInvitation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResendInvitationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeInvitationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetChamaMemberRequest.ProtoReflect.Descriptor instead.
-func (*GetChamaMemberRequest) Descriptor() ([]byte, []int) {
-	return file_chama_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *GetChamaMemberRequest) GetMemberId() string {
-	if x != nil {
-		return x.MemberId
-	}
-	return ""
-}
-
-var File_chama_proto protoreflect.FileDescriptor
-
-var file_chama_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x67,
-	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
-	0x75, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x96, 0x02, 0x0a, 0x05, 0x43, 0x68, 0x61,
-	0x6d, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74,
-	0x65, 0x22, 0x4d, 0x0a, 0x0b, 0x54, 0x72, 0x75, 0x73, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68,
-	0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
-	0x22, 0xd5, 0x05, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a,
-	0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73,
-	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69,
-	0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68,
-	0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x6a,
-	0x6f, 0x62, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e,
-	0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6a, 0x6f, 0x62,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x03, 0x6b, 0x79, 0x63, 0x18, 0x0a,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
-	0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x2e,
-	0x4b, 0x79, 0x63, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x6b, 0x79, 0x63, 0x12, 0x3f, 0x0a,
-	0x0d, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0b,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68,
-	0x61, 0x6d, 0x61, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52,
-	0x0d, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x39,
-	0x0a, 0x0a, 0x67, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
-	0x61, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x0a, 0x67,
-	0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d,
-	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74,
-	0x65, 0x1a, 0x3d, 0x0a, 0x0f, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x36, 0x0a, 0x08, 0x4b, 0x79, 0x63, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x44, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
-	0x0a, 0x05, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61,
-	0x6d, 0x61, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x22, 0x44,
-	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61,
-	0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x63,
-	0x68, 0x61, 0x6d, 0x61, 0x22, 0x2e, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x49, 0x64, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61,
-	0x6d, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64,
-	0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x69, 0x0a, 0x12, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2b, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43,
-	0x68, 0x61, 0x6d, 0x61, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x12, 0x26, 0x0a, 0x0f,
-	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x31, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07,
-	0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x22, 0x5d, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x6d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79,
-	0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6d, 0x61,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x5d, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x41, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x6d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
-	0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6d,
-	0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61,
-	0x6d, 0x61, 0x49, 0x64, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68,
-	0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61,
-	0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x6d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64,
-	0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
-	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x39, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x32, 0xf0, 0x03, 0x0a, 0x08, 0x43, 0x68, 0x61, 0x6d, 0x61,
-	0x41, 0x50, 0x49, 0x12, 0x67, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
-	0x6d, 0x61, 0x12, 0x20, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
-	0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1e, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61,
-	0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x78, 0x0a, 0x0b,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x12, 0x20, 0x2e, 0x67, 0x69,
-	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x32, 0x24, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d,
-	0x61, 0x73, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f,
-	0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x98, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x68, 0x61, 0x6d, 0x61, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63,
-	0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
-	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41,
-	0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63,
-	0x68, 0x61, 0x6d, 0x61, 0x73, 0x5a, 0x2a, 0x22, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61,
-	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x3a, 0x6c, 0x69, 0x73,
-	0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x3a, 0x01,
-	0x2a, 0x12, 0x66, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x12, 0x1d, 0x2e,
-	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x68, 0x61, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x67,
-	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6d,
-	0x61, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x73, 0x2f, 0x7b,
-	0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x7d, 0x32, 0xde, 0x05, 0x0a, 0x0e, 0x43, 0x68,
-	0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x50, 0x49, 0x12, 0x79, 0x0a, 0x11,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x26, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x6d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x92, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26, 0x2e,
-	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x3d, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x37, 0x32, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68,
-	0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
-	0x2f, 0x7b, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x2e, 0x6d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x82, 0x01, 0x0a,
-	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x26, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d,
-	0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x2a, 0x25, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x7d, 0x12, 0xb5, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
-	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
-	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4c, 0x12, 0x19, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d,
-	0x61, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x5a, 0x2f, 0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x67, 0x69,
-	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68,
-	0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2e,
-	0x43, 0x68, 0x61, 0x6d, 0x61, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x2d, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d,
-	0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2f,
-	0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x63, 0x68, 0x61, 0x6d,
-	0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_chama_proto_rawDescOnce sync.Once
-	file_chama_proto_rawDescData = file_chama_proto_rawDesc
-)
-
-func file_chama_proto_rawDescGZIP() []byte {
-	file_chama_proto_rawDescOnce.Do(func() {
-		file_chama_proto_rawDescData = protoimpl.X.CompressGZIP(file_chama_proto_rawDescData)
-	})
-	return file_chama_proto_rawDescData
-}
-
-var file_chama_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
-var file_chama_proto_goTypes = []interface{}{
-	(*Chama)(nil),                    // 0: gidyon.chama.Chama
-	(*TrustPerson)(nil),              // 1: gidyon.chama.TrustPerson
-	(*ChamaMember)(nil),              // 2: gidyon.chama.ChamaMember
-	(*CreateChamaRequest)(nil),       // 3: gidyon.chama.CreateChamaRequest
-	(*UpdateChamaRequest)(nil),       // 4: gidyon.chama.UpdateChamaRequest
-	(*ChamaFilter)(nil),              // 5: gidyon.chama.ChamaFilter
-	(*ListChamasRequest)(nil),        // 6: gidyon.chama.ListChamasRequest
-	(*ListChamasResponse)(nil),       // 7: gidyon.chama.ListChamasResponse
-	(*GetChamaRequest)(nil),          // 8: gidyon.chama.GetChamaRequest
-	(*CreateChamaMemberRequest)(nil), // 9: gidyon.chama.CreateChamaMemberRequest
-	(*UpdateChamaMemberRequest)(nil), // 10: gidyon.chama.UpdateChamaMemberRequest
-	(*DeleteChamaMemberRequest)(nil), // 11: gidyon.chama.DeleteChamaMemberRequest
-	(*ChamaMemberFilter)(nil),        // 12: gidyon.chama.ChamaMemberFilter
-	(*ListChamaMembersRequest)(nil),  // 13: gidyon.chama.ListChamaMembersRequest
-	(*ListChamaMembersResponse)(nil), // 14: gidyon.chama.ListChamaMembersResponse
-	(*GetChamaMemberRequest)(nil),    // 15: gidyon.chama.GetChamaMemberRequest
-	nil,                              // 16: gidyon.chama.ChamaMember.JobDetailsEntry
-	nil,                              // 17: gidyon.chama.ChamaMember.KycEntry
-	(*emptypb.Empty)(nil),            // 18: google.protobuf.Empty
-}
-var file_chama_proto_depIdxs = []int32{
-	16, // 0: gidyon.chama.ChamaMember.job_details:type_name -> gidyon.chama.ChamaMember.JobDetailsEntry
-	17, // 1: gidyon.chama.ChamaMember.kyc:type_name -> gidyon.chama.ChamaMember.KycEntry
-	1,  // 2: gidyon.chama.ChamaMember.beneficiaries:type_name -> gidyon.chama.TrustPerson
-	1,  // 3: gidyon.chama.ChamaMember.guarantees:type_name -> gidyon.chama.TrustPerson
-	0,  // 4: gidyon.chama.CreateChamaRequest.chama:type_name -> gidyon.chama.Chama
-	0,  // 5: gidyon.chama.UpdateChamaRequest.chama:type_name -> gidyon.chama.Chama
-	5,  // 6: gidyon.chama.ListChamasRequest.filter:type_name -> gidyon.chama.ChamaFilter
-	0,  // 7: gidyon.chama.ListChamasResponse.chamas:type_name -> gidyon.chama.Chama
-	2,  // 8: gidyon.chama.CreateChamaMemberRequest.chama_member:type_name -> gidyon.chama.ChamaMember
-	2,  // 9: gidyon.chama.UpdateChamaMemberRequest.chama_member:type_name -> gidyon.chama.ChamaMember
-	12, // 10: gidyon.chama.ListChamaMembersRequest.filter:type_name -> gidyon.chama.ChamaMemberFilter
-	2,  // 11: gidyon.chama.ListChamaMembersResponse.chama_members:type_name -> gidyon.chama.ChamaMember
-	3,  // 12: gidyon.chama.ChamaAPI.CreateChama:input_type -> gidyon.chama.CreateChamaRequest
-	4,  // 13: gidyon.chama.ChamaAPI.UpdateChama:input_type -> gidyon.chama.UpdateChamaRequest
-	6,  // 14: gidyon.chama.ChamaAPI.ListChamas:input_type -> gidyon.chama.ListChamasRequest
-	8,  // 15: gidyon.chama.ChamaAPI.GetChama:input_type -> gidyon.chama.GetChamaRequest
-	9,  // 16: gidyon.chama.ChamaMemberAPI.CreateChamaMember:input_type -> gidyon.chama.CreateChamaMemberRequest
-	10, // 17: gidyon.chama.ChamaMemberAPI.UpdateChamaMember:input_type -> gidyon.chama.UpdateChamaMemberRequest
-	11, // 18: gidyon.chama.ChamaMemberAPI.DeleteChamaMember:input_type -> gidyon.chama.DeleteChamaMemberRequest
-	13, // 19: gidyon.chama.ChamaMemberAPI.ListChamaMembers:input_type -> gidyon.chama.ListChamaMembersRequest
-	15, // 20: gidyon.chama.ChamaMemberAPI.GetChamaMember:input_type -> gidyon.chama.GetChamaMemberRequest
-	18, // 21: gidyon.chama.ChamaAPI.CreateChama:output_type -> google.protobuf.Empty
-	18, // 22: gidyon.chama.ChamaAPI.UpdateChama:output_type -> google.protobuf.Empty
-	7,  // 23: gidyon.chama.ChamaAPI.ListChamas:output_type -> gidyon.chama.ListChamasResponse
-	0,  // 24: gidyon.chama.ChamaAPI.GetChama:output_type -> gidyon.chama.Chama
-	18, // 25: gidyon.chama.ChamaMemberAPI.CreateChamaMember:output_type -> google.protobuf.Empty
-	18, // 26: gidyon.chama.ChamaMemberAPI.UpdateChamaMember:output_type -> google.protobuf.Empty
-	18, // 27: gidyon.chama.ChamaMemberAPI.DeleteChamaMember:output_type -> google.protobuf.Empty
-	14, // 28: gidyon.chama.ChamaMemberAPI.ListChamaMembers:output_type -> gidyon.chama.ListChamaMembersResponse
-	2,  // 29: gidyon.chama.ChamaMemberAPI.GetChamaMember:output_type -> gidyon.chama.ChamaMember
-	21, // [21:30] is the sub-list for method output_type
-	12, // [12:21] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
-}
-
-func init() { file_chama_proto_init() }
-func file_chama_proto_init() {
-	if File_chama_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_chama_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Chama); i {
+		file_chama_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptInvitationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1344,8 +9177,8 @@ func file_chama_proto_init() {
 				return nil
 			}
 		}
-		file_chama_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrustPerson); i {
+		file_chama_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmInvitationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1356,8 +9189,8 @@ func file_chama_proto_init() {
 				return nil
 			}
 		}
-		file_chama_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChamaMember); i {
+		file_chama_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInvitationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1368,8 +9201,8 @@ func file_chama_proto_init() {
 				return nil
 			}
 		}
-		file_chama_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateChamaRequest); i {
+		file_chama_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInvitationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1380,8 +9213,8 @@ func file_chama_proto_init() {
 				return nil
 			}
 		}
-		file_chama_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateChamaRequest); i {
+		file_chama_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettlementLine); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1392,8 +9225,8 @@ func file_chama_proto_init() {
 				return nil
 			}
 		}
-		file_chama_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChamaFilter); i {
+		file_chama_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExitSettlement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1404,8 +9237,8 @@ func file_chama_proto_init() {
 				return nil
 			}
 		}
-		file_chama_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListChamasRequest); i {
+		file_chama_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExitStatementRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1416,8 +9249,8 @@ func file_chama_proto_init() {
 				return nil
 			}
 		}
-		file_chama_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListChamasResponse); i {
+		file_chama_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExitChamaMemberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1428,8 +9261,8 @@ func file_chama_proto_init() {
 				return nil
 			}
 		}
-		file_chama_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetChamaRequest); i {
+		file_chama_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExitSettlementRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1440,8 +9273,8 @@ func file_chama_proto_init() {
 				return nil
 			}
 		}
-		file_chama_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateChamaMemberRequest); i {
+		file_chama_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KycDocument); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1452,8 +9285,8 @@ func file_chama_proto_init() {
 				return nil
 			}
 		}
-		file_chama_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateChamaMemberRequest); i {
+		file_chama_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberKyc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1464,8 +9297,8 @@ func file_chama_proto_init() {
 				return nil
 			}
 		}
-		file_chama_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteChamaMemberRequest); i {
+		file_chama_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadKycDocumentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1476,8 +9309,8 @@ func file_chama_proto_init() {
 				return nil
 			}
 		}
-		file_chama_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChamaMemberFilter); i {
+		file_chama_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadKycDocumentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1488,8 +9321,8 @@ func file_chama_proto_init() {
 				return nil
 			}
 		}
-		file_chama_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListChamaMembersRequest); i {
+		file_chama_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadKycDocumentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1500,8 +9333,8 @@ func file_chama_proto_init() {
 				return nil
 			}
 		}
-		file_chama_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListChamaMembersResponse); i {
+		file_chama_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMemberKycRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1512,8 +9345,32 @@ func file_chama_proto_init() {
 				return nil
 			}
 		}
-		file_chama_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetChamaMemberRequest); i {
+		file_chama_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewMemberKycRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMemberKycRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chama_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMemberKycResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1530,13 +9387,14 @@ func file_chama_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chama_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   18,
+			NumEnums:      14,
+			NumMessages:   87,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   8,
 		},
 		GoTypes:           file_chama_proto_goTypes,
 		DependencyIndexes: file_chama_proto_depIdxs,
+		EnumInfos:         file_chama_proto_enumTypes,
 		MessageInfos:      file_chama_proto_msgTypes,
 	}.Build()
 	File_chama_proto = out.File
@@ -22,6 +22,9 @@ type ChamaAPIClient interface {
 	UpdateChama(ctx context.Context, in *UpdateChamaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListChamas(ctx context.Context, in *ListChamasRequest, opts ...grpc.CallOption) (*ListChamasResponse, error)
 	GetChama(ctx context.Context, in *GetChamaRequest, opts ...grpc.CallOption) (*Chama, error)
+	SetContributionPlan(ctx context.Context, in *SetContributionPlanRequest, opts ...grpc.CallOption) (*ContributionPlan, error)
+	GetContributionPlan(ctx context.Context, in *GetContributionPlanRequest, opts ...grpc.CallOption) (*ContributionPlan, error)
+	ListContributionArrears(ctx context.Context, in *ListContributionArrearsRequest, opts ...grpc.CallOption) (*ListContributionArrearsResponse, error)
 }
 
 type chamaAPIClient struct {
@@ -68,6 +71,33 @@ func (c *chamaAPIClient) GetChama(ctx context.Context, in *GetChamaRequest, opts
 	return out, nil
 }
 
+func (c *chamaAPIClient) SetContributionPlan(ctx context.Context, in *SetContributionPlanRequest, opts ...grpc.CallOption) (*ContributionPlan, error) {
+	out := new(ContributionPlan)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.ChamaAPI/SetContributionPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chamaAPIClient) GetContributionPlan(ctx context.Context, in *GetContributionPlanRequest, opts ...grpc.CallOption) (*ContributionPlan, error) {
+	out := new(ContributionPlan)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.ChamaAPI/GetContributionPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chamaAPIClient) ListContributionArrears(ctx context.Context, in *ListContributionArrearsRequest, opts ...grpc.CallOption) (*ListContributionArrearsResponse, error) {
+	out := new(ListContributionArrearsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.ChamaAPI/ListContributionArrears", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChamaAPIServer is the server API for ChamaAPI service.
 // All implementations must embed UnimplementedChamaAPIServer
 // for forward compatibility
@@ -76,6 +106,9 @@ type ChamaAPIServer interface {
 	UpdateChama(context.Context, *UpdateChamaRequest) (*emptypb.Empty, error)
 	ListChamas(context.Context, *ListChamasRequest) (*ListChamasResponse, error)
 	GetChama(context.Context, *GetChamaRequest) (*Chama, error)
+	SetContributionPlan(context.Context, *SetContributionPlanRequest) (*ContributionPlan, error)
+	GetContributionPlan(context.Context, *GetContributionPlanRequest) (*ContributionPlan, error)
+	ListContributionArrears(context.Context, *ListContributionArrearsRequest) (*ListContributionArrearsResponse, error)
 	mustEmbedUnimplementedChamaAPIServer()
 }
 
@@ -95,6 +128,15 @@ func (UnimplementedChamaAPIServer) ListChamas(context.Context, *ListChamasReques
 func (UnimplementedChamaAPIServer) GetChama(context.Context, *GetChamaRequest) (*Chama, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChama not implemented")
 }
+func (UnimplementedChamaAPIServer) SetContributionPlan(context.Context, *SetContributionPlanRequest) (*ContributionPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContributionPlan not implemented")
+}
+func (UnimplementedChamaAPIServer) GetContributionPlan(context.Context, *GetContributionPlanRequest) (*ContributionPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContributionPlan not implemented")
+}
+func (UnimplementedChamaAPIServer) ListContributionArrears(context.Context, *ListContributionArrearsRequest) (*ListContributionArrearsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContributionArrears not implemented")
+}
 func (UnimplementedChamaAPIServer) mustEmbedUnimplementedChamaAPIServer() {}
 
 // UnsafeChamaAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -180,6 +222,60 @@ func _ChamaAPI_GetChama_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChamaAPI_SetContributionPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetContributionPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChamaAPIServer).SetContributionPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.ChamaAPI/SetContributionPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChamaAPIServer).SetContributionPlan(ctx, req.(*SetContributionPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChamaAPI_GetContributionPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContributionPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChamaAPIServer).GetContributionPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.ChamaAPI/GetContributionPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChamaAPIServer).GetContributionPlan(ctx, req.(*GetContributionPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChamaAPI_ListContributionArrears_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContributionArrearsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChamaAPIServer).ListContributionArrears(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.ChamaAPI/ListContributionArrears",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChamaAPIServer).ListContributionArrears(ctx, req.(*ListContributionArrearsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ChamaAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gidyon.chama.ChamaAPI",
 	HandlerType: (*ChamaAPIServer)(nil),
@@ -200,6 +296,18 @@ var _ChamaAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetChama",
 			Handler:    _ChamaAPI_GetChama_Handler,
 		},
+		{
+			MethodName: "SetContributionPlan",
+			Handler:    _ChamaAPI_SetContributionPlan_Handler,
+		},
+		{
+			MethodName: "GetContributionPlan",
+			Handler:    _ChamaAPI_GetContributionPlan_Handler,
+		},
+		{
+			MethodName: "ListContributionArrears",
+			Handler:    _ChamaAPI_ListContributionArrears_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chama.proto",
@@ -211,9 +319,11 @@ var _ChamaAPI_serviceDesc = grpc.ServiceDesc{
 type ChamaMemberAPIClient interface {
 	CreateChamaMember(ctx context.Context, in *CreateChamaMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateChamaMember(ctx context.Context, in *UpdateChamaMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	DeleteChamaMember(ctx context.Context, in *DeleteChamaMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListChamaMembers(ctx context.Context, in *ListChamaMembersRequest, opts ...grpc.CallOption) (*ListChamaMembersResponse, error)
 	GetChamaMember(ctx context.Context, in *GetChamaMemberRequest, opts ...grpc.CallOption) (*ChamaMember, error)
+	AssignChamaRole(ctx context.Context, in *AssignChamaRoleRequest, opts ...grpc.CallOption) (*ChamaMember, error)
+	FindMemberByIdentity(ctx context.Context, in *FindMemberByIdentityRequest, opts ...grpc.CallOption) (*FindMemberByIdentityResponse, error)
+	MergeChamaMembers(ctx context.Context, in *MergeChamaMembersRequest, opts ...grpc.CallOption) (*ChamaMember, error)
 }
 
 type chamaMemberAPIClient struct {
@@ -242,27 +352,45 @@ func (c *chamaMemberAPIClient) UpdateChamaMember(ctx context.Context, in *Update
 	return out, nil
 }
 
-func (c *chamaMemberAPIClient) DeleteChamaMember(ctx context.Context, in *DeleteChamaMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/gidyon.chama.ChamaMemberAPI/DeleteChamaMember", in, out, opts...)
+func (c *chamaMemberAPIClient) ListChamaMembers(ctx context.Context, in *ListChamaMembersRequest, opts ...grpc.CallOption) (*ListChamaMembersResponse, error) {
+	out := new(ListChamaMembersResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.ChamaMemberAPI/ListChamaMembers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chamaMemberAPIClient) ListChamaMembers(ctx context.Context, in *ListChamaMembersRequest, opts ...grpc.CallOption) (*ListChamaMembersResponse, error) {
-	out := new(ListChamaMembersResponse)
-	err := c.cc.Invoke(ctx, "/gidyon.chama.ChamaMemberAPI/ListChamaMembers", in, out, opts...)
+func (c *chamaMemberAPIClient) GetChamaMember(ctx context.Context, in *GetChamaMemberRequest, opts ...grpc.CallOption) (*ChamaMember, error) {
+	out := new(ChamaMember)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.ChamaMemberAPI/GetChamaMember", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chamaMemberAPIClient) GetChamaMember(ctx context.Context, in *GetChamaMemberRequest, opts ...grpc.CallOption) (*ChamaMember, error) {
+func (c *chamaMemberAPIClient) AssignChamaRole(ctx context.Context, in *AssignChamaRoleRequest, opts ...grpc.CallOption) (*ChamaMember, error) {
 	out := new(ChamaMember)
-	err := c.cc.Invoke(ctx, "/gidyon.chama.ChamaMemberAPI/GetChamaMember", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.ChamaMemberAPI/AssignChamaRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chamaMemberAPIClient) FindMemberByIdentity(ctx context.Context, in *FindMemberByIdentityRequest, opts ...grpc.CallOption) (*FindMemberByIdentityResponse, error) {
+	out := new(FindMemberByIdentityResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.ChamaMemberAPI/FindMemberByIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chamaMemberAPIClient) MergeChamaMembers(ctx context.Context, in *MergeChamaMembersRequest, opts ...grpc.CallOption) (*ChamaMember, error) {
+	out := new(ChamaMember)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.ChamaMemberAPI/MergeChamaMembers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -275,9 +403,11 @@ func (c *chamaMemberAPIClient) GetChamaMember(ctx context.Context, in *GetChamaM
 type ChamaMemberAPIServer interface {
 	CreateChamaMember(context.Context, *CreateChamaMemberRequest) (*emptypb.Empty, error)
 	UpdateChamaMember(context.Context, *UpdateChamaMemberRequest) (*emptypb.Empty, error)
-	DeleteChamaMember(context.Context, *DeleteChamaMemberRequest) (*emptypb.Empty, error)
 	ListChamaMembers(context.Context, *ListChamaMembersRequest) (*ListChamaMembersResponse, error)
 	GetChamaMember(context.Context, *GetChamaMemberRequest) (*ChamaMember, error)
+	AssignChamaRole(context.Context, *AssignChamaRoleRequest) (*ChamaMember, error)
+	FindMemberByIdentity(context.Context, *FindMemberByIdentityRequest) (*FindMemberByIdentityResponse, error)
+	MergeChamaMembers(context.Context, *MergeChamaMembersRequest) (*ChamaMember, error)
 	mustEmbedUnimplementedChamaMemberAPIServer()
 }
 
@@ -291,15 +421,21 @@ func (UnimplementedChamaMemberAPIServer) CreateChamaMember(context.Context, *Cre
 func (UnimplementedChamaMemberAPIServer) UpdateChamaMember(context.Context, *UpdateChamaMemberRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateChamaMember not implemented")
 }
-func (UnimplementedChamaMemberAPIServer) DeleteChamaMember(context.Context, *DeleteChamaMemberRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteChamaMember not implemented")
-}
 func (UnimplementedChamaMemberAPIServer) ListChamaMembers(context.Context, *ListChamaMembersRequest) (*ListChamaMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListChamaMembers not implemented")
 }
 func (UnimplementedChamaMemberAPIServer) GetChamaMember(context.Context, *GetChamaMemberRequest) (*ChamaMember, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChamaMember not implemented")
 }
+func (UnimplementedChamaMemberAPIServer) AssignChamaRole(context.Context, *AssignChamaRoleRequest) (*ChamaMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignChamaRole not implemented")
+}
+func (UnimplementedChamaMemberAPIServer) FindMemberByIdentity(context.Context, *FindMemberByIdentityRequest) (*FindMemberByIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindMemberByIdentity not implemented")
+}
+func (UnimplementedChamaMemberAPIServer) MergeChamaMembers(context.Context, *MergeChamaMembersRequest) (*ChamaMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeChamaMembers not implemented")
+}
 func (UnimplementedChamaMemberAPIServer) mustEmbedUnimplementedChamaMemberAPIServer() {}
 
 // UnsafeChamaMemberAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -349,56 +485,92 @@ func _ChamaMemberAPI_UpdateChamaMember_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChamaMemberAPI_DeleteChamaMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteChamaMemberRequest)
+func _ChamaMemberAPI_ListChamaMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChamaMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChamaMemberAPIServer).ListChamaMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.ChamaMemberAPI/ListChamaMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChamaMemberAPIServer).ListChamaMembers(ctx, req.(*ListChamaMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChamaMemberAPI_GetChamaMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChamaMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChamaMemberAPIServer).DeleteChamaMember(ctx, in)
+		return srv.(ChamaMemberAPIServer).GetChamaMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gidyon.chama.ChamaMemberAPI/DeleteChamaMember",
+		FullMethod: "/gidyon.chama.ChamaMemberAPI/GetChamaMember",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChamaMemberAPIServer).DeleteChamaMember(ctx, req.(*DeleteChamaMemberRequest))
+		return srv.(ChamaMemberAPIServer).GetChamaMember(ctx, req.(*GetChamaMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChamaMemberAPI_ListChamaMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListChamaMembersRequest)
+func _ChamaMemberAPI_AssignChamaRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignChamaRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChamaMemberAPIServer).ListChamaMembers(ctx, in)
+		return srv.(ChamaMemberAPIServer).AssignChamaRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gidyon.chama.ChamaMemberAPI/ListChamaMembers",
+		FullMethod: "/gidyon.chama.ChamaMemberAPI/AssignChamaRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChamaMemberAPIServer).ListChamaMembers(ctx, req.(*ListChamaMembersRequest))
+		return srv.(ChamaMemberAPIServer).AssignChamaRole(ctx, req.(*AssignChamaRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChamaMemberAPI_GetChamaMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetChamaMemberRequest)
+func _ChamaMemberAPI_FindMemberByIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindMemberByIdentityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChamaMemberAPIServer).GetChamaMember(ctx, in)
+		return srv.(ChamaMemberAPIServer).FindMemberByIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gidyon.chama.ChamaMemberAPI/GetChamaMember",
+		FullMethod: "/gidyon.chama.ChamaMemberAPI/FindMemberByIdentity",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChamaMemberAPIServer).GetChamaMember(ctx, req.(*GetChamaMemberRequest))
+		return srv.(ChamaMemberAPIServer).FindMemberByIdentity(ctx, req.(*FindMemberByIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChamaMemberAPI_MergeChamaMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeChamaMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChamaMemberAPIServer).MergeChamaMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.ChamaMemberAPI/MergeChamaMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChamaMemberAPIServer).MergeChamaMembers(ctx, req.(*MergeChamaMembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -415,10 +587,6 @@ var _ChamaMemberAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateChamaMember",
 			Handler:    _ChamaMemberAPI_UpdateChamaMember_Handler,
 		},
-		{
-			MethodName: "DeleteChamaMember",
-			Handler:    _ChamaMemberAPI_DeleteChamaMember_Handler,
-		},
 		{
 			MethodName: "ListChamaMembers",
 			Handler:    _ChamaMemberAPI_ListChamaMembers_Handler,
@@ -427,6 +595,1740 @@ var _ChamaMemberAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetChamaMember",
 			Handler:    _ChamaMemberAPI_GetChamaMember_Handler,
 		},
+		{
+			MethodName: "AssignChamaRole",
+			Handler:    _ChamaMemberAPI_AssignChamaRole_Handler,
+		},
+		{
+			MethodName: "FindMemberByIdentity",
+			Handler:    _ChamaMemberAPI_FindMemberByIdentity_Handler,
+		},
+		{
+			MethodName: "MergeChamaMembers",
+			Handler:    _ChamaMemberAPI_MergeChamaMembers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chama.proto",
+}
+
+// RotationAPIClient is the client API for RotationAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RotationAPIClient interface {
+	CreateRotation(ctx context.Context, in *CreateRotationRequest, opts ...grpc.CallOption) (*Rotation, error)
+	GetRotation(ctx context.Context, in *GetRotationRequest, opts ...grpc.CallOption) (*Rotation, error)
+	ListRotations(ctx context.Context, in *ListRotationsRequest, opts ...grpc.CallOption) (*ListRotationsResponse, error)
+	RecordRotationContribution(ctx context.Context, in *RecordRotationContributionRequest, opts ...grpc.CallOption) (*RotationCycle, error)
+	PayoutRotationCycle(ctx context.Context, in *PayoutRotationCycleRequest, opts ...grpc.CallOption) (*RotationCycle, error)
+	PlaceRotationBid(ctx context.Context, in *PlaceRotationBidRequest, opts ...grpc.CallOption) (*RotationCycle, error)
+	SkipRotationTurn(ctx context.Context, in *SkipRotationTurnRequest, opts ...grpc.CallOption) (*Rotation, error)
+	SwapRotationTurns(ctx context.Context, in *SwapRotationTurnsRequest, opts ...grpc.CallOption) (*Rotation, error)
+}
+
+type rotationAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRotationAPIClient(cc grpc.ClientConnInterface) RotationAPIClient {
+	return &rotationAPIClient{cc}
+}
+
+func (c *rotationAPIClient) CreateRotation(ctx context.Context, in *CreateRotationRequest, opts ...grpc.CallOption) (*Rotation, error) {
+	out := new(Rotation)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.RotationAPI/CreateRotation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotationAPIClient) GetRotation(ctx context.Context, in *GetRotationRequest, opts ...grpc.CallOption) (*Rotation, error) {
+	out := new(Rotation)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.RotationAPI/GetRotation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotationAPIClient) ListRotations(ctx context.Context, in *ListRotationsRequest, opts ...grpc.CallOption) (*ListRotationsResponse, error) {
+	out := new(ListRotationsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.RotationAPI/ListRotations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotationAPIClient) RecordRotationContribution(ctx context.Context, in *RecordRotationContributionRequest, opts ...grpc.CallOption) (*RotationCycle, error) {
+	out := new(RotationCycle)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.RotationAPI/RecordRotationContribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotationAPIClient) PayoutRotationCycle(ctx context.Context, in *PayoutRotationCycleRequest, opts ...grpc.CallOption) (*RotationCycle, error) {
+	out := new(RotationCycle)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.RotationAPI/PayoutRotationCycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotationAPIClient) PlaceRotationBid(ctx context.Context, in *PlaceRotationBidRequest, opts ...grpc.CallOption) (*RotationCycle, error) {
+	out := new(RotationCycle)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.RotationAPI/PlaceRotationBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotationAPIClient) SkipRotationTurn(ctx context.Context, in *SkipRotationTurnRequest, opts ...grpc.CallOption) (*Rotation, error) {
+	out := new(Rotation)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.RotationAPI/SkipRotationTurn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rotationAPIClient) SwapRotationTurns(ctx context.Context, in *SwapRotationTurnsRequest, opts ...grpc.CallOption) (*Rotation, error) {
+	out := new(Rotation)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.RotationAPI/SwapRotationTurns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RotationAPIServer is the server API for RotationAPI service.
+// All implementations must embed UnimplementedRotationAPIServer
+// for forward compatibility
+type RotationAPIServer interface {
+	CreateRotation(context.Context, *CreateRotationRequest) (*Rotation, error)
+	GetRotation(context.Context, *GetRotationRequest) (*Rotation, error)
+	ListRotations(context.Context, *ListRotationsRequest) (*ListRotationsResponse, error)
+	RecordRotationContribution(context.Context, *RecordRotationContributionRequest) (*RotationCycle, error)
+	PayoutRotationCycle(context.Context, *PayoutRotationCycleRequest) (*RotationCycle, error)
+	PlaceRotationBid(context.Context, *PlaceRotationBidRequest) (*RotationCycle, error)
+	SkipRotationTurn(context.Context, *SkipRotationTurnRequest) (*Rotation, error)
+	SwapRotationTurns(context.Context, *SwapRotationTurnsRequest) (*Rotation, error)
+	mustEmbedUnimplementedRotationAPIServer()
+}
+
+// UnimplementedRotationAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedRotationAPIServer struct {
+}
+
+func (UnimplementedRotationAPIServer) CreateRotation(context.Context, *CreateRotationRequest) (*Rotation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRotation not implemented")
+}
+func (UnimplementedRotationAPIServer) GetRotation(context.Context, *GetRotationRequest) (*Rotation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRotation not implemented")
+}
+func (UnimplementedRotationAPIServer) ListRotations(context.Context, *ListRotationsRequest) (*ListRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRotations not implemented")
+}
+func (UnimplementedRotationAPIServer) RecordRotationContribution(context.Context, *RecordRotationContributionRequest) (*RotationCycle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordRotationContribution not implemented")
+}
+func (UnimplementedRotationAPIServer) PayoutRotationCycle(context.Context, *PayoutRotationCycleRequest) (*RotationCycle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayoutRotationCycle not implemented")
+}
+func (UnimplementedRotationAPIServer) PlaceRotationBid(context.Context, *PlaceRotationBidRequest) (*RotationCycle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceRotationBid not implemented")
+}
+func (UnimplementedRotationAPIServer) SkipRotationTurn(context.Context, *SkipRotationTurnRequest) (*Rotation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipRotationTurn not implemented")
+}
+func (UnimplementedRotationAPIServer) SwapRotationTurns(context.Context, *SwapRotationTurnsRequest) (*Rotation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapRotationTurns not implemented")
+}
+func (UnimplementedRotationAPIServer) mustEmbedUnimplementedRotationAPIServer() {}
+
+// UnsafeRotationAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RotationAPIServer will
+// result in compilation errors.
+type UnsafeRotationAPIServer interface {
+	mustEmbedUnimplementedRotationAPIServer()
+}
+
+func RegisterRotationAPIServer(s grpc.ServiceRegistrar, srv RotationAPIServer) {
+	s.RegisterService(&_RotationAPI_serviceDesc, srv)
+}
+
+func _RotationAPI_CreateRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotationAPIServer).CreateRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.RotationAPI/CreateRotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotationAPIServer).CreateRotation(ctx, req.(*CreateRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RotationAPI_GetRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotationAPIServer).GetRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.RotationAPI/GetRotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotationAPIServer).GetRotation(ctx, req.(*GetRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RotationAPI_ListRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotationAPIServer).ListRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.RotationAPI/ListRotations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotationAPIServer).ListRotations(ctx, req.(*ListRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RotationAPI_RecordRotationContribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRotationContributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotationAPIServer).RecordRotationContribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.RotationAPI/RecordRotationContribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotationAPIServer).RecordRotationContribution(ctx, req.(*RecordRotationContributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RotationAPI_PayoutRotationCycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayoutRotationCycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotationAPIServer).PayoutRotationCycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.RotationAPI/PayoutRotationCycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotationAPIServer).PayoutRotationCycle(ctx, req.(*PayoutRotationCycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RotationAPI_PlaceRotationBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceRotationBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotationAPIServer).PlaceRotationBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.RotationAPI/PlaceRotationBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotationAPIServer).PlaceRotationBid(ctx, req.(*PlaceRotationBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RotationAPI_SkipRotationTurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipRotationTurnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotationAPIServer).SkipRotationTurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.RotationAPI/SkipRotationTurn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotationAPIServer).SkipRotationTurn(ctx, req.(*SkipRotationTurnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RotationAPI_SwapRotationTurns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapRotationTurnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RotationAPIServer).SwapRotationTurns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.RotationAPI/SwapRotationTurns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RotationAPIServer).SwapRotationTurns(ctx, req.(*SwapRotationTurnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RotationAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gidyon.chama.RotationAPI",
+	HandlerType: (*RotationAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRotation",
+			Handler:    _RotationAPI_CreateRotation_Handler,
+		},
+		{
+			MethodName: "GetRotation",
+			Handler:    _RotationAPI_GetRotation_Handler,
+		},
+		{
+			MethodName: "ListRotations",
+			Handler:    _RotationAPI_ListRotations_Handler,
+		},
+		{
+			MethodName: "RecordRotationContribution",
+			Handler:    _RotationAPI_RecordRotationContribution_Handler,
+		},
+		{
+			MethodName: "PayoutRotationCycle",
+			Handler:    _RotationAPI_PayoutRotationCycle_Handler,
+		},
+		{
+			MethodName: "PlaceRotationBid",
+			Handler:    _RotationAPI_PlaceRotationBid_Handler,
+		},
+		{
+			MethodName: "SkipRotationTurn",
+			Handler:    _RotationAPI_SkipRotationTurn_Handler,
+		},
+		{
+			MethodName: "SwapRotationTurns",
+			Handler:    _RotationAPI_SwapRotationTurns_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chama.proto",
+}
+
+// MeetingAPIClient is the client API for MeetingAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MeetingAPIClient interface {
+	ScheduleMeeting(ctx context.Context, in *ScheduleMeetingRequest, opts ...grpc.CallOption) (*Meeting, error)
+	UpdateMeeting(ctx context.Context, in *UpdateMeetingRequest, opts ...grpc.CallOption) (*Meeting, error)
+	CancelMeeting(ctx context.Context, in *CancelMeetingRequest, opts ...grpc.CallOption) (*Meeting, error)
+	GetMeeting(ctx context.Context, in *GetMeetingRequest, opts ...grpc.CallOption) (*Meeting, error)
+	ListMeetings(ctx context.Context, in *ListMeetingsRequest, opts ...grpc.CallOption) (*ListMeetingsResponse, error)
+	RecordAttendance(ctx context.Context, in *RecordAttendanceRequest, opts ...grpc.CallOption) (*Meeting, error)
+	RecordMinutes(ctx context.Context, in *RecordMinutesRequest, opts ...grpc.CallOption) (*Meeting, error)
+	AddResolution(ctx context.Context, in *AddResolutionRequest, opts ...grpc.CallOption) (*MeetingResolution, error)
+	CloseMeeting(ctx context.Context, in *CloseMeetingRequest, opts ...grpc.CallOption) (*Meeting, error)
+}
+
+type meetingAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMeetingAPIClient(cc grpc.ClientConnInterface) MeetingAPIClient {
+	return &meetingAPIClient{cc}
+}
+
+func (c *meetingAPIClient) ScheduleMeeting(ctx context.Context, in *ScheduleMeetingRequest, opts ...grpc.CallOption) (*Meeting, error) {
+	out := new(Meeting)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.MeetingAPI/ScheduleMeeting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingAPIClient) UpdateMeeting(ctx context.Context, in *UpdateMeetingRequest, opts ...grpc.CallOption) (*Meeting, error) {
+	out := new(Meeting)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.MeetingAPI/UpdateMeeting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingAPIClient) CancelMeeting(ctx context.Context, in *CancelMeetingRequest, opts ...grpc.CallOption) (*Meeting, error) {
+	out := new(Meeting)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.MeetingAPI/CancelMeeting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingAPIClient) GetMeeting(ctx context.Context, in *GetMeetingRequest, opts ...grpc.CallOption) (*Meeting, error) {
+	out := new(Meeting)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.MeetingAPI/GetMeeting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingAPIClient) ListMeetings(ctx context.Context, in *ListMeetingsRequest, opts ...grpc.CallOption) (*ListMeetingsResponse, error) {
+	out := new(ListMeetingsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.MeetingAPI/ListMeetings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingAPIClient) RecordAttendance(ctx context.Context, in *RecordAttendanceRequest, opts ...grpc.CallOption) (*Meeting, error) {
+	out := new(Meeting)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.MeetingAPI/RecordAttendance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingAPIClient) RecordMinutes(ctx context.Context, in *RecordMinutesRequest, opts ...grpc.CallOption) (*Meeting, error) {
+	out := new(Meeting)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.MeetingAPI/RecordMinutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingAPIClient) AddResolution(ctx context.Context, in *AddResolutionRequest, opts ...grpc.CallOption) (*MeetingResolution, error) {
+	out := new(MeetingResolution)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.MeetingAPI/AddResolution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingAPIClient) CloseMeeting(ctx context.Context, in *CloseMeetingRequest, opts ...grpc.CallOption) (*Meeting, error) {
+	out := new(Meeting)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.MeetingAPI/CloseMeeting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MeetingAPIServer is the server API for MeetingAPI service.
+// All implementations must embed UnimplementedMeetingAPIServer
+// for forward compatibility
+type MeetingAPIServer interface {
+	ScheduleMeeting(context.Context, *ScheduleMeetingRequest) (*Meeting, error)
+	UpdateMeeting(context.Context, *UpdateMeetingRequest) (*Meeting, error)
+	CancelMeeting(context.Context, *CancelMeetingRequest) (*Meeting, error)
+	GetMeeting(context.Context, *GetMeetingRequest) (*Meeting, error)
+	ListMeetings(context.Context, *ListMeetingsRequest) (*ListMeetingsResponse, error)
+	RecordAttendance(context.Context, *RecordAttendanceRequest) (*Meeting, error)
+	RecordMinutes(context.Context, *RecordMinutesRequest) (*Meeting, error)
+	AddResolution(context.Context, *AddResolutionRequest) (*MeetingResolution, error)
+	CloseMeeting(context.Context, *CloseMeetingRequest) (*Meeting, error)
+	mustEmbedUnimplementedMeetingAPIServer()
+}
+
+// UnimplementedMeetingAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedMeetingAPIServer struct {
+}
+
+func (UnimplementedMeetingAPIServer) ScheduleMeeting(context.Context, *ScheduleMeetingRequest) (*Meeting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMeeting not implemented")
+}
+func (UnimplementedMeetingAPIServer) UpdateMeeting(context.Context, *UpdateMeetingRequest) (*Meeting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMeeting not implemented")
+}
+func (UnimplementedMeetingAPIServer) CancelMeeting(context.Context, *CancelMeetingRequest) (*Meeting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMeeting not implemented")
+}
+func (UnimplementedMeetingAPIServer) GetMeeting(context.Context, *GetMeetingRequest) (*Meeting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeeting not implemented")
+}
+func (UnimplementedMeetingAPIServer) ListMeetings(context.Context, *ListMeetingsRequest) (*ListMeetingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMeetings not implemented")
+}
+func (UnimplementedMeetingAPIServer) RecordAttendance(context.Context, *RecordAttendanceRequest) (*Meeting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordAttendance not implemented")
+}
+func (UnimplementedMeetingAPIServer) RecordMinutes(context.Context, *RecordMinutesRequest) (*Meeting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordMinutes not implemented")
+}
+func (UnimplementedMeetingAPIServer) AddResolution(context.Context, *AddResolutionRequest) (*MeetingResolution, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddResolution not implemented")
+}
+func (UnimplementedMeetingAPIServer) CloseMeeting(context.Context, *CloseMeetingRequest) (*Meeting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseMeeting not implemented")
+}
+func (UnimplementedMeetingAPIServer) mustEmbedUnimplementedMeetingAPIServer() {}
+
+// UnsafeMeetingAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MeetingAPIServer will
+// result in compilation errors.
+type UnsafeMeetingAPIServer interface {
+	mustEmbedUnimplementedMeetingAPIServer()
+}
+
+func RegisterMeetingAPIServer(s grpc.ServiceRegistrar, srv MeetingAPIServer) {
+	s.RegisterService(&_MeetingAPI_serviceDesc, srv)
+}
+
+func _MeetingAPI_ScheduleMeeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMeetingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingAPIServer).ScheduleMeeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.MeetingAPI/ScheduleMeeting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingAPIServer).ScheduleMeeting(ctx, req.(*ScheduleMeetingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingAPI_UpdateMeeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMeetingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingAPIServer).UpdateMeeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.MeetingAPI/UpdateMeeting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingAPIServer).UpdateMeeting(ctx, req.(*UpdateMeetingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingAPI_CancelMeeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMeetingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingAPIServer).CancelMeeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.MeetingAPI/CancelMeeting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingAPIServer).CancelMeeting(ctx, req.(*CancelMeetingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingAPI_GetMeeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeetingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingAPIServer).GetMeeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.MeetingAPI/GetMeeting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingAPIServer).GetMeeting(ctx, req.(*GetMeetingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingAPI_ListMeetings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMeetingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingAPIServer).ListMeetings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.MeetingAPI/ListMeetings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingAPIServer).ListMeetings(ctx, req.(*ListMeetingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingAPI_RecordAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingAPIServer).RecordAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.MeetingAPI/RecordAttendance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingAPIServer).RecordAttendance(ctx, req.(*RecordAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingAPI_RecordMinutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordMinutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingAPIServer).RecordMinutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.MeetingAPI/RecordMinutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingAPIServer).RecordMinutes(ctx, req.(*RecordMinutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingAPI_AddResolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddResolutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingAPIServer).AddResolution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.MeetingAPI/AddResolution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingAPIServer).AddResolution(ctx, req.(*AddResolutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingAPI_CloseMeeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseMeetingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingAPIServer).CloseMeeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.MeetingAPI/CloseMeeting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingAPIServer).CloseMeeting(ctx, req.(*CloseMeetingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MeetingAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gidyon.chama.MeetingAPI",
+	HandlerType: (*MeetingAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleMeeting",
+			Handler:    _MeetingAPI_ScheduleMeeting_Handler,
+		},
+		{
+			MethodName: "UpdateMeeting",
+			Handler:    _MeetingAPI_UpdateMeeting_Handler,
+		},
+		{
+			MethodName: "CancelMeeting",
+			Handler:    _MeetingAPI_CancelMeeting_Handler,
+		},
+		{
+			MethodName: "GetMeeting",
+			Handler:    _MeetingAPI_GetMeeting_Handler,
+		},
+		{
+			MethodName: "ListMeetings",
+			Handler:    _MeetingAPI_ListMeetings_Handler,
+		},
+		{
+			MethodName: "RecordAttendance",
+			Handler:    _MeetingAPI_RecordAttendance_Handler,
+		},
+		{
+			MethodName: "RecordMinutes",
+			Handler:    _MeetingAPI_RecordMinutes_Handler,
+		},
+		{
+			MethodName: "AddResolution",
+			Handler:    _MeetingAPI_AddResolution_Handler,
+		},
+		{
+			MethodName: "CloseMeeting",
+			Handler:    _MeetingAPI_CloseMeeting_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chama.proto",
+}
+
+// FineAPIClient is the client API for FineAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FineAPIClient interface {
+	CreateFineType(ctx context.Context, in *CreateFineTypeRequest, opts ...grpc.CallOption) (*FineType, error)
+	UpdateFineType(ctx context.Context, in *UpdateFineTypeRequest, opts ...grpc.CallOption) (*FineType, error)
+	ListFineTypes(ctx context.Context, in *ListFineTypesRequest, opts ...grpc.CallOption) (*ListFineTypesResponse, error)
+	IssueFine(ctx context.Context, in *IssueFineRequest, opts ...grpc.CallOption) (*Fine, error)
+	GetFine(ctx context.Context, in *GetFineRequest, opts ...grpc.CallOption) (*Fine, error)
+	ListFines(ctx context.Context, in *ListFinesRequest, opts ...grpc.CallOption) (*ListFinesResponse, error)
+	PayFine(ctx context.Context, in *PayFineRequest, opts ...grpc.CallOption) (*Fine, error)
+	RequestFineWaiver(ctx context.Context, in *RequestFineWaiverRequest, opts ...grpc.CallOption) (*Fine, error)
+	ApproveFineWaiver(ctx context.Context, in *ApproveFineWaiverRequest, opts ...grpc.CallOption) (*Fine, error)
+}
+
+type fineAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFineAPIClient(cc grpc.ClientConnInterface) FineAPIClient {
+	return &fineAPIClient{cc}
+}
+
+func (c *fineAPIClient) CreateFineType(ctx context.Context, in *CreateFineTypeRequest, opts ...grpc.CallOption) (*FineType, error) {
+	out := new(FineType)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.FineAPI/CreateFineType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fineAPIClient) UpdateFineType(ctx context.Context, in *UpdateFineTypeRequest, opts ...grpc.CallOption) (*FineType, error) {
+	out := new(FineType)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.FineAPI/UpdateFineType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fineAPIClient) ListFineTypes(ctx context.Context, in *ListFineTypesRequest, opts ...grpc.CallOption) (*ListFineTypesResponse, error) {
+	out := new(ListFineTypesResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.FineAPI/ListFineTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fineAPIClient) IssueFine(ctx context.Context, in *IssueFineRequest, opts ...grpc.CallOption) (*Fine, error) {
+	out := new(Fine)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.FineAPI/IssueFine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fineAPIClient) GetFine(ctx context.Context, in *GetFineRequest, opts ...grpc.CallOption) (*Fine, error) {
+	out := new(Fine)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.FineAPI/GetFine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fineAPIClient) ListFines(ctx context.Context, in *ListFinesRequest, opts ...grpc.CallOption) (*ListFinesResponse, error) {
+	out := new(ListFinesResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.FineAPI/ListFines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fineAPIClient) PayFine(ctx context.Context, in *PayFineRequest, opts ...grpc.CallOption) (*Fine, error) {
+	out := new(Fine)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.FineAPI/PayFine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fineAPIClient) RequestFineWaiver(ctx context.Context, in *RequestFineWaiverRequest, opts ...grpc.CallOption) (*Fine, error) {
+	out := new(Fine)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.FineAPI/RequestFineWaiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fineAPIClient) ApproveFineWaiver(ctx context.Context, in *ApproveFineWaiverRequest, opts ...grpc.CallOption) (*Fine, error) {
+	out := new(Fine)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.FineAPI/ApproveFineWaiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FineAPIServer is the server API for FineAPI service.
+// All implementations must embed UnimplementedFineAPIServer
+// for forward compatibility
+type FineAPIServer interface {
+	CreateFineType(context.Context, *CreateFineTypeRequest) (*FineType, error)
+	UpdateFineType(context.Context, *UpdateFineTypeRequest) (*FineType, error)
+	ListFineTypes(context.Context, *ListFineTypesRequest) (*ListFineTypesResponse, error)
+	IssueFine(context.Context, *IssueFineRequest) (*Fine, error)
+	GetFine(context.Context, *GetFineRequest) (*Fine, error)
+	ListFines(context.Context, *ListFinesRequest) (*ListFinesResponse, error)
+	PayFine(context.Context, *PayFineRequest) (*Fine, error)
+	RequestFineWaiver(context.Context, *RequestFineWaiverRequest) (*Fine, error)
+	ApproveFineWaiver(context.Context, *ApproveFineWaiverRequest) (*Fine, error)
+	mustEmbedUnimplementedFineAPIServer()
+}
+
+// UnimplementedFineAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedFineAPIServer struct {
+}
+
+func (UnimplementedFineAPIServer) CreateFineType(context.Context, *CreateFineTypeRequest) (*FineType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFineType not implemented")
+}
+func (UnimplementedFineAPIServer) UpdateFineType(context.Context, *UpdateFineTypeRequest) (*FineType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFineType not implemented")
+}
+func (UnimplementedFineAPIServer) ListFineTypes(context.Context, *ListFineTypesRequest) (*ListFineTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFineTypes not implemented")
+}
+func (UnimplementedFineAPIServer) IssueFine(context.Context, *IssueFineRequest) (*Fine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueFine not implemented")
+}
+func (UnimplementedFineAPIServer) GetFine(context.Context, *GetFineRequest) (*Fine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFine not implemented")
+}
+func (UnimplementedFineAPIServer) ListFines(context.Context, *ListFinesRequest) (*ListFinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFines not implemented")
+}
+func (UnimplementedFineAPIServer) PayFine(context.Context, *PayFineRequest) (*Fine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayFine not implemented")
+}
+func (UnimplementedFineAPIServer) RequestFineWaiver(context.Context, *RequestFineWaiverRequest) (*Fine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestFineWaiver not implemented")
+}
+func (UnimplementedFineAPIServer) ApproveFineWaiver(context.Context, *ApproveFineWaiverRequest) (*Fine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFineWaiver not implemented")
+}
+func (UnimplementedFineAPIServer) mustEmbedUnimplementedFineAPIServer() {}
+
+// UnsafeFineAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FineAPIServer will
+// result in compilation errors.
+type UnsafeFineAPIServer interface {
+	mustEmbedUnimplementedFineAPIServer()
+}
+
+func RegisterFineAPIServer(s grpc.ServiceRegistrar, srv FineAPIServer) {
+	s.RegisterService(&_FineAPI_serviceDesc, srv)
+}
+
+func _FineAPI_CreateFineType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFineTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineAPIServer).CreateFineType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.FineAPI/CreateFineType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineAPIServer).CreateFineType(ctx, req.(*CreateFineTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FineAPI_UpdateFineType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFineTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineAPIServer).UpdateFineType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.FineAPI/UpdateFineType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineAPIServer).UpdateFineType(ctx, req.(*UpdateFineTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FineAPI_ListFineTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFineTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineAPIServer).ListFineTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.FineAPI/ListFineTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineAPIServer).ListFineTypes(ctx, req.(*ListFineTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FineAPI_IssueFine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueFineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineAPIServer).IssueFine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.FineAPI/IssueFine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineAPIServer).IssueFine(ctx, req.(*IssueFineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FineAPI_GetFine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineAPIServer).GetFine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.FineAPI/GetFine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineAPIServer).GetFine(ctx, req.(*GetFineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FineAPI_ListFines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineAPIServer).ListFines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.FineAPI/ListFines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineAPIServer).ListFines(ctx, req.(*ListFinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FineAPI_PayFine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayFineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineAPIServer).PayFine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.FineAPI/PayFine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineAPIServer).PayFine(ctx, req.(*PayFineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FineAPI_RequestFineWaiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestFineWaiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineAPIServer).RequestFineWaiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.FineAPI/RequestFineWaiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineAPIServer).RequestFineWaiver(ctx, req.(*RequestFineWaiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FineAPI_ApproveFineWaiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveFineWaiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineAPIServer).ApproveFineWaiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.FineAPI/ApproveFineWaiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineAPIServer).ApproveFineWaiver(ctx, req.(*ApproveFineWaiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _FineAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gidyon.chama.FineAPI",
+	HandlerType: (*FineAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFineType",
+			Handler:    _FineAPI_CreateFineType_Handler,
+		},
+		{
+			MethodName: "UpdateFineType",
+			Handler:    _FineAPI_UpdateFineType_Handler,
+		},
+		{
+			MethodName: "ListFineTypes",
+			Handler:    _FineAPI_ListFineTypes_Handler,
+		},
+		{
+			MethodName: "IssueFine",
+			Handler:    _FineAPI_IssueFine_Handler,
+		},
+		{
+			MethodName: "GetFine",
+			Handler:    _FineAPI_GetFine_Handler,
+		},
+		{
+			MethodName: "ListFines",
+			Handler:    _FineAPI_ListFines_Handler,
+		},
+		{
+			MethodName: "PayFine",
+			Handler:    _FineAPI_PayFine_Handler,
+		},
+		{
+			MethodName: "RequestFineWaiver",
+			Handler:    _FineAPI_RequestFineWaiver_Handler,
+		},
+		{
+			MethodName: "ApproveFineWaiver",
+			Handler:    _FineAPI_ApproveFineWaiver_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chama.proto",
+}
+
+// InvitationAPIClient is the client API for InvitationAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InvitationAPIClient interface {
+	InviteMember(ctx context.Context, in *InviteMemberRequest, opts ...grpc.CallOption) (*MemberInvitation, error)
+	ResendInvitation(ctx context.Context, in *ResendInvitationRequest, opts ...grpc.CallOption) (*MemberInvitation, error)
+	RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*MemberInvitation, error)
+	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*MemberInvitation, error)
+	ConfirmInvitation(ctx context.Context, in *ConfirmInvitationRequest, opts ...grpc.CallOption) (*MemberInvitation, error)
+	ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error)
+}
+
+type invitationAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInvitationAPIClient(cc grpc.ClientConnInterface) InvitationAPIClient {
+	return &invitationAPIClient{cc}
+}
+
+func (c *invitationAPIClient) InviteMember(ctx context.Context, in *InviteMemberRequest, opts ...grpc.CallOption) (*MemberInvitation, error) {
+	out := new(MemberInvitation)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.InvitationAPI/InviteMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invitationAPIClient) ResendInvitation(ctx context.Context, in *ResendInvitationRequest, opts ...grpc.CallOption) (*MemberInvitation, error) {
+	out := new(MemberInvitation)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.InvitationAPI/ResendInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invitationAPIClient) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*MemberInvitation, error) {
+	out := new(MemberInvitation)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.InvitationAPI/RevokeInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invitationAPIClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*MemberInvitation, error) {
+	out := new(MemberInvitation)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.InvitationAPI/AcceptInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invitationAPIClient) ConfirmInvitation(ctx context.Context, in *ConfirmInvitationRequest, opts ...grpc.CallOption) (*MemberInvitation, error) {
+	out := new(MemberInvitation)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.InvitationAPI/ConfirmInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invitationAPIClient) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error) {
+	out := new(ListInvitationsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.InvitationAPI/ListInvitations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InvitationAPIServer is the server API for InvitationAPI service.
+// All implementations must embed UnimplementedInvitationAPIServer
+// for forward compatibility
+type InvitationAPIServer interface {
+	InviteMember(context.Context, *InviteMemberRequest) (*MemberInvitation, error)
+	ResendInvitation(context.Context, *ResendInvitationRequest) (*MemberInvitation, error)
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*MemberInvitation, error)
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*MemberInvitation, error)
+	ConfirmInvitation(context.Context, *ConfirmInvitationRequest) (*MemberInvitation, error)
+	ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error)
+	mustEmbedUnimplementedInvitationAPIServer()
+}
+
+// UnimplementedInvitationAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedInvitationAPIServer struct {
+}
+
+func (UnimplementedInvitationAPIServer) InviteMember(context.Context, *InviteMemberRequest) (*MemberInvitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteMember not implemented")
+}
+func (UnimplementedInvitationAPIServer) ResendInvitation(context.Context, *ResendInvitationRequest) (*MemberInvitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendInvitation not implemented")
+}
+func (UnimplementedInvitationAPIServer) RevokeInvitation(context.Context, *RevokeInvitationRequest) (*MemberInvitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvitation not implemented")
+}
+func (UnimplementedInvitationAPIServer) AcceptInvitation(context.Context, *AcceptInvitationRequest) (*MemberInvitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
+func (UnimplementedInvitationAPIServer) ConfirmInvitation(context.Context, *ConfirmInvitationRequest) (*MemberInvitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmInvitation not implemented")
+}
+func (UnimplementedInvitationAPIServer) ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvitations not implemented")
+}
+func (UnimplementedInvitationAPIServer) mustEmbedUnimplementedInvitationAPIServer() {}
+
+// UnsafeInvitationAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InvitationAPIServer will
+// result in compilation errors.
+type UnsafeInvitationAPIServer interface {
+	mustEmbedUnimplementedInvitationAPIServer()
+}
+
+func RegisterInvitationAPIServer(s grpc.ServiceRegistrar, srv InvitationAPIServer) {
+	s.RegisterService(&_InvitationAPI_serviceDesc, srv)
+}
+
+func _InvitationAPI_InviteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvitationAPIServer).InviteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.InvitationAPI/InviteMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvitationAPIServer).InviteMember(ctx, req.(*InviteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvitationAPI_ResendInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvitationAPIServer).ResendInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.InvitationAPI/ResendInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvitationAPIServer).ResendInvitation(ctx, req.(*ResendInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvitationAPI_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvitationAPIServer).RevokeInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.InvitationAPI/RevokeInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvitationAPIServer).RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvitationAPI_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvitationAPIServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.InvitationAPI/AcceptInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvitationAPIServer).AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvitationAPI_ConfirmInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvitationAPIServer).ConfirmInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.InvitationAPI/ConfirmInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvitationAPIServer).ConfirmInvitation(ctx, req.(*ConfirmInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvitationAPI_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvitationAPIServer).ListInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.InvitationAPI/ListInvitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvitationAPIServer).ListInvitations(ctx, req.(*ListInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _InvitationAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gidyon.chama.InvitationAPI",
+	HandlerType: (*InvitationAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InviteMember",
+			Handler:    _InvitationAPI_InviteMember_Handler,
+		},
+		{
+			MethodName: "ResendInvitation",
+			Handler:    _InvitationAPI_ResendInvitation_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _InvitationAPI_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _InvitationAPI_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "ConfirmInvitation",
+			Handler:    _InvitationAPI_ConfirmInvitation_Handler,
+		},
+		{
+			MethodName: "ListInvitations",
+			Handler:    _InvitationAPI_ListInvitations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chama.proto",
+}
+
+// MemberExitAPIClient is the client API for MemberExitAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MemberExitAPIClient interface {
+	GetExitStatement(ctx context.Context, in *GetExitStatementRequest, opts ...grpc.CallOption) (*ExitSettlement, error)
+	ExitChamaMember(ctx context.Context, in *ExitChamaMemberRequest, opts ...grpc.CallOption) (*ExitSettlement, error)
+	GetExitSettlement(ctx context.Context, in *GetExitSettlementRequest, opts ...grpc.CallOption) (*ExitSettlement, error)
+}
+
+type memberExitAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMemberExitAPIClient(cc grpc.ClientConnInterface) MemberExitAPIClient {
+	return &memberExitAPIClient{cc}
+}
+
+func (c *memberExitAPIClient) GetExitStatement(ctx context.Context, in *GetExitStatementRequest, opts ...grpc.CallOption) (*ExitSettlement, error) {
+	out := new(ExitSettlement)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.MemberExitAPI/GetExitStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberExitAPIClient) ExitChamaMember(ctx context.Context, in *ExitChamaMemberRequest, opts ...grpc.CallOption) (*ExitSettlement, error) {
+	out := new(ExitSettlement)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.MemberExitAPI/ExitChamaMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberExitAPIClient) GetExitSettlement(ctx context.Context, in *GetExitSettlementRequest, opts ...grpc.CallOption) (*ExitSettlement, error) {
+	out := new(ExitSettlement)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.MemberExitAPI/GetExitSettlement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MemberExitAPIServer is the server API for MemberExitAPI service.
+// All implementations must embed UnimplementedMemberExitAPIServer
+// for forward compatibility
+type MemberExitAPIServer interface {
+	GetExitStatement(context.Context, *GetExitStatementRequest) (*ExitSettlement, error)
+	ExitChamaMember(context.Context, *ExitChamaMemberRequest) (*ExitSettlement, error)
+	GetExitSettlement(context.Context, *GetExitSettlementRequest) (*ExitSettlement, error)
+	mustEmbedUnimplementedMemberExitAPIServer()
+}
+
+// UnimplementedMemberExitAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedMemberExitAPIServer struct {
+}
+
+func (UnimplementedMemberExitAPIServer) GetExitStatement(context.Context, *GetExitStatementRequest) (*ExitSettlement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExitStatement not implemented")
+}
+func (UnimplementedMemberExitAPIServer) ExitChamaMember(context.Context, *ExitChamaMemberRequest) (*ExitSettlement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExitChamaMember not implemented")
+}
+func (UnimplementedMemberExitAPIServer) GetExitSettlement(context.Context, *GetExitSettlementRequest) (*ExitSettlement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExitSettlement not implemented")
+}
+func (UnimplementedMemberExitAPIServer) mustEmbedUnimplementedMemberExitAPIServer() {}
+
+// UnsafeMemberExitAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MemberExitAPIServer will
+// result in compilation errors.
+type UnsafeMemberExitAPIServer interface {
+	mustEmbedUnimplementedMemberExitAPIServer()
+}
+
+func RegisterMemberExitAPIServer(s grpc.ServiceRegistrar, srv MemberExitAPIServer) {
+	s.RegisterService(&_MemberExitAPI_serviceDesc, srv)
+}
+
+func _MemberExitAPI_GetExitStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExitStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberExitAPIServer).GetExitStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.MemberExitAPI/GetExitStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberExitAPIServer).GetExitStatement(ctx, req.(*GetExitStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberExitAPI_ExitChamaMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExitChamaMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberExitAPIServer).ExitChamaMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.MemberExitAPI/ExitChamaMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberExitAPIServer).ExitChamaMember(ctx, req.(*ExitChamaMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberExitAPI_GetExitSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExitSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberExitAPIServer).GetExitSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.MemberExitAPI/GetExitSettlement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberExitAPIServer).GetExitSettlement(ctx, req.(*GetExitSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MemberExitAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gidyon.chama.MemberExitAPI",
+	HandlerType: (*MemberExitAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetExitStatement",
+			Handler:    _MemberExitAPI_GetExitStatement_Handler,
+		},
+		{
+			MethodName: "ExitChamaMember",
+			Handler:    _MemberExitAPI_ExitChamaMember_Handler,
+		},
+		{
+			MethodName: "GetExitSettlement",
+			Handler:    _MemberExitAPI_GetExitSettlement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chama.proto",
+}
+
+// KycAPIClient is the client API for KycAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type KycAPIClient interface {
+	UploadKycDocument(ctx context.Context, in *UploadKycDocumentRequest, opts ...grpc.CallOption) (*MemberKyc, error)
+	DownloadKycDocument(ctx context.Context, in *DownloadKycDocumentRequest, opts ...grpc.CallOption) (*DownloadKycDocumentResponse, error)
+	GetMemberKyc(ctx context.Context, in *GetMemberKycRequest, opts ...grpc.CallOption) (*MemberKyc, error)
+	ReviewMemberKyc(ctx context.Context, in *ReviewMemberKycRequest, opts ...grpc.CallOption) (*MemberKyc, error)
+	ListMemberKyc(ctx context.Context, in *ListMemberKycRequest, opts ...grpc.CallOption) (*ListMemberKycResponse, error)
+}
+
+type kycAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKycAPIClient(cc grpc.ClientConnInterface) KycAPIClient {
+	return &kycAPIClient{cc}
+}
+
+func (c *kycAPIClient) UploadKycDocument(ctx context.Context, in *UploadKycDocumentRequest, opts ...grpc.CallOption) (*MemberKyc, error) {
+	out := new(MemberKyc)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.KycAPI/UploadKycDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kycAPIClient) DownloadKycDocument(ctx context.Context, in *DownloadKycDocumentRequest, opts ...grpc.CallOption) (*DownloadKycDocumentResponse, error) {
+	out := new(DownloadKycDocumentResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.KycAPI/DownloadKycDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kycAPIClient) GetMemberKyc(ctx context.Context, in *GetMemberKycRequest, opts ...grpc.CallOption) (*MemberKyc, error) {
+	out := new(MemberKyc)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.KycAPI/GetMemberKyc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kycAPIClient) ReviewMemberKyc(ctx context.Context, in *ReviewMemberKycRequest, opts ...grpc.CallOption) (*MemberKyc, error) {
+	out := new(MemberKyc)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.KycAPI/ReviewMemberKyc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kycAPIClient) ListMemberKyc(ctx context.Context, in *ListMemberKycRequest, opts ...grpc.CallOption) (*ListMemberKycResponse, error) {
+	out := new(ListMemberKycResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.chama.KycAPI/ListMemberKyc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KycAPIServer is the server API for KycAPI service.
+// All implementations must embed UnimplementedKycAPIServer
+// for forward compatibility
+type KycAPIServer interface {
+	UploadKycDocument(context.Context, *UploadKycDocumentRequest) (*MemberKyc, error)
+	DownloadKycDocument(context.Context, *DownloadKycDocumentRequest) (*DownloadKycDocumentResponse, error)
+	GetMemberKyc(context.Context, *GetMemberKycRequest) (*MemberKyc, error)
+	ReviewMemberKyc(context.Context, *ReviewMemberKycRequest) (*MemberKyc, error)
+	ListMemberKyc(context.Context, *ListMemberKycRequest) (*ListMemberKycResponse, error)
+	mustEmbedUnimplementedKycAPIServer()
+}
+
+// UnimplementedKycAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedKycAPIServer struct {
+}
+
+func (UnimplementedKycAPIServer) UploadKycDocument(context.Context, *UploadKycDocumentRequest) (*MemberKyc, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadKycDocument not implemented")
+}
+func (UnimplementedKycAPIServer) DownloadKycDocument(context.Context, *DownloadKycDocumentRequest) (*DownloadKycDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadKycDocument not implemented")
+}
+func (UnimplementedKycAPIServer) GetMemberKyc(context.Context, *GetMemberKycRequest) (*MemberKyc, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemberKyc not implemented")
+}
+func (UnimplementedKycAPIServer) ReviewMemberKyc(context.Context, *ReviewMemberKycRequest) (*MemberKyc, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewMemberKyc not implemented")
+}
+func (UnimplementedKycAPIServer) ListMemberKyc(context.Context, *ListMemberKycRequest) (*ListMemberKycResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemberKyc not implemented")
+}
+func (UnimplementedKycAPIServer) mustEmbedUnimplementedKycAPIServer() {}
+
+// UnsafeKycAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KycAPIServer will
+// result in compilation errors.
+type UnsafeKycAPIServer interface {
+	mustEmbedUnimplementedKycAPIServer()
+}
+
+func RegisterKycAPIServer(s grpc.ServiceRegistrar, srv KycAPIServer) {
+	s.RegisterService(&_KycAPI_serviceDesc, srv)
+}
+
+func _KycAPI_UploadKycDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadKycDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycAPIServer).UploadKycDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.KycAPI/UploadKycDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycAPIServer).UploadKycDocument(ctx, req.(*UploadKycDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KycAPI_DownloadKycDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadKycDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycAPIServer).DownloadKycDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.KycAPI/DownloadKycDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycAPIServer).DownloadKycDocument(ctx, req.(*DownloadKycDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KycAPI_GetMemberKyc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberKycRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycAPIServer).GetMemberKyc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.KycAPI/GetMemberKyc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycAPIServer).GetMemberKyc(ctx, req.(*GetMemberKycRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KycAPI_ReviewMemberKyc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewMemberKycRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycAPIServer).ReviewMemberKyc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.KycAPI/ReviewMemberKyc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycAPIServer).ReviewMemberKyc(ctx, req.(*ReviewMemberKycRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KycAPI_ListMemberKyc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberKycRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycAPIServer).ListMemberKyc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.chama.KycAPI/ListMemberKyc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycAPIServer).ListMemberKyc(ctx, req.(*ListMemberKycRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _KycAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gidyon.chama.KycAPI",
+	HandlerType: (*KycAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UploadKycDocument",
+			Handler:    _KycAPI_UploadKycDocument_Handler,
+		},
+		{
+			MethodName: "DownloadKycDocument",
+			Handler:    _KycAPI_DownloadKycDocument_Handler,
+		},
+		{
+			MethodName: "GetMemberKyc",
+			Handler:    _KycAPI_GetMemberKyc_Handler,
+		},
+		{
+			MethodName: "ReviewMemberKyc",
+			Handler:    _KycAPI_ReviewMemberKyc_Handler,
+		},
+		{
+			MethodName: "ListMemberKyc",
+			Handler:    _KycAPI_ListMemberKyc_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chama.proto",
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
-// 	protoc        v3.14.0
+// 	protoc-gen-go v1.26.0
+// 	protoc        (unknown)
 // source: loan.proto
 
 package loan
@@ -22,618 +22,751 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type LoanStatus int32
+type FeeCalculation int32
 
 const (
-	LoanStatus_WAITING_APPROVAL        LoanStatus = 0
-	LoanStatus_APPROVED                LoanStatus = 1
-	LoanStatus_FUNDS_WITHDRAWN_ACCOUNT LoanStatus = 2
-	LoanStatus_WAITING_FUNDS_TRANSFER  LoanStatus = 3
-	LoanStatus_FUNDS_TRANSFERED        LoanStatus = 4
+	FeeCalculation_FEE_FLAT       FeeCalculation = 0
+	FeeCalculation_FEE_PERCENTAGE FeeCalculation = 1
 )
 
-// Enum value maps for LoanStatus.
+// Enum value maps for FeeCalculation.
 var (
-	LoanStatus_name = map[int32]string{
-		0: "WAITING_APPROVAL",
-		1: "APPROVED",
-		2: "FUNDS_WITHDRAWN_ACCOUNT",
-		3: "WAITING_FUNDS_TRANSFER",
-		4: "FUNDS_TRANSFERED",
+	FeeCalculation_name = map[int32]string{
+		0: "FEE_FLAT",
+		1: "FEE_PERCENTAGE",
 	}
-	LoanStatus_value = map[string]int32{
-		"WAITING_APPROVAL":        0,
-		"APPROVED":                1,
-		"FUNDS_WITHDRAWN_ACCOUNT": 2,
-		"WAITING_FUNDS_TRANSFER":  3,
-		"FUNDS_TRANSFERED":        4,
+	FeeCalculation_value = map[string]int32{
+		"FEE_FLAT":       0,
+		"FEE_PERCENTAGE": 1,
 	}
 )
 
-func (x LoanStatus) Enum() *LoanStatus {
-	p := new(LoanStatus)
+func (x FeeCalculation) Enum() *FeeCalculation {
+	p := new(FeeCalculation)
 	*p = x
 	return p
 }
 
-func (x LoanStatus) String() string {
+func (x FeeCalculation) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (LoanStatus) Descriptor() protoreflect.EnumDescriptor {
+func (FeeCalculation) Descriptor() protoreflect.EnumDescriptor {
 	return file_loan_proto_enumTypes[0].Descriptor()
 }
 
-func (LoanStatus) Type() protoreflect.EnumType {
+func (FeeCalculation) Type() protoreflect.EnumType {
 	return &file_loan_proto_enumTypes[0]
 }
 
-func (x LoanStatus) Number() protoreflect.EnumNumber {
+func (x FeeCalculation) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use LoanStatus.Descriptor instead.
-func (LoanStatus) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use FeeCalculation.Descriptor instead.
+func (FeeCalculation) EnumDescriptor() ([]byte, []int) {
 	return file_loan_proto_rawDescGZIP(), []int{0}
 }
 
-type LoanProduct struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type FeeTreatment int32
 
-	ProductId           string  `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	ChamaId             string  `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
-	Name                string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description         string  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	LoanDurationDays    int32   `protobuf:"varint,5,opt,name=loan_duration_days,json=loanDurationDays,proto3" json:"loan_duration_days,omitempty"`
-	InterestRate        float32 `protobuf:"fixed32,6,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
-	LoanMinimumAmount   float64 `protobuf:"fixed64,7,opt,name=loan_minimum_amount,json=loanMinimumAmount,proto3" json:"loan_minimum_amount,omitempty"`
-	LoanMaximumAmount   float64 `protobuf:"fixed64,8,opt,name=loan_maximum_amount,json=loanMaximumAmount,proto3" json:"loan_maximum_amount,omitempty"`
-	LoanAccountBalance  float64 `protobuf:"fixed64,9,opt,name=loan_account_balance,json=loanAccountBalance,proto3" json:"loan_account_balance,omitempty"`
-	LoanInterestBalance float64 `protobuf:"fixed64,10,opt,name=loan_interest_balance,json=loanInterestBalance,proto3" json:"loan_interest_balance,omitempty"`
-	LoanSettledBalance  float64 `protobuf:"fixed64,11,opt,name=loan_settled_balance,json=loanSettledBalance,proto3" json:"loan_settled_balance,omitempty"`
-	SettledLoans        int32   `protobuf:"varint,12,opt,name=settled_loans,json=settledLoans,proto3" json:"settled_loans,omitempty"`
-	ActiveLoans         int32   `protobuf:"varint,13,opt,name=active_loans,json=activeLoans,proto3" json:"active_loans,omitempty"`
-	TotalLoans          int32   `protobuf:"varint,14,opt,name=total_loans,json=totalLoans,proto3" json:"total_loans,omitempty"`
-	UpdatedDate         string  `protobuf:"bytes,15,opt,name=updated_date,json=updatedDate,proto3" json:"updated_date,omitempty"`
-	CreatedDate         string  `protobuf:"bytes,16,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
-}
+const (
+	FeeTreatment_FEE_DEDUCTED    FeeTreatment = 0
+	FeeTreatment_FEE_CAPITALIZED FeeTreatment = 1
+)
 
-func (x *LoanProduct) Reset() {
-	*x = LoanProduct{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for FeeTreatment.
+var (
+	FeeTreatment_name = map[int32]string{
+		0: "FEE_DEDUCTED",
+		1: "FEE_CAPITALIZED",
 	}
-}
+	FeeTreatment_value = map[string]int32{
+		"FEE_DEDUCTED":    0,
+		"FEE_CAPITALIZED": 1,
+	}
+)
 
-func (x *LoanProduct) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x FeeTreatment) Enum() *FeeTreatment {
+	p := new(FeeTreatment)
+	*p = x
+	return p
 }
 
-func (*LoanProduct) ProtoMessage() {}
-
-func (x *LoanProduct) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x FeeTreatment) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// Deprecated: Use LoanProduct.ProtoReflect.Descriptor instead.
-func (*LoanProduct) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{0}
+func (FeeTreatment) Descriptor() protoreflect.EnumDescriptor {
+	return file_loan_proto_enumTypes[1].Descriptor()
 }
 
-func (x *LoanProduct) GetProductId() string {
-	if x != nil {
-		return x.ProductId
-	}
-	return ""
+func (FeeTreatment) Type() protoreflect.EnumType {
+	return &file_loan_proto_enumTypes[1]
 }
 
-func (x *LoanProduct) GetChamaId() string {
-	if x != nil {
-		return x.ChamaId
-	}
-	return ""
+func (x FeeTreatment) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LoanProduct) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use FeeTreatment.Descriptor instead.
+func (FeeTreatment) EnumDescriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *LoanProduct) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
+type GraceType int32
 
-func (x *LoanProduct) GetLoanDurationDays() int32 {
-	if x != nil {
-		return x.LoanDurationDays
-	}
-	return 0
-}
+const (
+	GraceType_GRACE_NONE      GraceType = 0
+	GraceType_INTEREST_ONLY   GraceType = 1
+	GraceType_FULL_MORATORIUM GraceType = 2
+)
 
-func (x *LoanProduct) GetInterestRate() float32 {
-	if x != nil {
-		return x.InterestRate
+// Enum value maps for GraceType.
+var (
+	GraceType_name = map[int32]string{
+		0: "GRACE_NONE",
+		1: "INTEREST_ONLY",
+		2: "FULL_MORATORIUM",
 	}
-	return 0
-}
-
-func (x *LoanProduct) GetLoanMinimumAmount() float64 {
-	if x != nil {
-		return x.LoanMinimumAmount
+	GraceType_value = map[string]int32{
+		"GRACE_NONE":      0,
+		"INTEREST_ONLY":   1,
+		"FULL_MORATORIUM": 2,
 	}
-	return 0
-}
+)
 
-func (x *LoanProduct) GetLoanMaximumAmount() float64 {
-	if x != nil {
-		return x.LoanMaximumAmount
-	}
-	return 0
+func (x GraceType) Enum() *GraceType {
+	p := new(GraceType)
+	*p = x
+	return p
 }
 
-func (x *LoanProduct) GetLoanAccountBalance() float64 {
-	if x != nil {
-		return x.LoanAccountBalance
-	}
-	return 0
+func (x GraceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *LoanProduct) GetLoanInterestBalance() float64 {
-	if x != nil {
-		return x.LoanInterestBalance
-	}
-	return 0
+func (GraceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_loan_proto_enumTypes[2].Descriptor()
 }
 
-func (x *LoanProduct) GetLoanSettledBalance() float64 {
-	if x != nil {
-		return x.LoanSettledBalance
-	}
-	return 0
+func (GraceType) Type() protoreflect.EnumType {
+	return &file_loan_proto_enumTypes[2]
 }
 
-func (x *LoanProduct) GetSettledLoans() int32 {
-	if x != nil {
-		return x.SettledLoans
-	}
-	return 0
+func (x GraceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LoanProduct) GetActiveLoans() int32 {
-	if x != nil {
-		return x.ActiveLoans
-	}
-	return 0
+// Deprecated: Use GraceType.Descriptor instead.
+func (GraceType) EnumDescriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *LoanProduct) GetTotalLoans() int32 {
-	if x != nil {
-		return x.TotalLoans
-	}
-	return 0
-}
+type PenaltyType int32
 
-func (x *LoanProduct) GetUpdatedDate() string {
-	if x != nil {
-		return x.UpdatedDate
-	}
-	return ""
-}
+const (
+	PenaltyType_PENALTY_TYPE_UNSPECIFIED PenaltyType = 0
+	PenaltyType_FLAT                     PenaltyType = 1
+	PenaltyType_PERCENTAGE_PER_DAY       PenaltyType = 2
+	PenaltyType_PERCENTAGE_PER_PERIOD    PenaltyType = 3
+)
 
-func (x *LoanProduct) GetCreatedDate() string {
-	if x != nil {
-		return x.CreatedDate
+// Enum value maps for PenaltyType.
+var (
+	PenaltyType_name = map[int32]string{
+		0: "PENALTY_TYPE_UNSPECIFIED",
+		1: "FLAT",
+		2: "PERCENTAGE_PER_DAY",
+		3: "PERCENTAGE_PER_PERIOD",
 	}
-	return ""
-}
-
-type Loan struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	PenaltyType_value = map[string]int32{
+		"PENALTY_TYPE_UNSPECIFIED": 0,
+		"FLAT":                     1,
+		"PERCENTAGE_PER_DAY":       2,
+		"PERCENTAGE_PER_PERIOD":    3,
+	}
+)
 
-	LoanId        string     `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
-	ChamaId       string     `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
-	ProductId     string     `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	MemberId      string     `protobuf:"bytes,4,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
-	LoaneeNames   string     `protobuf:"bytes,5,opt,name=loanee_names,json=loaneeNames,proto3" json:"loanee_names,omitempty"`
-	LoaneePhone   string     `protobuf:"bytes,6,opt,name=loanee_phone,json=loaneePhone,proto3" json:"loanee_phone,omitempty"`
-	LoaneeEmail   string     `protobuf:"bytes,7,opt,name=loanee_email,json=loaneeEmail,proto3" json:"loanee_email,omitempty"`
-	NationalId    string     `protobuf:"bytes,8,opt,name=national_id,json=nationalId,proto3" json:"national_id,omitempty"`
-	Approved      bool       `protobuf:"varint,9,opt,name=approved,proto3" json:"approved,omitempty"`
-	Status        LoanStatus `protobuf:"varint,17,opt,name=status,proto3,enum=gidyon.loan.LoanStatus" json:"status,omitempty"`
-	DurationDays  int32      `protobuf:"varint,10,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
-	InterestRate  float32    `protobuf:"fixed32,11,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
-	LoanAmount    float64    `protobuf:"fixed64,12,opt,name=loan_amount,json=loanAmount,proto3" json:"loan_amount,omitempty"`
-	SettledAmount float64    `protobuf:"fixed64,13,opt,name=settled_amount,json=settledAmount,proto3" json:"settled_amount,omitempty"`
-	PenaltyAmount float64    `protobuf:"fixed64,14,opt,name=penalty_amount,json=penaltyAmount,proto3" json:"penalty_amount,omitempty"`
-	UpdatedDate   string     `protobuf:"bytes,15,opt,name=updated_date,json=updatedDate,proto3" json:"updated_date,omitempty"`
-	BorrowedDate  string     `protobuf:"bytes,16,opt,name=borrowed_date,json=borrowedDate,proto3" json:"borrowed_date,omitempty"`
+func (x PenaltyType) Enum() *PenaltyType {
+	p := new(PenaltyType)
+	*p = x
+	return p
 }
 
-func (x *Loan) Reset() {
-	*x = Loan{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x PenaltyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Loan) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (PenaltyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_loan_proto_enumTypes[3].Descriptor()
 }
 
-func (*Loan) ProtoMessage() {}
-
-func (x *Loan) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (PenaltyType) Type() protoreflect.EnumType {
+	return &file_loan_proto_enumTypes[3]
 }
 
-// Deprecated: Use Loan.ProtoReflect.Descriptor instead.
-func (*Loan) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{1}
+func (x PenaltyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Loan) GetLoanId() string {
-	if x != nil {
-		return x.LoanId
-	}
-	return ""
+// Deprecated: Use PenaltyType.Descriptor instead.
+func (PenaltyType) EnumDescriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Loan) GetChamaId() string {
-	if x != nil {
-		return x.ChamaId
-	}
-	return ""
-}
+type EarlySettlementType int32
 
-func (x *Loan) GetProductId() string {
-	if x != nil {
-		return x.ProductId
-	}
-	return ""
-}
+const (
+	EarlySettlementType_FULL_INTEREST     EarlySettlementType = 0
+	EarlySettlementType_PRO_RATA_INTEREST EarlySettlementType = 1
+	EarlySettlementType_INTEREST_REBATE   EarlySettlementType = 2
+)
 
-func (x *Loan) GetMemberId() string {
-	if x != nil {
-		return x.MemberId
+// Enum value maps for EarlySettlementType.
+var (
+	EarlySettlementType_name = map[int32]string{
+		0: "FULL_INTEREST",
+		1: "PRO_RATA_INTEREST",
+		2: "INTEREST_REBATE",
 	}
-	return ""
+	EarlySettlementType_value = map[string]int32{
+		"FULL_INTEREST":     0,
+		"PRO_RATA_INTEREST": 1,
+		"INTEREST_REBATE":   2,
+	}
+)
+
+func (x EarlySettlementType) Enum() *EarlySettlementType {
+	p := new(EarlySettlementType)
+	*p = x
+	return p
 }
 
-func (x *Loan) GetLoaneeNames() string {
-	if x != nil {
-		return x.LoaneeNames
-	}
-	return ""
+func (x EarlySettlementType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Loan) GetLoaneePhone() string {
-	if x != nil {
-		return x.LoaneePhone
-	}
-	return ""
+func (EarlySettlementType) Descriptor() protoreflect.EnumDescriptor {
+	return file_loan_proto_enumTypes[4].Descriptor()
 }
 
-func (x *Loan) GetLoaneeEmail() string {
-	if x != nil {
-		return x.LoaneeEmail
-	}
-	return ""
+func (EarlySettlementType) Type() protoreflect.EnumType {
+	return &file_loan_proto_enumTypes[4]
 }
 
-func (x *Loan) GetNationalId() string {
-	if x != nil {
-		return x.NationalId
-	}
-	return ""
+func (x EarlySettlementType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Loan) GetApproved() bool {
-	if x != nil {
-		return x.Approved
-	}
-	return false
+// Deprecated: Use EarlySettlementType.Descriptor instead.
+func (EarlySettlementType) EnumDescriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Loan) GetStatus() LoanStatus {
-	if x != nil {
-		return x.Status
+type LoanStatus int32
+
+const (
+	LoanStatus_WAITING_APPROVAL        LoanStatus = 0
+	LoanStatus_APPROVED                LoanStatus = 1
+	LoanStatus_FUNDS_WITHDRAWN_ACCOUNT LoanStatus = 2
+	LoanStatus_WAITING_FUNDS_TRANSFER  LoanStatus = 3
+	LoanStatus_FUNDS_TRANSFERED        LoanStatus = 4
+	LoanStatus_REFINANCED              LoanStatus = 5
+	LoanStatus_WRITTEN_OFF             LoanStatus = 6
+	LoanStatus_CANCELLED               LoanStatus = 7
+)
+
+// Enum value maps for LoanStatus.
+var (
+	LoanStatus_name = map[int32]string{
+		0: "WAITING_APPROVAL",
+		1: "APPROVED",
+		2: "FUNDS_WITHDRAWN_ACCOUNT",
+		3: "WAITING_FUNDS_TRANSFER",
+		4: "FUNDS_TRANSFERED",
+		5: "REFINANCED",
+		6: "WRITTEN_OFF",
+		7: "CANCELLED",
 	}
-	return LoanStatus_WAITING_APPROVAL
+	LoanStatus_value = map[string]int32{
+		"WAITING_APPROVAL":        0,
+		"APPROVED":                1,
+		"FUNDS_WITHDRAWN_ACCOUNT": 2,
+		"WAITING_FUNDS_TRANSFER":  3,
+		"FUNDS_TRANSFERED":        4,
+		"REFINANCED":              5,
+		"WRITTEN_OFF":             6,
+		"CANCELLED":               7,
+	}
+)
+
+func (x LoanStatus) Enum() *LoanStatus {
+	p := new(LoanStatus)
+	*p = x
+	return p
 }
 
-func (x *Loan) GetDurationDays() int32 {
-	if x != nil {
-		return x.DurationDays
-	}
-	return 0
+func (x LoanStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Loan) GetInterestRate() float32 {
-	if x != nil {
-		return x.InterestRate
-	}
-	return 0
+func (LoanStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_loan_proto_enumTypes[5].Descriptor()
 }
 
-func (x *Loan) GetLoanAmount() float64 {
-	if x != nil {
-		return x.LoanAmount
-	}
-	return 0
+func (LoanStatus) Type() protoreflect.EnumType {
+	return &file_loan_proto_enumTypes[5]
 }
 
-func (x *Loan) GetSettledAmount() float64 {
-	if x != nil {
-		return x.SettledAmount
-	}
-	return 0
+func (x LoanStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Loan) GetPenaltyAmount() float64 {
-	if x != nil {
-		return x.PenaltyAmount
-	}
-	return 0
+// Deprecated: Use LoanStatus.Descriptor instead.
+func (LoanStatus) EnumDescriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Loan) GetUpdatedDate() string {
-	if x != nil {
-		return x.UpdatedDate
+type DaysPastDueBucket int32
+
+const (
+	DaysPastDueBucket_CURRENT     DaysPastDueBucket = 0
+	DaysPastDueBucket_DPD_1_30    DaysPastDueBucket = 1
+	DaysPastDueBucket_DPD_31_90   DaysPastDueBucket = 2
+	DaysPastDueBucket_DPD_OVER_90 DaysPastDueBucket = 3
+)
+
+// Enum value maps for DaysPastDueBucket.
+var (
+	DaysPastDueBucket_name = map[int32]string{
+		0: "CURRENT",
+		1: "DPD_1_30",
+		2: "DPD_31_90",
+		3: "DPD_OVER_90",
 	}
-	return ""
+	DaysPastDueBucket_value = map[string]int32{
+		"CURRENT":     0,
+		"DPD_1_30":    1,
+		"DPD_31_90":   2,
+		"DPD_OVER_90": 3,
+	}
+)
+
+func (x DaysPastDueBucket) Enum() *DaysPastDueBucket {
+	p := new(DaysPastDueBucket)
+	*p = x
+	return p
 }
 
-func (x *Loan) GetBorrowedDate() string {
-	if x != nil {
-		return x.BorrowedDate
-	}
-	return ""
+func (x DaysPastDueBucket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type CreateLoanProductRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (DaysPastDueBucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_loan_proto_enumTypes[6].Descriptor()
+}
 
-	LoanProduct *LoanProduct `protobuf:"bytes,1,opt,name=loan_product,json=loanProduct,proto3" json:"loan_product,omitempty"`
+func (DaysPastDueBucket) Type() protoreflect.EnumType {
+	return &file_loan_proto_enumTypes[6]
 }
 
-func (x *CreateLoanProductRequest) Reset() {
-	*x = CreateLoanProductRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x DaysPastDueBucket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreateLoanProductRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use DaysPastDueBucket.Descriptor instead.
+func (DaysPastDueBucket) EnumDescriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{6}
 }
 
-func (*CreateLoanProductRequest) ProtoMessage() {}
+type CollateralType int32
 
-func (x *CreateLoanProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	CollateralType_COLLATERAL_OTHER CollateralType = 0
+	CollateralType_TITLE_DEED       CollateralType = 1
+	CollateralType_LOGBOOK          CollateralType = 2
+	CollateralType_HOUSEHOLD_ASSETS CollateralType = 3
+)
+
+// Enum value maps for CollateralType.
+var (
+	CollateralType_name = map[int32]string{
+		0: "COLLATERAL_OTHER",
+		1: "TITLE_DEED",
+		2: "LOGBOOK",
+		3: "HOUSEHOLD_ASSETS",
 	}
-	return mi.MessageOf(x)
-}
+	CollateralType_value = map[string]int32{
+		"COLLATERAL_OTHER": 0,
+		"TITLE_DEED":       1,
+		"LOGBOOK":          2,
+		"HOUSEHOLD_ASSETS": 3,
+	}
+)
 
-// Deprecated: Use CreateLoanProductRequest.ProtoReflect.Descriptor instead.
-func (*CreateLoanProductRequest) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{2}
+func (x CollateralType) Enum() *CollateralType {
+	p := new(CollateralType)
+	*p = x
+	return p
 }
 
-func (x *CreateLoanProductRequest) GetLoanProduct() *LoanProduct {
-	if x != nil {
-		return x.LoanProduct
-	}
-	return nil
+func (x CollateralType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type UpdateLoanProductRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (CollateralType) Descriptor() protoreflect.EnumDescriptor {
+	return file_loan_proto_enumTypes[7].Descriptor()
+}
 
-	LoanProduct *LoanProduct `protobuf:"bytes,1,opt,name=loan_product,json=loanProduct,proto3" json:"loan_product,omitempty"`
+func (CollateralType) Type() protoreflect.EnumType {
+	return &file_loan_proto_enumTypes[7]
 }
 
-func (x *UpdateLoanProductRequest) Reset() {
-	*x = UpdateLoanProductRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x CollateralType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UpdateLoanProductRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use CollateralType.Descriptor instead.
+func (CollateralType) EnumDescriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{7}
 }
 
-func (*UpdateLoanProductRequest) ProtoMessage() {}
+type LienStatus int32
 
-func (x *UpdateLoanProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	LienStatus_LIEN_PENDING    LienStatus = 0
+	LienStatus_LIEN_REGISTERED LienStatus = 1
+	LienStatus_LIEN_RELEASED   LienStatus = 2
+)
+
+// Enum value maps for LienStatus.
+var (
+	LienStatus_name = map[int32]string{
+		0: "LIEN_PENDING",
+		1: "LIEN_REGISTERED",
+		2: "LIEN_RELEASED",
 	}
-	return mi.MessageOf(x)
-}
+	LienStatus_value = map[string]int32{
+		"LIEN_PENDING":    0,
+		"LIEN_REGISTERED": 1,
+		"LIEN_RELEASED":   2,
+	}
+)
 
-// Deprecated: Use UpdateLoanProductRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLoanProductRequest) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{3}
+func (x LienStatus) Enum() *LienStatus {
+	p := new(LienStatus)
+	*p = x
+	return p
 }
 
-func (x *UpdateLoanProductRequest) GetLoanProduct() *LoanProduct {
-	if x != nil {
-		return x.LoanProduct
-	}
-	return nil
+func (x LienStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type DeleteLoanProductRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (LienStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_loan_proto_enumTypes[8].Descriptor()
+}
 
-	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+func (LienStatus) Type() protoreflect.EnumType {
+	return &file_loan_proto_enumTypes[8]
 }
 
-func (x *DeleteLoanProductRequest) Reset() {
-	*x = DeleteLoanProductRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x LienStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *DeleteLoanProductRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use LienStatus.Descriptor instead.
+func (LienStatus) EnumDescriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{8}
 }
 
-func (*DeleteLoanProductRequest) ProtoMessage() {}
+type NotificationEvent int32
 
-func (x *DeleteLoanProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	NotificationEvent_NOTIFICATION_EVENT_UNSPECIFIED NotificationEvent = 0
+	NotificationEvent_INSTALLMENT_UPCOMING           NotificationEvent = 1
+	NotificationEvent_INSTALLMENT_DUE                NotificationEvent = 2
+	NotificationEvent_LOAN_IN_ARREARS                NotificationEvent = 3
+)
+
+// Enum value maps for NotificationEvent.
+var (
+	NotificationEvent_name = map[int32]string{
+		0: "NOTIFICATION_EVENT_UNSPECIFIED",
+		1: "INSTALLMENT_UPCOMING",
+		2: "INSTALLMENT_DUE",
+		3: "LOAN_IN_ARREARS",
 	}
-	return mi.MessageOf(x)
+	NotificationEvent_value = map[string]int32{
+		"NOTIFICATION_EVENT_UNSPECIFIED": 0,
+		"INSTALLMENT_UPCOMING":           1,
+		"INSTALLMENT_DUE":                2,
+		"LOAN_IN_ARREARS":                3,
+	}
+)
+
+func (x NotificationEvent) Enum() *NotificationEvent {
+	p := new(NotificationEvent)
+	*p = x
+	return p
 }
 
-// Deprecated: Use DeleteLoanProductRequest.ProtoReflect.Descriptor instead.
-func (*DeleteLoanProductRequest) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{4}
+func (x NotificationEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *DeleteLoanProductRequest) GetProductId() string {
-	if x != nil {
-		return x.ProductId
-	}
-	return ""
+func (NotificationEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_loan_proto_enumTypes[9].Descriptor()
 }
 
-type LoanProductFilter struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (NotificationEvent) Type() protoreflect.EnumType {
+	return &file_loan_proto_enumTypes[9]
+}
 
-	ChamaIds []string `protobuf:"bytes,1,rep,name=chama_ids,json=chamaIds,proto3" json:"chama_ids,omitempty"`
+func (x NotificationEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LoanProductFilter) Reset() {
-	*x = LoanProductFilter{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Deprecated: Use NotificationEvent.Descriptor instead.
+func (NotificationEvent) EnumDescriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{9}
+}
+
+type NotificationChannel int32
+
+const (
+	NotificationChannel_CHANNEL_SMS   NotificationChannel = 0
+	NotificationChannel_CHANNEL_EMAIL NotificationChannel = 1
+)
+
+// Enum value maps for NotificationChannel.
+var (
+	NotificationChannel_name = map[int32]string{
+		0: "CHANNEL_SMS",
+		1: "CHANNEL_EMAIL",
+	}
+	NotificationChannel_value = map[string]int32{
+		"CHANNEL_SMS":   0,
+		"CHANNEL_EMAIL": 1,
 	}
+)
+
+func (x NotificationChannel) Enum() *NotificationChannel {
+	p := new(NotificationChannel)
+	*p = x
+	return p
 }
 
-func (x *LoanProductFilter) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x NotificationChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*LoanProductFilter) ProtoMessage() {}
+func (NotificationChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_loan_proto_enumTypes[10].Descriptor()
+}
 
-func (x *LoanProductFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (NotificationChannel) Type() protoreflect.EnumType {
+	return &file_loan_proto_enumTypes[10]
+}
+
+func (x NotificationChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationChannel.Descriptor instead.
+func (NotificationChannel) EnumDescriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{10}
+}
+
+type DeliveryStatus int32
+
+const (
+	DeliveryStatus_DELIVERY_PENDING DeliveryStatus = 0
+	DeliveryStatus_DELIVERY_SENT    DeliveryStatus = 1
+	DeliveryStatus_DELIVERY_FAILED  DeliveryStatus = 2
+)
+
+// Enum value maps for DeliveryStatus.
+var (
+	DeliveryStatus_name = map[int32]string{
+		0: "DELIVERY_PENDING",
+		1: "DELIVERY_SENT",
+		2: "DELIVERY_FAILED",
 	}
-	return mi.MessageOf(x)
+	DeliveryStatus_value = map[string]int32{
+		"DELIVERY_PENDING": 0,
+		"DELIVERY_SENT":    1,
+		"DELIVERY_FAILED":  2,
+	}
+)
+
+func (x DeliveryStatus) Enum() *DeliveryStatus {
+	p := new(DeliveryStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use LoanProductFilter.ProtoReflect.Descriptor instead.
-func (*LoanProductFilter) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{5}
+func (x DeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *LoanProductFilter) GetChamaIds() []string {
-	if x != nil {
-		return x.ChamaIds
+func (DeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_loan_proto_enumTypes[11].Descriptor()
+}
+
+func (DeliveryStatus) Type() protoreflect.EnumType {
+	return &file_loan_proto_enumTypes[11]
+}
+
+func (x DeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeliveryStatus.Descriptor instead.
+func (DeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{11}
+}
+
+type StatementEntryType int32
+
+const (
+	StatementEntryType_STATEMENT_ENTRY_UNSPECIFIED StatementEntryType = 0
+	StatementEntryType_ENTRY_DISBURSEMENT          StatementEntryType = 1
+	StatementEntryType_ENTRY_INTEREST              StatementEntryType = 2
+	StatementEntryType_ENTRY_CHARGE                StatementEntryType = 3
+	StatementEntryType_ENTRY_REPAYMENT             StatementEntryType = 4
+	StatementEntryType_ENTRY_WRITE_OFF             StatementEntryType = 5
+	StatementEntryType_ENTRY_CARRIED_OVER          StatementEntryType = 6
+)
+
+// Enum value maps for StatementEntryType.
+var (
+	StatementEntryType_name = map[int32]string{
+		0: "STATEMENT_ENTRY_UNSPECIFIED",
+		1: "ENTRY_DISBURSEMENT",
+		2: "ENTRY_INTEREST",
+		3: "ENTRY_CHARGE",
+		4: "ENTRY_REPAYMENT",
+		5: "ENTRY_WRITE_OFF",
+		6: "ENTRY_CARRIED_OVER",
 	}
-	return nil
+	StatementEntryType_value = map[string]int32{
+		"STATEMENT_ENTRY_UNSPECIFIED": 0,
+		"ENTRY_DISBURSEMENT":          1,
+		"ENTRY_INTEREST":              2,
+		"ENTRY_CHARGE":                3,
+		"ENTRY_REPAYMENT":             4,
+		"ENTRY_WRITE_OFF":             5,
+		"ENTRY_CARRIED_OVER":          6,
+	}
+)
+
+func (x StatementEntryType) Enum() *StatementEntryType {
+	p := new(StatementEntryType)
+	*p = x
+	return p
 }
 
-type ListLoanProductsRequest struct {
+func (x StatementEntryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatementEntryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_loan_proto_enumTypes[12].Descriptor()
+}
+
+func (StatementEntryType) Type() protoreflect.EnumType {
+	return &file_loan_proto_enumTypes[12]
+}
+
+func (x StatementEntryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatementEntryType.Descriptor instead.
+func (StatementEntryType) EnumDescriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{12}
+}
+
+type StatementFormat int32
+
+const (
+	StatementFormat_STATEMENT_NONE StatementFormat = 0
+	StatementFormat_STATEMENT_CSV  StatementFormat = 1
+	StatementFormat_STATEMENT_PDF  StatementFormat = 2
+)
+
+// Enum value maps for StatementFormat.
+var (
+	StatementFormat_name = map[int32]string{
+		0: "STATEMENT_NONE",
+		1: "STATEMENT_CSV",
+		2: "STATEMENT_PDF",
+	}
+	StatementFormat_value = map[string]int32{
+		"STATEMENT_NONE": 0,
+		"STATEMENT_CSV":  1,
+		"STATEMENT_PDF":  2,
+	}
+)
+
+func (x StatementFormat) Enum() *StatementFormat {
+	p := new(StatementFormat)
+	*p = x
+	return p
+}
+
+func (x StatementFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatementFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_loan_proto_enumTypes[13].Descriptor()
+}
+
+func (StatementFormat) Type() protoreflect.EnumType {
+	return &file_loan_proto_enumTypes[13]
+}
+
+func (x StatementFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatementFormat.Descriptor instead.
+func (StatementFormat) EnumDescriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{13}
+}
+
+type EligibilityRules struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filter    *LoanProductFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	PageToken string             `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	PageSize  int32              `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	MaxSavingsMultiplier float32 `protobuf:"fixed32,1,opt,name=max_savings_multiplier,json=maxSavingsMultiplier,proto3" json:"max_savings_multiplier,omitempty"`
+	MinMembershipDays    int32   `protobuf:"varint,2,opt,name=min_membership_days,json=minMembershipDays,proto3" json:"min_membership_days,omitempty"`
+	RejectLoansInArrears bool    `protobuf:"varint,3,opt,name=reject_loans_in_arrears,json=rejectLoansInArrears,proto3" json:"reject_loans_in_arrears,omitempty"`
+	MaxActiveLoans       int32   `protobuf:"varint,4,opt,name=max_active_loans,json=maxActiveLoans,proto3" json:"max_active_loans,omitempty"`
+	MinCreditScore       int32   `protobuf:"varint,5,opt,name=min_credit_score,json=minCreditScore,proto3" json:"min_credit_score,omitempty"`
 }
 
-func (x *ListLoanProductsRequest) Reset() {
-	*x = ListLoanProductsRequest{}
+func (x *EligibilityRules) Reset() {
+	*x = EligibilityRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_proto_msgTypes[6]
+		mi := &file_loan_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListLoanProductsRequest) String() string {
+func (x *EligibilityRules) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLoanProductsRequest) ProtoMessage() {}
+func (*EligibilityRules) ProtoMessage() {}
 
-func (x *ListLoanProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[6]
+func (x *EligibilityRules) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,58 +777,75 @@ func (x *ListLoanProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLoanProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListLoanProductsRequest) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use EligibilityRules.ProtoReflect.Descriptor instead.
+func (*EligibilityRules) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *ListLoanProductsRequest) GetFilter() *LoanProductFilter {
+func (x *EligibilityRules) GetMaxSavingsMultiplier() float32 {
 	if x != nil {
-		return x.Filter
+		return x.MaxSavingsMultiplier
 	}
-	return nil
+	return 0
 }
 
-func (x *ListLoanProductsRequest) GetPageToken() string {
+func (x *EligibilityRules) GetMinMembershipDays() int32 {
 	if x != nil {
-		return x.PageToken
+		return x.MinMembershipDays
 	}
-	return ""
+	return 0
 }
 
-func (x *ListLoanProductsRequest) GetPageSize() int32 {
+func (x *EligibilityRules) GetRejectLoansInArrears() bool {
 	if x != nil {
-		return x.PageSize
+		return x.RejectLoansInArrears
+	}
+	return false
+}
+
+func (x *EligibilityRules) GetMaxActiveLoans() int32 {
+	if x != nil {
+		return x.MaxActiveLoans
 	}
 	return 0
 }
 
-type ListLoanProductsResponse struct {
+func (x *EligibilityRules) GetMinCreditScore() int32 {
+	if x != nil {
+		return x.MinCreditScore
+	}
+	return 0
+}
+
+type LoanFee struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LoanProducts  []*LoanProduct `protobuf:"bytes,1,rep,name=loan_products,json=loanProducts,proto3" json:"loan_products,omitempty"`
-	NextPageToken string         `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Name        string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Calculation FeeCalculation `protobuf:"varint,2,opt,name=calculation,proto3,enum=gidyon.loan.FeeCalculation" json:"calculation,omitempty"`
+	Amount      float64        `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Treatment   FeeTreatment   `protobuf:"varint,4,opt,name=treatment,proto3,enum=gidyon.loan.FeeTreatment" json:"treatment,omitempty"`
+	AccountName string         `protobuf:"bytes,5,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
 }
 
-func (x *ListLoanProductsResponse) Reset() {
-	*x = ListLoanProductsResponse{}
+func (x *LoanFee) Reset() {
+	*x = LoanFee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_proto_msgTypes[7]
+		mi := &file_loan_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListLoanProductsResponse) String() string {
+func (x *LoanFee) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLoanProductsResponse) ProtoMessage() {}
+func (*LoanFee) ProtoMessage() {}
 
-func (x *ListLoanProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[7]
+func (x *LoanFee) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,50 +856,74 @@ func (x *ListLoanProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLoanProductsResponse.ProtoReflect.Descriptor instead.
-func (*ListLoanProductsResponse) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use LoanFee.ProtoReflect.Descriptor instead.
+func (*LoanFee) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ListLoanProductsResponse) GetLoanProducts() []*LoanProduct {
+func (x *LoanFee) GetName() string {
 	if x != nil {
-		return x.LoanProducts
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ListLoanProductsResponse) GetNextPageToken() string {
+func (x *LoanFee) GetCalculation() FeeCalculation {
 	if x != nil {
-		return x.NextPageToken
+		return x.Calculation
+	}
+	return FeeCalculation_FEE_FLAT
+}
+
+func (x *LoanFee) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LoanFee) GetTreatment() FeeTreatment {
+	if x != nil {
+		return x.Treatment
+	}
+	return FeeTreatment_FEE_DEDUCTED
+}
+
+func (x *LoanFee) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
 	}
 	return ""
 }
 
-type GetLoanProductRequest struct {
+type GracePolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	GraceType          GraceType `protobuf:"varint,1,opt,name=grace_type,json=graceType,proto3,enum=gidyon.loan.GraceType" json:"grace_type,omitempty"`
+	GracePeriods       int32     `protobuf:"varint,2,opt,name=grace_periods,json=gracePeriods,proto3" json:"grace_periods,omitempty"`
+	CapitalizeInterest bool      `protobuf:"varint,3,opt,name=capitalize_interest,json=capitalizeInterest,proto3" json:"capitalize_interest,omitempty"`
+	MaxHolidayPeriods  int32     `protobuf:"varint,4,opt,name=max_holiday_periods,json=maxHolidayPeriods,proto3" json:"max_holiday_periods,omitempty"`
 }
 
-func (x *GetLoanProductRequest) Reset() {
-	*x = GetLoanProductRequest{}
+func (x *GracePolicy) Reset() {
+	*x = GracePolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_proto_msgTypes[8]
+		mi := &file_loan_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLoanProductRequest) String() string {
+func (x *GracePolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoanProductRequest) ProtoMessage() {}
+func (*GracePolicy) ProtoMessage() {}
 
-func (x *GetLoanProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[8]
+func (x *GracePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -760,43 +934,68 @@ func (x *GetLoanProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoanProductRequest.ProtoReflect.Descriptor instead.
-func (*GetLoanProductRequest) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GracePolicy.ProtoReflect.Descriptor instead.
+func (*GracePolicy) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetLoanProductRequest) GetProductId() string {
+func (x *GracePolicy) GetGraceType() GraceType {
 	if x != nil {
-		return x.ProductId
+		return x.GraceType
 	}
-	return ""
+	return GraceType_GRACE_NONE
 }
 
-type CreateLoanRequest struct {
+func (x *GracePolicy) GetGracePeriods() int32 {
+	if x != nil {
+		return x.GracePeriods
+	}
+	return 0
+}
+
+func (x *GracePolicy) GetCapitalizeInterest() bool {
+	if x != nil {
+		return x.CapitalizeInterest
+	}
+	return false
+}
+
+func (x *GracePolicy) GetMaxHolidayPeriods() int32 {
+	if x != nil {
+		return x.MaxHolidayPeriods
+	}
+	return 0
+}
+
+type PenaltyPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Loan *Loan `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	PenaltyType PenaltyType `protobuf:"varint,1,opt,name=penalty_type,json=penaltyType,proto3,enum=gidyon.loan.PenaltyType" json:"penalty_type,omitempty"`
+	Amount      float64     `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Cap         float64     `protobuf:"fixed64,3,opt,name=cap,proto3" json:"cap,omitempty"`
+	GraceDays   int32       `protobuf:"varint,4,opt,name=grace_days,json=graceDays,proto3" json:"grace_days,omitempty"`
+	PeriodDays  int32       `protobuf:"varint,5,opt,name=period_days,json=periodDays,proto3" json:"period_days,omitempty"`
 }
 
-func (x *CreateLoanRequest) Reset() {
-	*x = CreateLoanRequest{}
+func (x *PenaltyPolicy) Reset() {
+	*x = PenaltyPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_proto_msgTypes[9]
+		mi := &file_loan_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateLoanRequest) String() string {
+func (x *PenaltyPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLoanRequest) ProtoMessage() {}
+func (*PenaltyPolicy) ProtoMessage() {}
 
-func (x *CreateLoanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[9]
+func (x *PenaltyPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -807,43 +1006,74 @@ func (x *CreateLoanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLoanRequest.ProtoReflect.Descriptor instead.
-func (*CreateLoanRequest) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use PenaltyPolicy.ProtoReflect.Descriptor instead.
+func (*PenaltyPolicy) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateLoanRequest) GetLoan() *Loan {
+func (x *PenaltyPolicy) GetPenaltyType() PenaltyType {
 	if x != nil {
-		return x.Loan
+		return x.PenaltyType
 	}
-	return nil
+	return PenaltyType_PENALTY_TYPE_UNSPECIFIED
 }
 
-type UpdateLoanRequest struct {
+func (x *PenaltyPolicy) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PenaltyPolicy) GetCap() float64 {
+	if x != nil {
+		return x.Cap
+	}
+	return 0
+}
+
+func (x *PenaltyPolicy) GetGraceDays() int32 {
+	if x != nil {
+		return x.GraceDays
+	}
+	return 0
+}
+
+func (x *PenaltyPolicy) GetPeriodDays() int32 {
+	if x != nil {
+		return x.PeriodDays
+	}
+	return 0
+}
+
+type ApprovalPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Loan *Loan `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	RequiredApprovals           int32   `protobuf:"varint,1,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	MemberVoteMinAmount         float64 `protobuf:"fixed64,2,opt,name=member_vote_min_amount,json=memberVoteMinAmount,proto3" json:"member_vote_min_amount,omitempty"`
+	QuorumPercentage            float32 `protobuf:"fixed32,3,opt,name=quorum_percentage,json=quorumPercentage,proto3" json:"quorum_percentage,omitempty"`
+	ApprovalThresholdPercentage float32 `protobuf:"fixed32,4,opt,name=approval_threshold_percentage,json=approvalThresholdPercentage,proto3" json:"approval_threshold_percentage,omitempty"`
 }
 
-func (x *UpdateLoanRequest) Reset() {
-	*x = UpdateLoanRequest{}
+func (x *ApprovalPolicy) Reset() {
+	*x = ApprovalPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_proto_msgTypes[10]
+		mi := &file_loan_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateLoanRequest) String() string {
+func (x *ApprovalPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLoanRequest) ProtoMessage() {}
+func (*ApprovalPolicy) ProtoMessage() {}
 
-func (x *UpdateLoanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[10]
+func (x *ApprovalPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,44 +1084,68 @@ func (x *UpdateLoanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLoanRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLoanRequest) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ApprovalPolicy.ProtoReflect.Descriptor instead.
+func (*ApprovalPolicy) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *UpdateLoanRequest) GetLoan() *Loan {
+func (x *ApprovalPolicy) GetRequiredApprovals() int32 {
 	if x != nil {
-		return x.Loan
+		return x.RequiredApprovals
 	}
-	return nil
+	return 0
 }
 
-type LoanFilter struct {
+func (x *ApprovalPolicy) GetMemberVoteMinAmount() float64 {
+	if x != nil {
+		return x.MemberVoteMinAmount
+	}
+	return 0
+}
+
+func (x *ApprovalPolicy) GetQuorumPercentage() float32 {
+	if x != nil {
+		return x.QuorumPercentage
+	}
+	return 0
+}
+
+func (x *ApprovalPolicy) GetApprovalThresholdPercentage() float32 {
+	if x != nil {
+		return x.ApprovalThresholdPercentage
+	}
+	return 0
+}
+
+type EarlySettlementPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChamaIds   []string `protobuf:"bytes,1,rep,name=chama_ids,json=chamaIds,proto3" json:"chama_ids,omitempty"`
-	ProductIds []string `protobuf:"bytes,2,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	SettlementType    EarlySettlementType `protobuf:"varint,1,opt,name=settlement_type,json=settlementType,proto3,enum=gidyon.loan.EarlySettlementType" json:"settlement_type,omitempty"`
+	RebatePercentage  float32             `protobuf:"fixed32,2,opt,name=rebate_percentage,json=rebatePercentage,proto3" json:"rebate_percentage,omitempty"`
+	FeeAmount         float64             `protobuf:"fixed64,3,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	FeePercentage     float32             `protobuf:"fixed32,4,opt,name=fee_percentage,json=feePercentage,proto3" json:"fee_percentage,omitempty"`
+	QuoteValidityDays int32               `protobuf:"varint,5,opt,name=quote_validity_days,json=quoteValidityDays,proto3" json:"quote_validity_days,omitempty"`
 }
 
-func (x *LoanFilter) Reset() {
-	*x = LoanFilter{}
+func (x *EarlySettlementPolicy) Reset() {
+	*x = EarlySettlementPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_proto_msgTypes[11]
+		mi := &file_loan_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoanFilter) String() string {
+func (x *EarlySettlementPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoanFilter) ProtoMessage() {}
+func (*EarlySettlementPolicy) ProtoMessage() {}
 
-func (x *LoanFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[11]
+func (x *EarlySettlementPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,52 +1156,97 @@ func (x *LoanFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoanFilter.ProtoReflect.Descriptor instead.
-func (*LoanFilter) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use EarlySettlementPolicy.ProtoReflect.Descriptor instead.
+func (*EarlySettlementPolicy) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *LoanFilter) GetChamaIds() []string {
+func (x *EarlySettlementPolicy) GetSettlementType() EarlySettlementType {
 	if x != nil {
-		return x.ChamaIds
+		return x.SettlementType
 	}
-	return nil
+	return EarlySettlementType_FULL_INTEREST
 }
 
-func (x *LoanFilter) GetProductIds() []string {
+func (x *EarlySettlementPolicy) GetRebatePercentage() float32 {
 	if x != nil {
-		return x.ProductIds
+		return x.RebatePercentage
 	}
-	return nil
+	return 0
 }
 
-type ListLoansRequest struct {
+func (x *EarlySettlementPolicy) GetFeeAmount() float64 {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return 0
+}
+
+func (x *EarlySettlementPolicy) GetFeePercentage() float32 {
+	if x != nil {
+		return x.FeePercentage
+	}
+	return 0
+}
+
+func (x *EarlySettlementPolicy) GetQuoteValidityDays() int32 {
+	if x != nil {
+		return x.QuoteValidityDays
+	}
+	return 0
+}
+
+type LoanProduct struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filter    *LoanFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	PageToken string      `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	PageSize  int32       `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ProductId             string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ChamaId               string                 `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	Name                  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description           string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	LoanDurationDays      int32                  `protobuf:"varint,5,opt,name=loan_duration_days,json=loanDurationDays,proto3" json:"loan_duration_days,omitempty"`
+	InterestRate          float32                `protobuf:"fixed32,6,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	LoanMinimumAmount     float64                `protobuf:"fixed64,7,opt,name=loan_minimum_amount,json=loanMinimumAmount,proto3" json:"loan_minimum_amount,omitempty"`
+	LoanMaximumAmount     float64                `protobuf:"fixed64,8,opt,name=loan_maximum_amount,json=loanMaximumAmount,proto3" json:"loan_maximum_amount,omitempty"`
+	LoanAccountBalance    float64                `protobuf:"fixed64,9,opt,name=loan_account_balance,json=loanAccountBalance,proto3" json:"loan_account_balance,omitempty"`
+	LoanInterestBalance   float64                `protobuf:"fixed64,10,opt,name=loan_interest_balance,json=loanInterestBalance,proto3" json:"loan_interest_balance,omitempty"`
+	LoanSettledBalance    float64                `protobuf:"fixed64,11,opt,name=loan_settled_balance,json=loanSettledBalance,proto3" json:"loan_settled_balance,omitempty"`
+	SettledLoans          int32                  `protobuf:"varint,12,opt,name=settled_loans,json=settledLoans,proto3" json:"settled_loans,omitempty"`
+	ActiveLoans           int32                  `protobuf:"varint,13,opt,name=active_loans,json=activeLoans,proto3" json:"active_loans,omitempty"`
+	TotalLoans            int32                  `protobuf:"varint,14,opt,name=total_loans,json=totalLoans,proto3" json:"total_loans,omitempty"`
+	UpdatedDate           string                 `protobuf:"bytes,15,opt,name=updated_date,json=updatedDate,proto3" json:"updated_date,omitempty"`
+	CreatedDate           string                 `protobuf:"bytes,16,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
+	EligibilityRules      *EligibilityRules      `protobuf:"bytes,17,opt,name=eligibility_rules,json=eligibilityRules,proto3" json:"eligibility_rules,omitempty"`
+	RepaymentPeriodDays   int32                  `protobuf:"varint,18,opt,name=repayment_period_days,json=repaymentPeriodDays,proto3" json:"repayment_period_days,omitempty"`
+	PenaltyPolicy         *PenaltyPolicy         `protobuf:"bytes,19,opt,name=penalty_policy,json=penaltyPolicy,proto3" json:"penalty_policy,omitempty"`
+	ApprovalPolicy        *ApprovalPolicy        `protobuf:"bytes,20,opt,name=approval_policy,json=approvalPolicy,proto3" json:"approval_policy,omitempty"`
+	EarlySettlementPolicy *EarlySettlementPolicy `protobuf:"bytes,21,opt,name=early_settlement_policy,json=earlySettlementPolicy,proto3" json:"early_settlement_policy,omitempty"`
+	Version               int32                  `protobuf:"varint,22,opt,name=version,proto3" json:"version,omitempty"`
+	Archived              bool                   `protobuf:"varint,23,opt,name=archived,proto3" json:"archived,omitempty"`
+	Versions              []*LoanProductVersion  `protobuf:"bytes,24,rep,name=versions,proto3" json:"versions,omitempty"`
+	CollateralCoverage    float32                `protobuf:"fixed32,25,opt,name=collateral_coverage,json=collateralCoverage,proto3" json:"collateral_coverage,omitempty"`
+	Fees                  []*LoanFee             `protobuf:"bytes,26,rep,name=fees,proto3" json:"fees,omitempty"`
+	GracePolicy           *GracePolicy           `protobuf:"bytes,27,opt,name=grace_policy,json=gracePolicy,proto3" json:"grace_policy,omitempty"`
 }
 
-func (x *ListLoansRequest) Reset() {
-	*x = ListLoansRequest{}
+func (x *LoanProduct) Reset() {
+	*x = LoanProduct{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_proto_msgTypes[12]
+		mi := &file_loan_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListLoansRequest) String() string {
+func (x *LoanProduct) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLoansRequest) ProtoMessage() {}
+func (*LoanProduct) ProtoMessage() {}
 
-func (x *ListLoansRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[12]
+func (x *LoanProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,528 +1257,7094 @@ func (x *ListLoansRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLoansRequest.ProtoReflect.Descriptor instead.
-func (*ListLoansRequest) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use LoanProduct.ProtoReflect.Descriptor instead.
+func (*LoanProduct) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListLoansRequest) GetFilter() *LoanFilter {
+func (x *LoanProduct) GetProductId() string {
 	if x != nil {
-		return x.Filter
+		return x.ProductId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListLoansRequest) GetPageToken() string {
+func (x *LoanProduct) GetChamaId() string {
 	if x != nil {
-		return x.PageToken
+		return x.ChamaId
 	}
 	return ""
 }
 
-func (x *ListLoansRequest) GetPageSize() int32 {
+func (x *LoanProduct) GetName() string {
 	if x != nil {
-		return x.PageSize
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetLoanDurationDays() int32 {
+	if x != nil {
+		return x.LoanDurationDays
 	}
 	return 0
 }
 
-type ListLoansResponse struct {
+func (x *LoanProduct) GetInterestRate() float32 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
+}
+
+func (x *LoanProduct) GetLoanMinimumAmount() float64 {
+	if x != nil {
+		return x.LoanMinimumAmount
+	}
+	return 0
+}
+
+func (x *LoanProduct) GetLoanMaximumAmount() float64 {
+	if x != nil {
+		return x.LoanMaximumAmount
+	}
+	return 0
+}
+
+func (x *LoanProduct) GetLoanAccountBalance() float64 {
+	if x != nil {
+		return x.LoanAccountBalance
+	}
+	return 0
+}
+
+func (x *LoanProduct) GetLoanInterestBalance() float64 {
+	if x != nil {
+		return x.LoanInterestBalance
+	}
+	return 0
+}
+
+func (x *LoanProduct) GetLoanSettledBalance() float64 {
+	if x != nil {
+		return x.LoanSettledBalance
+	}
+	return 0
+}
+
+func (x *LoanProduct) GetSettledLoans() int32 {
+	if x != nil {
+		return x.SettledLoans
+	}
+	return 0
+}
+
+func (x *LoanProduct) GetActiveLoans() int32 {
+	if x != nil {
+		return x.ActiveLoans
+	}
+	return 0
+}
+
+func (x *LoanProduct) GetTotalLoans() int32 {
+	if x != nil {
+		return x.TotalLoans
+	}
+	return 0
+}
+
+func (x *LoanProduct) GetUpdatedDate() string {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetEligibilityRules() *EligibilityRules {
+	if x != nil {
+		return x.EligibilityRules
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetRepaymentPeriodDays() int32 {
+	if x != nil {
+		return x.RepaymentPeriodDays
+	}
+	return 0
+}
+
+func (x *LoanProduct) GetPenaltyPolicy() *PenaltyPolicy {
+	if x != nil {
+		return x.PenaltyPolicy
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetApprovalPolicy() *ApprovalPolicy {
+	if x != nil {
+		return x.ApprovalPolicy
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetEarlySettlementPolicy() *EarlySettlementPolicy {
+	if x != nil {
+		return x.EarlySettlementPolicy
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LoanProduct) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *LoanProduct) GetVersions() []*LoanProductVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetCollateralCoverage() float32 {
+	if x != nil {
+		return x.CollateralCoverage
+	}
+	return 0
+}
+
+func (x *LoanProduct) GetFees() []*LoanFee {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetGracePolicy() *GracePolicy {
+	if x != nil {
+		return x.GracePolicy
+	}
+	return nil
+}
+
+type LoanProductVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Loans         []*Loan `protobuf:"bytes,1,rep,name=loans,proto3" json:"loans,omitempty"`
-	NextPageToken string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Version     int32        `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Terms       *LoanProduct `protobuf:"bytes,2,opt,name=terms,proto3" json:"terms,omitempty"`
+	CreatedDate string       `protobuf:"bytes,3,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
 }
 
-func (x *ListLoansResponse) Reset() {
-	*x = ListLoansResponse{}
+func (x *LoanProductVersion) Reset() {
+	*x = LoanProductVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_proto_msgTypes[13]
+		mi := &file_loan_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListLoansResponse) String() string {
+func (x *LoanProductVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLoansResponse) ProtoMessage() {}
+func (*LoanProductVersion) ProtoMessage() {}
 
-func (x *ListLoansResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[13]
+func (x *LoanProductVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListLoansResponse.ProtoReflect.Descriptor instead.
-func (*ListLoansResponse) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ListLoansResponse) GetLoans() []*Loan {
-	if x != nil {
-		return x.Loans
-	}
-	return nil
-}
-
-func (x *ListLoansResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-type GetLoanRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
-}
-
-func (x *GetLoanRequest) Reset() {
-	*x = GetLoanRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetLoanRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetLoanRequest) ProtoMessage() {}
-
-func (x *GetLoanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanProductVersion.ProtoReflect.Descriptor instead.
+func (*LoanProductVersion) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LoanProductVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LoanProductVersion) GetTerms() *LoanProduct {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *LoanProductVersion) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+type LoanInstallment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstallmentId     string  `protobuf:"bytes,1,opt,name=installment_id,json=installmentId,proto3" json:"installment_id,omitempty"`
+	LoanId            string  `protobuf:"bytes,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	InstallmentNumber int32   `protobuf:"varint,3,opt,name=installment_number,json=installmentNumber,proto3" json:"installment_number,omitempty"`
+	PrincipalDue      float64 `protobuf:"fixed64,4,opt,name=principal_due,json=principalDue,proto3" json:"principal_due,omitempty"`
+	InterestDue       float64 `protobuf:"fixed64,5,opt,name=interest_due,json=interestDue,proto3" json:"interest_due,omitempty"`
+	AmountDue         float64 `protobuf:"fixed64,6,opt,name=amount_due,json=amountDue,proto3" json:"amount_due,omitempty"`
+	AmountPaid        float64 `protobuf:"fixed64,7,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"`
+	DueDate           string  `protobuf:"bytes,8,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+}
+
+func (x *LoanInstallment) Reset() {
+	*x = LoanInstallment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoanInstallment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanInstallment) ProtoMessage() {}
+
+func (x *LoanInstallment) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanInstallment.ProtoReflect.Descriptor instead.
+func (*LoanInstallment) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LoanInstallment) GetInstallmentId() string {
+	if x != nil {
+		return x.InstallmentId
+	}
+	return ""
+}
+
+func (x *LoanInstallment) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *LoanInstallment) GetInstallmentNumber() int32 {
+	if x != nil {
+		return x.InstallmentNumber
+	}
+	return 0
+}
+
+func (x *LoanInstallment) GetPrincipalDue() float64 {
+	if x != nil {
+		return x.PrincipalDue
+	}
+	return 0
+}
+
+func (x *LoanInstallment) GetInterestDue() float64 {
+	if x != nil {
+		return x.InterestDue
+	}
+	return 0
+}
+
+func (x *LoanInstallment) GetAmountDue() float64 {
+	if x != nil {
+		return x.AmountDue
+	}
+	return 0
+}
+
+func (x *LoanInstallment) GetAmountPaid() float64 {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return 0
+}
+
+func (x *LoanInstallment) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+type Loan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId            string             `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	ChamaId           string             `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	ProductId         string             `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MemberId          string             `protobuf:"bytes,4,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	LoaneeNames       string             `protobuf:"bytes,5,opt,name=loanee_names,json=loaneeNames,proto3" json:"loanee_names,omitempty"`
+	LoaneePhone       string             `protobuf:"bytes,6,opt,name=loanee_phone,json=loaneePhone,proto3" json:"loanee_phone,omitempty"`
+	LoaneeEmail       string             `protobuf:"bytes,7,opt,name=loanee_email,json=loaneeEmail,proto3" json:"loanee_email,omitempty"`
+	NationalId        string             `protobuf:"bytes,8,opt,name=national_id,json=nationalId,proto3" json:"national_id,omitempty"`
+	Approved          bool               `protobuf:"varint,9,opt,name=approved,proto3" json:"approved,omitempty"`
+	Status            LoanStatus         `protobuf:"varint,17,opt,name=status,proto3,enum=gidyon.loan.LoanStatus" json:"status,omitempty"`
+	DurationDays      int32              `protobuf:"varint,10,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+	InterestRate      float32            `protobuf:"fixed32,11,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	LoanAmount        float64            `protobuf:"fixed64,12,opt,name=loan_amount,json=loanAmount,proto3" json:"loan_amount,omitempty"`
+	SettledAmount     float64            `protobuf:"fixed64,13,opt,name=settled_amount,json=settledAmount,proto3" json:"settled_amount,omitempty"`
+	PenaltyAmount     float64            `protobuf:"fixed64,14,opt,name=penalty_amount,json=penaltyAmount,proto3" json:"penalty_amount,omitempty"`
+	UpdatedDate       string             `protobuf:"bytes,15,opt,name=updated_date,json=updatedDate,proto3" json:"updated_date,omitempty"`
+	BorrowedDate      string             `protobuf:"bytes,16,opt,name=borrowed_date,json=borrowedDate,proto3" json:"borrowed_date,omitempty"`
+	Installments      []*LoanInstallment `protobuf:"bytes,18,rep,name=installments,proto3" json:"installments,omitempty"`
+	ParentLoanId      string             `protobuf:"bytes,19,opt,name=parent_loan_id,json=parentLoanId,proto3" json:"parent_loan_id,omitempty"`
+	CarriedOverAmount float64            `protobuf:"fixed64,20,opt,name=carried_over_amount,json=carriedOverAmount,proto3" json:"carried_over_amount,omitempty"`
+	WrittenOffAmount  float64            `protobuf:"fixed64,21,opt,name=written_off_amount,json=writtenOffAmount,proto3" json:"written_off_amount,omitempty"`
+	ProductVersion    int32              `protobuf:"varint,22,opt,name=product_version,json=productVersion,proto3" json:"product_version,omitempty"`
+	FeeAmount         float64            `protobuf:"fixed64,23,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	NetAmount         float64            `protobuf:"fixed64,24,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+}
+
+func (x *Loan) Reset() {
+	*x = Loan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Loan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Loan) ProtoMessage() {}
+
+func (x *Loan) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Loan.ProtoReflect.Descriptor instead.
+func (*Loan) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Loan) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *Loan) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *Loan) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Loan) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *Loan) GetLoaneeNames() string {
+	if x != nil {
+		return x.LoaneeNames
+	}
+	return ""
+}
+
+func (x *Loan) GetLoaneePhone() string {
+	if x != nil {
+		return x.LoaneePhone
+	}
+	return ""
+}
+
+func (x *Loan) GetLoaneeEmail() string {
+	if x != nil {
+		return x.LoaneeEmail
+	}
+	return ""
+}
+
+func (x *Loan) GetNationalId() string {
+	if x != nil {
+		return x.NationalId
+	}
+	return ""
+}
+
+func (x *Loan) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *Loan) GetStatus() LoanStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LoanStatus_WAITING_APPROVAL
+}
+
+func (x *Loan) GetDurationDays() int32 {
+	if x != nil {
+		return x.DurationDays
+	}
+	return 0
+}
+
+func (x *Loan) GetInterestRate() float32 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
+}
+
+func (x *Loan) GetLoanAmount() float64 {
+	if x != nil {
+		return x.LoanAmount
+	}
+	return 0
+}
+
+func (x *Loan) GetSettledAmount() float64 {
+	if x != nil {
+		return x.SettledAmount
+	}
+	return 0
+}
+
+func (x *Loan) GetPenaltyAmount() float64 {
+	if x != nil {
+		return x.PenaltyAmount
+	}
+	return 0
+}
+
+func (x *Loan) GetUpdatedDate() string {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return ""
+}
+
+func (x *Loan) GetBorrowedDate() string {
+	if x != nil {
+		return x.BorrowedDate
+	}
+	return ""
+}
+
+func (x *Loan) GetInstallments() []*LoanInstallment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+func (x *Loan) GetParentLoanId() string {
+	if x != nil {
+		return x.ParentLoanId
+	}
+	return ""
+}
+
+func (x *Loan) GetCarriedOverAmount() float64 {
+	if x != nil {
+		return x.CarriedOverAmount
+	}
+	return 0
+}
+
+func (x *Loan) GetWrittenOffAmount() float64 {
+	if x != nil {
+		return x.WrittenOffAmount
+	}
+	return 0
+}
+
+func (x *Loan) GetProductVersion() int32 {
+	if x != nil {
+		return x.ProductVersion
+	}
+	return 0
+}
+
+func (x *Loan) GetFeeAmount() float64 {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return 0
+}
+
+func (x *Loan) GetNetAmount() float64 {
+	if x != nil {
+		return x.NetAmount
+	}
+	return 0
+}
+
+type CreateLoanProductRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanProduct *LoanProduct `protobuf:"bytes,1,opt,name=loan_product,json=loanProduct,proto3" json:"loan_product,omitempty"`
+}
+
+func (x *CreateLoanProductRequest) Reset() {
+	*x = CreateLoanProductRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLoanProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLoanProductRequest) ProtoMessage() {}
+
+func (x *CreateLoanProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLoanProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateLoanProductRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateLoanProductRequest) GetLoanProduct() *LoanProduct {
+	if x != nil {
+		return x.LoanProduct
+	}
+	return nil
+}
+
+type UpdateLoanProductRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanProduct *LoanProduct `protobuf:"bytes,1,opt,name=loan_product,json=loanProduct,proto3" json:"loan_product,omitempty"`
+}
+
+func (x *UpdateLoanProductRequest) Reset() {
+	*x = UpdateLoanProductRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLoanProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLoanProductRequest) ProtoMessage() {}
+
+func (x *UpdateLoanProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLoanProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLoanProductRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateLoanProductRequest) GetLoanProduct() *LoanProduct {
+	if x != nil {
+		return x.LoanProduct
+	}
+	return nil
+}
+
+type DeleteLoanProductRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+}
+
+func (x *DeleteLoanProductRequest) Reset() {
+	*x = DeleteLoanProductRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLoanProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLoanProductRequest) ProtoMessage() {}
+
+func (x *DeleteLoanProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLoanProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLoanProductRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteLoanProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type LoanProductFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaIds []string `protobuf:"bytes,1,rep,name=chama_ids,json=chamaIds,proto3" json:"chama_ids,omitempty"`
+}
+
+func (x *LoanProductFilter) Reset() {
+	*x = LoanProductFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoanProductFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanProductFilter) ProtoMessage() {}
+
+func (x *LoanProductFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanProductFilter.ProtoReflect.Descriptor instead.
+func (*LoanProductFilter) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LoanProductFilter) GetChamaIds() []string {
+	if x != nil {
+		return x.ChamaIds
+	}
+	return nil
+}
+
+type ListLoanProductsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter    *LoanProductFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageToken string             `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32              `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListLoanProductsRequest) Reset() {
+	*x = ListLoanProductsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoanProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoanProductsRequest) ProtoMessage() {}
+
+func (x *ListLoanProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoanProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListLoanProductsRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListLoanProductsRequest) GetFilter() *LoanProductFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListLoanProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListLoanProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListLoanProductsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanProducts  []*LoanProduct `protobuf:"bytes,1,rep,name=loan_products,json=loanProducts,proto3" json:"loan_products,omitempty"`
+	NextPageToken string         `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListLoanProductsResponse) Reset() {
+	*x = ListLoanProductsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoanProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoanProductsResponse) ProtoMessage() {}
+
+func (x *ListLoanProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoanProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListLoanProductsResponse) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListLoanProductsResponse) GetLoanProducts() []*LoanProduct {
+	if x != nil {
+		return x.LoanProducts
+	}
+	return nil
+}
+
+func (x *ListLoanProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetPortfolioReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaIds     []string `protobuf:"bytes,1,rep,name=chama_ids,json=chamaIds,proto3" json:"chama_ids,omitempty"`
+	ProductIds   []string `protobuf:"bytes,2,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	AsOfDate     string   `protobuf:"bytes,3,opt,name=as_of_date,json=asOfDate,proto3" json:"as_of_date,omitempty"`
+	TopBorrowers int32    `protobuf:"varint,4,opt,name=top_borrowers,json=topBorrowers,proto3" json:"top_borrowers,omitempty"`
+}
+
+func (x *GetPortfolioReportRequest) Reset() {
+	*x = GetPortfolioReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPortfolioReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioReportRequest) ProtoMessage() {}
+
+func (x *GetPortfolioReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioReportRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioReportRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetPortfolioReportRequest) GetChamaIds() []string {
+	if x != nil {
+		return x.ChamaIds
+	}
+	return nil
+}
+
+func (x *GetPortfolioReportRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *GetPortfolioReportRequest) GetAsOfDate() string {
+	if x != nil {
+		return x.AsOfDate
+	}
+	return ""
+}
+
+func (x *GetPortfolioReportRequest) GetTopBorrowers() int32 {
+	if x != nil {
+		return x.TopBorrowers
+	}
+	return 0
+}
+
+type TopBorrower struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId             string  `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	LoaneeNames          string  `protobuf:"bytes,2,opt,name=loanee_names,json=loaneeNames,proto3" json:"loanee_names,omitempty"`
+	Loans                int32   `protobuf:"varint,3,opt,name=loans,proto3" json:"loans,omitempty"`
+	OutstandingPrincipal float64 `protobuf:"fixed64,4,opt,name=outstanding_principal,json=outstandingPrincipal,proto3" json:"outstanding_principal,omitempty"`
+}
+
+func (x *TopBorrower) Reset() {
+	*x = TopBorrower{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopBorrower) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopBorrower) ProtoMessage() {}
+
+func (x *TopBorrower) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopBorrower.ProtoReflect.Descriptor instead.
+func (*TopBorrower) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TopBorrower) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *TopBorrower) GetLoaneeNames() string {
+	if x != nil {
+		return x.LoaneeNames
+	}
+	return ""
+}
+
+func (x *TopBorrower) GetLoans() int32 {
+	if x != nil {
+		return x.Loans
+	}
+	return 0
+}
+
+func (x *TopBorrower) GetOutstandingPrincipal() float64 {
+	if x != nil {
+		return x.OutstandingPrincipal
+	}
+	return 0
+}
+
+type ProductPortfolio struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaId              string         `protobuf:"bytes,1,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	ProductId            string         `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName          string         `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	TotalLoans           int32          `protobuf:"varint,4,opt,name=total_loans,json=totalLoans,proto3" json:"total_loans,omitempty"`
+	ActiveLoans          int32          `protobuf:"varint,5,opt,name=active_loans,json=activeLoans,proto3" json:"active_loans,omitempty"`
+	SettledLoans         int32          `protobuf:"varint,6,opt,name=settled_loans,json=settledLoans,proto3" json:"settled_loans,omitempty"`
+	DisbursedAmount      float64        `protobuf:"fixed64,7,opt,name=disbursed_amount,json=disbursedAmount,proto3" json:"disbursed_amount,omitempty"`
+	OutstandingPrincipal float64        `protobuf:"fixed64,8,opt,name=outstanding_principal,json=outstandingPrincipal,proto3" json:"outstanding_principal,omitempty"`
+	OutstandingInterest  float64        `protobuf:"fixed64,9,opt,name=outstanding_interest,json=outstandingInterest,proto3" json:"outstanding_interest,omitempty"`
+	InterestEarned       float64        `protobuf:"fixed64,10,opt,name=interest_earned,json=interestEarned,proto3" json:"interest_earned,omitempty"`
+	SettledAmount        float64        `protobuf:"fixed64,11,opt,name=settled_amount,json=settledAmount,proto3" json:"settled_amount,omitempty"`
+	Par30Amount          float64        `protobuf:"fixed64,12,opt,name=par30_amount,json=par30Amount,proto3" json:"par30_amount,omitempty"`
+	Par90Amount          float64        `protobuf:"fixed64,13,opt,name=par90_amount,json=par90Amount,proto3" json:"par90_amount,omitempty"`
+	Par30Percentage      float32        `protobuf:"fixed32,14,opt,name=par30_percentage,json=par30Percentage,proto3" json:"par30_percentage,omitempty"`
+	Par90Percentage      float32        `protobuf:"fixed32,15,opt,name=par90_percentage,json=par90Percentage,proto3" json:"par90_percentage,omitempty"`
+	RepaymentRate        float32        `protobuf:"fixed32,16,opt,name=repayment_rate,json=repaymentRate,proto3" json:"repayment_rate,omitempty"`
+	TopBorrowers         []*TopBorrower `protobuf:"bytes,17,rep,name=top_borrowers,json=topBorrowers,proto3" json:"top_borrowers,omitempty"`
+}
+
+func (x *ProductPortfolio) Reset() {
+	*x = ProductPortfolio{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductPortfolio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPortfolio) ProtoMessage() {}
+
+func (x *ProductPortfolio) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPortfolio.ProtoReflect.Descriptor instead.
+func (*ProductPortfolio) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ProductPortfolio) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *ProductPortfolio) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductPortfolio) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ProductPortfolio) GetTotalLoans() int32 {
+	if x != nil {
+		return x.TotalLoans
+	}
+	return 0
+}
+
+func (x *ProductPortfolio) GetActiveLoans() int32 {
+	if x != nil {
+		return x.ActiveLoans
+	}
+	return 0
+}
+
+func (x *ProductPortfolio) GetSettledLoans() int32 {
+	if x != nil {
+		return x.SettledLoans
+	}
+	return 0
+}
+
+func (x *ProductPortfolio) GetDisbursedAmount() float64 {
+	if x != nil {
+		return x.DisbursedAmount
+	}
+	return 0
+}
+
+func (x *ProductPortfolio) GetOutstandingPrincipal() float64 {
+	if x != nil {
+		return x.OutstandingPrincipal
+	}
+	return 0
+}
+
+func (x *ProductPortfolio) GetOutstandingInterest() float64 {
+	if x != nil {
+		return x.OutstandingInterest
+	}
+	return 0
+}
+
+func (x *ProductPortfolio) GetInterestEarned() float64 {
+	if x != nil {
+		return x.InterestEarned
+	}
+	return 0
+}
+
+func (x *ProductPortfolio) GetSettledAmount() float64 {
+	if x != nil {
+		return x.SettledAmount
+	}
+	return 0
+}
+
+func (x *ProductPortfolio) GetPar30Amount() float64 {
+	if x != nil {
+		return x.Par30Amount
+	}
+	return 0
+}
+
+func (x *ProductPortfolio) GetPar90Amount() float64 {
+	if x != nil {
+		return x.Par90Amount
+	}
+	return 0
+}
+
+func (x *ProductPortfolio) GetPar30Percentage() float32 {
+	if x != nil {
+		return x.Par30Percentage
+	}
+	return 0
+}
+
+func (x *ProductPortfolio) GetPar90Percentage() float32 {
+	if x != nil {
+		return x.Par90Percentage
+	}
+	return 0
+}
+
+func (x *ProductPortfolio) GetRepaymentRate() float32 {
+	if x != nil {
+		return x.RepaymentRate
+	}
+	return 0
+}
+
+func (x *ProductPortfolio) GetTopBorrowers() []*TopBorrower {
+	if x != nil {
+		return x.TopBorrowers
+	}
+	return nil
+}
+
+type PortfolioReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AsOfDate string              `protobuf:"bytes,1,opt,name=as_of_date,json=asOfDate,proto3" json:"as_of_date,omitempty"`
+	Products []*ProductPortfolio `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+}
+
+func (x *PortfolioReport) Reset() {
+	*x = PortfolioReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortfolioReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioReport) ProtoMessage() {}
+
+func (x *PortfolioReport) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioReport.ProtoReflect.Descriptor instead.
+func (*PortfolioReport) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PortfolioReport) GetAsOfDate() string {
+	if x != nil {
+		return x.AsOfDate
+	}
+	return ""
+}
+
+func (x *PortfolioReport) GetProducts() []*ProductPortfolio {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type ArchiveLoanProductRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Archived  bool   `protobuf:"varint,2,opt,name=archived,proto3" json:"archived,omitempty"`
+}
+
+func (x *ArchiveLoanProductRequest) Reset() {
+	*x = ArchiveLoanProductRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveLoanProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveLoanProductRequest) ProtoMessage() {}
+
+func (x *ArchiveLoanProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveLoanProductRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveLoanProductRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ArchiveLoanProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ArchiveLoanProductRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type GetLoanProductRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+}
+
+func (x *GetLoanProductRequest) Reset() {
+	*x = GetLoanProductRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoanProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanProductRequest) ProtoMessage() {}
+
+func (x *GetLoanProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanProductRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanProductRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetLoanProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type CreateLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loan *Loan `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+}
+
+func (x *CreateLoanRequest) Reset() {
+	*x = CreateLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLoanRequest) ProtoMessage() {}
+
+func (x *CreateLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLoanRequest.ProtoReflect.Descriptor instead.
+func (*CreateLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateLoanRequest) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+type UpdateLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loan *Loan `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+}
+
+func (x *UpdateLoanRequest) Reset() {
+	*x = UpdateLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLoanRequest) ProtoMessage() {}
+
+func (x *UpdateLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLoanRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateLoanRequest) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+type LoanFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaIds      []string `protobuf:"bytes,1,rep,name=chama_ids,json=chamaIds,proto3" json:"chama_ids,omitempty"`
+	ProductIds    []string `protobuf:"bytes,2,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	ParentLoanIds []string `protobuf:"bytes,3,rep,name=parent_loan_ids,json=parentLoanIds,proto3" json:"parent_loan_ids,omitempty"`
+}
+
+func (x *LoanFilter) Reset() {
+	*x = LoanFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoanFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanFilter) ProtoMessage() {}
+
+func (x *LoanFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanFilter.ProtoReflect.Descriptor instead.
+func (*LoanFilter) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LoanFilter) GetChamaIds() []string {
+	if x != nil {
+		return x.ChamaIds
+	}
+	return nil
+}
+
+func (x *LoanFilter) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *LoanFilter) GetParentLoanIds() []string {
+	if x != nil {
+		return x.ParentLoanIds
+	}
+	return nil
+}
+
+type ListLoansRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter    *LoanFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageToken string      `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32       `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListLoansRequest) Reset() {
+	*x = ListLoansRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoansRequest) ProtoMessage() {}
+
+func (x *ListLoansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoansRequest.ProtoReflect.Descriptor instead.
+func (*ListLoansRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListLoansRequest) GetFilter() *LoanFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListLoansRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListLoansRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListLoansResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loans         []*Loan `protobuf:"bytes,1,rep,name=loans,proto3" json:"loans,omitempty"`
+	NextPageToken string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListLoansResponse) Reset() {
+	*x = ListLoansResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoansResponse) ProtoMessage() {}
+
+func (x *ListLoansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoansResponse.ProtoReflect.Descriptor instead.
+func (*ListLoansResponse) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListLoansResponse) GetLoans() []*Loan {
+	if x != nil {
+		return x.Loans
+	}
+	return nil
+}
+
+func (x *ListLoansResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *GetLoanRequest) Reset() {
+	*x = GetLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanRequest) ProtoMessage() {}
+
+func (x *GetLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type ApproveLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId      string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	AccountName string `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Comment     string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *ApproveLoanRequest) Reset() {
+	*x = ApproveLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveLoanRequest) ProtoMessage() {}
+
+func (x *ApproveLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveLoanRequest.ProtoReflect.Descriptor instead.
+func (*ApproveLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ApproveLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *ApproveLoanRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ApproveLoanRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type LoanApproval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApprovalId    string `protobuf:"bytes,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	LoanId        string `protobuf:"bytes,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	ApproverId    string `protobuf:"bytes,3,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	ApproverNames string `protobuf:"bytes,4,opt,name=approver_names,json=approverNames,proto3" json:"approver_names,omitempty"`
+	AccountName   string `protobuf:"bytes,5,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Comment       string `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	ApprovedDate  string `protobuf:"bytes,7,opt,name=approved_date,json=approvedDate,proto3" json:"approved_date,omitempty"`
+}
+
+func (x *LoanApproval) Reset() {
+	*x = LoanApproval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoanApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanApproval) ProtoMessage() {}
+
+func (x *LoanApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanApproval.ProtoReflect.Descriptor instead.
+func (*LoanApproval) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LoanApproval) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *LoanApproval) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *LoanApproval) GetApproverId() string {
+	if x != nil {
+		return x.ApproverId
+	}
+	return ""
+}
+
+func (x *LoanApproval) GetApproverNames() string {
+	if x != nil {
+		return x.ApproverNames
+	}
+	return ""
+}
+
+func (x *LoanApproval) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *LoanApproval) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *LoanApproval) GetApprovedDate() string {
+	if x != nil {
+		return x.ApprovedDate
+	}
+	return ""
+}
+
+type CastLoanVoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId   string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	MemberId string `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	InFavour bool   `protobuf:"varint,3,opt,name=in_favour,json=inFavour,proto3" json:"in_favour,omitempty"`
+}
+
+func (x *CastLoanVoteRequest) Reset() {
+	*x = CastLoanVoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CastLoanVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CastLoanVoteRequest) ProtoMessage() {}
+
+func (x *CastLoanVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CastLoanVoteRequest.ProtoReflect.Descriptor instead.
+func (*CastLoanVoteRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CastLoanVoteRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *CastLoanVoteRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *CastLoanVoteRequest) GetInFavour() bool {
+	if x != nil {
+		return x.InFavour
+	}
+	return false
+}
+
+type PendingApproval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loan               *Loan           `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	Approvals          []*LoanApproval `protobuf:"bytes,2,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	RequiredApprovals  int32           `protobuf:"varint,3,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	MemberVoteRequired bool            `protobuf:"varint,4,opt,name=member_vote_required,json=memberVoteRequired,proto3" json:"member_vote_required,omitempty"`
+	VotesFor           int32           `protobuf:"varint,5,opt,name=votes_for,json=votesFor,proto3" json:"votes_for,omitempty"`
+	VotesAgainst       int32           `protobuf:"varint,6,opt,name=votes_against,json=votesAgainst,proto3" json:"votes_against,omitempty"`
+	EligibleVoters     int32           `protobuf:"varint,7,opt,name=eligible_voters,json=eligibleVoters,proto3" json:"eligible_voters,omitempty"`
+	PolicySatisfied    bool            `protobuf:"varint,8,opt,name=policy_satisfied,json=policySatisfied,proto3" json:"policy_satisfied,omitempty"`
+}
+
+func (x *PendingApproval) Reset() {
+	*x = PendingApproval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingApproval) ProtoMessage() {}
+
+func (x *PendingApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingApproval.ProtoReflect.Descriptor instead.
+func (*PendingApproval) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PendingApproval) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *PendingApproval) GetApprovals() []*LoanApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *PendingApproval) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *PendingApproval) GetMemberVoteRequired() bool {
+	if x != nil {
+		return x.MemberVoteRequired
+	}
+	return false
+}
+
+func (x *PendingApproval) GetVotesFor() int32 {
+	if x != nil {
+		return x.VotesFor
+	}
+	return 0
+}
+
+func (x *PendingApproval) GetVotesAgainst() int32 {
+	if x != nil {
+		return x.VotesAgainst
+	}
+	return 0
+}
+
+func (x *PendingApproval) GetEligibleVoters() int32 {
+	if x != nil {
+		return x.EligibleVoters
+	}
+	return 0
+}
+
+func (x *PendingApproval) GetPolicySatisfied() bool {
+	if x != nil {
+		return x.PolicySatisfied
+	}
+	return false
+}
+
+type ListPendingApprovalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaIds  []string `protobuf:"bytes,1,rep,name=chama_ids,json=chamaIds,proto3" json:"chama_ids,omitempty"`
+	PageToken string   `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListPendingApprovalsRequest) Reset() {
+	*x = ListPendingApprovalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingApprovalsRequest) ProtoMessage() {}
+
+func (x *ListPendingApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListPendingApprovalsRequest) GetChamaIds() []string {
+	if x != nil {
+		return x.ChamaIds
+	}
+	return nil
+}
+
+func (x *ListPendingApprovalsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPendingApprovalsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListPendingApprovalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PendingApprovals []*PendingApproval `protobuf:"bytes,1,rep,name=pending_approvals,json=pendingApprovals,proto3" json:"pending_approvals,omitempty"`
+	NextPageToken    string             `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListPendingApprovalsResponse) Reset() {
+	*x = ListPendingApprovalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingApprovalsResponse) ProtoMessage() {}
+
+func (x *ListPendingApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListPendingApprovalsResponse) GetPendingApprovals() []*PendingApproval {
+	if x != nil {
+		return x.PendingApprovals
+	}
+	return nil
+}
+
+func (x *ListPendingApprovalsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type TopUpLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId       string  `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	TopUpAmount  float64 `protobuf:"fixed64,2,opt,name=top_up_amount,json=topUpAmount,proto3" json:"top_up_amount,omitempty"`
+	DurationDays int32   `protobuf:"varint,3,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+}
+
+func (x *TopUpLoanRequest) Reset() {
+	*x = TopUpLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopUpLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpLoanRequest) ProtoMessage() {}
+
+func (x *TopUpLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpLoanRequest.ProtoReflect.Descriptor instead.
+func (*TopUpLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TopUpLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *TopUpLoanRequest) GetTopUpAmount() float64 {
+	if x != nil {
+		return x.TopUpAmount
+	}
+	return 0
+}
+
+func (x *TopUpLoanRequest) GetDurationDays() int32 {
+	if x != nil {
+		return x.DurationDays
+	}
+	return 0
+}
+
+type CancelLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *CancelLoanRequest) Reset() {
+	*x = CancelLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelLoanRequest) ProtoMessage() {}
+
+func (x *CancelLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelLoanRequest.ProtoReflect.Descriptor instead.
+func (*CancelLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CancelLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type RestructureLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId       string  `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	DurationDays int32   `protobuf:"varint,2,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+	InterestRate float32 `protobuf:"fixed32,3,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+}
+
+func (x *RestructureLoanRequest) Reset() {
+	*x = RestructureLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestructureLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestructureLoanRequest) ProtoMessage() {}
+
+func (x *RestructureLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestructureLoanRequest.ProtoReflect.Descriptor instead.
+func (*RestructureLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RestructureLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *RestructureLoanRequest) GetDurationDays() int32 {
+	if x != nil {
+		return x.DurationDays
+	}
+	return 0
+}
+
+func (x *RestructureLoanRequest) GetInterestRate() float32 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
+}
+
+type WriteOffLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId      string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	AccountName string `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Reason      string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *WriteOffLoanRequest) Reset() {
+	*x = WriteOffLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteOffLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteOffLoanRequest) ProtoMessage() {}
+
+func (x *WriteOffLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteOffLoanRequest.ProtoReflect.Descriptor instead.
+func (*WriteOffLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WriteOffLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *WriteOffLoanRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *WriteOffLoanRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type LoanWriteOff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WriteOffId    string `protobuf:"bytes,1,opt,name=write_off_id,json=writeOffId,proto3" json:"write_off_id,omitempty"`
+	LoanId        string `protobuf:"bytes,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	ApproverId    string `protobuf:"bytes,3,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	ApproverNames string `protobuf:"bytes,4,opt,name=approver_names,json=approverNames,proto3" json:"approver_names,omitempty"`
+	AccountName   string `protobuf:"bytes,5,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	ApprovedDate  string `protobuf:"bytes,7,opt,name=approved_date,json=approvedDate,proto3" json:"approved_date,omitempty"`
+}
+
+func (x *LoanWriteOff) Reset() {
+	*x = LoanWriteOff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoanWriteOff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanWriteOff) ProtoMessage() {}
+
+func (x *LoanWriteOff) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanWriteOff.ProtoReflect.Descriptor instead.
+func (*LoanWriteOff) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LoanWriteOff) GetWriteOffId() string {
+	if x != nil {
+		return x.WriteOffId
+	}
+	return ""
+}
+
+func (x *LoanWriteOff) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *LoanWriteOff) GetApproverId() string {
+	if x != nil {
+		return x.ApproverId
+	}
+	return ""
+}
+
+func (x *LoanWriteOff) GetApproverNames() string {
+	if x != nil {
+		return x.ApproverNames
+	}
+	return ""
+}
+
+func (x *LoanWriteOff) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *LoanWriteOff) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LoanWriteOff) GetApprovedDate() string {
+	if x != nil {
+		return x.ApprovedDate
+	}
+	return ""
+}
+
+type WriteOffLoanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WrittenOff        bool            `protobuf:"varint,1,opt,name=written_off,json=writtenOff,proto3" json:"written_off,omitempty"`
+	WrittenOffAmount  float64         `protobuf:"fixed64,2,opt,name=written_off_amount,json=writtenOffAmount,proto3" json:"written_off_amount,omitempty"`
+	RequiredApprovals int32           `protobuf:"varint,3,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	Approvals         []*LoanWriteOff `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals,omitempty"`
+}
+
+func (x *WriteOffLoanResponse) Reset() {
+	*x = WriteOffLoanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteOffLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteOffLoanResponse) ProtoMessage() {}
+
+func (x *WriteOffLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteOffLoanResponse.ProtoReflect.Descriptor instead.
+func (*WriteOffLoanResponse) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *WriteOffLoanResponse) GetWrittenOff() bool {
+	if x != nil {
+		return x.WrittenOff
+	}
+	return false
+}
+
+func (x *WriteOffLoanResponse) GetWrittenOffAmount() float64 {
+	if x != nil {
+		return x.WrittenOffAmount
+	}
+	return 0
+}
+
+func (x *WriteOffLoanResponse) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *WriteOffLoanResponse) GetApprovals() []*LoanWriteOff {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+type ProvisionRates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Current    float32 `protobuf:"fixed32,1,opt,name=current,proto3" json:"current,omitempty"`
+	Dpd_1_30   float32 `protobuf:"fixed32,2,opt,name=dpd_1_30,json=dpd130,proto3" json:"dpd_1_30,omitempty"`
+	Dpd_31_90  float32 `protobuf:"fixed32,3,opt,name=dpd_31_90,json=dpd3190,proto3" json:"dpd_31_90,omitempty"`
+	DpdOver_90 float32 `protobuf:"fixed32,4,opt,name=dpd_over_90,json=dpdOver90,proto3" json:"dpd_over_90,omitempty"`
+}
+
+func (x *ProvisionRates) Reset() {
+	*x = ProvisionRates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProvisionRates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisionRates) ProtoMessage() {}
+
+func (x *ProvisionRates) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisionRates.ProtoReflect.Descriptor instead.
+func (*ProvisionRates) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ProvisionRates) GetCurrent() float32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *ProvisionRates) GetDpd_1_30() float32 {
+	if x != nil {
+		return x.Dpd_1_30
+	}
+	return 0
+}
+
+func (x *ProvisionRates) GetDpd_31_90() float32 {
+	if x != nil {
+		return x.Dpd_31_90
+	}
+	return 0
+}
+
+func (x *ProvisionRates) GetDpdOver_90() float32 {
+	if x != nil {
+		return x.DpdOver_90
+	}
+	return 0
+}
+
+type ProvisionBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bucket              DaysPastDueBucket `protobuf:"varint,1,opt,name=bucket,proto3,enum=gidyon.loan.DaysPastDueBucket" json:"bucket,omitempty"`
+	Loans               int32             `protobuf:"varint,2,opt,name=loans,proto3" json:"loans,omitempty"`
+	OutstandingAmount   float64           `protobuf:"fixed64,3,opt,name=outstanding_amount,json=outstandingAmount,proto3" json:"outstanding_amount,omitempty"`
+	ProvisionPercentage float32           `protobuf:"fixed32,4,opt,name=provision_percentage,json=provisionPercentage,proto3" json:"provision_percentage,omitempty"`
+	ProvisionAmount     float64           `protobuf:"fixed64,5,opt,name=provision_amount,json=provisionAmount,proto3" json:"provision_amount,omitempty"`
+}
+
+func (x *ProvisionBucket) Reset() {
+	*x = ProvisionBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProvisionBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisionBucket) ProtoMessage() {}
+
+func (x *ProvisionBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisionBucket.ProtoReflect.Descriptor instead.
+func (*ProvisionBucket) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ProvisionBucket) GetBucket() DaysPastDueBucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return DaysPastDueBucket_CURRENT
+}
+
+func (x *ProvisionBucket) GetLoans() int32 {
+	if x != nil {
+		return x.Loans
+	}
+	return 0
+}
+
+func (x *ProvisionBucket) GetOutstandingAmount() float64 {
+	if x != nil {
+		return x.OutstandingAmount
+	}
+	return 0
+}
+
+func (x *ProvisionBucket) GetProvisionPercentage() float32 {
+	if x != nil {
+		return x.ProvisionPercentage
+	}
+	return 0
+}
+
+func (x *ProvisionBucket) GetProvisionAmount() float64 {
+	if x != nil {
+		return x.ProvisionAmount
+	}
+	return 0
+}
+
+type GetProvisioningReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaIds       []string        `protobuf:"bytes,1,rep,name=chama_ids,json=chamaIds,proto3" json:"chama_ids,omitempty"`
+	AsOfDate       string          `protobuf:"bytes,2,opt,name=as_of_date,json=asOfDate,proto3" json:"as_of_date,omitempty"`
+	ProvisionRates *ProvisionRates `protobuf:"bytes,3,opt,name=provision_rates,json=provisionRates,proto3" json:"provision_rates,omitempty"`
+}
+
+func (x *GetProvisioningReportRequest) Reset() {
+	*x = GetProvisioningReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProvisioningReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProvisioningReportRequest) ProtoMessage() {}
+
+func (x *GetProvisioningReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProvisioningReportRequest.ProtoReflect.Descriptor instead.
+func (*GetProvisioningReportRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetProvisioningReportRequest) GetChamaIds() []string {
+	if x != nil {
+		return x.ChamaIds
+	}
+	return nil
+}
+
+func (x *GetProvisioningReportRequest) GetAsOfDate() string {
+	if x != nil {
+		return x.AsOfDate
+	}
+	return ""
+}
+
+func (x *GetProvisioningReportRequest) GetProvisionRates() *ProvisionRates {
+	if x != nil {
+		return x.ProvisionRates
+	}
+	return nil
+}
+
+type ProvisioningReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AsOfDate         string             `protobuf:"bytes,1,opt,name=as_of_date,json=asOfDate,proto3" json:"as_of_date,omitempty"`
+	Buckets          []*ProvisionBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	TotalOutstanding float64            `protobuf:"fixed64,3,opt,name=total_outstanding,json=totalOutstanding,proto3" json:"total_outstanding,omitempty"`
+	TotalProvision   float64            `protobuf:"fixed64,4,opt,name=total_provision,json=totalProvision,proto3" json:"total_provision,omitempty"`
+	WrittenOffAmount float64            `protobuf:"fixed64,5,opt,name=written_off_amount,json=writtenOffAmount,proto3" json:"written_off_amount,omitempty"`
+}
+
+func (x *ProvisioningReport) Reset() {
+	*x = ProvisioningReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProvisioningReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisioningReport) ProtoMessage() {}
+
+func (x *ProvisioningReport) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisioningReport.ProtoReflect.Descriptor instead.
+func (*ProvisioningReport) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ProvisioningReport) GetAsOfDate() string {
+	if x != nil {
+		return x.AsOfDate
+	}
+	return ""
+}
+
+func (x *ProvisioningReport) GetBuckets() []*ProvisionBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *ProvisioningReport) GetTotalOutstanding() float64 {
+	if x != nil {
+		return x.TotalOutstanding
+	}
+	return 0
+}
+
+func (x *ProvisioningReport) GetTotalProvision() float64 {
+	if x != nil {
+		return x.TotalProvision
+	}
+	return 0
+}
+
+func (x *ProvisioningReport) GetWrittenOffAmount() float64 {
+	if x != nil {
+		return x.WrittenOffAmount
+	}
+	return 0
+}
+
+type GetPayoffQuoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId   string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	AsOfDate string `protobuf:"bytes,2,opt,name=as_of_date,json=asOfDate,proto3" json:"as_of_date,omitempty"`
+}
+
+func (x *GetPayoffQuoteRequest) Reset() {
+	*x = GetPayoffQuoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayoffQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoffQuoteRequest) ProtoMessage() {}
+
+func (x *GetPayoffQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoffQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetPayoffQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetPayoffQuoteRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *GetPayoffQuoteRequest) GetAsOfDate() string {
+	if x != nil {
+		return x.AsOfDate
+	}
+	return ""
+}
+
+type PayoffQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuoteId          string              `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	LoanId           string              `protobuf:"bytes,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	SettlementType   EarlySettlementType `protobuf:"varint,3,opt,name=settlement_type,json=settlementType,proto3,enum=gidyon.loan.EarlySettlementType" json:"settlement_type,omitempty"`
+	AsOfDate         string              `protobuf:"bytes,4,opt,name=as_of_date,json=asOfDate,proto3" json:"as_of_date,omitempty"`
+	ExpiryDate       string              `protobuf:"bytes,5,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
+	PrincipalAmount  float64             `protobuf:"fixed64,6,opt,name=principal_amount,json=principalAmount,proto3" json:"principal_amount,omitempty"`
+	InterestAmount   float64             `protobuf:"fixed64,7,opt,name=interest_amount,json=interestAmount,proto3" json:"interest_amount,omitempty"`
+	PenaltyAmount    float64             `protobuf:"fixed64,8,opt,name=penalty_amount,json=penaltyAmount,proto3" json:"penalty_amount,omitempty"`
+	RebateAmount     float64             `protobuf:"fixed64,9,opt,name=rebate_amount,json=rebateAmount,proto3" json:"rebate_amount,omitempty"`
+	FeeAmount        float64             `protobuf:"fixed64,10,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	SettlementAmount float64             `protobuf:"fixed64,11,opt,name=settlement_amount,json=settlementAmount,proto3" json:"settlement_amount,omitempty"`
+}
+
+func (x *PayoffQuote) Reset() {
+	*x = PayoffQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayoffQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoffQuote) ProtoMessage() {}
+
+func (x *PayoffQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoffQuote.ProtoReflect.Descriptor instead.
+func (*PayoffQuote) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PayoffQuote) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *PayoffQuote) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *PayoffQuote) GetSettlementType() EarlySettlementType {
+	if x != nil {
+		return x.SettlementType
+	}
+	return EarlySettlementType_FULL_INTEREST
+}
+
+func (x *PayoffQuote) GetAsOfDate() string {
+	if x != nil {
+		return x.AsOfDate
+	}
+	return ""
+}
+
+func (x *PayoffQuote) GetExpiryDate() string {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return ""
+}
+
+func (x *PayoffQuote) GetPrincipalAmount() float64 {
+	if x != nil {
+		return x.PrincipalAmount
+	}
+	return 0
+}
+
+func (x *PayoffQuote) GetInterestAmount() float64 {
+	if x != nil {
+		return x.InterestAmount
+	}
+	return 0
+}
+
+func (x *PayoffQuote) GetPenaltyAmount() float64 {
+	if x != nil {
+		return x.PenaltyAmount
+	}
+	return 0
+}
+
+func (x *PayoffQuote) GetRebateAmount() float64 {
+	if x != nil {
+		return x.RebateAmount
+	}
+	return 0
+}
+
+func (x *PayoffQuote) GetFeeAmount() float64 {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return 0
+}
+
+func (x *PayoffQuote) GetSettlementAmount() float64 {
+	if x != nil {
+		return x.SettlementAmount
+	}
+	return 0
+}
+
+type RepayLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId  string  `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Amount  float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	QuoteId string  `protobuf:"bytes,3,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+}
+
+func (x *RepayLoanRequest) Reset() {
+	*x = RepayLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepayLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepayLoanRequest) ProtoMessage() {}
+
+func (x *RepayLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepayLoanRequest.ProtoReflect.Descriptor instead.
+func (*RepayLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RepayLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *RepayLoanRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RepayLoanRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+type Collateral struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollateralId       string         `protobuf:"bytes,1,opt,name=collateral_id,json=collateralId,proto3" json:"collateral_id,omitempty"`
+	LoanId             string         `protobuf:"bytes,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	CollateralType     CollateralType `protobuf:"varint,3,opt,name=collateral_type,json=collateralType,proto3,enum=gidyon.loan.CollateralType" json:"collateral_type,omitempty"`
+	Description        string         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	EstimatedValue     float64        `protobuf:"fixed64,5,opt,name=estimated_value,json=estimatedValue,proto3" json:"estimated_value,omitempty"`
+	DocumentReferences []string       `protobuf:"bytes,6,rep,name=document_references,json=documentReferences,proto3" json:"document_references,omitempty"`
+	LienStatus         LienStatus     `protobuf:"varint,7,opt,name=lien_status,json=lienStatus,proto3,enum=gidyon.loan.LienStatus" json:"lien_status,omitempty"`
+	ReleasedDate       string         `protobuf:"bytes,8,opt,name=released_date,json=releasedDate,proto3" json:"released_date,omitempty"`
+	CreatedDate        string         `protobuf:"bytes,9,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
+}
+
+func (x *Collateral) Reset() {
+	*x = Collateral{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Collateral) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collateral) ProtoMessage() {}
+
+func (x *Collateral) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collateral.ProtoReflect.Descriptor instead.
+func (*Collateral) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Collateral) GetCollateralId() string {
+	if x != nil {
+		return x.CollateralId
+	}
+	return ""
+}
+
+func (x *Collateral) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *Collateral) GetCollateralType() CollateralType {
+	if x != nil {
+		return x.CollateralType
+	}
+	return CollateralType_COLLATERAL_OTHER
+}
+
+func (x *Collateral) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Collateral) GetEstimatedValue() float64 {
+	if x != nil {
+		return x.EstimatedValue
+	}
+	return 0
+}
+
+func (x *Collateral) GetDocumentReferences() []string {
+	if x != nil {
+		return x.DocumentReferences
+	}
+	return nil
+}
+
+func (x *Collateral) GetLienStatus() LienStatus {
+	if x != nil {
+		return x.LienStatus
+	}
+	return LienStatus_LIEN_PENDING
+}
+
+func (x *Collateral) GetReleasedDate() string {
+	if x != nil {
+		return x.ReleasedDate
+	}
+	return ""
+}
+
+func (x *Collateral) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+type AddLoanCollateralRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collateral *Collateral `protobuf:"bytes,1,opt,name=collateral,proto3" json:"collateral,omitempty"`
+}
+
+func (x *AddLoanCollateralRequest) Reset() {
+	*x = AddLoanCollateralRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddLoanCollateralRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLoanCollateralRequest) ProtoMessage() {}
+
+func (x *AddLoanCollateralRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLoanCollateralRequest.ProtoReflect.Descriptor instead.
+func (*AddLoanCollateralRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AddLoanCollateralRequest) GetCollateral() *Collateral {
+	if x != nil {
+		return x.Collateral
+	}
+	return nil
+}
+
+type UpdateCollateralLienRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollateralId string     `protobuf:"bytes,1,opt,name=collateral_id,json=collateralId,proto3" json:"collateral_id,omitempty"`
+	LienStatus   LienStatus `protobuf:"varint,2,opt,name=lien_status,json=lienStatus,proto3,enum=gidyon.loan.LienStatus" json:"lien_status,omitempty"`
+}
+
+func (x *UpdateCollateralLienRequest) Reset() {
+	*x = UpdateCollateralLienRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCollateralLienRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollateralLienRequest) ProtoMessage() {}
+
+func (x *UpdateCollateralLienRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollateralLienRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCollateralLienRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateCollateralLienRequest) GetCollateralId() string {
+	if x != nil {
+		return x.CollateralId
+	}
+	return ""
+}
+
+func (x *UpdateCollateralLienRequest) GetLienStatus() LienStatus {
+	if x != nil {
+		return x.LienStatus
+	}
+	return LienStatus_LIEN_PENDING
+}
+
+type ListLoanCollateralRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *ListLoanCollateralRequest) Reset() {
+	*x = ListLoanCollateralRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoanCollateralRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoanCollateralRequest) ProtoMessage() {}
+
+func (x *ListLoanCollateralRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoanCollateralRequest.ProtoReflect.Descriptor instead.
+func (*ListLoanCollateralRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListLoanCollateralRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type ListLoanCollateralResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collaterals []*Collateral `protobuf:"bytes,1,rep,name=collaterals,proto3" json:"collaterals,omitempty"`
+}
+
+func (x *ListLoanCollateralResponse) Reset() {
+	*x = ListLoanCollateralResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoanCollateralResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoanCollateralResponse) ProtoMessage() {}
+
+func (x *ListLoanCollateralResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoanCollateralResponse.ProtoReflect.Descriptor instead.
+func (*ListLoanCollateralResponse) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListLoanCollateralResponse) GetCollaterals() []*Collateral {
+	if x != nil {
+		return x.Collaterals
+	}
+	return nil
+}
+
+type NotificationTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateId  string              `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	ChamaId     string              `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	Event       NotificationEvent   `protobuf:"varint,3,opt,name=event,proto3,enum=gidyon.loan.NotificationEvent" json:"event,omitempty"`
+	Channel     NotificationChannel `protobuf:"varint,4,opt,name=channel,proto3,enum=gidyon.loan.NotificationChannel" json:"channel,omitempty"`
+	Body        string              `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	DaysBefore  int32               `protobuf:"varint,6,opt,name=days_before,json=daysBefore,proto3" json:"days_before,omitempty"`
+	Disabled    bool                `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	UpdatedDate string              `protobuf:"bytes,8,opt,name=updated_date,json=updatedDate,proto3" json:"updated_date,omitempty"`
+}
+
+func (x *NotificationTemplate) Reset() {
+	*x = NotificationTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationTemplate) ProtoMessage() {}
+
+func (x *NotificationTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationTemplate.ProtoReflect.Descriptor instead.
+func (*NotificationTemplate) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *NotificationTemplate) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *NotificationTemplate) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *NotificationTemplate) GetEvent() NotificationEvent {
+	if x != nil {
+		return x.Event
+	}
+	return NotificationEvent_NOTIFICATION_EVENT_UNSPECIFIED
+}
+
+func (x *NotificationTemplate) GetChannel() NotificationChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return NotificationChannel_CHANNEL_SMS
+}
+
+func (x *NotificationTemplate) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *NotificationTemplate) GetDaysBefore() int32 {
+	if x != nil {
+		return x.DaysBefore
+	}
+	return 0
+}
+
+func (x *NotificationTemplate) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *NotificationTemplate) GetUpdatedDate() string {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return ""
+}
+
+type NotificationDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeliveryId        string              `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	ChamaId           string              `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	LoanId            string              `protobuf:"bytes,3,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	InstallmentNumber int32               `protobuf:"varint,4,opt,name=installment_number,json=installmentNumber,proto3" json:"installment_number,omitempty"`
+	Event             NotificationEvent   `protobuf:"varint,5,opt,name=event,proto3,enum=gidyon.loan.NotificationEvent" json:"event,omitempty"`
+	Channel           NotificationChannel `protobuf:"varint,6,opt,name=channel,proto3,enum=gidyon.loan.NotificationChannel" json:"channel,omitempty"`
+	Recipient         string              `protobuf:"bytes,7,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Message           string              `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	Status            DeliveryStatus      `protobuf:"varint,9,opt,name=status,proto3,enum=gidyon.loan.DeliveryStatus" json:"status,omitempty"`
+	FailureReason     string              `protobuf:"bytes,10,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	CreatedDate       string              `protobuf:"bytes,11,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
+}
+
+func (x *NotificationDelivery) Reset() {
+	*x = NotificationDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationDelivery) ProtoMessage() {}
+
+func (x *NotificationDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationDelivery.ProtoReflect.Descriptor instead.
+func (*NotificationDelivery) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *NotificationDelivery) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetInstallmentNumber() int32 {
+	if x != nil {
+		return x.InstallmentNumber
+	}
+	return 0
+}
+
+func (x *NotificationDelivery) GetEvent() NotificationEvent {
+	if x != nil {
+		return x.Event
+	}
+	return NotificationEvent_NOTIFICATION_EVENT_UNSPECIFIED
+}
+
+func (x *NotificationDelivery) GetChannel() NotificationChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return NotificationChannel_CHANNEL_SMS
+}
+
+func (x *NotificationDelivery) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetStatus() DeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeliveryStatus_DELIVERY_PENDING
+}
+
+func (x *NotificationDelivery) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+type SetNotificationTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *NotificationTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *SetNotificationTemplateRequest) Reset() {
+	*x = SetNotificationTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNotificationTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationTemplateRequest) ProtoMessage() {}
+
+func (x *SetNotificationTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SetNotificationTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SetNotificationTemplateRequest) GetTemplate() *NotificationTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type ListNotificationTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaId string `protobuf:"bytes,1,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+}
+
+func (x *ListNotificationTemplatesRequest) Reset() {
+	*x = ListNotificationTemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNotificationTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationTemplatesRequest) ProtoMessage() {}
+
+func (x *ListNotificationTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListNotificationTemplatesRequest) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+type ListNotificationTemplatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Templates []*NotificationTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *ListNotificationTemplatesResponse) Reset() {
+	*x = ListNotificationTemplatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNotificationTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationTemplatesResponse) ProtoMessage() {}
+
+func (x *ListNotificationTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListNotificationTemplatesResponse) GetTemplates() []*NotificationTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type NotificationDeliveryFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChamaId  string           `protobuf:"bytes,1,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	LoanId   string           `protobuf:"bytes,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Statuses []DeliveryStatus `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=gidyon.loan.DeliveryStatus" json:"statuses,omitempty"`
+}
+
+func (x *NotificationDeliveryFilter) Reset() {
+	*x = NotificationDeliveryFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationDeliveryFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationDeliveryFilter) ProtoMessage() {}
+
+func (x *NotificationDeliveryFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationDeliveryFilter.ProtoReflect.Descriptor instead.
+func (*NotificationDeliveryFilter) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *NotificationDeliveryFilter) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *NotificationDeliveryFilter) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *NotificationDeliveryFilter) GetStatuses() []DeliveryStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type ListNotificationDeliveriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageToken string                      `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32                       `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter    *NotificationDeliveryFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListNotificationDeliveriesRequest) Reset() {
+	*x = ListNotificationDeliveriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNotificationDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListNotificationDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListNotificationDeliveriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListNotificationDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNotificationDeliveriesRequest) GetFilter() *NotificationDeliveryFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListNotificationDeliveriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextPageToken string                  `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Deliveries    []*NotificationDelivery `protobuf:"bytes,2,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (x *ListNotificationDeliveriesResponse) Reset() {
+	*x = ListNotificationDeliveriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNotificationDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListNotificationDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListNotificationDeliveriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListNotificationDeliveriesResponse) GetDeliveries() []*NotificationDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type RepaymentHoliday struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HolidayId      string  `protobuf:"bytes,1,opt,name=holiday_id,json=holidayId,proto3" json:"holiday_id,omitempty"`
+	LoanId         string  `protobuf:"bytes,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	ActorId        string  `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Periods        int32   `protobuf:"varint,4,opt,name=periods,proto3" json:"periods,omitempty"`
+	ShiftDays      int32   `protobuf:"varint,5,opt,name=shift_days,json=shiftDays,proto3" json:"shift_days,omitempty"`
+	InterestAmount float64 `protobuf:"fixed64,6,opt,name=interest_amount,json=interestAmount,proto3" json:"interest_amount,omitempty"`
+	Reason         string  `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedDate    string  `protobuf:"bytes,8,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
+}
+
+func (x *RepaymentHoliday) Reset() {
+	*x = RepaymentHoliday{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepaymentHoliday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepaymentHoliday) ProtoMessage() {}
+
+func (x *RepaymentHoliday) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepaymentHoliday.ProtoReflect.Descriptor instead.
+func (*RepaymentHoliday) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RepaymentHoliday) GetHolidayId() string {
+	if x != nil {
+		return x.HolidayId
+	}
+	return ""
+}
+
+func (x *RepaymentHoliday) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *RepaymentHoliday) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *RepaymentHoliday) GetPeriods() int32 {
+	if x != nil {
+		return x.Periods
+	}
+	return 0
+}
+
+func (x *RepaymentHoliday) GetShiftDays() int32 {
+	if x != nil {
+		return x.ShiftDays
+	}
+	return 0
+}
+
+func (x *RepaymentHoliday) GetInterestAmount() float64 {
+	if x != nil {
+		return x.InterestAmount
+	}
+	return 0
+}
+
+func (x *RepaymentHoliday) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RepaymentHoliday) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+type GrantRepaymentHolidayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId  string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Periods int32  `protobuf:"varint,2,opt,name=periods,proto3" json:"periods,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *GrantRepaymentHolidayRequest) Reset() {
+	*x = GrantRepaymentHolidayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantRepaymentHolidayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantRepaymentHolidayRequest) ProtoMessage() {}
+
+func (x *GrantRepaymentHolidayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantRepaymentHolidayRequest.ProtoReflect.Descriptor instead.
+func (*GrantRepaymentHolidayRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GrantRepaymentHolidayRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *GrantRepaymentHolidayRequest) GetPeriods() int32 {
+	if x != nil {
+		return x.Periods
+	}
+	return 0
+}
+
+func (x *GrantRepaymentHolidayRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListRepaymentHolidaysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *ListRepaymentHolidaysRequest) Reset() {
+	*x = ListRepaymentHolidaysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRepaymentHolidaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepaymentHolidaysRequest) ProtoMessage() {}
+
+func (x *ListRepaymentHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepaymentHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*ListRepaymentHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListRepaymentHolidaysRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type ListRepaymentHolidaysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Holidays []*RepaymentHoliday `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays,omitempty"`
+}
+
+func (x *ListRepaymentHolidaysResponse) Reset() {
+	*x = ListRepaymentHolidaysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRepaymentHolidaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepaymentHolidaysResponse) ProtoMessage() {}
+
+func (x *ListRepaymentHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepaymentHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*ListRepaymentHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListRepaymentHolidaysResponse) GetHolidays() []*RepaymentHoliday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type CheckEligibilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductId  string  `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MemberId   string  `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	LoanAmount float64 `protobuf:"fixed64,3,opt,name=loan_amount,json=loanAmount,proto3" json:"loan_amount,omitempty"`
+}
+
+func (x *CheckEligibilityRequest) Reset() {
+	*x = CheckEligibilityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckEligibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckEligibilityRequest) ProtoMessage() {}
+
+func (x *CheckEligibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckEligibilityRequest.ProtoReflect.Descriptor instead.
+func (*CheckEligibilityRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CheckEligibilityRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CheckEligibilityRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *CheckEligibilityRequest) GetLoanAmount() float64 {
+	if x != nil {
+		return x.LoanAmount
+	}
+	return 0
+}
+
+type CheckEligibilityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eligible      bool     `protobuf:"varint,1,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	MaximumAmount float64  `protobuf:"fixed64,2,opt,name=maximum_amount,json=maximumAmount,proto3" json:"maximum_amount,omitempty"`
+	Reasons       []string `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	CreditScore   int32    `protobuf:"varint,4,opt,name=credit_score,json=creditScore,proto3" json:"credit_score,omitempty"`
+}
+
+func (x *CheckEligibilityResponse) Reset() {
+	*x = CheckEligibilityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckEligibilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckEligibilityResponse) ProtoMessage() {}
+
+func (x *CheckEligibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckEligibilityResponse.ProtoReflect.Descriptor instead.
+func (*CheckEligibilityResponse) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CheckEligibilityResponse) GetEligible() bool {
+	if x != nil {
+		return x.Eligible
+	}
+	return false
+}
+
+func (x *CheckEligibilityResponse) GetMaximumAmount() float64 {
+	if x != nil {
+		return x.MaximumAmount
+	}
+	return 0
+}
+
+func (x *CheckEligibilityResponse) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *CheckEligibilityResponse) GetCreditScore() int32 {
+	if x != nil {
+		return x.CreditScore
+	}
+	return 0
+}
+
+type CreditFactor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Score  float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	Weight float32 `protobuf:"fixed32,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Detail string  `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *CreditFactor) Reset() {
+	*x = CreditFactor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreditFactor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditFactor) ProtoMessage() {}
+
+func (x *CreditFactor) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditFactor.ProtoReflect.Descriptor instead.
+func (*CreditFactor) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreditFactor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreditFactor) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CreditFactor) GetWeight() float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CreditFactor) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type MemberCreditProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId     string          `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	ChamaId      string          `protobuf:"bytes,2,opt,name=chama_id,json=chamaId,proto3" json:"chama_id,omitempty"`
+	CreditScore  int32           `protobuf:"varint,3,opt,name=credit_score,json=creditScore,proto3" json:"credit_score,omitempty"`
+	Factors      []*CreditFactor `protobuf:"bytes,4,rep,name=factors,proto3" json:"factors,omitempty"`
+	ComputedDate string          `protobuf:"bytes,5,opt,name=computed_date,json=computedDate,proto3" json:"computed_date,omitempty"`
+}
+
+func (x *MemberCreditProfile) Reset() {
+	*x = MemberCreditProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemberCreditProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberCreditProfile) ProtoMessage() {}
+
+func (x *MemberCreditProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberCreditProfile.ProtoReflect.Descriptor instead.
+func (*MemberCreditProfile) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *MemberCreditProfile) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *MemberCreditProfile) GetChamaId() string {
+	if x != nil {
+		return x.ChamaId
+	}
+	return ""
+}
+
+func (x *MemberCreditProfile) GetCreditScore() int32 {
+	if x != nil {
+		return x.CreditScore
+	}
+	return 0
+}
+
+func (x *MemberCreditProfile) GetFactors() []*CreditFactor {
+	if x != nil {
+		return x.Factors
+	}
+	return nil
+}
+
+func (x *MemberCreditProfile) GetComputedDate() string {
+	if x != nil {
+		return x.ComputedDate
+	}
+	return ""
+}
+
+type GetMemberCreditProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+}
+
+func (x *GetMemberCreditProfileRequest) Reset() {
+	*x = GetMemberCreditProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMemberCreditProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberCreditProfileRequest) ProtoMessage() {}
+
+func (x *GetMemberCreditProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberCreditProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetMemberCreditProfileRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetMemberCreditProfileRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type StatementEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId          string             `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	EntryDate       string             `protobuf:"bytes,2,opt,name=entry_date,json=entryDate,proto3" json:"entry_date,omitempty"`
+	EntryType       StatementEntryType `protobuf:"varint,3,opt,name=entry_type,json=entryType,proto3,enum=gidyon.loan.StatementEntryType" json:"entry_type,omitempty"`
+	Description     string             `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Debit           float64            `protobuf:"fixed64,5,opt,name=debit,proto3" json:"debit,omitempty"`
+	Credit          float64            `protobuf:"fixed64,6,opt,name=credit,proto3" json:"credit,omitempty"`
+	PrincipalAmount float64            `protobuf:"fixed64,7,opt,name=principal_amount,json=principalAmount,proto3" json:"principal_amount,omitempty"`
+	InterestAmount  float64            `protobuf:"fixed64,8,opt,name=interest_amount,json=interestAmount,proto3" json:"interest_amount,omitempty"`
+	PenaltyAmount   float64            `protobuf:"fixed64,9,opt,name=penalty_amount,json=penaltyAmount,proto3" json:"penalty_amount,omitempty"`
+	RunningBalance  float64            `protobuf:"fixed64,10,opt,name=running_balance,json=runningBalance,proto3" json:"running_balance,omitempty"`
+}
+
+func (x *StatementEntry) Reset() {
+	*x = StatementEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatementEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementEntry) ProtoMessage() {}
+
+func (x *StatementEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementEntry.ProtoReflect.Descriptor instead.
+func (*StatementEntry) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *StatementEntry) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *StatementEntry) GetEntryDate() string {
+	if x != nil {
+		return x.EntryDate
+	}
+	return ""
+}
+
+func (x *StatementEntry) GetEntryType() StatementEntryType {
+	if x != nil {
+		return x.EntryType
+	}
+	return StatementEntryType_STATEMENT_ENTRY_UNSPECIFIED
+}
+
+func (x *StatementEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StatementEntry) GetDebit() float64 {
+	if x != nil {
+		return x.Debit
+	}
+	return 0
+}
+
+func (x *StatementEntry) GetCredit() float64 {
+	if x != nil {
+		return x.Credit
+	}
+	return 0
+}
+
+func (x *StatementEntry) GetPrincipalAmount() float64 {
+	if x != nil {
+		return x.PrincipalAmount
+	}
+	return 0
+}
+
+func (x *StatementEntry) GetInterestAmount() float64 {
+	if x != nil {
+		return x.InterestAmount
+	}
+	return 0
+}
+
+func (x *StatementEntry) GetPenaltyAmount() float64 {
+	if x != nil {
+		return x.PenaltyAmount
+	}
+	return 0
+}
+
+func (x *StatementEntry) GetRunningBalance() float64 {
+	if x != nil {
+		return x.RunningBalance
+	}
+	return 0
+}
+
+type LoanStatement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId             string            `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	MemberId           string            `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	LoaneeNames        string            `protobuf:"bytes,3,opt,name=loanee_names,json=loaneeNames,proto3" json:"loanee_names,omitempty"`
+	StatementDate      string            `protobuf:"bytes,4,opt,name=statement_date,json=statementDate,proto3" json:"statement_date,omitempty"`
+	Entries            []*StatementEntry `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries,omitempty"`
+	TotalDisbursed     float64           `protobuf:"fixed64,6,opt,name=total_disbursed,json=totalDisbursed,proto3" json:"total_disbursed,omitempty"`
+	TotalInterest      float64           `protobuf:"fixed64,7,opt,name=total_interest,json=totalInterest,proto3" json:"total_interest,omitempty"`
+	TotalCharges       float64           `protobuf:"fixed64,8,opt,name=total_charges,json=totalCharges,proto3" json:"total_charges,omitempty"`
+	TotalRepaid        float64           `protobuf:"fixed64,9,opt,name=total_repaid,json=totalRepaid,proto3" json:"total_repaid,omitempty"`
+	OutstandingBalance float64           `protobuf:"fixed64,10,opt,name=outstanding_balance,json=outstandingBalance,proto3" json:"outstanding_balance,omitempty"`
+	NextInstallment    *LoanInstallment  `protobuf:"bytes,11,opt,name=next_installment,json=nextInstallment,proto3" json:"next_installment,omitempty"`
+}
+
+func (x *LoanStatement) Reset() {
+	*x = LoanStatement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoanStatement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanStatement) ProtoMessage() {}
+
+func (x *LoanStatement) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanStatement.ProtoReflect.Descriptor instead.
+func (*LoanStatement) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *LoanStatement) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *LoanStatement) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *LoanStatement) GetLoaneeNames() string {
+	if x != nil {
+		return x.LoaneeNames
+	}
+	return ""
+}
+
+func (x *LoanStatement) GetStatementDate() string {
+	if x != nil {
+		return x.StatementDate
+	}
+	return ""
+}
+
+func (x *LoanStatement) GetEntries() []*StatementEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *LoanStatement) GetTotalDisbursed() float64 {
+	if x != nil {
+		return x.TotalDisbursed
+	}
+	return 0
+}
+
+func (x *LoanStatement) GetTotalInterest() float64 {
+	if x != nil {
+		return x.TotalInterest
+	}
+	return 0
+}
+
+func (x *LoanStatement) GetTotalCharges() float64 {
+	if x != nil {
+		return x.TotalCharges
+	}
+	return 0
+}
+
+func (x *LoanStatement) GetTotalRepaid() float64 {
+	if x != nil {
+		return x.TotalRepaid
+	}
+	return 0
+}
+
+func (x *LoanStatement) GetOutstandingBalance() float64 {
+	if x != nil {
+		return x.OutstandingBalance
+	}
+	return 0
+}
+
+func (x *LoanStatement) GetNextInstallment() *LoanInstallment {
+	if x != nil {
+		return x.NextInstallment
+	}
+	return nil
+}
+
+type GetLoanStatementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId   string          `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	MemberId string          `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Format   StatementFormat `protobuf:"varint,3,opt,name=format,proto3,enum=gidyon.loan.StatementFormat" json:"format,omitempty"`
+}
+
+func (x *GetLoanStatementRequest) Reset() {
+	*x = GetLoanStatementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoanStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanStatementRequest) ProtoMessage() {}
+
+func (x *GetLoanStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanStatementRequest) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetLoanStatementRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *GetLoanStatementRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *GetLoanStatementRequest) GetFormat() StatementFormat {
+	if x != nil {
+		return x.Format
+	}
+	return StatementFormat_STATEMENT_NONE
+}
+
+type GetLoanStatementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Statement   *LoanStatement `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+	Document    []byte         `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`
+	ContentType string         `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	FileName    string         `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+}
+
+func (x *GetLoanStatementResponse) Reset() {
+	*x = GetLoanStatementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoanStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanStatementResponse) ProtoMessage() {}
+
+func (x *GetLoanStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanStatementResponse.ProtoReflect.Descriptor instead.
+func (*GetLoanStatementResponse) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetLoanStatementResponse) GetStatement() *LoanStatement {
+	if x != nil {
+		return x.Statement
+	}
+	return nil
+}
+
+func (x *GetLoanStatementResponse) GetDocument() []byte {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *GetLoanStatementResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetLoanStatementResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+var File_loan_proto protoreflect.FileDescriptor
+
+var file_loan_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x83, 0x02, 0x0a, 0x10, 0x45, 0x6c, 0x69, 0x67, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x6d, 0x61, 0x78,
+	0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x6d, 0x69, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x44, 0x61, 0x79,
+	0x73, 0x12, 0x35, 0x0a, 0x17, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c, 0x6f, 0x61, 0x6e,
+	0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x61, 0x72, 0x72, 0x65, 0x61, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x14, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x49,
+	0x6e, 0x41, 0x72, 0x72, 0x65, 0x61, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x61,
+	0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x69,
+	0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xd0, 0x01, 0x0a,
+	0x07, 0x4c, 0x6f, 0x61, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0b,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e,
+	0x46, 0x65, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x72, 0x65, 0x61, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x46, 0x65, 0x65, 0x54, 0x72, 0x65, 0x61, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x09, 0x74, 0x72, 0x65, 0x61, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0xca, 0x01, 0x0a, 0x0b, 0x47, 0x72, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x35, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x2e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x67, 0x72, 0x61,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x67,
+	0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x63,
+	0x61, 0x70, 0x69, 0x74, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13,
+	0x6d, 0x61, 0x78, 0x5f, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x48, 0x6f,
+	0x6c, 0x69, 0x64, 0x61, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x22, 0xb6, 0x01, 0x0a,
+	0x0d, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3b,
+	0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x2e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b,
+	0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x63, 0x61, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x64,
+	0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x67, 0x72, 0x61, 0x63, 0x65,
+	0x44, 0x61, 0x79, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x64,
+	0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x44, 0x61, 0x79, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x56,
+	0x6f, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11,
+	0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x42, 0x0a, 0x1d, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x1b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x85, 0x02,
+	0x0a, 0x15, 0x45, 0x61, 0x72, 0x6c, 0x79, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x49, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x20, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x45,
+	0x61, 0x72, 0x6c, 0x79, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x72,
+	0x65, 0x62, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x44, 0x61, 0x79, 0x73, 0x22, 0xe7, 0x09, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x6c, 0x6f, 0x61, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x61, 0x79, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6c, 0x6f, 0x61, 0x6e, 0x4d, 0x69, 0x6e, 0x69, 0x6d,
+	0x75, 0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6c, 0x6f, 0x61, 0x6e, 0x4d, 0x61, 0x78, 0x69, 0x6d,
+	0x75, 0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6c, 0x6f, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6c, 0x6f, 0x61, 0x6e, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x30,
+	0x0a, 0x14, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6c, 0x6f,
+	0x61, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x61, 0x6e,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64,
+	0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x4a, 0x0a, 0x11, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x10, 0x65, 0x6c, 0x69, 0x67, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x72,
+	0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x72, 0x65, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x44, 0x61, 0x79, 0x73, 0x12,
+	0x41, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x44, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x5a, 0x0a, 0x17, 0x65, 0x61, 0x72, 0x6c,
+	0x79, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x45, 0x61, 0x72, 0x6c, 0x79, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x15, 0x65,
+	0x61, 0x72, 0x6c, 0x79, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
+	0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73,
+	0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x04, 0x66, 0x65,
+	0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22,
+	0x81, 0x01, 0x0a, 0x12, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2e, 0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f,
+	0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x22, 0xa3, 0x02, 0x0a, 0x0f, 0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69,
+	0x70, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x70,
+	0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x44, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x44, 0x75, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x75, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0xfb, 0x06, 0x0a, 0x04, 0x4c, 0x6f,
+	0x61, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x61, 0x6e, 0x65, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x61, 0x6e, 0x65, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x61, 0x6e, 0x65, 0x65, 0x5f,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x61,
+	0x6e, 0x65, 0x65, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x61, 0x6e,
+	0x65, 0x65, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6c, 0x6f, 0x61, 0x6e, 0x65, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c, 0x6f, 0x61, 0x6e, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x6f,
+	0x72, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c,
+	0x6f, 0x61, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x6e,
+	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x64, 0x5f, 0x6f, 0x76,
+	0x65, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x11, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x5f, 0x6f, 0x66,
+	0x66, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10,
+	0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x4f, 0x66, 0x66, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66,
+	0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6e, 0x65,
+	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0c, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0b, 0x6c, 0x6f, 0x61, 0x6e, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x5d, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x41, 0x0a, 0x0c, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0b, 0x6c, 0x6f, 0x61, 0x6e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x3f, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c,
+	0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x11, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x0c, 0x6c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9c, 0x01, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x61, 0x73, 0x5f, 0x6f,
+	0x66, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73,
+	0x4f, 0x66, 0x44, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x5f, 0x62, 0x6f,
+	0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74,
+	0x6f, 0x70, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x0b,
+	0x54, 0x6f, 0x70, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x61, 0x6e,
+	0x65, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6c, 0x6f, 0x61, 0x6e, 0x65, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x6f, 0x61, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x6e,
+	0x73, 0x12, 0x33, 0x0a, 0x15, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x14, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x22, 0xbd, 0x05, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x4c, 0x6f, 0x61, 0x6e,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x62, 0x75, 0x72, 0x73, 0x65, 0x64, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x69, 0x73,
+	0x62, 0x75, 0x72, 0x73, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x15,
+	0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x6e,
+	0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x6f, 0x75, 0x74,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x12, 0x31, 0x0a, 0x14, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x13, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
+	0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x33, 0x30, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x33,
+	0x30, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x39, 0x30,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70,
+	0x61, 0x72, 0x39, 0x30, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61,
+	0x72, 0x33, 0x30, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x33, 0x30, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x39, 0x30, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0f, 0x70, 0x61, 0x72, 0x39, 0x30, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x5f, 0x62,
+	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x54, 0x6f, 0x70,
+	0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x0c, 0x74, 0x6f, 0x70, 0x42, 0x6f, 0x72,
+	0x72, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x22, 0x6a, 0x0a, 0x0f, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x61, 0x73, 0x5f,
+	0x6f, 0x66, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x73, 0x4f, 0x66, 0x44, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x73, 0x22, 0x5c, 0x0a, 0x19, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x61,
+	0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x22, 0x3c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x22, 0x40,
+	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e,
+	0x4c, 0x6f, 0x61, 0x6e, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x6e,
+	0x22, 0x40, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x6c, 0x6f,
+	0x61, 0x6e, 0x22, 0x72, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x4c,
+	0x6f, 0x61, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x7f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f,
+	0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x64, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05,
+	0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x05,
+	0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2f, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x76,
+	0x0a, 0x12, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x6c, 0x6f, 0x61,
+	0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xf2, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x61, 0x6e, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x74, 0x0a, 0x13, 0x43,
+	0x61, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x75,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x46, 0x61, 0x76, 0x6f, 0x75,
+	0x72, 0x22, 0xe8, 0x02, 0x0a, 0x0f, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x12, 0x37, 0x0a, 0x09,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f,
+	0x61, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x76,
+	0x6f, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f,
+	0x66, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x46, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x67, 0x61,
+	0x69, 0x6e, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6c, 0x69, 0x67,
+	0x69, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x73, 0x61, 0x74, 0x69,
+	0x73, 0x66, 0x69, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x53, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x22, 0x76, 0x0a, 0x1b,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x11, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x10,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x10, 0x54, 0x6f, 0x70,
+	0x55, 0x70, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0d,
+	0x74, 0x6f, 0x70, 0x5f, 0x75, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0b, 0x74, 0x6f, 0x70, 0x55, 0x70,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x22, 0x32, 0x0a, 0x11, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22,
+	0x87, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x4c,
+	0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x02, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x0d, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x79, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0x7b, 0x0a, 0x13, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x4f, 0x66, 0x66, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xf1, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x61, 0x6e, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x12, 0x20, 0x0a, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x5f, 0x6f, 0x66, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xcd, 0x01, 0x0a, 0x14, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x5f, 0x6f,
+	0x66, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65,
+	0x6e, 0x4f, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x5f,
+	0x6f, 0x66, 0x66, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x10, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x4f, 0x66, 0x66, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x73, 0x12, 0x37, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x52,
+	0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x0e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x08, 0x64, 0x70, 0x64, 0x5f, 0x31,
+	0x5f, 0x33, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x64, 0x70, 0x64, 0x31, 0x33,
+	0x30, 0x12, 0x1a, 0x0a, 0x09, 0x64, 0x70, 0x64, 0x5f, 0x33, 0x31, 0x5f, 0x39, 0x30, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x64, 0x70, 0x64, 0x33, 0x31, 0x39, 0x30, 0x12, 0x1e, 0x0a,
+	0x0b, 0x64, 0x70, 0x64, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x39, 0x30, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x09, 0x64, 0x70, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x39, 0x30, 0x22, 0xec, 0x01,
+	0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x36, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e,
+	0x44, 0x61, 0x79, 0x73, 0x50, 0x61, 0x73, 0x74, 0x44, 0x75, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x61,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x12,
+	0x2d, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6f, 0x75, 0x74,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31,
+	0x0a, 0x14, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9f, 0x01, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x61, 0x73,
+	0x5f, 0x6f, 0x66, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x73, 0x4f, 0x66, 0x44, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x73, 0x22, 0xee,
+	0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73, 0x4f, 0x66, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x75, 0x74,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x5f, 0x6f, 0x66, 0x66,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x77,
+	0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x4f, 0x66, 0x66, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x54, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x61, 0x73, 0x5f, 0x6f, 0x66,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73, 0x4f,
+	0x66, 0x44, 0x61, 0x74, 0x65, 0x22, 0xb7, 0x03, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x0f, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x2e, 0x45, 0x61, 0x72, 0x6c, 0x79, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x0a, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73, 0x4f, 0x66, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x70,
+	0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x62, 0x61, 0x74, 0x65, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x73,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x6a, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x8e, 0x03, 0x0a, 0x0a,
+	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x0a, 0x0f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x6c, 0x69,
+	0x65, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x69,
+	0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x6c, 0x69, 0x65, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x59, 0x0a, 0x18,
+	0x41, 0x64, 0x64, 0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
+	0x74, 0x65, 0x72, 0x61, 0x6c, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x22, 0x82, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4c, 0x69, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
+	0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x02, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x49, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x6c, 0x69, 0x65, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x0a, 0x6c, 0x69, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3a, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x6c, 0x6f, 0x61,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02,
+	0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x73, 0x22, 0xb8, 0x02, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x61, 0x79, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x64, 0x61, 0x79, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xc3, 0x03, 0x0a,
+	0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x3a, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x20, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x22, 0x65, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x43, 0x0a, 0x20, 0x4c, 0x69, 0x73,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x22, 0x64,
+	0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x1a, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x22, 0xa0, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x22, 0x8f, 0x01, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x41, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x82, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f,
+	0x6c, 0x69, 0x64, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x69, 0x66, 0x74,
+	0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x44, 0x61, 0x79, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x7b, 0x0a, 0x1c, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69,
+	0x64, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x02, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x07, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02,
+	0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06,
+	0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64,
+	0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x79, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6c, 0x69, 0x67,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c, 0x6f, 0x61,
+	0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x22, 0x68, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0xca,
+	0x01, 0x0a, 0x13, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x42, 0x0a, 0x1d, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0xfc, 0x02, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x62, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x62,
+	0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xd8,
+	0x03, 0x0a, 0x0d, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x61, 0x6e, 0x65, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f,
+	0x61, 0x6e, 0x65, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x64, 0x69, 0x73, 0x62, 0x75, 0x72, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x62, 0x75, 0x72, 0x73, 0x65, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x61, 0x69, 0x64, 0x12,
+	0x2f, 0x0a, 0x13, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6f, 0x75,
+	0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x47, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x22, 0xb0, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e,
+	0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x2a, 0x32, 0x0a, 0x0e, 0x46, 0x65, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x45, 0x45, 0x5f, 0x46, 0x4c,
+	0x41, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x45, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x43,
+	0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10, 0x01, 0x2a, 0x35, 0x0a, 0x0c, 0x46, 0x65, 0x65, 0x54,
+	0x72, 0x65, 0x61, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x45, 0x45, 0x5f,
+	0x44, 0x45, 0x44, 0x55, 0x43, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x45,
+	0x45, 0x5f, 0x43, 0x41, 0x50, 0x49, 0x54, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x01, 0x2a,
+	0x43, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a,
+	0x47, 0x52, 0x41, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
+	0x49, 0x4e, 0x54, 0x45, 0x52, 0x45, 0x53, 0x54, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12,
+	0x13, 0x0a, 0x0f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x4d, 0x4f, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x49,
+	0x55, 0x4d, 0x10, 0x02, 0x2a, 0x68, 0x0a, 0x0b, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x4c, 0x41, 0x54, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x50,
+	0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x44, 0x41,
+	0x59, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47,
+	0x45, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x10, 0x03, 0x2a, 0x54,
+	0x0a, 0x13, 0x45, 0x61, 0x72, 0x6c, 0x79, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x5f,
+	0x52, 0x41, 0x54, 0x41, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12,
+	0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x42, 0x41,
+	0x54, 0x45, 0x10, 0x02, 0x2a, 0xaf, 0x01, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41,
+	0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50,
+	0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x55, 0x4e, 0x44, 0x53,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x4e, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55,
+	0x4e, 0x54, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x46, 0x55, 0x4e, 0x44, 0x53, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x03,
+	0x12, 0x14, 0x0a, 0x10, 0x46, 0x55, 0x4e, 0x44, 0x53, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x45, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x46, 0x49, 0x4e, 0x41,
+	0x4e, 0x43, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x52, 0x49, 0x54, 0x54, 0x45,
+	0x4e, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45,
+	0x4c, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x2a, 0x4e, 0x0a, 0x11, 0x44, 0x61, 0x79, 0x73, 0x50, 0x61,
+	0x73, 0x74, 0x44, 0x75, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x0b, 0x0a, 0x07, 0x43,
+	0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x50, 0x44, 0x5f,
+	0x31, 0x5f, 0x33, 0x30, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x50, 0x44, 0x5f, 0x33, 0x31,
+	0x5f, 0x39, 0x30, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x50, 0x44, 0x5f, 0x4f, 0x56, 0x45,
+	0x52, 0x5f, 0x39, 0x30, 0x10, 0x03, 0x2a, 0x59, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4c, 0x4c,
+	0x41, 0x54, 0x45, 0x52, 0x41, 0x4c, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0e,
+	0x0a, 0x0a, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x4c, 0x4f, 0x47, 0x42, 0x4f, 0x4f, 0x4b, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x48,
+	0x4f, 0x55, 0x53, 0x45, 0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x53, 0x10,
+	0x03, 0x2a, 0x46, 0x0a, 0x0a, 0x4c, 0x69, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x10, 0x0a, 0x0c, 0x4c, 0x49, 0x45, 0x4e, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x49, 0x45, 0x4e, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54,
+	0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x49, 0x45, 0x4e, 0x5f, 0x52,
+	0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x7b, 0x0a, 0x11, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x22,
+	0x0a, 0x1e, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x55, 0x50, 0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
+	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x55, 0x45, 0x10,
+	0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x41, 0x4e, 0x5f, 0x49, 0x4e, 0x5f, 0x41, 0x52, 0x52,
+	0x45, 0x41, 0x52, 0x53, 0x10, 0x03, 0x2a, 0x39, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x0f, 0x0a,
+	0x0b, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x4d, 0x53, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10,
+	0x01, 0x2a, 0x4e, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f,
+	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x45, 0x4c,
+	0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
+	0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x02, 0x2a, 0xb5, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4e, 0x54,
+	0x52, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x42, 0x55, 0x52, 0x53, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10,
+	0x01, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x43,
+	0x48, 0x41, 0x52, 0x47, 0x45, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x4e, 0x54, 0x52, 0x59,
+	0x5f, 0x52, 0x45, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f,
+	0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x10,
+	0x05, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x43, 0x41, 0x52, 0x52, 0x49,
+	0x45, 0x44, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x06, 0x2a, 0x4b, 0x0a, 0x0f, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x0e,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x53,
+	0x56, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x50, 0x44, 0x46, 0x10, 0x02, 0x32, 0x80, 0x08, 0x0a, 0x0e, 0x4c, 0x6f, 0x61, 0x6e, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x50, 0x49, 0x12, 0x78, 0x0a, 0x11, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x25,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d,
+	0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f,
+	0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x25, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f,
+	0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38,
+	0x3a, 0x01, 0x2a, 0x32, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2f, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x7b,
+	0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x25,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x28, 0x2a, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2f, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73,
+	0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xb3, 0x01,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x73, 0x12, 0x24, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4c, 0x5a, 0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x4c, 0x6f, 0x61, 0x6e, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d,
+	0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x73, 0x12, 0x7e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x7d, 0x12, 0x8d, 0x01, 0x0a, 0x12, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c,
+	0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x26, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x31, 0x3a, 0x01, 0x2a, 0x22, 0x2c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2f, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73,
+	0x3a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x12, 0x93, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x3a, 0x01, 0x2a, 0x22, 0x2c, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x3a, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x32, 0xa0, 0x1d, 0x0a, 0x07, 0x4c, 0x6f,
+	0x61, 0x6e, 0x41, 0x50, 0x49, 0x12, 0x63, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c,
+	0x6f, 0x61, 0x6e, 0x12, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x72, 0x0a, 0x0a, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x32, 0x21, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x2f,
+	0x7b, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x89,
+	0x01, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f,
+	0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x37, 0x5a, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d,
+	0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x6c, 0x69, 0x73,
+	0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x5f, 0x0a, 0x07, 0x47, 0x65,
+	0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e,
+	0x73, 0x2f, 0x7b, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x71, 0x0a, 0x0b, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1f, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61,
+	0x6e, 0x73, 0x3a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x8f,
+	0x01, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x24, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6c, 0x69,
+	0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0xc9, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x61, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x5b, 0x5a, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x67, 0x65, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f,
+	0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x67, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x74, 0x0a, 0x0c,
+	0x43, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x43, 0x61, 0x73, 0x74, 0x4c,
+	0x6f, 0x61, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01,
+	0x2a, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f,
+	0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0xc5, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x28, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x58, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x52, 0x5a, 0x34, 0x3a, 0x01, 0x2a, 0x22, 0x2f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x1a,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61,
+	0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x66, 0x0a, 0x09, 0x54, 0x6f,
+	0x70, 0x55, 0x70, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x4c, 0x6f, 0x61, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61,
+	0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x74, 0x6f, 0x70, 0x55, 0x70, 0x4c, 0x6f,
+	0x61, 0x6e, 0x12, 0x69, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x6e,
+	0x12, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c,
+	0x6f, 0x61, 0x6e, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61,
+	0x6e, 0x73, 0x3a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x78, 0x0a,
+	0x0f, 0x52, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x61, 0x6e,
+	0x12, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
+	0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d,
+	0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x72, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x7f, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x4f, 0x66, 0x66, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x20, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x4c, 0x6f,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66,
+	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x4f, 0x66, 0x66, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x98, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x33,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x67, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x7c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61,
+	0x6e, 0x73, 0x3a, 0x67, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x12, 0x66, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1d,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x52, 0x65, 0x70,
+	0x61, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e,
+	0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a,
+	0x72, 0x65, 0x70, 0x61, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x7c, 0x0a, 0x11, 0x41, 0x64, 0x64,
+	0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x25,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x41, 0x64, 0x64,
+	0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x22, 0x27,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x12, 0x96, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4c, 0x69, 0x65, 0x6e,
+	0x12, 0x28, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4c,
+	0x69, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x3a, 0x01, 0x2a, 0x22, 0x31, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x3a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4c, 0x69, 0x65, 0x6e,
+	0x12, 0xc1, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x26, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x54,
+	0x5a, 0x34, 0x3a, 0x01, 0x2a, 0x22, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68,
+	0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63,
+	0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x73, 0x12, 0xa0, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x2b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x53,
+	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a,
+	0x73, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0xe3, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x61, 0x5a, 0x31, 0x3a, 0x01,
+	0x2a, 0x22, 0x2c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f,
+	0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x2c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f,
+	0x61, 0x6e, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0xe8, 0x01,
+	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x63, 0x5a, 0x32, 0x3a, 0x01, 0x2a, 0x22, 0x2d, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x6c,
+	0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x6c, 0x69,
+	0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x96, 0x01, 0x0a, 0x15, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64,
+	0x61, 0x79, 0x12, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48,
+	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x22, 0x33, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x79, 0x12, 0xcf, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x12, 0x29, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x5f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x59, 0x5a, 0x2d, 0x3a, 0x01, 0x2a, 0x22,
+	0x28, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f,
+	0x61, 0x6e, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x12, 0x28, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64,
+	0x61, 0x79, 0x73, 0x12, 0xb6, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4f, 0x5a, 0x28, 0x3a,
+	0x01, 0x2a, 0x22, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61,
+	0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61,
+	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x67, 0x65, 0x74, 0x4c,
+	0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x34, 0x5a, 0x32,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f,
+	0x61, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_loan_proto_rawDescOnce sync.Once
+	file_loan_proto_rawDescData = file_loan_proto_rawDesc
+)
+
+func file_loan_proto_rawDescGZIP() []byte {
+	file_loan_proto_rawDescOnce.Do(func() {
+		file_loan_proto_rawDescData = protoimpl.X.CompressGZIP(file_loan_proto_rawDescData)
+	})
+	return file_loan_proto_rawDescData
+}
+
+var file_loan_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_loan_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
+var file_loan_proto_goTypes = []interface{}{
+	(FeeCalculation)(0),                        // 0: gidyon.loan.FeeCalculation
+	(FeeTreatment)(0),                          // 1: gidyon.loan.FeeTreatment
+	(GraceType)(0),                             // 2: gidyon.loan.GraceType
+	(PenaltyType)(0),                           // 3: gidyon.loan.PenaltyType
+	(EarlySettlementType)(0),                   // 4: gidyon.loan.EarlySettlementType
+	(LoanStatus)(0),                            // 5: gidyon.loan.LoanStatus
+	(DaysPastDueBucket)(0),                     // 6: gidyon.loan.DaysPastDueBucket
+	(CollateralType)(0),                        // 7: gidyon.loan.CollateralType
+	(LienStatus)(0),                            // 8: gidyon.loan.LienStatus
+	(NotificationEvent)(0),                     // 9: gidyon.loan.NotificationEvent
+	(NotificationChannel)(0),                   // 10: gidyon.loan.NotificationChannel
+	(DeliveryStatus)(0),                        // 11: gidyon.loan.DeliveryStatus
+	(StatementEntryType)(0),                    // 12: gidyon.loan.StatementEntryType
+	(StatementFormat)(0),                       // 13: gidyon.loan.StatementFormat
+	(*EligibilityRules)(nil),                   // 14: gidyon.loan.EligibilityRules
+	(*LoanFee)(nil),                            // 15: gidyon.loan.LoanFee
+	(*GracePolicy)(nil),                        // 16: gidyon.loan.GracePolicy
+	(*PenaltyPolicy)(nil),                      // 17: gidyon.loan.PenaltyPolicy
+	(*ApprovalPolicy)(nil),                     // 18: gidyon.loan.ApprovalPolicy
+	(*EarlySettlementPolicy)(nil),              // 19: gidyon.loan.EarlySettlementPolicy
+	(*LoanProduct)(nil),                        // 20: gidyon.loan.LoanProduct
+	(*LoanProductVersion)(nil),                 // 21: gidyon.loan.LoanProductVersion
+	(*LoanInstallment)(nil),                    // 22: gidyon.loan.LoanInstallment
+	(*Loan)(nil),                               // 23: gidyon.loan.Loan
+	(*CreateLoanProductRequest)(nil),           // 24: gidyon.loan.CreateLoanProductRequest
+	(*UpdateLoanProductRequest)(nil),           // 25: gidyon.loan.UpdateLoanProductRequest
+	(*DeleteLoanProductRequest)(nil),           // 26: gidyon.loan.DeleteLoanProductRequest
+	(*LoanProductFilter)(nil),                  // 27: gidyon.loan.LoanProductFilter
+	(*ListLoanProductsRequest)(nil),            // 28: gidyon.loan.ListLoanProductsRequest
+	(*ListLoanProductsResponse)(nil),           // 29: gidyon.loan.ListLoanProductsResponse
+	(*GetPortfolioReportRequest)(nil),          // 30: gidyon.loan.GetPortfolioReportRequest
+	(*TopBorrower)(nil),                        // 31: gidyon.loan.TopBorrower
+	(*ProductPortfolio)(nil),                   // 32: gidyon.loan.ProductPortfolio
+	(*PortfolioReport)(nil),                    // 33: gidyon.loan.PortfolioReport
+	(*ArchiveLoanProductRequest)(nil),          // 34: gidyon.loan.ArchiveLoanProductRequest
+	(*GetLoanProductRequest)(nil),              // 35: gidyon.loan.GetLoanProductRequest
+	(*CreateLoanRequest)(nil),                  // 36: gidyon.loan.CreateLoanRequest
+	(*UpdateLoanRequest)(nil),                  // 37: gidyon.loan.UpdateLoanRequest
+	(*LoanFilter)(nil),                         // 38: gidyon.loan.LoanFilter
+	(*ListLoansRequest)(nil),                   // 39: gidyon.loan.ListLoansRequest
+	(*ListLoansResponse)(nil),                  // 40: gidyon.loan.ListLoansResponse
+	(*GetLoanRequest)(nil),                     // 41: gidyon.loan.GetLoanRequest
+	(*ApproveLoanRequest)(nil),                 // 42: gidyon.loan.ApproveLoanRequest
+	(*LoanApproval)(nil),                       // 43: gidyon.loan.LoanApproval
+	(*CastLoanVoteRequest)(nil),                // 44: gidyon.loan.CastLoanVoteRequest
+	(*PendingApproval)(nil),                    // 45: gidyon.loan.PendingApproval
+	(*ListPendingApprovalsRequest)(nil),        // 46: gidyon.loan.ListPendingApprovalsRequest
+	(*ListPendingApprovalsResponse)(nil),       // 47: gidyon.loan.ListPendingApprovalsResponse
+	(*TopUpLoanRequest)(nil),                   // 48: gidyon.loan.TopUpLoanRequest
+	(*CancelLoanRequest)(nil),                  // 49: gidyon.loan.CancelLoanRequest
+	(*RestructureLoanRequest)(nil),             // 50: gidyon.loan.RestructureLoanRequest
+	(*WriteOffLoanRequest)(nil),                // 51: gidyon.loan.WriteOffLoanRequest
+	(*LoanWriteOff)(nil),                       // 52: gidyon.loan.LoanWriteOff
+	(*WriteOffLoanResponse)(nil),               // 53: gidyon.loan.WriteOffLoanResponse
+	(*ProvisionRates)(nil),                     // 54: gidyon.loan.ProvisionRates
+	(*ProvisionBucket)(nil),                    // 55: gidyon.loan.ProvisionBucket
+	(*GetProvisioningReportRequest)(nil),       // 56: gidyon.loan.GetProvisioningReportRequest
+	(*ProvisioningReport)(nil),                 // 57: gidyon.loan.ProvisioningReport
+	(*GetPayoffQuoteRequest)(nil),              // 58: gidyon.loan.GetPayoffQuoteRequest
+	(*PayoffQuote)(nil),                        // 59: gidyon.loan.PayoffQuote
+	(*RepayLoanRequest)(nil),                   // 60: gidyon.loan.RepayLoanRequest
+	(*Collateral)(nil),                         // 61: gidyon.loan.Collateral
+	(*AddLoanCollateralRequest)(nil),           // 62: gidyon.loan.AddLoanCollateralRequest
+	(*UpdateCollateralLienRequest)(nil),        // 63: gidyon.loan.UpdateCollateralLienRequest
+	(*ListLoanCollateralRequest)(nil),          // 64: gidyon.loan.ListLoanCollateralRequest
+	(*ListLoanCollateralResponse)(nil),         // 65: gidyon.loan.ListLoanCollateralResponse
+	(*NotificationTemplate)(nil),               // 66: gidyon.loan.NotificationTemplate
+	(*NotificationDelivery)(nil),               // 67: gidyon.loan.NotificationDelivery
+	(*SetNotificationTemplateRequest)(nil),     // 68: gidyon.loan.SetNotificationTemplateRequest
+	(*ListNotificationTemplatesRequest)(nil),   // 69: gidyon.loan.ListNotificationTemplatesRequest
+	(*ListNotificationTemplatesResponse)(nil),  // 70: gidyon.loan.ListNotificationTemplatesResponse
+	(*NotificationDeliveryFilter)(nil),         // 71: gidyon.loan.NotificationDeliveryFilter
+	(*ListNotificationDeliveriesRequest)(nil),  // 72: gidyon.loan.ListNotificationDeliveriesRequest
+	(*ListNotificationDeliveriesResponse)(nil), // 73: gidyon.loan.ListNotificationDeliveriesResponse
+	(*RepaymentHoliday)(nil),                   // 74: gidyon.loan.RepaymentHoliday
+	(*GrantRepaymentHolidayRequest)(nil),       // 75: gidyon.loan.GrantRepaymentHolidayRequest
+	(*ListRepaymentHolidaysRequest)(nil),       // 76: gidyon.loan.ListRepaymentHolidaysRequest
+	(*ListRepaymentHolidaysResponse)(nil),      // 77: gidyon.loan.ListRepaymentHolidaysResponse
+	(*CheckEligibilityRequest)(nil),            // 78: gidyon.loan.CheckEligibilityRequest
+	(*CheckEligibilityResponse)(nil),           // 79: gidyon.loan.CheckEligibilityResponse
+	(*CreditFactor)(nil),                       // 80: gidyon.loan.CreditFactor
+	(*MemberCreditProfile)(nil),                // 81: gidyon.loan.MemberCreditProfile
+	(*GetMemberCreditProfileRequest)(nil),      // 82: gidyon.loan.GetMemberCreditProfileRequest
+	(*StatementEntry)(nil),                     // 83: gidyon.loan.StatementEntry
+	(*LoanStatement)(nil),                      // 84: gidyon.loan.LoanStatement
+	(*GetLoanStatementRequest)(nil),            // 85: gidyon.loan.GetLoanStatementRequest
+	(*GetLoanStatementResponse)(nil),           // 86: gidyon.loan.GetLoanStatementResponse
+	(*emptypb.Empty)(nil),                      // 87: google.protobuf.Empty
+}
+var file_loan_proto_depIdxs = []int32{
+	0,  // 0: gidyon.loan.LoanFee.calculation:type_name -> gidyon.loan.FeeCalculation
+	1,  // 1: gidyon.loan.LoanFee.treatment:type_name -> gidyon.loan.FeeTreatment
+	2,  // 2: gidyon.loan.GracePolicy.grace_type:type_name -> gidyon.loan.GraceType
+	3,  // 3: gidyon.loan.PenaltyPolicy.penalty_type:type_name -> gidyon.loan.PenaltyType
+	4,  // 4: gidyon.loan.EarlySettlementPolicy.settlement_type:type_name -> gidyon.loan.EarlySettlementType
+	14, // 5: gidyon.loan.LoanProduct.eligibility_rules:type_name -> gidyon.loan.EligibilityRules
+	17, // 6: gidyon.loan.LoanProduct.penalty_policy:type_name -> gidyon.loan.PenaltyPolicy
+	18, // 7: gidyon.loan.LoanProduct.approval_policy:type_name -> gidyon.loan.ApprovalPolicy
+	19, // 8: gidyon.loan.LoanProduct.early_settlement_policy:type_name -> gidyon.loan.EarlySettlementPolicy
+	21, // 9: gidyon.loan.LoanProduct.versions:type_name -> gidyon.loan.LoanProductVersion
+	15, // 10: gidyon.loan.LoanProduct.fees:type_name -> gidyon.loan.LoanFee
+	16, // 11: gidyon.loan.LoanProduct.grace_policy:type_name -> gidyon.loan.GracePolicy
+	20, // 12: gidyon.loan.LoanProductVersion.terms:type_name -> gidyon.loan.LoanProduct
+	5,  // 13: gidyon.loan.Loan.status:type_name -> gidyon.loan.LoanStatus
+	22, // 14: gidyon.loan.Loan.installments:type_name -> gidyon.loan.LoanInstallment
+	20, // 15: gidyon.loan.CreateLoanProductRequest.loan_product:type_name -> gidyon.loan.LoanProduct
+	20, // 16: gidyon.loan.UpdateLoanProductRequest.loan_product:type_name -> gidyon.loan.LoanProduct
+	27, // 17: gidyon.loan.ListLoanProductsRequest.filter:type_name -> gidyon.loan.LoanProductFilter
+	20, // 18: gidyon.loan.ListLoanProductsResponse.loan_products:type_name -> gidyon.loan.LoanProduct
+	31, // 19: gidyon.loan.ProductPortfolio.top_borrowers:type_name -> gidyon.loan.TopBorrower
+	32, // 20: gidyon.loan.PortfolioReport.products:type_name -> gidyon.loan.ProductPortfolio
+	23, // 21: gidyon.loan.CreateLoanRequest.loan:type_name -> gidyon.loan.Loan
+	23, // 22: gidyon.loan.UpdateLoanRequest.loan:type_name -> gidyon.loan.Loan
+	38, // 23: gidyon.loan.ListLoansRequest.filter:type_name -> gidyon.loan.LoanFilter
+	23, // 24: gidyon.loan.ListLoansResponse.loans:type_name -> gidyon.loan.Loan
+	23, // 25: gidyon.loan.PendingApproval.loan:type_name -> gidyon.loan.Loan
+	43, // 26: gidyon.loan.PendingApproval.approvals:type_name -> gidyon.loan.LoanApproval
+	45, // 27: gidyon.loan.ListPendingApprovalsResponse.pending_approvals:type_name -> gidyon.loan.PendingApproval
+	52, // 28: gidyon.loan.WriteOffLoanResponse.approvals:type_name -> gidyon.loan.LoanWriteOff
+	6,  // 29: gidyon.loan.ProvisionBucket.bucket:type_name -> gidyon.loan.DaysPastDueBucket
+	54, // 30: gidyon.loan.GetProvisioningReportRequest.provision_rates:type_name -> gidyon.loan.ProvisionRates
+	55, // 31: gidyon.loan.ProvisioningReport.buckets:type_name -> gidyon.loan.ProvisionBucket
+	4,  // 32: gidyon.loan.PayoffQuote.settlement_type:type_name -> gidyon.loan.EarlySettlementType
+	7,  // 33: gidyon.loan.Collateral.collateral_type:type_name -> gidyon.loan.CollateralType
+	8,  // 34: gidyon.loan.Collateral.lien_status:type_name -> gidyon.loan.LienStatus
+	61, // 35: gidyon.loan.AddLoanCollateralRequest.collateral:type_name -> gidyon.loan.Collateral
+	8,  // 36: gidyon.loan.UpdateCollateralLienRequest.lien_status:type_name -> gidyon.loan.LienStatus
+	61, // 37: gidyon.loan.ListLoanCollateralResponse.collaterals:type_name -> gidyon.loan.Collateral
+	9,  // 38: gidyon.loan.NotificationTemplate.event:type_name -> gidyon.loan.NotificationEvent
+	10, // 39: gidyon.loan.NotificationTemplate.channel:type_name -> gidyon.loan.NotificationChannel
+	9,  // 40: gidyon.loan.NotificationDelivery.event:type_name -> gidyon.loan.NotificationEvent
+	10, // 41: gidyon.loan.NotificationDelivery.channel:type_name -> gidyon.loan.NotificationChannel
+	11, // 42: gidyon.loan.NotificationDelivery.status:type_name -> gidyon.loan.DeliveryStatus
+	66, // 43: gidyon.loan.SetNotificationTemplateRequest.template:type_name -> gidyon.loan.NotificationTemplate
+	66, // 44: gidyon.loan.ListNotificationTemplatesResponse.templates:type_name -> gidyon.loan.NotificationTemplate
+	11, // 45: gidyon.loan.NotificationDeliveryFilter.statuses:type_name -> gidyon.loan.DeliveryStatus
+	71, // 46: gidyon.loan.ListNotificationDeliveriesRequest.filter:type_name -> gidyon.loan.NotificationDeliveryFilter
+	67, // 47: gidyon.loan.ListNotificationDeliveriesResponse.deliveries:type_name -> gidyon.loan.NotificationDelivery
+	74, // 48: gidyon.loan.ListRepaymentHolidaysResponse.holidays:type_name -> gidyon.loan.RepaymentHoliday
+	80, // 49: gidyon.loan.MemberCreditProfile.factors:type_name -> gidyon.loan.CreditFactor
+	12, // 50: gidyon.loan.StatementEntry.entry_type:type_name -> gidyon.loan.StatementEntryType
+	83, // 51: gidyon.loan.LoanStatement.entries:type_name -> gidyon.loan.StatementEntry
+	22, // 52: gidyon.loan.LoanStatement.next_installment:type_name -> gidyon.loan.LoanInstallment
+	13, // 53: gidyon.loan.GetLoanStatementRequest.format:type_name -> gidyon.loan.StatementFormat
+	84, // 54: gidyon.loan.GetLoanStatementResponse.statement:type_name -> gidyon.loan.LoanStatement
+	24, // 55: gidyon.loan.LoanProductAPI.CreateLoanProduct:input_type -> gidyon.loan.CreateLoanProductRequest
+	25, // 56: gidyon.loan.LoanProductAPI.UpdateLoanProduct:input_type -> gidyon.loan.UpdateLoanProductRequest
+	26, // 57: gidyon.loan.LoanProductAPI.DeleteLoanProduct:input_type -> gidyon.loan.DeleteLoanProductRequest
+	28, // 58: gidyon.loan.LoanProductAPI.ListLoanProducts:input_type -> gidyon.loan.ListLoanProductsRequest
+	35, // 59: gidyon.loan.LoanProductAPI.GetLoanProduct:input_type -> gidyon.loan.GetLoanProductRequest
+	34, // 60: gidyon.loan.LoanProductAPI.ArchiveLoanProduct:input_type -> gidyon.loan.ArchiveLoanProductRequest
+	30, // 61: gidyon.loan.LoanProductAPI.GetPortfolioReport:input_type -> gidyon.loan.GetPortfolioReportRequest
+	36, // 62: gidyon.loan.LoanAPI.CreateLoan:input_type -> gidyon.loan.CreateLoanRequest
+	37, // 63: gidyon.loan.LoanAPI.UpdateLoan:input_type -> gidyon.loan.UpdateLoanRequest
+	39, // 64: gidyon.loan.LoanAPI.ListLoans:input_type -> gidyon.loan.ListLoansRequest
+	41, // 65: gidyon.loan.LoanAPI.GetLoan:input_type -> gidyon.loan.GetLoanRequest
+	42, // 66: gidyon.loan.LoanAPI.ApproveLoan:input_type -> gidyon.loan.ApproveLoanRequest
+	78, // 67: gidyon.loan.LoanAPI.CheckEligibility:input_type -> gidyon.loan.CheckEligibilityRequest
+	82, // 68: gidyon.loan.LoanAPI.GetMemberCreditProfile:input_type -> gidyon.loan.GetMemberCreditProfileRequest
+	44, // 69: gidyon.loan.LoanAPI.CastLoanVote:input_type -> gidyon.loan.CastLoanVoteRequest
+	46, // 70: gidyon.loan.LoanAPI.ListPendingApprovals:input_type -> gidyon.loan.ListPendingApprovalsRequest
+	48, // 71: gidyon.loan.LoanAPI.TopUpLoan:input_type -> gidyon.loan.TopUpLoanRequest
+	49, // 72: gidyon.loan.LoanAPI.CancelLoan:input_type -> gidyon.loan.CancelLoanRequest
+	50, // 73: gidyon.loan.LoanAPI.RestructureLoan:input_type -> gidyon.loan.RestructureLoanRequest
+	51, // 74: gidyon.loan.LoanAPI.WriteOffLoan:input_type -> gidyon.loan.WriteOffLoanRequest
+	56, // 75: gidyon.loan.LoanAPI.GetProvisioningReport:input_type -> gidyon.loan.GetProvisioningReportRequest
+	58, // 76: gidyon.loan.LoanAPI.GetPayoffQuote:input_type -> gidyon.loan.GetPayoffQuoteRequest
+	60, // 77: gidyon.loan.LoanAPI.RepayLoan:input_type -> gidyon.loan.RepayLoanRequest
+	62, // 78: gidyon.loan.LoanAPI.AddLoanCollateral:input_type -> gidyon.loan.AddLoanCollateralRequest
+	63, // 79: gidyon.loan.LoanAPI.UpdateCollateralLien:input_type -> gidyon.loan.UpdateCollateralLienRequest
+	64, // 80: gidyon.loan.LoanAPI.ListLoanCollateral:input_type -> gidyon.loan.ListLoanCollateralRequest
+	68, // 81: gidyon.loan.LoanAPI.SetNotificationTemplate:input_type -> gidyon.loan.SetNotificationTemplateRequest
+	69, // 82: gidyon.loan.LoanAPI.ListNotificationTemplates:input_type -> gidyon.loan.ListNotificationTemplatesRequest
+	72, // 83: gidyon.loan.LoanAPI.ListNotificationDeliveries:input_type -> gidyon.loan.ListNotificationDeliveriesRequest
+	75, // 84: gidyon.loan.LoanAPI.GrantRepaymentHoliday:input_type -> gidyon.loan.GrantRepaymentHolidayRequest
+	76, // 85: gidyon.loan.LoanAPI.ListRepaymentHolidays:input_type -> gidyon.loan.ListRepaymentHolidaysRequest
+	85, // 86: gidyon.loan.LoanAPI.GetLoanStatement:input_type -> gidyon.loan.GetLoanStatementRequest
+	87, // 87: gidyon.loan.LoanProductAPI.CreateLoanProduct:output_type -> google.protobuf.Empty
+	87, // 88: gidyon.loan.LoanProductAPI.UpdateLoanProduct:output_type -> google.protobuf.Empty
+	87, // 89: gidyon.loan.LoanProductAPI.DeleteLoanProduct:output_type -> google.protobuf.Empty
+	29, // 90: gidyon.loan.LoanProductAPI.ListLoanProducts:output_type -> gidyon.loan.ListLoanProductsResponse
+	20, // 91: gidyon.loan.LoanProductAPI.GetLoanProduct:output_type -> gidyon.loan.LoanProduct
+	87, // 92: gidyon.loan.LoanProductAPI.ArchiveLoanProduct:output_type -> google.protobuf.Empty
+	33, // 93: gidyon.loan.LoanProductAPI.GetPortfolioReport:output_type -> gidyon.loan.PortfolioReport
+	87, // 94: gidyon.loan.LoanAPI.CreateLoan:output_type -> google.protobuf.Empty
+	87, // 95: gidyon.loan.LoanAPI.UpdateLoan:output_type -> google.protobuf.Empty
+	40, // 96: gidyon.loan.LoanAPI.ListLoans:output_type -> gidyon.loan.ListLoansResponse
+	23, // 97: gidyon.loan.LoanAPI.GetLoan:output_type -> gidyon.loan.Loan
+	87, // 98: gidyon.loan.LoanAPI.ApproveLoan:output_type -> google.protobuf.Empty
+	79, // 99: gidyon.loan.LoanAPI.CheckEligibility:output_type -> gidyon.loan.CheckEligibilityResponse
+	81, // 100: gidyon.loan.LoanAPI.GetMemberCreditProfile:output_type -> gidyon.loan.MemberCreditProfile
+	87, // 101: gidyon.loan.LoanAPI.CastLoanVote:output_type -> google.protobuf.Empty
+	47, // 102: gidyon.loan.LoanAPI.ListPendingApprovals:output_type -> gidyon.loan.ListPendingApprovalsResponse
+	23, // 103: gidyon.loan.LoanAPI.TopUpLoan:output_type -> gidyon.loan.Loan
+	23, // 104: gidyon.loan.LoanAPI.CancelLoan:output_type -> gidyon.loan.Loan
+	23, // 105: gidyon.loan.LoanAPI.RestructureLoan:output_type -> gidyon.loan.Loan
+	53, // 106: gidyon.loan.LoanAPI.WriteOffLoan:output_type -> gidyon.loan.WriteOffLoanResponse
+	57, // 107: gidyon.loan.LoanAPI.GetProvisioningReport:output_type -> gidyon.loan.ProvisioningReport
+	59, // 108: gidyon.loan.LoanAPI.GetPayoffQuote:output_type -> gidyon.loan.PayoffQuote
+	23, // 109: gidyon.loan.LoanAPI.RepayLoan:output_type -> gidyon.loan.Loan
+	61, // 110: gidyon.loan.LoanAPI.AddLoanCollateral:output_type -> gidyon.loan.Collateral
+	87, // 111: gidyon.loan.LoanAPI.UpdateCollateralLien:output_type -> google.protobuf.Empty
+	65, // 112: gidyon.loan.LoanAPI.ListLoanCollateral:output_type -> gidyon.loan.ListLoanCollateralResponse
+	66, // 113: gidyon.loan.LoanAPI.SetNotificationTemplate:output_type -> gidyon.loan.NotificationTemplate
+	70, // 114: gidyon.loan.LoanAPI.ListNotificationTemplates:output_type -> gidyon.loan.ListNotificationTemplatesResponse
+	73, // 115: gidyon.loan.LoanAPI.ListNotificationDeliveries:output_type -> gidyon.loan.ListNotificationDeliveriesResponse
+	74, // 116: gidyon.loan.LoanAPI.GrantRepaymentHoliday:output_type -> gidyon.loan.RepaymentHoliday
+	77, // 117: gidyon.loan.LoanAPI.ListRepaymentHolidays:output_type -> gidyon.loan.ListRepaymentHolidaysResponse
+	86, // 118: gidyon.loan.LoanAPI.GetLoanStatement:output_type -> gidyon.loan.GetLoanStatementResponse
+	87, // [87:119] is the sub-list for method output_type
+	55, // [55:87] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
+}
+
+func init() { file_loan_proto_init() }
+func file_loan_proto_init() {
+	if File_loan_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_loan_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EligibilityRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GracePolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PenaltyPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApprovalPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EarlySettlementPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanProduct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanProductVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanInstallment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Loan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLoanProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLoanProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLoanProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanProductFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoanProductsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoanProductsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPortfolioReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopBorrower); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProductPortfolio); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortfolioReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveLoanProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoanProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoansRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoansResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanApproval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CastLoanVoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingApproval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingApprovalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingApprovalsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopUpLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestructureLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteOffLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanWriteOff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteOffLoanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvisionRates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvisionBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProvisioningReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvisioningReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayoffQuoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoffQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepayLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Collateral); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddLoanCollateralRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCollateralLienRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoanCollateralRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoanCollateralResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetLoanRequest.ProtoReflect.Descriptor instead.
-func (*GetLoanRequest) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *GetLoanRequest) GetLoanId() string {
-	if x != nil {
-		return x.LoanId
-	}
-	return ""
-}
-
-type ApproveLoanRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	LoanId      string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
-	AccountName string `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-}
-
-func (x *ApproveLoanRequest) Reset() {
-	*x = ApproveLoanRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ApproveLoanRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ApproveLoanRequest) ProtoMessage() {}
-
-func (x *ApproveLoanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_loan_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ApproveLoanRequest.ProtoReflect.Descriptor instead.
-func (*ApproveLoanRequest) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *ApproveLoanRequest) GetLoanId() string {
-	if x != nil {
-		return x.LoanId
-	}
-	return ""
-}
-
-func (x *ApproveLoanRequest) GetAccountName() string {
-	if x != nil {
-		return x.AccountName
-	}
-	return ""
-}
-
-var File_loan_proto protoreflect.FileDescriptor
-
-var file_loan_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x67, 0x69,
-	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf7, 0x04, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x6e, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x10, 0x6c, 0x6f, 0x61, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x61, 0x79, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
-	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x6f, 0x61,
-	0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6c, 0x6f, 0x61, 0x6e, 0x4d, 0x69, 0x6e, 0x69,
-	0x6d, 0x75, 0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x6f, 0x61,
-	0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6c, 0x6f, 0x61, 0x6e, 0x4d, 0x61, 0x78, 0x69,
-	0x6d, 0x75, 0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x6f, 0x61,
-	0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6c, 0x6f, 0x61, 0x6e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6c,
-	0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6c, 0x6f, 0x61, 0x6e,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x30, 0x0a, 0x14, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6c,
-	0x6f, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x61,
-	0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x64, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65,
-	0x22, 0xce, 0x04, 0x0a, 0x04, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e,
-	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x61,
-	0x6e, 0x65, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x6c, 0x6f, 0x61, 0x6e, 0x65, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
-	0x6c, 0x6f, 0x61, 0x6e, 0x65, 0x65, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x61, 0x6e, 0x65, 0x65, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12,
-	0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x61, 0x6e, 0x65, 0x65, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x61, 0x6e, 0x65, 0x65, 0x45, 0x6d, 0x61,
-	0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12,
-	0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f,
-	0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79,
-	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
-	0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f,
-	0x61, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0a, 0x6c, 0x6f, 0x61, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x61,
-	0x6c, 0x74, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d,
-	0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x61, 0x74,
-	0x65, 0x22, 0x5c, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
-	0x0c, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61,
-	0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x42, 0x03, 0xe0,
-	0x41, 0x02, 0x52, 0x0b, 0x6c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22,
-	0x5c, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x6c,
-	0x6f, 0x61, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e,
-	0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x42, 0x03, 0xe0, 0x41, 0x02,
-	0x52, 0x0b, 0x6c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x3e, 0x0a,
-	0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
-	0x41, 0x02, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x22, 0x30, 0x0a,
-	0x11, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x73, 0x22,
-	0x8d, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x69,
-	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22,
-	0x81, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d,
-	0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61,
-	0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x0c, 0x6c,
-	0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
-	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x3b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64,
-	0x22, 0x3f, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61,
-	0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6c, 0x6f, 0x61,
-	0x6e, 0x22, 0x3f, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f,
-	0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6c, 0x6f,
-	0x61, 0x6e, 0x22, 0x4a, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a,
-	0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x73, 0x22, 0x7f,
-	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
-	0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22,
-	0x64, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61,
-	0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x26, 0x0a,
-	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x6c,
-	0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
-	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x07, 0x6c,
-	0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x03, 0xe0, 0x41, 0x02, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x2a, 0x7f, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x14, 0x0a, 0x10, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f,
-	0x56, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45,
-	0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x55, 0x4e, 0x44, 0x53, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x44, 0x52, 0x41, 0x57, 0x4e, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x02,
-	0x12, 0x1a, 0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x55, 0x4e, 0x44,
-	0x53, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10,
-	0x46, 0x55, 0x4e, 0x44, 0x53, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x45, 0x44,
-	0x10, 0x04, 0x32, 0xda, 0x05, 0x0a, 0x0e, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x41, 0x50, 0x49, 0x12, 0x78, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c,
-	0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x25, 0x2e, 0x67, 0x69, 0x64,
-	0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c,
-	0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1e, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f,
-	0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12,
-	0x92, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x25, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c,
-	0x6f, 0x61, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x32, 0x33, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x4c, 0x6f, 0x61, 0x6e, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64,
-	0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x82, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c,
-	0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x25, 0x2e, 0x67, 0x69, 0x64,
-	0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c,
-	0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x28, 0x2a, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f,
-	0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xb3, 0x01, 0x0a, 0x10, 0x4c, 0x69,
-	0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x24,
-	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f,
-	0x61, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x4c, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d,
-	0x61, 0x2f, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x5a, 0x2f,
-	0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x4c,
-	0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74,
-	0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12,
-	0x7e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x12, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e,
-	0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c,
-	0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22,
-	0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61,
-	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x32,
-	0xc2, 0x04, 0x0a, 0x07, 0x4c, 0x6f, 0x61, 0x6e, 0x41, 0x50, 0x49, 0x12, 0x63, 0x0a, 0x0a, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79,
-	0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f,
-	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x01, 0x2a,
-	0x12, 0x72, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1e,
-	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x32, 0x21,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61,
-	0x6e, 0x73, 0x2f, 0x7b, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64,
-	0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x89, 0x01, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61,
-	0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x5a, 0x21, 0x22,
-	0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f,
-	0x61, 0x6e, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x01, 0x2a,
-	0x12, 0x5f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1b, 0x2e, 0x67, 0x69,
-	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
-	0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x22, 0x24, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d,
-	0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x2f, 0x7b, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64,
-	0x7d, 0x12, 0x71, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x6e,
-	0x12, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x23, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f,
-	0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4c, 0x6f, 0x61,
-	0x6e, 0x3a, 0x01, 0x2a, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x61, 0x6d,
-	0x61, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61,
-	0x63, 0x68, 0x61, 0x6d, 0x61, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
-}
-
-var (
-	file_loan_proto_rawDescOnce sync.Once
-	file_loan_proto_rawDescData = file_loan_proto_rawDesc
-)
-
-func file_loan_proto_rawDescGZIP() []byte {
-	file_loan_proto_rawDescOnce.Do(func() {
-		file_loan_proto_rawDescData = protoimpl.X.CompressGZIP(file_loan_proto_rawDescData)
-	})
-	return file_loan_proto_rawDescData
-}
-
-var file_loan_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_loan_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
-var file_loan_proto_goTypes = []interface{}{
-	(LoanStatus)(0),                  // 0: gidyon.loan.LoanStatus
-	(*LoanProduct)(nil),              // 1: gidyon.loan.LoanProduct
-	(*Loan)(nil),                     // 2: gidyon.loan.Loan
-	(*CreateLoanProductRequest)(nil), // 3: gidyon.loan.CreateLoanProductRequest
-	(*UpdateLoanProductRequest)(nil), // 4: gidyon.loan.UpdateLoanProductRequest
-	(*DeleteLoanProductRequest)(nil), // 5: gidyon.loan.DeleteLoanProductRequest
-	(*LoanProductFilter)(nil),        // 6: gidyon.loan.LoanProductFilter
-	(*ListLoanProductsRequest)(nil),  // 7: gidyon.loan.ListLoanProductsRequest
-	(*ListLoanProductsResponse)(nil), // 8: gidyon.loan.ListLoanProductsResponse
-	(*GetLoanProductRequest)(nil),    // 9: gidyon.loan.GetLoanProductRequest
-	(*CreateLoanRequest)(nil),        // 10: gidyon.loan.CreateLoanRequest
-	(*UpdateLoanRequest)(nil),        // 11: gidyon.loan.UpdateLoanRequest
-	(*LoanFilter)(nil),               // 12: gidyon.loan.LoanFilter
-	(*ListLoansRequest)(nil),         // 13: gidyon.loan.ListLoansRequest
-	(*ListLoansResponse)(nil),        // 14: gidyon.loan.ListLoansResponse
-	(*GetLoanRequest)(nil),           // 15: gidyon.loan.GetLoanRequest
-	(*ApproveLoanRequest)(nil),       // 16: gidyon.loan.ApproveLoanRequest
-	(*emptypb.Empty)(nil),            // 17: google.protobuf.Empty
-}
-var file_loan_proto_depIdxs = []int32{
-	0,  // 0: gidyon.loan.Loan.status:type_name -> gidyon.loan.LoanStatus
-	1,  // 1: gidyon.loan.CreateLoanProductRequest.loan_product:type_name -> gidyon.loan.LoanProduct
-	1,  // 2: gidyon.loan.UpdateLoanProductRequest.loan_product:type_name -> gidyon.loan.LoanProduct
-	6,  // 3: gidyon.loan.ListLoanProductsRequest.filter:type_name -> gidyon.loan.LoanProductFilter
-	1,  // 4: gidyon.loan.ListLoanProductsResponse.loan_products:type_name -> gidyon.loan.LoanProduct
-	2,  // 5: gidyon.loan.CreateLoanRequest.loan:type_name -> gidyon.loan.Loan
-	2,  // 6: gidyon.loan.UpdateLoanRequest.loan:type_name -> gidyon.loan.Loan
-	12, // 7: gidyon.loan.ListLoansRequest.filter:type_name -> gidyon.loan.LoanFilter
-	2,  // 8: gidyon.loan.ListLoansResponse.loans:type_name -> gidyon.loan.Loan
-	3,  // 9: gidyon.loan.LoanProductAPI.CreateLoanProduct:input_type -> gidyon.loan.CreateLoanProductRequest
-	4,  // 10: gidyon.loan.LoanProductAPI.UpdateLoanProduct:input_type -> gidyon.loan.UpdateLoanProductRequest
-	5,  // 11: gidyon.loan.LoanProductAPI.DeleteLoanProduct:input_type -> gidyon.loan.DeleteLoanProductRequest
-	7,  // 12: gidyon.loan.LoanProductAPI.ListLoanProducts:input_type -> gidyon.loan.ListLoanProductsRequest
-	9,  // 13: gidyon.loan.LoanProductAPI.GetLoanProduct:input_type -> gidyon.loan.GetLoanProductRequest
-	10, // 14: gidyon.loan.LoanAPI.CreateLoan:input_type -> gidyon.loan.CreateLoanRequest
-	11, // 15: gidyon.loan.LoanAPI.UpdateLoan:input_type -> gidyon.loan.UpdateLoanRequest
-	13, // 16: gidyon.loan.LoanAPI.ListLoans:input_type -> gidyon.loan.ListLoansRequest
-	15, // 17: gidyon.loan.LoanAPI.GetLoan:input_type -> gidyon.loan.GetLoanRequest
-	16, // 18: gidyon.loan.LoanAPI.ApproveLoan:input_type -> gidyon.loan.ApproveLoanRequest
-	17, // 19: gidyon.loan.LoanProductAPI.CreateLoanProduct:output_type -> google.protobuf.Empty
-	17, // 20: gidyon.loan.LoanProductAPI.UpdateLoanProduct:output_type -> google.protobuf.Empty
-	17, // 21: gidyon.loan.LoanProductAPI.DeleteLoanProduct:output_type -> google.protobuf.Empty
-	8,  // 22: gidyon.loan.LoanProductAPI.ListLoanProducts:output_type -> gidyon.loan.ListLoanProductsResponse
-	1,  // 23: gidyon.loan.LoanProductAPI.GetLoanProduct:output_type -> gidyon.loan.LoanProduct
-	17, // 24: gidyon.loan.LoanAPI.CreateLoan:output_type -> google.protobuf.Empty
-	17, // 25: gidyon.loan.LoanAPI.UpdateLoan:output_type -> google.protobuf.Empty
-	14, // 26: gidyon.loan.LoanAPI.ListLoans:output_type -> gidyon.loan.ListLoansResponse
-	2,  // 27: gidyon.loan.LoanAPI.GetLoan:output_type -> gidyon.loan.Loan
-	17, // 28: gidyon.loan.LoanAPI.ApproveLoan:output_type -> google.protobuf.Empty
-	19, // [19:29] is the sub-list for method output_type
-	9,  // [9:19] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
-}
-
-func init() { file_loan_proto_init() }
-func file_loan_proto_init() {
-	if File_loan_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_loan_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoanProduct); i {
+		file_loan_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNotificationTemplateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1490,8 +8355,8 @@ func file_loan_proto_init() {
 				return nil
 			}
 		}
-		file_loan_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Loan); i {
+		file_loan_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNotificationTemplatesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1502,8 +8367,8 @@ func file_loan_proto_init() {
 				return nil
 			}
 		}
-		file_loan_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateLoanProductRequest); i {
+		file_loan_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNotificationTemplatesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1514,8 +8379,8 @@ func file_loan_proto_init() {
 				return nil
 			}
 		}
-		file_loan_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLoanProductRequest); i {
+		file_loan_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationDeliveryFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1526,8 +8391,8 @@ func file_loan_proto_init() {
 				return nil
 			}
 		}
-		file_loan_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteLoanProductRequest); i {
+		file_loan_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNotificationDeliveriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1538,8 +8403,8 @@ func file_loan_proto_init() {
 				return nil
 			}
 		}
-		file_loan_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoanProductFilter); i {
+		file_loan_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNotificationDeliveriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1550,8 +8415,8 @@ func file_loan_proto_init() {
 				return nil
 			}
 		}
-		file_loan_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListLoanProductsRequest); i {
+		file_loan_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepaymentHoliday); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1562,8 +8427,8 @@ func file_loan_proto_init() {
 				return nil
 			}
 		}
-		file_loan_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListLoanProductsResponse); i {
+		file_loan_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantRepaymentHolidayRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1574,8 +8439,8 @@ func file_loan_proto_init() {
 				return nil
 			}
 		}
-		file_loan_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLoanProductRequest); i {
+		file_loan_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRepaymentHolidaysRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1586,8 +8451,8 @@ func file_loan_proto_init() {
 				return nil
 			}
 		}
-		file_loan_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateLoanRequest); i {
+		file_loan_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRepaymentHolidaysResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1598,8 +8463,8 @@ func file_loan_proto_init() {
 				return nil
 			}
 		}
-		file_loan_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLoanRequest); i {
+		file_loan_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckEligibilityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1610,8 +8475,8 @@ func file_loan_proto_init() {
 				return nil
 			}
 		}
-		file_loan_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoanFilter); i {
+		file_loan_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckEligibilityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1622,8 +8487,8 @@ func file_loan_proto_init() {
 				return nil
 			}
 		}
-		file_loan_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListLoansRequest); i {
+		file_loan_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreditFactor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1634,8 +8499,8 @@ func file_loan_proto_init() {
 				return nil
 			}
 		}
-		file_loan_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListLoansResponse); i {
+		file_loan_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberCreditProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1646,8 +8511,8 @@ func file_loan_proto_init() {
 				return nil
 			}
 		}
-		file_loan_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLoanRequest); i {
+		file_loan_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMemberCreditProfileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1658,8 +8523,44 @@ func file_loan_proto_init() {
 				return nil
 			}
 		}
-		file_loan_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApproveLoanRequest); i {
+		file_loan_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanStatement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoanStatementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoanStatementResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1676,8 +8577,8 @@ func file_loan_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_loan_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   16,
+			NumEnums:      14,
+			NumMessages:   73,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -23,6 +23,8 @@ type LoanProductAPIClient interface {
 	DeleteLoanProduct(ctx context.Context, in *DeleteLoanProductRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListLoanProducts(ctx context.Context, in *ListLoanProductsRequest, opts ...grpc.CallOption) (*ListLoanProductsResponse, error)
 	GetLoanProduct(ctx context.Context, in *GetLoanProductRequest, opts ...grpc.CallOption) (*LoanProduct, error)
+	ArchiveLoanProduct(ctx context.Context, in *ArchiveLoanProductRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetPortfolioReport(ctx context.Context, in *GetPortfolioReportRequest, opts ...grpc.CallOption) (*PortfolioReport, error)
 }
 
 type loanProductAPIClient struct {
@@ -78,6 +80,24 @@ func (c *loanProductAPIClient) GetLoanProduct(ctx context.Context, in *GetLoanPr
 	return out, nil
 }
 
+func (c *loanProductAPIClient) ArchiveLoanProduct(ctx context.Context, in *ArchiveLoanProductRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanProductAPI/ArchiveLoanProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanProductAPIClient) GetPortfolioReport(ctx context.Context, in *GetPortfolioReportRequest, opts ...grpc.CallOption) (*PortfolioReport, error) {
+	out := new(PortfolioReport)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanProductAPI/GetPortfolioReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LoanProductAPIServer is the server API for LoanProductAPI service.
 // All implementations must embed UnimplementedLoanProductAPIServer
 // for forward compatibility
@@ -87,6 +107,8 @@ type LoanProductAPIServer interface {
 	DeleteLoanProduct(context.Context, *DeleteLoanProductRequest) (*emptypb.Empty, error)
 	ListLoanProducts(context.Context, *ListLoanProductsRequest) (*ListLoanProductsResponse, error)
 	GetLoanProduct(context.Context, *GetLoanProductRequest) (*LoanProduct, error)
+	ArchiveLoanProduct(context.Context, *ArchiveLoanProductRequest) (*emptypb.Empty, error)
+	GetPortfolioReport(context.Context, *GetPortfolioReportRequest) (*PortfolioReport, error)
 	mustEmbedUnimplementedLoanProductAPIServer()
 }
 
@@ -109,6 +131,12 @@ func (UnimplementedLoanProductAPIServer) ListLoanProducts(context.Context, *List
 func (UnimplementedLoanProductAPIServer) GetLoanProduct(context.Context, *GetLoanProductRequest) (*LoanProduct, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLoanProduct not implemented")
 }
+func (UnimplementedLoanProductAPIServer) ArchiveLoanProduct(context.Context, *ArchiveLoanProductRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveLoanProduct not implemented")
+}
+func (UnimplementedLoanProductAPIServer) GetPortfolioReport(context.Context, *GetPortfolioReportRequest) (*PortfolioReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioReport not implemented")
+}
 func (UnimplementedLoanProductAPIServer) mustEmbedUnimplementedLoanProductAPIServer() {}
 
 // UnsafeLoanProductAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -212,6 +240,42 @@ func _LoanProductAPI_GetLoanProduct_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoanProductAPI_ArchiveLoanProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveLoanProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanProductAPIServer).ArchiveLoanProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanProductAPI/ArchiveLoanProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanProductAPIServer).ArchiveLoanProduct(ctx, req.(*ArchiveLoanProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanProductAPI_GetPortfolioReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanProductAPIServer).GetPortfolioReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanProductAPI/GetPortfolioReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanProductAPIServer).GetPortfolioReport(ctx, req.(*GetPortfolioReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _LoanProductAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gidyon.loan.LoanProductAPI",
 	HandlerType: (*LoanProductAPIServer)(nil),
@@ -236,6 +300,14 @@ var _LoanProductAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetLoanProduct",
 			Handler:    _LoanProductAPI_GetLoanProduct_Handler,
 		},
+		{
+			MethodName: "ArchiveLoanProduct",
+			Handler:    _LoanProductAPI_ArchiveLoanProduct_Handler,
+		},
+		{
+			MethodName: "GetPortfolioReport",
+			Handler:    _LoanProductAPI_GetPortfolioReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "loan.proto",
@@ -250,6 +322,26 @@ type LoanAPIClient interface {
 	ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error)
 	GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*Loan, error)
 	ApproveLoan(ctx context.Context, in *ApproveLoanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CheckEligibility(ctx context.Context, in *CheckEligibilityRequest, opts ...grpc.CallOption) (*CheckEligibilityResponse, error)
+	GetMemberCreditProfile(ctx context.Context, in *GetMemberCreditProfileRequest, opts ...grpc.CallOption) (*MemberCreditProfile, error)
+	CastLoanVote(ctx context.Context, in *CastLoanVoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListPendingApprovals(ctx context.Context, in *ListPendingApprovalsRequest, opts ...grpc.CallOption) (*ListPendingApprovalsResponse, error)
+	TopUpLoan(ctx context.Context, in *TopUpLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	CancelLoan(ctx context.Context, in *CancelLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	RestructureLoan(ctx context.Context, in *RestructureLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	WriteOffLoan(ctx context.Context, in *WriteOffLoanRequest, opts ...grpc.CallOption) (*WriteOffLoanResponse, error)
+	GetProvisioningReport(ctx context.Context, in *GetProvisioningReportRequest, opts ...grpc.CallOption) (*ProvisioningReport, error)
+	GetPayoffQuote(ctx context.Context, in *GetPayoffQuoteRequest, opts ...grpc.CallOption) (*PayoffQuote, error)
+	RepayLoan(ctx context.Context, in *RepayLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	AddLoanCollateral(ctx context.Context, in *AddLoanCollateralRequest, opts ...grpc.CallOption) (*Collateral, error)
+	UpdateCollateralLien(ctx context.Context, in *UpdateCollateralLienRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListLoanCollateral(ctx context.Context, in *ListLoanCollateralRequest, opts ...grpc.CallOption) (*ListLoanCollateralResponse, error)
+	SetNotificationTemplate(ctx context.Context, in *SetNotificationTemplateRequest, opts ...grpc.CallOption) (*NotificationTemplate, error)
+	ListNotificationTemplates(ctx context.Context, in *ListNotificationTemplatesRequest, opts ...grpc.CallOption) (*ListNotificationTemplatesResponse, error)
+	ListNotificationDeliveries(ctx context.Context, in *ListNotificationDeliveriesRequest, opts ...grpc.CallOption) (*ListNotificationDeliveriesResponse, error)
+	GrantRepaymentHoliday(ctx context.Context, in *GrantRepaymentHolidayRequest, opts ...grpc.CallOption) (*RepaymentHoliday, error)
+	ListRepaymentHolidays(ctx context.Context, in *ListRepaymentHolidaysRequest, opts ...grpc.CallOption) (*ListRepaymentHolidaysResponse, error)
+	GetLoanStatement(ctx context.Context, in *GetLoanStatementRequest, opts ...grpc.CallOption) (*GetLoanStatementResponse, error)
 }
 
 type loanAPIClient struct {
@@ -305,6 +397,186 @@ func (c *loanAPIClient) ApproveLoan(ctx context.Context, in *ApproveLoanRequest,
 	return out, nil
 }
 
+func (c *loanAPIClient) CheckEligibility(ctx context.Context, in *CheckEligibilityRequest, opts ...grpc.CallOption) (*CheckEligibilityResponse, error) {
+	out := new(CheckEligibilityResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/CheckEligibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) GetMemberCreditProfile(ctx context.Context, in *GetMemberCreditProfileRequest, opts ...grpc.CallOption) (*MemberCreditProfile, error) {
+	out := new(MemberCreditProfile)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/GetMemberCreditProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) CastLoanVote(ctx context.Context, in *CastLoanVoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/CastLoanVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) ListPendingApprovals(ctx context.Context, in *ListPendingApprovalsRequest, opts ...grpc.CallOption) (*ListPendingApprovalsResponse, error) {
+	out := new(ListPendingApprovalsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/ListPendingApprovals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) TopUpLoan(ctx context.Context, in *TopUpLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/TopUpLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) CancelLoan(ctx context.Context, in *CancelLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/CancelLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) RestructureLoan(ctx context.Context, in *RestructureLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/RestructureLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) WriteOffLoan(ctx context.Context, in *WriteOffLoanRequest, opts ...grpc.CallOption) (*WriteOffLoanResponse, error) {
+	out := new(WriteOffLoanResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/WriteOffLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) GetProvisioningReport(ctx context.Context, in *GetProvisioningReportRequest, opts ...grpc.CallOption) (*ProvisioningReport, error) {
+	out := new(ProvisioningReport)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/GetProvisioningReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) GetPayoffQuote(ctx context.Context, in *GetPayoffQuoteRequest, opts ...grpc.CallOption) (*PayoffQuote, error) {
+	out := new(PayoffQuote)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/GetPayoffQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) RepayLoan(ctx context.Context, in *RepayLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/RepayLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) AddLoanCollateral(ctx context.Context, in *AddLoanCollateralRequest, opts ...grpc.CallOption) (*Collateral, error) {
+	out := new(Collateral)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/AddLoanCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) UpdateCollateralLien(ctx context.Context, in *UpdateCollateralLienRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/UpdateCollateralLien", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) ListLoanCollateral(ctx context.Context, in *ListLoanCollateralRequest, opts ...grpc.CallOption) (*ListLoanCollateralResponse, error) {
+	out := new(ListLoanCollateralResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/ListLoanCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) SetNotificationTemplate(ctx context.Context, in *SetNotificationTemplateRequest, opts ...grpc.CallOption) (*NotificationTemplate, error) {
+	out := new(NotificationTemplate)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/SetNotificationTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) ListNotificationTemplates(ctx context.Context, in *ListNotificationTemplatesRequest, opts ...grpc.CallOption) (*ListNotificationTemplatesResponse, error) {
+	out := new(ListNotificationTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/ListNotificationTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) ListNotificationDeliveries(ctx context.Context, in *ListNotificationDeliveriesRequest, opts ...grpc.CallOption) (*ListNotificationDeliveriesResponse, error) {
+	out := new(ListNotificationDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/ListNotificationDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) GrantRepaymentHoliday(ctx context.Context, in *GrantRepaymentHolidayRequest, opts ...grpc.CallOption) (*RepaymentHoliday, error) {
+	out := new(RepaymentHoliday)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/GrantRepaymentHoliday", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) ListRepaymentHolidays(ctx context.Context, in *ListRepaymentHolidaysRequest, opts ...grpc.CallOption) (*ListRepaymentHolidaysResponse, error) {
+	out := new(ListRepaymentHolidaysResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/ListRepaymentHolidays", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanAPIClient) GetLoanStatement(ctx context.Context, in *GetLoanStatementRequest, opts ...grpc.CallOption) (*GetLoanStatementResponse, error) {
+	out := new(GetLoanStatementResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.loan.LoanAPI/GetLoanStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LoanAPIServer is the server API for LoanAPI service.
 // All implementations must embed UnimplementedLoanAPIServer
 // for forward compatibility
@@ -314,6 +586,26 @@ type LoanAPIServer interface {
 	ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error)
 	GetLoan(context.Context, *GetLoanRequest) (*Loan, error)
 	ApproveLoan(context.Context, *ApproveLoanRequest) (*emptypb.Empty, error)
+	CheckEligibility(context.Context, *CheckEligibilityRequest) (*CheckEligibilityResponse, error)
+	GetMemberCreditProfile(context.Context, *GetMemberCreditProfileRequest) (*MemberCreditProfile, error)
+	CastLoanVote(context.Context, *CastLoanVoteRequest) (*emptypb.Empty, error)
+	ListPendingApprovals(context.Context, *ListPendingApprovalsRequest) (*ListPendingApprovalsResponse, error)
+	TopUpLoan(context.Context, *TopUpLoanRequest) (*Loan, error)
+	CancelLoan(context.Context, *CancelLoanRequest) (*Loan, error)
+	RestructureLoan(context.Context, *RestructureLoanRequest) (*Loan, error)
+	WriteOffLoan(context.Context, *WriteOffLoanRequest) (*WriteOffLoanResponse, error)
+	GetProvisioningReport(context.Context, *GetProvisioningReportRequest) (*ProvisioningReport, error)
+	GetPayoffQuote(context.Context, *GetPayoffQuoteRequest) (*PayoffQuote, error)
+	RepayLoan(context.Context, *RepayLoanRequest) (*Loan, error)
+	AddLoanCollateral(context.Context, *AddLoanCollateralRequest) (*Collateral, error)
+	UpdateCollateralLien(context.Context, *UpdateCollateralLienRequest) (*emptypb.Empty, error)
+	ListLoanCollateral(context.Context, *ListLoanCollateralRequest) (*ListLoanCollateralResponse, error)
+	SetNotificationTemplate(context.Context, *SetNotificationTemplateRequest) (*NotificationTemplate, error)
+	ListNotificationTemplates(context.Context, *ListNotificationTemplatesRequest) (*ListNotificationTemplatesResponse, error)
+	ListNotificationDeliveries(context.Context, *ListNotificationDeliveriesRequest) (*ListNotificationDeliveriesResponse, error)
+	GrantRepaymentHoliday(context.Context, *GrantRepaymentHolidayRequest) (*RepaymentHoliday, error)
+	ListRepaymentHolidays(context.Context, *ListRepaymentHolidaysRequest) (*ListRepaymentHolidaysResponse, error)
+	GetLoanStatement(context.Context, *GetLoanStatementRequest) (*GetLoanStatementResponse, error)
 	mustEmbedUnimplementedLoanAPIServer()
 }
 
@@ -336,6 +628,66 @@ func (UnimplementedLoanAPIServer) GetLoan(context.Context, *GetLoanRequest) (*Lo
 func (UnimplementedLoanAPIServer) ApproveLoan(context.Context, *ApproveLoanRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApproveLoan not implemented")
 }
+func (UnimplementedLoanAPIServer) CheckEligibility(context.Context, *CheckEligibilityRequest) (*CheckEligibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckEligibility not implemented")
+}
+func (UnimplementedLoanAPIServer) GetMemberCreditProfile(context.Context, *GetMemberCreditProfileRequest) (*MemberCreditProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemberCreditProfile not implemented")
+}
+func (UnimplementedLoanAPIServer) CastLoanVote(context.Context, *CastLoanVoteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CastLoanVote not implemented")
+}
+func (UnimplementedLoanAPIServer) ListPendingApprovals(context.Context, *ListPendingApprovalsRequest) (*ListPendingApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingApprovals not implemented")
+}
+func (UnimplementedLoanAPIServer) TopUpLoan(context.Context, *TopUpLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpLoan not implemented")
+}
+func (UnimplementedLoanAPIServer) CancelLoan(context.Context, *CancelLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLoan not implemented")
+}
+func (UnimplementedLoanAPIServer) RestructureLoan(context.Context, *RestructureLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestructureLoan not implemented")
+}
+func (UnimplementedLoanAPIServer) WriteOffLoan(context.Context, *WriteOffLoanRequest) (*WriteOffLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteOffLoan not implemented")
+}
+func (UnimplementedLoanAPIServer) GetProvisioningReport(context.Context, *GetProvisioningReportRequest) (*ProvisioningReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProvisioningReport not implemented")
+}
+func (UnimplementedLoanAPIServer) GetPayoffQuote(context.Context, *GetPayoffQuoteRequest) (*PayoffQuote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayoffQuote not implemented")
+}
+func (UnimplementedLoanAPIServer) RepayLoan(context.Context, *RepayLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepayLoan not implemented")
+}
+func (UnimplementedLoanAPIServer) AddLoanCollateral(context.Context, *AddLoanCollateralRequest) (*Collateral, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLoanCollateral not implemented")
+}
+func (UnimplementedLoanAPIServer) UpdateCollateralLien(context.Context, *UpdateCollateralLienRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollateralLien not implemented")
+}
+func (UnimplementedLoanAPIServer) ListLoanCollateral(context.Context, *ListLoanCollateralRequest) (*ListLoanCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoanCollateral not implemented")
+}
+func (UnimplementedLoanAPIServer) SetNotificationTemplate(context.Context, *SetNotificationTemplateRequest) (*NotificationTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNotificationTemplate not implemented")
+}
+func (UnimplementedLoanAPIServer) ListNotificationTemplates(context.Context, *ListNotificationTemplatesRequest) (*ListNotificationTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotificationTemplates not implemented")
+}
+func (UnimplementedLoanAPIServer) ListNotificationDeliveries(context.Context, *ListNotificationDeliveriesRequest) (*ListNotificationDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotificationDeliveries not implemented")
+}
+func (UnimplementedLoanAPIServer) GrantRepaymentHoliday(context.Context, *GrantRepaymentHolidayRequest) (*RepaymentHoliday, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRepaymentHoliday not implemented")
+}
+func (UnimplementedLoanAPIServer) ListRepaymentHolidays(context.Context, *ListRepaymentHolidaysRequest) (*ListRepaymentHolidaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRepaymentHolidays not implemented")
+}
+func (UnimplementedLoanAPIServer) GetLoanStatement(context.Context, *GetLoanStatementRequest) (*GetLoanStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoanStatement not implemented")
+}
 func (UnimplementedLoanAPIServer) mustEmbedUnimplementedLoanAPIServer() {}
 
 // UnsafeLoanAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -439,6 +791,366 @@ func _LoanAPI_ApproveLoan_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoanAPI_CheckEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckEligibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).CheckEligibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/CheckEligibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).CheckEligibility(ctx, req.(*CheckEligibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_GetMemberCreditProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberCreditProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).GetMemberCreditProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/GetMemberCreditProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).GetMemberCreditProfile(ctx, req.(*GetMemberCreditProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_CastLoanVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CastLoanVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).CastLoanVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/CastLoanVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).CastLoanVote(ctx, req.(*CastLoanVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_ListPendingApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).ListPendingApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/ListPendingApprovals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).ListPendingApprovals(ctx, req.(*ListPendingApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_TopUpLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopUpLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).TopUpLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/TopUpLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).TopUpLoan(ctx, req.(*TopUpLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_CancelLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).CancelLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/CancelLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).CancelLoan(ctx, req.(*CancelLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_RestructureLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestructureLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).RestructureLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/RestructureLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).RestructureLoan(ctx, req.(*RestructureLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_WriteOffLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteOffLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).WriteOffLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/WriteOffLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).WriteOffLoan(ctx, req.(*WriteOffLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_GetProvisioningReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProvisioningReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).GetProvisioningReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/GetProvisioningReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).GetProvisioningReport(ctx, req.(*GetProvisioningReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_GetPayoffQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayoffQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).GetPayoffQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/GetPayoffQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).GetPayoffQuote(ctx, req.(*GetPayoffQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_RepayLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepayLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).RepayLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/RepayLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).RepayLoan(ctx, req.(*RepayLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_AddLoanCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLoanCollateralRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).AddLoanCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/AddLoanCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).AddLoanCollateral(ctx, req.(*AddLoanCollateralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_UpdateCollateralLien_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollateralLienRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).UpdateCollateralLien(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/UpdateCollateralLien",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).UpdateCollateralLien(ctx, req.(*UpdateCollateralLienRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_ListLoanCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoanCollateralRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).ListLoanCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/ListLoanCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).ListLoanCollateral(ctx, req.(*ListLoanCollateralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_SetNotificationTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotificationTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).SetNotificationTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/SetNotificationTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).SetNotificationTemplate(ctx, req.(*SetNotificationTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_ListNotificationTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).ListNotificationTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/ListNotificationTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).ListNotificationTemplates(ctx, req.(*ListNotificationTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_ListNotificationDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).ListNotificationDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/ListNotificationDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).ListNotificationDeliveries(ctx, req.(*ListNotificationDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_GrantRepaymentHoliday_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantRepaymentHolidayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).GrantRepaymentHoliday(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/GrantRepaymentHoliday",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).GrantRepaymentHoliday(ctx, req.(*GrantRepaymentHolidayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_ListRepaymentHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRepaymentHolidaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).ListRepaymentHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/ListRepaymentHolidays",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).ListRepaymentHolidays(ctx, req.(*ListRepaymentHolidaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanAPI_GetLoanStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanAPIServer).GetLoanStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.loan.LoanAPI/GetLoanStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanAPIServer).GetLoanStatement(ctx, req.(*GetLoanStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _LoanAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gidyon.loan.LoanAPI",
 	HandlerType: (*LoanAPIServer)(nil),
@@ -463,6 +1175,86 @@ var _LoanAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ApproveLoan",
 			Handler:    _LoanAPI_ApproveLoan_Handler,
 		},
+		{
+			MethodName: "CheckEligibility",
+			Handler:    _LoanAPI_CheckEligibility_Handler,
+		},
+		{
+			MethodName: "GetMemberCreditProfile",
+			Handler:    _LoanAPI_GetMemberCreditProfile_Handler,
+		},
+		{
+			MethodName: "CastLoanVote",
+			Handler:    _LoanAPI_CastLoanVote_Handler,
+		},
+		{
+			MethodName: "ListPendingApprovals",
+			Handler:    _LoanAPI_ListPendingApprovals_Handler,
+		},
+		{
+			MethodName: "TopUpLoan",
+			Handler:    _LoanAPI_TopUpLoan_Handler,
+		},
+		{
+			MethodName: "CancelLoan",
+			Handler:    _LoanAPI_CancelLoan_Handler,
+		},
+		{
+			MethodName: "RestructureLoan",
+			Handler:    _LoanAPI_RestructureLoan_Handler,
+		},
+		{
+			MethodName: "WriteOffLoan",
+			Handler:    _LoanAPI_WriteOffLoan_Handler,
+		},
+		{
+			MethodName: "GetProvisioningReport",
+			Handler:    _LoanAPI_GetProvisioningReport_Handler,
+		},
+		{
+			MethodName: "GetPayoffQuote",
+			Handler:    _LoanAPI_GetPayoffQuote_Handler,
+		},
+		{
+			MethodName: "RepayLoan",
+			Handler:    _LoanAPI_RepayLoan_Handler,
+		},
+		{
+			MethodName: "AddLoanCollateral",
+			Handler:    _LoanAPI_AddLoanCollateral_Handler,
+		},
+		{
+			MethodName: "UpdateCollateralLien",
+			Handler:    _LoanAPI_UpdateCollateralLien_Handler,
+		},
+		{
+			MethodName: "ListLoanCollateral",
+			Handler:    _LoanAPI_ListLoanCollateral_Handler,
+		},
+		{
+			MethodName: "SetNotificationTemplate",
+			Handler:    _LoanAPI_SetNotificationTemplate_Handler,
+		},
+		{
+			MethodName: "ListNotificationTemplates",
+			Handler:    _LoanAPI_ListNotificationTemplates_Handler,
+		},
+		{
+			MethodName: "ListNotificationDeliveries",
+			Handler:    _LoanAPI_ListNotificationDeliveries_Handler,
+		},
+		{
+			MethodName: "GrantRepaymentHoliday",
+			Handler:    _LoanAPI_GrantRepaymentHoliday_Handler,
+		},
+		{
+			MethodName: "ListRepaymentHolidays",
+			Handler:    _LoanAPI_ListRepaymentHolidays_Handler,
+		},
+		{
+			MethodName: "GetLoanStatement",
+			Handler:    _LoanAPI_GetLoanStatement_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "loan.proto",